@@ -0,0 +1,60 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+
+	"github.com/fonero-project/fnowallet/auditlog"
+	"github.com/fonero-project/fnowallet/rpc/rpcserver"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// auditRPC records a call of a sensitive RPC method in the audit log, if
+// enabled.  The wallet records the details of the operations it performs, and
+// these entries record who requested them.
+func auditRPC(source, method, client, remote string, err error) {
+	if cfg.auditLog == nil {
+		return
+	}
+	e := &auditlog.Entry{
+		Source:    source,
+		Operation: method,
+		Client:    client,
+		Remote:    remote,
+	}
+	if err != nil {
+		e.Error = err.Error()
+	}
+	if err := cfg.auditLog.Append(e); err != nil {
+		log.Errorf("Failed to write audit log entry for %s: %v", method, err)
+	}
+}
+
+// auditGRPC records a completed or denied call of a gRPC method requiring
+// full permission.  Calls made by the gateway are recorded with the gateway
+// client's identity.
+func auditGRPC(ctx context.Context, method string, err error) {
+	if cfg.auditLog == nil {
+		return
+	}
+	c := grpcCallerFromContext(ctx)
+	source := auditlog.SourceGRPC
+	if c.gateway {
+		source = auditlog.SourceGateway
+	}
+	auditGRPCCaller(source, c, method, err)
+}
+
+// auditGRPCCaller records a call of a gRPC method by a described client when
+// the method requires full permission.  Rate limited calls are not recorded.
+func auditGRPCCaller(source string, c grpcCaller, method string, err error) {
+	if rpcserver.MethodPermission(method) != rpcserver.Full ||
+		status.Code(err) == codes.ResourceExhausted {
+		return
+	}
+	auditRPC(source, method, c.client, c.remote, err)
+}
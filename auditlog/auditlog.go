@@ -0,0 +1,303 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package auditlog implements an append-only, hash-chained log of sensitive
+// wallet operations.
+//
+// The log is a file of JSON entries, one per line.  Each entry records the hash
+// of the previous entry and its own hash, which is the SHA-256 hash of the JSON
+// encoding of the entry without the hash field.  Modifying, removing, or
+// reordering any entry other than the last breaks the chain.  Removal of the
+// final entries can only be detected by comparing the last hash with a
+// previously recorded copy.
+package auditlog
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/fonero-project/fnowallet/errors"
+)
+
+// Sources of entries.
+const (
+	SourceWallet  = "wallet"
+	SourceGRPC    = "grpc"
+	SourceGateway = "gateway"
+	SourceJSONRPC = "jsonrpc"
+)
+
+// GenesisHash is the previous hash of the first entry.
+var GenesisHash = hex.EncodeToString(make([]byte, sha256.Size))
+
+// Entry is a record of an operation.
+type Entry struct {
+	Seq       uint64            `json:"seq"`
+	Time      string            `json:"time"` // RFC 3339 in UTC
+	Source    string            `json:"source"`
+	Operation string            `json:"operation"`
+	Client    string            `json:"client,omitempty"`
+	Remote    string            `json:"remote,omitempty"`
+	Details   map[string]string `json:"details,omitempty"`
+	Error     string            `json:"error,omitempty"`
+	PrevHash  string            `json:"prev"`
+	Hash      string            `json:"hash,omitempty"`
+}
+
+// computeHash returns the hex encoded hash of the entry without its hash field.
+func (e *Entry) computeHash() (string, error) {
+	c := *e
+	c.Hash = ""
+	b, err := json.Marshal(&c)
+	if err != nil {
+		return "", err
+	}
+	h := sha256.Sum256(b)
+	return hex.EncodeToString(h[:]), nil
+}
+
+// Log is an open audit log.  A nil Log discards all entries.
+type Log struct {
+	mu      sync.Mutex
+	path    string
+	f       *os.File
+	seq     uint64
+	prev    string
+	offsets []int64 // File offsets of each entry, indexed by Seq-1
+	size    int64   // End of the last entry
+}
+
+// Open opens the audit log at path, creating it if it does not exist.  The
+// existing entries are verified and an error is returned if the hash chain is
+// broken.  A final line which is missing its newline, which may be left by a
+// crash during a write, is removed.
+func Open(path string) (*Log, error) {
+	const op errors.Op = "auditlog.Open"
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	r := &chainReader{r: bufio.NewReader(f), prev: GenesisHash}
+	var offsets []int64
+	for {
+		offset := r.offset
+		_, err := r.next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			f.Close()
+			return nil, errors.E(op, err)
+		}
+		offsets = append(offsets, offset)
+	}
+	if r.partial {
+		err = f.Truncate(r.offset)
+		if err != nil {
+			f.Close()
+			return nil, errors.E(op, err)
+		}
+	}
+	_, err = f.Seek(r.offset, io.SeekStart)
+	if err != nil {
+		f.Close()
+		return nil, errors.E(op, err)
+	}
+	l := &Log{
+		path:    path,
+		f:       f,
+		seq:     r.seq,
+		prev:    r.prev,
+		offsets: offsets,
+		size:    r.offset,
+	}
+	return l, nil
+}
+
+// Close closes the log file.
+func (l *Log) Close() error {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.f.Close()
+}
+
+// Append sets the sequence number, time, and hashes of the entry and writes it
+// to the log.  The file is synced before returning.
+func (l *Log) Append(e *Entry) error {
+	const op errors.Op = "auditlog.Append"
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	e.Seq = l.seq + 1
+	e.Time = time.Now().UTC().Format(time.RFC3339Nano)
+	e.PrevHash = l.prev
+	hash, err := e.computeHash()
+	if err != nil {
+		return errors.E(op, err)
+	}
+	e.Hash = hash
+	b, err := json.Marshal(e)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	b = append(b, '\n')
+	// Writing at the end of the last entry overwrites anything left by a
+	// previously failed write.
+	_, err = l.f.WriteAt(b, l.size)
+	if err != nil {
+		return errors.E(op, errors.IO, err)
+	}
+	err = l.f.Sync()
+	if err != nil {
+		return errors.E(op, errors.IO, err)
+	}
+	l.seq = e.Seq
+	l.prev = e.Hash
+	l.offsets = append(l.offsets, l.size)
+	l.size += int64(len(b))
+	return nil
+}
+
+// Head returns the sequence number and hash of the last entry.  The sequence
+// number is zero and the hash is GenesisHash when the log is empty.
+func (l *Log) Head() (seq uint64, hash string) {
+	if l == nil {
+		return 0, GenesisHash
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.seq, l.prev
+}
+
+// Entries returns up to count entries beginning with sequence number start.
+// The entries are read from a separate file handle without blocking appends.
+func (l *Log) Entries(start uint64, count int) ([]Entry, error) {
+	const op errors.Op = "auditlog.Entries"
+	if l == nil || count <= 0 {
+		return nil, nil
+	}
+	if start == 0 {
+		start = 1
+	}
+
+	// Only the range of complete entries is read, so entries appended
+	// after the offsets are found are never partially read.
+	l.mu.Lock()
+	if start > l.seq {
+		l.mu.Unlock()
+		return nil, nil
+	}
+	begin := l.offsets[start-1]
+	end := l.size
+	if last := start - 1 + uint64(count); last < l.seq {
+		end = l.offsets[last]
+	}
+	l.mu.Unlock()
+
+	f, err := os.Open(l.path)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	defer f.Close()
+	var entries []Entry
+	s := bufio.NewScanner(io.NewSectionReader(f, begin, end-begin))
+	s.Buffer(nil, maxEntrySize)
+	for s.Scan() {
+		var e Entry
+		err := json.Unmarshal(s.Bytes(), &e)
+		if err != nil {
+			return nil, errors.E(op, errors.Encoding, err)
+		}
+		entries = append(entries, e)
+	}
+	if err := s.Err(); err != nil {
+		return nil, errors.E(op, err)
+	}
+	return entries, nil
+}
+
+// maxEntrySize is the maximum length of a single encoded entry.
+const maxEntrySize = 1024 * 1024
+
+// chainReader reads and verifies entries.
+type chainReader struct {
+	r       *bufio.Reader
+	seq     uint64
+	prev    string
+	offset  int64 // End of the last complete line
+	partial bool  // Whether a final line is missing its newline
+}
+
+func (r *chainReader) next() (*Entry, error) {
+	line, err := r.r.ReadBytes('\n')
+	if err == io.EOF {
+		if len(line) != 0 {
+			r.partial = true
+		}
+		return nil, io.EOF
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(line) > maxEntrySize {
+		return nil, errors.Errorf("entry %d is too large", r.seq+1)
+	}
+	var e Entry
+	err = json.Unmarshal(bytes.TrimSuffix(line, []byte{'\n'}), &e)
+	if err != nil {
+		return nil, errors.E(errors.Encoding,
+			errors.Errorf("entry %d is not valid JSON: %v", r.seq+1, err))
+	}
+	if e.Seq != r.seq+1 {
+		return nil, errors.Errorf("entry %d has sequence number %d", r.seq+1, e.Seq)
+	}
+	if e.PrevHash != r.prev {
+		return nil, errors.Errorf("entry %d does not chain to the previous entry", e.Seq)
+	}
+	hash, err := e.computeHash()
+	if err != nil {
+		return nil, err
+	}
+	if e.Hash != hash {
+		return nil, errors.Errorf("entry %d has an invalid hash", e.Seq)
+	}
+	r.seq = e.Seq
+	r.prev = e.Hash
+	r.offset += int64(len(line))
+	return &e, nil
+}
+
+// Verify reads and verifies all entries from r, returning the sequence number
+// and hash of the last entry.  An error describes the first entry which does
+// not verify.
+func Verify(r io.Reader) (seq uint64, hash string, err error) {
+	const op errors.Op = "auditlog.Verify"
+	cr := &chainReader{r: bufio.NewReader(r), prev: GenesisHash}
+	for {
+		_, err := cr.next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return cr.seq, cr.prev, errors.E(op, err)
+		}
+	}
+	if cr.partial {
+		return cr.seq, cr.prev, errors.E(op, errors.Errorf("entry %d is incomplete", cr.seq+1))
+	}
+	return cr.seq, cr.prev, nil
+}
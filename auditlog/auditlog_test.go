@@ -0,0 +1,179 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package auditlog
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+)
+
+func TestLog(t *testing.T) {
+	dir, err := ioutil.TempDir("", "auditlog")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "audit.log")
+
+	l, err := Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, op := range []string{"unlock", "dumpprivkey", "signtransaction"} {
+		err := l.Append(&Entry{
+			Source:    SourceWallet,
+			Operation: op,
+			Details:   map[string]string{"account": "0"},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	seq, head := l.Head()
+	if seq != 3 {
+		t.Fatalf("head sequence %d, want 3", seq)
+	}
+	entries, err := l.Entries(2, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 || entries[0].Operation != "dumpprivkey" ||
+		entries[1].Hash != head || entries[1].PrevHash != entries[0].Hash {
+		t.Fatalf("unexpected entries %+v", entries)
+	}
+	l.Close()
+
+	// Simulate a crash during a write.  The partial entry is removed when
+	// reopened and appends continue the chain.
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ioutil.WriteFile(path, append(b, `{"seq":4`...), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l, err = Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := l.Append(&Entry{Source: SourceGRPC, Operation: "lock"}); err != nil {
+		t.Fatal(err)
+	}
+	_, head = l.Head()
+	l.Close()
+
+	b, err = ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	seq, hash, err := Verify(bytes.NewReader(b))
+	if err != nil || seq != 4 || hash != head {
+		t.Fatalf("Verify = %d %s %v, want 4 %s", seq, hash, err, head)
+	}
+
+	// Modifying any entry breaks the chain.
+	tampered := bytes.Replace(b, []byte("dumpprivkey"), []byte("dumpprivkez"), 1)
+	if _, _, err := Verify(bytes.NewReader(tampered)); err == nil {
+		t.Error("verified tampered log")
+	}
+	if err := ioutil.WriteFile(path, tampered, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Open(path); err == nil {
+		t.Error("opened tampered log")
+	}
+
+	var nilLog *Log
+	if err := nilLog.Append(&Entry{}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestEntriesPages(t *testing.T) {
+	dir, err := ioutil.TempDir("", "auditlog")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "audit.log")
+
+	l, err := Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const n = 25
+	for i := 0; i < n; i++ {
+		err := l.Append(&Entry{Source: SourceWallet, Operation: fmt.Sprint(i)})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Reopening indexes the existing entries.
+	l.Close()
+	l, err = Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	tests := []struct {
+		start      uint64
+		count      int
+		first, num int
+	}{
+		{0, 10, 1, 10},
+		{1, 10, 1, 10},
+		{11, 10, 11, 10},
+		{21, 10, 21, 5},
+		{25, 1, 25, 1},
+		{26, 10, 0, 0},
+		{5, 0, 0, 0},
+	}
+	for _, test := range tests {
+		entries, err := l.Entries(test.start, test.count)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(entries) != test.num {
+			t.Errorf("Entries(%d, %d) returned %d entries, want %d",
+				test.start, test.count, len(entries), test.num)
+			continue
+		}
+		for i := range entries {
+			want := uint64(test.first + i)
+			if entries[i].Seq != want || entries[i].Operation != fmt.Sprint(want-1) {
+				t.Errorf("Entries(%d, %d)[%d] has seq %d, want %d",
+					test.start, test.count, i, entries[i].Seq, want)
+			}
+		}
+	}
+
+	// Entries read concurrently with appends are always complete.
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 50; i++ {
+			l.Append(&Entry{Source: SourceGRPC, Operation: "append"})
+		}
+	}()
+	for i := 0; i < 50; i++ {
+		seq, _ := l.Head()
+		entries, err := l.Entries(seq, 100)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(entries) == 0 || entries[0].Seq != seq {
+			t.Fatalf("Entries(%d) did not return the head entry", seq)
+		}
+	}
+	wg.Wait()
+}
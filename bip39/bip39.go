@@ -0,0 +1,115 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package bip39
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"strings"
+
+	"github.com/fonero-project/fnowallet/errors"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Entropy lengths in bytes.
+const (
+	MinEntropyBytes         = 16 // 12 words
+	MaxEntropyBytes         = 32 // 24 words
+	RecommendedEntropyBytes = 32
+)
+
+// SeedBytes is the length of seeds derived from mnemonics.
+const SeedBytes = 64
+
+const seedIterations = 2048
+
+func checkEntropyLen(n int) error {
+	if n < MinEntropyBytes || n > MaxEntropyBytes || n%4 != 0 {
+		return errors.Errorf("entropy length %d is not a multiple of 4 "+
+			"between %d and %d bytes", n, MinEntropyBytes, MaxEntropyBytes)
+	}
+	return nil
+}
+
+// EncodeMnemonic encodes entropy as a mnemonic of words separated by spaces.
+// The entropy length must be a multiple of four between MinEntropyBytes and
+// MaxEntropyBytes.
+func EncodeMnemonic(entropy []byte) (string, error) {
+	const op errors.Op = "bip39.EncodeMnemonic"
+	if err := checkEntropyLen(len(entropy)); err != nil {
+		return "", errors.E(op, errors.Invalid, err)
+	}
+
+	// The checksum is the first len(entropy)*8/32 bits of the SHA256 of the
+	// entropy, and is appended to the entropy before it is split into 11
+	// bit word indexes.
+	checksum := sha256.Sum256(entropy)
+	data := append(append([]byte(nil), entropy...), checksum[0])
+	nwords := (len(entropy)*8 + len(entropy)/4) / 11
+	words := make([]string, nwords)
+	for i := range words {
+		var index uint16
+		for bit := i * 11; bit < (i+1)*11; bit++ {
+			index = index<<1 | uint16(data[bit/8]>>(7-uint(bit%8))&1)
+		}
+		words[i] = wordList[index]
+	}
+	return strings.Join(words, " "), nil
+}
+
+// DecodeMnemonic decodes the entropy encoded by a mnemonic and checks its
+// checksum.  Words may be separated by any whitespace and are not case
+// sensitive.
+func DecodeMnemonic(mnemonic string) ([]byte, error) {
+	const op errors.Op = "bip39.DecodeMnemonic"
+	words := strings.Fields(mnemonic)
+	if len(words)%3 != 0 || len(words) < 12 || len(words) > 24 {
+		return nil, errors.E(op, errors.Encoding, errors.Errorf("invalid "+
+			"mnemonic length %d", len(words)))
+	}
+
+	nbits := len(words) * 11
+	data := make([]byte, (nbits+7)/8)
+	for i, w := range words {
+		index, ok := wordIndexes[strings.ToLower(w)]
+		if !ok {
+			err := errors.Errorf("word %v is not in the BIP-0039 word list", w)
+			return nil, errors.E(op, errors.Encoding, err)
+		}
+		for b := 0; b < 11; b++ {
+			if index&(1<<uint(10-b)) != 0 {
+				bit := i*11 + b
+				data[bit/8] |= 1 << (7 - uint(bit%8))
+			}
+		}
+	}
+
+	checksumBits := nbits / 33
+	entropy := data[:(nbits-checksumBits)/8]
+	checksum := sha256.Sum256(entropy)
+	mask := byte(0xff) << uint(8-checksumBits)
+	if data[len(entropy)]&mask != checksum[0]&mask {
+		return nil, errors.E(op, errors.Encoding, "checksum mismatch")
+	}
+	return entropy, nil
+}
+
+// Seed checks the checksum of a mnemonic and derives the seed of the mnemonic
+// and a passphrase.  The passphrase may be empty.
+func Seed(mnemonic, passphrase string) ([]byte, error) {
+	const op errors.Op = "bip39.Seed"
+	_, err := DecodeMnemonic(mnemonic)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	// The seed is derived from the mnemonic as written with single spaces
+	// in lower case, which is the form EncodeMnemonic returns.
+	mnemonic = strings.ToLower(strings.Join(strings.Fields(mnemonic), " "))
+	salt := norm.NFKD.String("mnemonic" + passphrase)
+	seed := pbkdf2.Key([]byte(norm.NFKD.String(mnemonic)), []byte(salt),
+		seedIterations, SeedBytes, sha512.New)
+	return seed, nil
+}
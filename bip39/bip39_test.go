@@ -0,0 +1,104 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package bip39
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/fonero-project/fnowallet/errors"
+)
+
+// Test vectors from the reference implementation using the passphrase
+// "TREZOR".
+var vectors = []struct {
+	entropy  string
+	mnemonic string
+	seed     string
+}{
+	{
+		entropy:  "00000000000000000000000000000000",
+		mnemonic: "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+		seed:     "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+	},
+	{
+		entropy:  "7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f",
+		mnemonic: "legal winner thank year wave sausage worth useful legal winner thank yellow",
+		seed:     "2e8905819b8723fe2c1d161860e5ee1830318dbf49a83bd451cfb8440c28bd6fa457fe1296106559a3c80937a1c1069be3a3a5bd381ee6260e8d9739fce1f607",
+	},
+	{
+		entropy:  "80808080808080808080808080808080",
+		mnemonic: "letter advice cage absurd amount doctor acoustic avoid letter advice cage above",
+		seed:     "d71de856f81a8acc65e6fc851a38d4d7ec216fd0796d0a6827a3ad6ed5511a30fa280f12eb2e47ed2ac03b5c462a0358d18d69fe4f985ec81778c1b370b652a8",
+	},
+	{
+		entropy:  "ffffffffffffffffffffffffffffffff",
+		mnemonic: "zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo wrong",
+		seed:     "ac27495480225222079d7be181583751e86f571027b0497b5b5d11218e0a8a13332572917f0f8e5a589620c6f15b11c61dee327651a14c34e18231052e48c069",
+	},
+	{
+		entropy:  "0000000000000000000000000000000000000000000000000000000000000000",
+		mnemonic: "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon art",
+		seed:     "bda85446c68413707090a52022edd26a1c9462295029f2e60cd7c4f2bbd3097170af7a4d73245cafa9c3cca8d561a7c3de6f5d4a10be8ed2a5e608d68f92fcc8",
+	},
+}
+
+func TestVectors(t *testing.T) {
+	for i, v := range vectors {
+		entropy, _ := hex.DecodeString(v.entropy)
+		mnemonic, err := EncodeMnemonic(entropy)
+		if err != nil {
+			t.Errorf("test %d: encode: %v", i, err)
+			continue
+		}
+		if mnemonic != v.mnemonic {
+			t.Errorf("test %d: got `%v` want `%v`", i, mnemonic, v.mnemonic)
+		}
+		decoded, err := DecodeMnemonic(v.mnemonic)
+		if err != nil {
+			t.Errorf("test %d: decode: %v", i, err)
+			continue
+		}
+		if !bytes.Equal(decoded, entropy) {
+			t.Errorf("test %d: got %x want %x", i, decoded, entropy)
+		}
+		seed, err := Seed(strings.ToUpper(v.mnemonic), "TREZOR")
+		if err != nil {
+			t.Errorf("test %d: seed: %v", i, err)
+			continue
+		}
+		if hex.EncodeToString(seed) != v.seed {
+			t.Errorf("test %d: got seed %x want %v", i, seed, v.seed)
+		}
+	}
+}
+
+func TestDecodeMnemonicInvalid(t *testing.T) {
+	tests := []string{
+		// Checksum mismatch
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon",
+		// Word not in the list
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon aboutt",
+		// Invalid length
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+	}
+	for i, test := range tests {
+		_, err := DecodeMnemonic(test)
+		if !errors.Is(errors.Encoding, err) {
+			t.Errorf("test %d: expected Encoding error, got %v", i, err)
+		}
+	}
+}
+
+func TestEncodeMnemonicInvalid(t *testing.T) {
+	for _, n := range []int{0, 15, 18, 36} {
+		_, err := EncodeMnemonic(make([]byte, n))
+		if !errors.Is(errors.Invalid, err) {
+			t.Errorf("entropy length %d: expected Invalid error, got %v", n, err)
+		}
+	}
+}
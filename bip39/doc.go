@@ -0,0 +1,12 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package bip39 implements the BIP-0039 mnemonic encoding of seed entropy and
+// the derivation of seeds from mnemonics.
+//
+// BIP-0039 mnemonics encode 128 to 256 bits of entropy and a checksum using
+// the English word list.  The seed used by a wallet is not the entropy, but is
+// derived from the mnemonic and an optional passphrase using PBKDF2, so a
+// mnemonic with different passphrases results in different wallets.
+package bip39
@@ -8,14 +8,19 @@ import (
 	"context"
 	"encoding/hex"
 	"strings"
+	"sync"
 
+	"github.com/fonero-project/fnod/blockchain/stake"
+	"github.com/fonero-project/fnod/chaincfg"
 	"github.com/fonero-project/fnod/chaincfg/chainhash"
 	"github.com/fonero-project/fnod/fnoutil"
 	"github.com/fonero-project/fnod/gcs"
 	"github.com/fonero-project/fnod/rpcclient"
+	"github.com/fonero-project/fnod/txscript"
 	"github.com/fonero-project/fnod/wire"
 	"github.com/fonero-project/fnowallet/errors"
 	"github.com/fonero-project/fnowallet/wallet"
+	"github.com/jrick/bitset"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -24,6 +29,7 @@ type rpcBackend struct {
 }
 
 var _ wallet.NetworkBackend = (*rpcBackend)(nil)
+var _ wallet.MissedTicketsChecker = (*rpcBackend)(nil)
 
 // BackendFromRPCClient creates a wallet network backend from an RPC client.
 func BackendFromRPCClient(rpcClient *rpcclient.Client) wallet.NetworkBackend {
@@ -36,12 +42,14 @@ func BackendFromRPCClient(rpcClient *rpcclient.Client) wallet.NetworkBackend {
 func RPCClientFromBackend(n wallet.NetworkBackend) (*rpcclient.Client, error) {
 	const op errors.Op = "chain.RPCClientFromBackend"
 
-	b, ok := n.(*rpcBackend)
-	if !ok {
-		return nil, errors.E(op, errors.Invalid, "this operation requires "+
-			"the network backend to be the consensus RPC server")
+	switch b := n.(type) {
+	case *rpcBackend:
+		return b.rpcClient, nil
+	case *walletBackend:
+		return b.rpcClient, nil
 	}
-	return b.rpcClient, nil
+	return nil, errors.E(op, errors.Invalid, "this operation requires "+
+		"the network backend to be the consensus RPC server")
 }
 
 func (b *rpcBackend) GetBlocks(ctx context.Context, blockHashes []*chainhash.Hash) ([]*wire.MsgBlock, error) {
@@ -188,6 +196,129 @@ func (b *rpcBackend) StakeDifficulty(ctx context.Context) (fnoutil.Amount, error
 	return amount, nil
 }
 
+func (b *rpcBackend) ExistsMissedTickets(ctx context.Context, tickets []*chainhash.Hash) (bitset.Bytes, error) {
+	const op errors.Op = "fnod.jsonrpc.existsmissedtickets"
+
+	bitsHex, err := b.rpcClient.ExistsMissedTicketsAsync(tickets).Receive()
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	bits, err := hex.DecodeString(bitsHex)
+	if err != nil {
+		return nil, errors.E(op, errors.Encoding, err)
+	}
+	return bitset.Bytes(bits), nil
+}
+
 func (b *rpcBackend) RPCClient() *rpcclient.Client {
 	return b.rpcClient
 }
+
+// walletBackend is the network backend of a wallet synchronized by an
+// RPCSyncer.  The server's transaction filter is shared by every wallet
+// synchronized with the same RPC client, so the filter is never reloaded and
+// transactions notified or rescanned by the server are matched against a
+// precise filter of only this wallet's data.
+type walletBackend struct {
+	*rpcBackend
+
+	params   *chaincfg.Params
+	filter   *wallet.RescanFilter
+	filterMu sync.Mutex
+}
+
+var _ wallet.NetworkBackend = (*walletBackend)(nil)
+var _ wallet.MissedTicketsChecker = (*walletBackend)(nil)
+
+func newWalletBackend(c *RPCClient) *walletBackend {
+	return &walletBackend{
+		rpcBackend: &rpcBackend{c.Client},
+		params:     c.chainParams,
+		filter:     wallet.NewRescanFilter(nil, nil),
+	}
+}
+
+func (b *walletBackend) LoadTxFilter(ctx context.Context, reload bool, addrs []fnoutil.Address, outpoints []wire.OutPoint) error {
+	b.filterMu.Lock()
+	if reload {
+		b.filter = wallet.NewRescanFilter(nil, nil)
+	}
+	for _, addr := range addrs {
+		b.filter.AddAddress(addr)
+	}
+	for i := range outpoints {
+		b.filter.AddUnspentOutPoint(&outpoints[i])
+	}
+	b.filterMu.Unlock()
+
+	// Reloading the server's filter would remove the data of every other
+	// wallet, so data is only ever added to it.
+	return b.rpcBackend.LoadTxFilter(ctx, false, addrs, outpoints)
+}
+
+func (b *walletBackend) Rescan(ctx context.Context, blocks []chainhash.Hash, r wallet.RescanSaver) error {
+	return b.rpcBackend.Rescan(ctx, blocks, rescanSaverFunc(func(blockHash *chainhash.Hash, txs []*wire.MsgTx) error {
+		txs = b.filterRelevant(txs)
+		if len(txs) == 0 {
+			return nil
+		}
+		return r.SaveRescanned(blockHash, txs)
+	}))
+}
+
+// rescanSaverFunc implements wallet.RescanSaver with a function.
+type rescanSaverFunc func(blockHash *chainhash.Hash, txs []*wire.MsgTx) error
+
+func (f rescanSaverFunc) SaveRescanned(blockHash *chainhash.Hash, txs []*wire.MsgTx) error {
+	return f(blockHash, txs)
+}
+
+// filterRelevant returns the transactions which spend or pay to the wallet's
+// filtered data.  The outpoints of outputs paying to filtered addresses are
+// added to the filter so later transactions spending them are also matched.
+func (b *walletBackend) filterRelevant(txs []*wire.MsgTx) []*wire.MsgTx {
+	defer b.filterMu.Unlock()
+	b.filterMu.Lock()
+
+	var matches []*wire.MsgTx
+	for _, tx := range txs {
+		txType := stake.DetermineTxType(tx)
+		tree := wire.TxTreeRegular
+		if txType != stake.TxTypeRegular {
+			tree = wire.TxTreeStake
+		}
+		match := false
+		for i, in := range tx.TxIn {
+			// The stakebase input of a vote does not spend a previous
+			// output.
+			if i == 0 && txType == stake.TxTypeSSGen {
+				continue
+			}
+			if b.filter.ExistsUnspentOutPoint(&in.PreviousOutPoint) {
+				match = true
+				break
+			}
+		}
+		txHash := tx.TxHash()
+		for i, out := range tx.TxOut {
+			_, addrs, _, err := txscript.ExtractPkScriptAddrs(out.Version,
+				out.PkScript, b.params)
+			if err != nil {
+				continue
+			}
+			for _, a := range addrs {
+				if !b.filter.ExistsAddress(a) {
+					continue
+				}
+				match = true
+				op := wire.OutPoint{Hash: txHash, Index: uint32(i), Tree: tree}
+				b.filter.AddUnspentOutPoint(&op)
+				break
+			}
+		}
+		if match {
+			matches = append(matches, tx)
+		}
+	}
+	return matches
+}
@@ -21,30 +21,132 @@ import (
 )
 
 // RPCSyncer implements wallet synchronization services by processing
-// notifications from a fnod JSON-RPC server.
+// notifications from a fnod JSON-RPC server.  Every wallet added to a single
+// RPCSyncer is synchronized using the notifications of its RPC client.
 type RPCSyncer struct {
-	atomicWalletSynced uint32 // CAS (synced=1) when wallet syncing complete
+	atomicWalletSynced uint32 // CAS (synced=1) when syncing of every wallet is complete
 
-	wallet    *wallet.Wallet
 	rpcClient *RPCClient
 
-	discoverAccts bool
-	mu            sync.Mutex
+	// primary is the wallet the RPCSyncer was created for.  Errors
+	// synchronizing this wallet end Run, while errors synchronizing wallets
+	// added with AddWallet are logged.
+	primary        *syncWallet
+	wallets        []*syncWallet
+	runCtx         context.Context // Set while running
+	runStartupSync bool
+	walletsMu      sync.Mutex
 
 	// Holds all potential callbacks used to notify clients
 	notifications *Notifications
 }
 
+// syncWallet is a wallet synchronized by an RPCSyncer.
+type syncWallet struct {
+	*RPCSyncer
+
+	atomicSynced uint32 // (synced=1) when wallet syncing complete
+
+	wallet  *wallet.Wallet
+	backend *walletBackend
+	cancel  func() // Stops synchronizing an added wallet; protected by walletsMu
+
+	discoverAccts bool
+	mu            sync.Mutex
+
+	// Consensus server notifications queued for processing by the wallet.
+	// queued is signaled after a notification is queued.
+	queue   []interface{}
+	queued  chan struct{}
+	queueMu sync.Mutex
+}
+
 // NewRPCSyncer creates an RPCSyncer that will sync the wallet using the RPC
 // client.
 func NewRPCSyncer(w *wallet.Wallet, rpcClient *RPCClient) *RPCSyncer {
-	return &RPCSyncer{
+	s := &RPCSyncer{
+		rpcClient: rpcClient,
+	}
+	s.primary = newSyncWallet(s, w)
+	s.wallets = []*syncWallet{s.primary}
+	return s
+}
+
+func newSyncWallet(s *RPCSyncer, w *wallet.Wallet) *syncWallet {
+	return &syncWallet{
+		RPCSyncer:     s,
 		wallet:        w,
-		rpcClient:     rpcClient,
+		backend:       newWalletBackend(s.rpcClient),
 		discoverAccts: !w.Locked(),
+		queued:        make(chan struct{}, 1),
 	}
 }
 
+// Backend returns the network backend of the wallet the RPCSyncer was created
+// for.  The backend must be used by the wallet instead of a backend created by
+// BackendFromRPCClient, as the RPC client's transaction filter is shared by
+// every synchronized wallet.
+func (s *RPCSyncer) Backend() wallet.NetworkBackend {
+	return s.primary.backend
+}
+
+// AddWallet synchronizes an additional wallet using the RPC client and returns
+// the network backend of the wallet.  A wallet added while the RPCSyncer is
+// running begins synchronizing immediately.
+func (s *RPCSyncer) AddWallet(w *wallet.Wallet) wallet.NetworkBackend {
+	sw := newSyncWallet(s, w)
+	s.walletsMu.Lock()
+	s.wallets = append(s.wallets, sw)
+	if s.runCtx != nil {
+		s.startWallet(sw)
+	}
+	s.walletsMu.Unlock()
+	s.updateSynced()
+	return sw.backend
+}
+
+// RemoveWallet stops synchronizing a wallet added with AddWallet.
+func (s *RPCSyncer) RemoveWallet(w *wallet.Wallet) {
+	s.walletsMu.Lock()
+	for i, sw := range s.wallets {
+		if sw.wallet == w && sw != s.primary {
+			if sw.cancel != nil {
+				sw.cancel()
+			}
+			// Copy the remaining wallets so the slices returned by
+			// syncWallets are never modified.
+			s.wallets = append(s.wallets[:i:i], s.wallets[i+1:]...)
+			break
+		}
+	}
+	s.walletsMu.Unlock()
+	s.updateSynced()
+}
+
+// syncWallets returns every wallet synchronized by the RPCSyncer.
+func (s *RPCSyncer) syncWallets() []*syncWallet {
+	s.walletsMu.Lock()
+	wallets := s.wallets
+	s.walletsMu.Unlock()
+	return wallets
+}
+
+// startWallet synchronizes a wallet added with AddWallet in the background
+// until the RPCSyncer stops running or the wallet is removed.  Requires the
+// wallets mutex to be held while running.
+func (s *RPCSyncer) startWallet(sw *syncWallet) {
+	ctx, cancel := context.WithCancel(s.runCtx)
+	sw.cancel = cancel
+	startupSync := s.runStartupSync
+	go func() {
+		defer cancel()
+		err := sw.run(ctx, startupSync)
+		if err != nil && ctx.Err() == nil {
+			log.Errorf("Failed to synchronize wallet: %v", err)
+		}
+	}()
+}
+
 // Notifications struct to contain all of the upcoming callbacks that will
 // be used to update the rpc streams for syncing.
 type Notifications struct {
@@ -68,6 +170,24 @@ func (s *RPCSyncer) SetNotifications(ntfns *Notifications) {
 	s.notifications = ntfns
 }
 
+// updateSynced records the RPCSyncer as synced when every wallet is synced, and
+// as unsynced otherwise.
+func (s *RPCSyncer) updateSynced() {
+	for _, sw := range s.syncWallets() {
+		if atomic.LoadUint32(&sw.atomicSynced) == 0 {
+			s.unsynced()
+			return
+		}
+	}
+	s.synced()
+}
+
+// synced records the wallet as synced.
+func (sw *syncWallet) synced() {
+	atomic.StoreUint32(&sw.atomicSynced, 1)
+	sw.updateSynced()
+}
+
 // synced checks the atomic that controls wallet syncness and if previously
 // unsynced, updates to synced and notifies the callback, if set.
 func (s *RPCSyncer) synced() {
@@ -153,30 +273,20 @@ func (s *RPCSyncer) rescanFinished() {
 	}
 }
 
-// Run synchronizes the wallet, returning when synchronization fails or the
-// context is cancelled.  If startupSync is true, all synchronization tasks
-// needed to fully register the wallet for notifications and synchronize it with
-// the fnod server are performed.  Otherwise, it will listen for notifications
-// but not register for any updates.
+// Run synchronizes every wallet, returning when synchronization of the wallet
+// the RPCSyncer was created for fails or the context is cancelled.  If
+// startupSync is true, all synchronization tasks needed to fully register the
+// wallets for notifications and synchronize them with the fnod server are
+// performed.  Otherwise, it will listen for notifications but not register for
+// any updates.
 func (s *RPCSyncer) Run(ctx context.Context, startupSync bool) error {
 	const op errors.Op = "rpcsyncer.Run"
 
-	tipHash, tipHeight := s.wallet.MainChainTip()
-	rescanPoint, err := s.wallet.RescanPoint()
-	if err != nil {
-		return errors.E(op, err)
-	}
-	log.Infof("Headers synced through block %v height %d", &tipHash, tipHeight)
-	if rescanPoint != nil {
-		h, err := s.wallet.BlockHeader(rescanPoint)
+	for _, sw := range s.syncWallets() {
+		err := sw.logSyncState()
 		if err != nil {
 			return errors.E(op, err)
 		}
-		// The rescan point is the first block that does not have synced
-		// transactions, so we are synced with the parent.
-		log.Infof("Transactions synced through block %v height %d", &h.PrevBlock, h.Height-1)
-	} else {
-		log.Infof("Transactions synced through block %v height %d", &tipHash, tipHeight)
 	}
 
 	// TODO: handling of voting notifications should be done sequentially with
@@ -184,14 +294,25 @@ func (s *RPCSyncer) Run(ctx context.Context, startupSync bool) error {
 	// on).  Until then, a couple notification processing goroutines must be
 	// started and errors merged.
 	g, ctx := errgroup.WithContext(ctx)
-	g.Go(func() error {
-		if startupSync {
-			err := s.startupSync(ctx)
-			if err != nil {
-				return err
-			}
+	s.walletsMu.Lock()
+	s.runCtx = ctx
+	s.runStartupSync = startupSync
+	for _, sw := range s.wallets {
+		if sw != s.primary {
+			s.startWallet(sw)
 		}
-		return s.handleNotifications(ctx)
+	}
+	s.walletsMu.Unlock()
+	defer func() {
+		s.walletsMu.Lock()
+		s.runCtx = nil
+		s.walletsMu.Unlock()
+	}()
+	g.Go(func() error {
+		return s.primary.run(ctx, startupSync)
+	})
+	g.Go(func() error {
+		return s.dispatchNotifications(ctx)
 	})
 	g.Go(func() error {
 		return s.handleVoteNotifications(ctx)
@@ -203,7 +324,8 @@ func (s *RPCSyncer) Run(ctx context.Context, startupSync bool) error {
 			return errors.E(op, err)
 		}
 
-		if s.wallet.VotingEnabled() {
+		w := s.primary.wallet
+		if w.VotingEnabled() {
 			// Request notifications for winning tickets.
 			err := s.rpcClient.NotifyWinningTickets()
 			if err != nil {
@@ -211,7 +333,7 @@ func (s *RPCSyncer) Run(ctx context.Context, startupSync bool) error {
 				return errors.E(op, err)
 			}
 
-			vb := s.wallet.VoteBits()
+			vb := w.VoteBits()
 			log.Infof("Wallet voting enabled: vote bits = %#04x, "+
 				"extended vote bits = %x", vb.Bits, vb.ExtendedBits)
 			log.Infof("Please ensure your wallet remains unlocked so it may vote")
@@ -219,22 +341,56 @@ func (s *RPCSyncer) Run(ctx context.Context, startupSync bool) error {
 
 		return nil
 	})
-	err = g.Wait()
+	err := g.Wait()
 	if err != nil {
 		return errors.E(op, err)
 	}
 	return nil
 }
 
-func (s *RPCSyncer) handleNotifications(ctx context.Context) error {
-	// connectingBlocks keeps track of whether any blocks have been successfully
-	// attached to the main chain.  Once any blocks have attached, if a future
-	// block fails to attach, the error is fatal.  Otherwise, errors are logged.
-	connectingBlocks := false
+// logSyncState logs the blocks through which the wallet's headers and
+// transactions are synced.
+func (sw *syncWallet) logSyncState() error {
+	tipHash, tipHeight := sw.wallet.MainChainTip()
+	rescanPoint, err := sw.wallet.RescanPoint()
+	if err != nil {
+		return err
+	}
+	log.Infof("Headers synced through block %v height %d", &tipHash, tipHeight)
+	if rescanPoint != nil {
+		h, err := sw.wallet.BlockHeader(rescanPoint)
+		if err != nil {
+			return err
+		}
+		// The rescan point is the first block that does not have synced
+		// transactions, so we are synced with the parent.
+		log.Infof("Transactions synced through block %v height %d", &h.PrevBlock, h.Height-1)
+	} else {
+		log.Infof("Transactions synced through block %v height %d", &tipHash, tipHeight)
+	}
+	return nil
+}
 
-	sidechains := new(wallet.SidechainForest)
-	var relevantTxs map[chainhash.Hash][]*wire.MsgTx
+// run synchronizes the wallet until synchronization fails or the context is
+// cancelled.
+func (sw *syncWallet) run(ctx context.Context, startupSync bool) error {
+	// Notifications queued during a previous run are discarded.
+	sw.queueMu.Lock()
+	sw.queue = nil
+	sw.queueMu.Unlock()
 
+	if startupSync {
+		err := sw.startupSync(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	return sw.handleNotifications(ctx)
+}
+
+// dispatchNotifications queues every notification of the RPC client for
+// processing by each synchronized wallet.
+func (s *RPCSyncer) dispatchNotifications(ctx context.Context) error {
 	c := s.rpcClient.notifications()
 	for {
 		select {
@@ -245,116 +401,171 @@ func (s *RPCSyncer) handleNotifications(ctx context.Context) error {
 			if !ok {
 				return errors.E(errors.NoPeers, "RPC client disconnected")
 			}
+			for _, sw := range s.syncWallets() {
+				sw.enqueue(n)
+			}
+		}
+	}
+}
 
-			var op errors.Op
-			var err error
-			nonFatal := false
-			switch n := n.(type) {
-			case blockConnected:
-				op = "fnod.jsonrpc.blockconnected"
-				header := new(wire.BlockHeader)
-				err = header.Deserialize(bytes.NewReader(n.blockHeader))
-				if err != nil {
-					break
-				}
-				blockHash := header.BlockHash()
-				getCfilter := s.rpcClient.GetCFilterAsync(&blockHash, wire.GCSFilterRegular)
-				var rpt *chainhash.Hash
-				rpt, err = s.wallet.RescanPoint()
-				if err != nil {
-					break
-				}
-				if rpt == nil {
-					txs := make([]*wire.MsgTx, 0, len(n.transactions))
-					for _, tx := range n.transactions {
-						msgTx := new(wire.MsgTx)
-						err = msgTx.Deserialize(bytes.NewReader(tx))
-						if err != nil {
-							break
-						}
-						txs = append(txs, msgTx)
-					}
-					if relevantTxs == nil {
-						relevantTxs = make(map[chainhash.Hash][]*wire.MsgTx)
-					}
-					relevantTxs[blockHash] = txs
-				}
+// enqueue queues a notification for processing by the wallet.
+func (sw *syncWallet) enqueue(n interface{}) {
+	sw.queueMu.Lock()
+	sw.queue = append(sw.queue, n)
+	sw.queueMu.Unlock()
+	select {
+	case sw.queued <- struct{}{}:
+	default:
+	}
+}
 
-				var f *gcs.Filter
-				f, err = getCfilter.Receive()
-				if err != nil {
-					break
-				}
+// nextNotification waits for and dequeues the next notification to be
+// processed by the wallet.
+func (sw *syncWallet) nextNotification(ctx context.Context) (interface{}, error) {
+	for {
+		sw.queueMu.Lock()
+		if len(sw.queue) != 0 {
+			n := sw.queue[0]
+			sw.queue[0] = nil
+			sw.queue = sw.queue[1:]
+			sw.queueMu.Unlock()
+			return n, nil
+		}
+		sw.queueMu.Unlock()
 
-				blockNode := wallet.NewBlockNode(header, &blockHash, f)
-				sidechains.AddBlockNode(blockNode)
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-sw.queued:
+		}
+	}
+}
 
-				var bestChain []*wallet.BlockNode
-				bestChain, err = s.wallet.EvaluateBestChain(sidechains)
-				if err != nil {
-					break
-				}
-				if len(bestChain) != 0 {
-					var prevChain []*wallet.BlockNode
-					prevChain, err = s.wallet.ChainSwitch(sidechains, bestChain, relevantTxs)
-					if err == nil {
-						connectingBlocks = true
-					}
-					nonFatal = !connectingBlocks
+func (sw *syncWallet) handleNotifications(ctx context.Context) error {
+	// connectingBlocks keeps track of whether any blocks have been successfully
+	// attached to the main chain.  Once any blocks have attached, if a future
+	// block fails to attach, the error is fatal.  Otherwise, errors are logged.
+	connectingBlocks := false
+
+	sidechains := new(wallet.SidechainForest)
+	var relevantTxs map[chainhash.Hash][]*wire.MsgTx
+
+	for {
+		n, err := sw.nextNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		var op errors.Op
+		nonFatal := false
+		switch n := n.(type) {
+		case blockConnected:
+			op = "fnod.jsonrpc.blockconnected"
+			header := new(wire.BlockHeader)
+			err = header.Deserialize(bytes.NewReader(n.blockHeader))
+			if err != nil {
+				break
+			}
+			blockHash := header.BlockHash()
+			getCfilter := sw.rpcClient.GetCFilterAsync(&blockHash, wire.GCSFilterRegular)
+			var rpt *chainhash.Hash
+			rpt, err = sw.wallet.RescanPoint()
+			if err != nil {
+				break
+			}
+			if rpt == nil {
+				txs := make([]*wire.MsgTx, 0, len(n.transactions))
+				for _, tx := range n.transactions {
+					msgTx := new(wire.MsgTx)
+					err = msgTx.Deserialize(bytes.NewReader(tx))
 					if err != nil {
 						break
 					}
-
-					if len(prevChain) != 0 {
-						log.Infof("Reorganize from %v to %v (total %d block(s) reorged)",
-							prevChain[len(prevChain)-1].Hash, bestChain[len(bestChain)-1].Hash, len(prevChain))
-						for _, n := range prevChain {
-							sidechains.AddBlockNode(n)
-						}
-					}
-					for _, n := range bestChain {
-						log.Infof("Connected block %v, height %d, %d wallet transaction(s)",
-							n.Hash, n.Header.Height, len(relevantTxs[*n.Hash]))
-					}
-
-					relevantTxs = nil
+					txs = append(txs, msgTx)
 				}
+				if relevantTxs == nil {
+					relevantTxs = make(map[chainhash.Hash][]*wire.MsgTx)
+				}
+				relevantTxs[blockHash] = sw.backend.filterRelevant(txs)
+			}
+
+			var f *gcs.Filter
+			f, err = getCfilter.Receive()
+			if err != nil {
+				break
+			}
 
-			case blockDisconnected, reorganization:
-				continue // These notifications are ignored
+			blockNode := wallet.NewBlockNode(header, &blockHash, f)
+			sidechains.AddBlockNode(blockNode)
 
-			case relevantTxAccepted:
-				op = "fnod.jsonrpc.relevanttxaccepted"
-				nonFatal = true
-				var rpt *chainhash.Hash
-				rpt, err = s.wallet.RescanPoint()
-				if err != nil || rpt != nil {
+			var bestChain []*wallet.BlockNode
+			bestChain, err = sw.wallet.EvaluateBestChain(sidechains)
+			if err != nil {
+				break
+			}
+			if len(bestChain) != 0 {
+				var prevChain []*wallet.BlockNode
+				prevChain, err = sw.wallet.ChainSwitch(sidechains, bestChain, relevantTxs)
+				if err == nil {
+					connectingBlocks = true
+				}
+				nonFatal = !connectingBlocks
+				if err != nil {
 					break
 				}
-				err = s.wallet.AcceptMempoolTx(n.transaction)
 
-			case missedTickets:
-				op = "fnod.jsonrpc.spentandmissedtickets"
-				err = s.wallet.RevokeOwnedTickets(n.tickets)
-				nonFatal = true
+				if len(prevChain) != 0 {
+					log.Infof("Reorganize from %v to %v (total %d block(s) reorged)",
+						prevChain[len(prevChain)-1].Hash, bestChain[len(bestChain)-1].Hash, len(prevChain))
+					for _, n := range prevChain {
+						sidechains.AddBlockNode(n)
+					}
+				}
+				for _, n := range bestChain {
+					log.Infof("Connected block %v, height %d, %d wallet transaction(s)",
+						n.Hash, n.Header.Height, len(relevantTxs[*n.Hash]))
+				}
 
-			default:
-				log.Warnf("Notification handler received unknown notification type %T", n)
-				continue
+				relevantTxs = nil
 			}
 
-			if err == nil {
-				continue
-			}
+		case blockDisconnected, reorganization:
+			continue // These notifications are ignored
 
-			err = errors.E(op, err)
-			if nonFatal {
-				log.Errorf("Failed to process consensus server notification: %v", err)
-				continue
+		case relevantTxAccepted:
+			op = "fnod.jsonrpc.relevanttxaccepted"
+			nonFatal = true
+			var rpt *chainhash.Hash
+			rpt, err = sw.wallet.RescanPoint()
+			if err != nil || rpt != nil {
+				break
+			}
+			if len(sw.backend.filterRelevant([]*wire.MsgTx{n.transaction})) == 0 {
+				break
 			}
+			err = sw.wallet.AcceptMempoolTx(n.transaction)
 
-			return err
+		case missedTickets:
+			op = "fnod.jsonrpc.spentandmissedtickets"
+			err = sw.wallet.RevokeOwnedTickets(n.tickets)
+			nonFatal = true
+
+		default:
+			log.Warnf("Notification handler received unknown notification type %T", n)
+			continue
 		}
+
+		if err == nil {
+			continue
+		}
+
+		err = errors.E(op, err)
+		if nonFatal {
+			log.Errorf("Failed to process consensus server notification: %v", err)
+			continue
+		}
+
+		return err
 	}
 }
 
@@ -370,19 +581,20 @@ func (s *RPCSyncer) handleVoteNotifications(ctx context.Context) error {
 				return errors.E(errors.NoPeers, "RPC client disconnected")
 			}
 
-			var op errors.Op
-			var err error
 			switch n := n.(type) {
 			case winningTickets:
-				op = "fnod.jsonrpc.winningtickets"
-				err = s.wallet.VoteOnOwnedTickets(n.tickets, n.blockHash, int32(n.blockHeight))
+				// Each wallet votes with its own tickets.
+				const op errors.Op = "fnod.jsonrpc.winningtickets"
+				for _, sw := range s.syncWallets() {
+					err := sw.wallet.VoteOnOwnedTickets(n.tickets, n.blockHash, int32(n.blockHeight))
+					if err != nil {
+						err = errors.E(op, err)
+						log.Errorf("Failed to process consensus server notification: %v", err)
+					}
+				}
 			default:
 				log.Warnf("Voting handler received unknown notification type %T", n)
 			}
-			if err != nil {
-				err = errors.E(op, err)
-				log.Errorf("Failed to process consensus server notification: %v", err)
-			}
 		}
 	}
 }
@@ -413,24 +625,24 @@ var hashStop chainhash.Hash
 // startupSync brings the wallet up to date with the current chain server
 // connection.  It creates a rescan request and blocks until the rescan has
 // finished.
-func (s *RPCSyncer) startupSync(ctx context.Context) error {
-	n := BackendFromRPCClient(s.rpcClient.Client)
+func (sw *syncWallet) startupSync(ctx context.Context) error {
+	n := sw.backend
 
 	// Fetch any missing main chain compact filters.
-	s.fetchMissingCfiltersStart()
+	sw.fetchMissingCfiltersStart()
 	progress := make(chan wallet.MissingCFilterProgress, 1)
-	go s.wallet.FetchMissingCFiltersWithProgress(ctx, n, progress)
+	go sw.wallet.FetchMissingCFiltersWithProgress(ctx, n, progress)
 
 	for p := range progress {
 		if p.Err != nil {
 			return p.Err
 		}
-		s.fetchMissingCfiltersProgress(p.BlockHeightStart, p.BlockHeightEnd)
+		sw.fetchMissingCfiltersProgress(p.BlockHeightStart, p.BlockHeightEnd)
 	}
-	s.fetchMissingCfiltersFinished()
+	sw.fetchMissingCfiltersFinished()
 
 	// Request notifications for connected and disconnected blocks.
-	err := s.rpcClient.NotifyBlocks()
+	err := sw.rpcClient.NotifyBlocks()
 	if err != nil {
 		const op errors.Op = "fnod.jsonrpc.notifyblocks"
 		return errors.E(op, err)
@@ -438,19 +650,19 @@ func (s *RPCSyncer) startupSync(ctx context.Context) error {
 
 	// Fetch new headers and cfilters from the server.
 	var sidechains wallet.SidechainForest
-	locators, err := s.wallet.BlockLocators(nil)
+	locators, err := sw.wallet.BlockLocators(nil)
 	if err != nil {
 		return err
 	}
 
-	s.fetchHeadersStart()
+	sw.fetchHeadersStart()
 	for {
 		if err := ctx.Err(); err != nil {
 			return err
 		}
 		var headers []*wire.BlockHeader
 		err := ctxdo(ctx, "fnod.jsonrpc.getheaders", func() error {
-			headersMsg, err := s.rpcClient.GetHeaders(locators, &hashStop)
+			headersMsg, err := sw.rpcClient.GetHeaders(locators, &hashStop)
 			if err != nil {
 				return err
 			}
@@ -483,7 +695,7 @@ func (s *RPCSyncer) startupSync(ctx context.Context) error {
 				err := ctxdo(ctx, "", func() error {
 					const opf = "fnod.jsonrpc.getcfilter(%v)"
 					var err error
-					filter, err = s.rpcClient.GetCFilter(&hash, wire.GCSFilterRegular)
+					filter, err = sw.rpcClient.GetCFilter(&hash, wire.GCSFilterRegular)
 					if err != nil {
 						op := errors.Opf(opf, &hash)
 						err = errors.E(op, err)
@@ -504,7 +716,7 @@ func (s *RPCSyncer) startupSync(ctx context.Context) error {
 
 		var added int
 		for _, n := range nodes {
-			haveBlock, _, _ := s.wallet.BlockInMainChain(n.Hash)
+			haveBlock, _, _ := sw.wallet.BlockInMainChain(n.Hash)
 			if haveBlock {
 				continue
 			}
@@ -513,10 +725,10 @@ func (s *RPCSyncer) startupSync(ctx context.Context) error {
 			}
 		}
 
-		s.fetchHeadersProgress(int32(added), headers[len(headers)-1].Timestamp.Unix())
+		sw.fetchHeadersProgress(int32(added), headers[len(headers)-1].Timestamp.Unix())
 
 		log.Infof("Fetched %d new header(s) ending at height %d from %v",
-			added, nodes[len(nodes)-1].Header.Height, s.rpcClient)
+			added, nodes[len(nodes)-1].Header.Height, sw.rpcClient)
 
 		// Stop fetching headers when no new blocks are returned.
 		// Because getheaders did return located blocks, this indicates
@@ -528,7 +740,7 @@ func (s *RPCSyncer) startupSync(ctx context.Context) error {
 			break
 		}
 
-		bestChain, err := s.wallet.EvaluateBestChain(&sidechains)
+		bestChain, err := sw.wallet.EvaluateBestChain(&sidechains)
 		if err != nil {
 			return err
 		}
@@ -536,12 +748,12 @@ func (s *RPCSyncer) startupSync(ctx context.Context) error {
 			continue
 		}
 
-		_, err = s.wallet.ValidateHeaderChainDifficulties(bestChain, 0)
+		_, err = sw.wallet.ValidateHeaderChainDifficulties(bestChain, 0)
 		if err != nil {
 			return err
 		}
 
-		prevChain, err := s.wallet.ChainSwitch(&sidechains, bestChain, nil)
+		prevChain, err := sw.wallet.ChainSwitch(&sidechains, bestChain, nil)
 		if err != nil {
 			return err
 		}
@@ -561,61 +773,61 @@ func (s *RPCSyncer) startupSync(ctx context.Context) error {
 				len(bestChain), tip.Hash, tip.Header.Height, tip.Header.Timestamp)
 		}
 
-		locators, err = s.wallet.BlockLocators(nil)
+		locators, err = sw.wallet.BlockLocators(nil)
 		if err != nil {
 			return err
 		}
 	}
-	s.fetchHeadersFinished()
+	sw.fetchHeadersFinished()
 
-	rescanPoint, err := s.wallet.RescanPoint()
+	rescanPoint, err := sw.wallet.RescanPoint()
 	if err != nil {
 		return err
 	}
 	if rescanPoint != nil {
-		s.mu.Lock()
-		discoverAccts := s.discoverAccts
-		s.mu.Unlock()
-		s.discoverAddressesStart()
-		err = s.wallet.DiscoverActiveAddresses(ctx, n, rescanPoint, discoverAccts)
+		sw.mu.Lock()
+		discoverAccts := sw.discoverAccts
+		sw.mu.Unlock()
+		sw.discoverAddressesStart()
+		err = sw.wallet.DiscoverActiveAddresses(ctx, n, rescanPoint, discoverAccts)
 		if err != nil {
 			return err
 		}
-		s.discoverAddressesFinished()
-		s.mu.Lock()
-		s.discoverAccts = false
-		s.mu.Unlock()
-		err = s.wallet.LoadActiveDataFilters(ctx, n, true)
+		sw.discoverAddressesFinished()
+		sw.mu.Lock()
+		sw.discoverAccts = false
+		sw.mu.Unlock()
+		err = sw.wallet.LoadActiveDataFilters(ctx, n, true)
 		if err != nil {
 			return err
 		}
 
-		s.rescanStart()
-		rescanBlock, err := s.wallet.BlockHeader(rescanPoint)
+		sw.rescanStart()
+		rescanBlock, err := sw.wallet.BlockHeader(rescanPoint)
 		if err != nil {
 			return err
 		}
 		progress := make(chan wallet.RescanProgress, 1)
-		go s.wallet.RescanProgressFromHeight(ctx, n, int32(rescanBlock.Height), progress)
+		go sw.wallet.RescanProgressFromHeight(ctx, n, int32(rescanBlock.Height), progress)
 
 		for p := range progress {
 			if p.Err != nil {
 				return p.Err
 			}
-			s.rescanProgress(p.ScannedThrough)
+			sw.rescanProgress(p.ScannedThrough)
 		}
-		s.rescanFinished()
+		sw.rescanFinished()
 
 	} else {
-		err = s.wallet.LoadActiveDataFilters(ctx, n, true)
+		err = sw.wallet.LoadActiveDataFilters(ctx, n, true)
 		if err != nil {
 			return err
 		}
 	}
-	s.synced()
+	sw.synced()
 
 	// Rebroadcast unmined transactions
-	err = s.wallet.PublishUnminedTransactions(ctx, n)
+	err = sw.wallet.PublishUnminedTransactions(ctx, n)
 	if err != nil {
 		// Returning this error would end and (likely) restart sync in
 		// an endless loop.  It's possible a transaction should be
@@ -623,12 +835,12 @@ func (s *RPCSyncer) startupSync(ctx context.Context) error {
 		log.Warnf("Could not publish one or more unmined transactions: %v", err)
 	}
 
-	_, err = s.rpcClient.RawRequest("rebroadcastwinners", nil)
+	_, err = sw.rpcClient.RawRequest("rebroadcastwinners", nil)
 	if err != nil {
 		const op errors.Op = "fnod.jsonrpc.rebroadcastwinners"
 		return errors.E(op, err)
 	}
-	_, err = s.rpcClient.RawRequest("rebroadcastmissed", nil)
+	_, err = sw.rpcClient.RawRequest("rebroadcastmissed", nil)
 	if err != nil {
 		const op errors.Op = "fnod.jsonrpc.rebroadcastmissed"
 		return errors.E(op, err)
@@ -0,0 +1,97 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// auditverify verifies the hash chain of a wallet audit log written with the
+// fnowallet --auditlog option and prints the sequence number and hash of its
+// last entry.  Removal of entries from the end of a log can not be detected
+// from the log alone, so a previously recorded sequence number and hash may be
+// provided to check that the entry is still present.
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/fonero-project/fnowallet/auditlog"
+	"github.com/jessevdk/go-flags"
+)
+
+var newlineBytes = []byte{'\n'}
+
+func fatalf(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, format, args...)
+	os.Stderr.Write(newlineBytes)
+	os.Exit(1)
+}
+
+// Flags.
+var opts = struct {
+	Seq  uint64 `long:"seq" description:"Sequence number of a previously recorded entry"`
+	Hash string `long:"hash" description:"Previously recorded hash of the entry with sequence number --seq"`
+}{}
+
+func main() {
+	args, err := flags.Parse(&opts)
+	if err != nil {
+		os.Exit(1)
+	}
+	if len(args) != 1 {
+		fatalf("Usage: auditverify [--seq=<n> --hash=<hash>] <logfile>")
+	}
+	if (opts.Seq == 0) != (opts.Hash == "") {
+		fatalf("--seq and --hash must be set together")
+	}
+
+	f, err := os.Open(args[0])
+	if err != nil {
+		fatalf("%v", err)
+	}
+	defer f.Close()
+	seq, hash, err := auditlog.Verify(f)
+	if err != nil {
+		fatalf("Verification failed after %d valid entries: %v", seq, err)
+	}
+
+	if opts.Seq != 0 {
+		if opts.Seq > seq {
+			fatalf("Entry %d is missing: the log ends at entry %d", opts.Seq, seq)
+		}
+		_, err = f.Seek(0, 0)
+		if err != nil {
+			fatalf("%v", err)
+		}
+		e, err := findEntry(f, opts.Seq)
+		if err != nil {
+			fatalf("%v", err)
+		}
+		if e.Hash != opts.Hash {
+			fatalf("Entry %d has hash %s, expected %s", opts.Seq, e.Hash, opts.Hash)
+		}
+	}
+
+	fmt.Printf("Verified %d entries\n", seq)
+	fmt.Printf("Last hash: %s\n", hash)
+}
+
+// findEntry returns the entry with sequence number seq from a verified log.
+func findEntry(f *os.File, seq uint64) (*auditlog.Entry, error) {
+	s := bufio.NewScanner(f)
+	s.Buffer(nil, 1024*1024)
+	for s.Scan() {
+		var e auditlog.Entry
+		err := json.Unmarshal(s.Bytes(), &e)
+		if err != nil {
+			return nil, err
+		}
+		if e.Seq == seq {
+			return &e, nil
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	return nil, fmt.Errorf("entry %d not found", seq)
+}
@@ -0,0 +1,162 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// compactdb compacts the database of a wallet which is not running by copying
+// it into a new file, without the free space left behind by removed and
+// replaced data, which then replaces the database file.  The sizes of the
+// database contents by category may be reported instead.  Running wallets are
+// compacted with the CompactDatabase gRPC method.
+package main
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	_ "github.com/fonero-project/fnowallet/wallet/drivers/bdb"
+	"github.com/fonero-project/fnowallet/wallet/udb"
+	"github.com/fonero-project/fnowallet/wallet/walletdb"
+	"github.com/jessevdk/go-flags"
+)
+
+const dbType = "bdb"
+
+// openTimeout is the duration to wait for the database file lock held by a
+// running wallet.
+const openTimeout = 5 * time.Second
+
+var newlineBytes = []byte{'\n'}
+
+func fatalf(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, format, args...)
+	os.Stderr.Write(newlineBytes)
+	os.Exit(1)
+}
+
+// Flags.
+var opts = struct {
+	Report bool `long:"report" description:"Report the database size by category without compacting"`
+}{}
+
+func main() {
+	args, err := flags.Parse(&opts)
+	if err != nil {
+		os.Exit(1)
+	}
+	if len(args) != 1 {
+		fatalf("Usage: compactdb [--report] <path to wallet.db>")
+	}
+	dbPath := args[0]
+
+	db, err := openDB(dbPath)
+	if err != nil {
+		fatalf("%v", err)
+	}
+	if opts.Report {
+		err = report(db, dbPath)
+		db.Close()
+		if err != nil {
+			fatalf("%v", err)
+		}
+		return
+	}
+
+	before, err := fileSize(dbPath)
+	if err != nil {
+		fatalf("%v", err)
+	}
+	compactPath := dbPath + ".compact"
+	err = compact(db, compactPath)
+	db.Close()
+	if err != nil {
+		os.Remove(compactPath)
+		fatalf("Failed to compact database: %v", err)
+	}
+	err = os.Rename(compactPath, dbPath)
+	if err != nil {
+		os.Remove(compactPath)
+		fatalf("Failed to replace database: %v", err)
+	}
+	after, err := fileSize(dbPath)
+	if err != nil {
+		fatalf("%v", err)
+	}
+	fmt.Printf("Compacted %s from %d to %d bytes\n", dbPath, before, after)
+}
+
+// openDB opens the database, failing if the database remains locked by a
+// running wallet.
+func openDB(dbPath string) (walletdb.DB, error) {
+	type result struct {
+		db  walletdb.DB
+		err error
+	}
+	c := make(chan result, 1)
+	go func() {
+		db, err := walletdb.Open(dbType, dbPath)
+		c <- result{db, err}
+	}()
+	select {
+	case r := <-c:
+		return r.db, r.err
+	case <-time.After(openTimeout):
+		return nil, fmt.Errorf("database %s is in use: the wallet must not "+
+			"be running", dbPath)
+	}
+}
+
+func fileSize(path string) (int64, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return 0, err
+	}
+	return fi.Size(), nil
+}
+
+// compact copies the database into a new database at compactPath.
+func compact(db walletdb.DB, compactPath string) error {
+	// Remove any copy left behind by an interrupted compaction.
+	err := os.Remove(compactPath)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	compacted, err := walletdb.Create(dbType, compactPath)
+	if err != nil {
+		return err
+	}
+	err = walletdb.CopyDB(compacted, db)
+	if err != nil {
+		compacted.Close()
+		return err
+	}
+	return compacted.Close()
+}
+
+func report(db walletdb.DB, dbPath string) error {
+	size, err := fileSize(dbPath)
+	if err != nil {
+		return err
+	}
+	var sizes []udb.BucketSize
+	err = walletdb.View(db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		sizes, err = udb.DatabaseSizes(dbtx)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "Category\tKeys\tBytes\t")
+	var total int64
+	for _, s := range sizes {
+		fmt.Fprintf(tw, "%s\t%d\t%d\t\n", s.Category, s.Keys, s.Bytes)
+		total += s.Bytes
+	}
+	fmt.Fprintf(tw, "total\t\t%d\t\n", total)
+	fmt.Fprintf(tw, "file\t\t%d\t\n", size)
+	return tw.Flush()
+}
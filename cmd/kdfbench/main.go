@@ -0,0 +1,147 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// kdfbench measures the key derivation functions used to derive wallet
+// encryption keys from passphrases and recommends the strongest parameters
+// which derive a key within a target duration and memory limit on this host.
+// The recommended parameters may be passed to the ChangePassphrase RPC to
+// strengthen the private passphrase of an existing wallet.
+package main
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"runtime/debug"
+	"time"
+
+	"github.com/jessevdk/go-flags"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/scrypt"
+)
+
+var newlineBytes = []byte{'\n'}
+
+func fatalf(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, format, args...)
+	os.Stderr.Write(newlineBytes)
+	os.Exit(1)
+}
+
+// Flags.
+var opts = struct {
+	KDF    string        `long:"kdf" description:"Key derivation function to measure (scrypt, argon2id, or all)" default:"all"`
+	Target time.Duration `long:"target" description:"Target duration of a single key derivation" default:"1s"`
+	MaxMem uint          `long:"maxmem" description:"Maximum memory in MiB used by a key derivation" default:"1024"`
+}{}
+
+// Parameters below these minimums are never recommended.  They are the
+// defaults of wallets created before the KDF could be changed.
+const (
+	minScryptN   = 1 << 18
+	minArgon2Mem = 64 * 1024 // KiB
+)
+
+var (
+	password = []byte("kdfbench password")
+	salt     = make([]byte, 32)
+)
+
+func measure(f func()) time.Duration {
+	debug.FreeOSMemory()
+	start := time.Now()
+	f()
+	return time.Since(start)
+}
+
+// benchScrypt doubles the scrypt N parameter until a derivation exceeds the
+// target duration or memory limit, and reports the largest N within both.
+func benchScrypt() {
+	const r, p = 8, 1
+	maxMem := uint64(opts.MaxMem) << 20
+	n, elapsed := 0, time.Duration(0)
+	for next := 1 << 14; uint64(128*next*r) <= maxMem; next <<= 1 {
+		d := measure(func() {
+			_, err := scrypt.Key(password, salt, next, r, p, 32)
+			if err != nil {
+				fatalf("scrypt: %v", err)
+			}
+		})
+		if d > opts.Target {
+			break
+		}
+		n, elapsed = next, d
+	}
+	if n < minScryptN {
+		fmt.Printf("scrypt: no parameters stronger than the default N=%d r=%d p=%d "+
+			"fit the target duration and memory limit\n", minScryptN, r, p)
+		return
+	}
+	fmt.Printf("scrypt: N=%d r=%d p=%d (%v, %d MiB)\n", n, r, p,
+		elapsed.Round(time.Millisecond), 128*n*r>>20)
+	fmt.Printf("  ChangePassphrase: kdf=SCRYPT kdf_n=%d kdf_r=%d kdf_p=%d\n", n, r, p)
+}
+
+// benchArgon2id uses as much memory as permitted while a single pass fits the
+// target duration, and then adds passes until the target is reached.
+func benchArgon2id() {
+	threads := runtime.NumCPU()
+	if threads > 255 {
+		threads = 255
+	}
+	derive := func(passes, mem uint32) time.Duration {
+		return measure(func() {
+			argon2.IDKey(password, salt, passes, mem, uint8(threads), 32)
+		})
+	}
+
+	mem := uint32(opts.MaxMem) << 10
+	d := derive(1, mem)
+	for d > opts.Target && mem/2 >= minArgon2Mem {
+		mem /= 2
+		d = derive(1, mem)
+	}
+	if d > opts.Target || mem < minArgon2Mem {
+		fmt.Printf("argon2id: no parameters stronger than the default memory=%d KiB "+
+			"fit the target duration and memory limit\n", minArgon2Mem)
+		return
+	}
+	passes := uint32(opts.Target / d)
+	if passes > 1 {
+		d = derive(passes, mem)
+		for passes > 1 && d > opts.Target {
+			passes--
+			d = derive(passes, mem)
+		}
+	}
+	fmt.Printf("argon2id: memory=%d KiB passes=%d threads=%d (%v)\n", mem, passes,
+		threads, d.Round(time.Millisecond))
+	fmt.Printf("  ChangePassphrase: kdf=ARGON2ID kdf_n=%d kdf_r=%d kdf_p=%d\n",
+		mem, passes, threads)
+}
+
+func main() {
+	_, err := flags.Parse(&opts)
+	if err != nil {
+		os.Exit(1)
+	}
+	if opts.Target <= 0 {
+		fatalf("--target must be positive")
+	}
+	if opts.MaxMem == 0 || opts.MaxMem >= 1<<22 {
+		fatalf("--maxmem must be between 1 and %d MiB", 1<<22-1)
+	}
+
+	switch opts.KDF {
+	case "scrypt":
+		benchScrypt()
+	case "argon2id":
+		benchArgon2id()
+	case "all":
+		benchScrypt()
+		benchArgon2id()
+	default:
+		fatalf("Unknown KDF %q", opts.KDF)
+	}
+}
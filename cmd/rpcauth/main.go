@@ -0,0 +1,62 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// rpcauth creates the rpcauth config line for an additional legacy JSON-RPC
+// user.  Only a salted hash of the password is written to the config, so the
+// password must be kept by the client.  A random password is generated when
+// one is not provided.
+package main
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/fonero-project/fnowallet/rpc/legacyrpc"
+)
+
+var newlineBytes = []byte{'\n'}
+
+func fatalf(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, format, args...)
+	os.Stderr.Write(newlineBytes)
+	os.Exit(1)
+}
+
+func random(n int) []byte {
+	b := make([]byte, n)
+	_, err := rand.Read(b)
+	if err != nil {
+		fatalf("Failed to read random bytes: %v", err)
+	}
+	return b
+}
+
+func main() {
+	if len(os.Args) != 2 && len(os.Args) != 3 {
+		fatalf("Usage: rpcauth <username> [<password>]")
+	}
+	username := os.Args[1]
+	if username == "" || strings.ContainsRune(username, ':') {
+		fatalf("Username must be non-empty and may not contain ':'")
+	}
+	var password string
+	if len(os.Args) == 3 {
+		password = os.Args[2]
+	} else {
+		password = base64.URLEncoding.EncodeToString(random(32))
+	}
+	salt := hex.EncodeToString(random(16))
+	hash := legacyrpc.RPCAuthHash(salt, password)
+
+	fmt.Println("String to be appended to fnowallet.conf:")
+	fmt.Printf("rpcauth=%s:%s$%x\n", username, salt, hash)
+	if len(os.Args) == 2 {
+		fmt.Println("Your password:")
+		fmt.Println(password)
+	}
+}
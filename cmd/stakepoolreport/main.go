@@ -0,0 +1,190 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// stakepoolreport writes the stake pool fee accounting of a running wallet as
+// CSV.  The wallet is queried over gRPC using the StakePoolFeeReport method.
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/fonero-project/fnod/fnoutil"
+	"github.com/fonero-project/fnowallet/netparams"
+	pb "github.com/fonero-project/fnowallet/rpc/walletrpc"
+	"github.com/jessevdk/go-flags"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+var (
+	walletDataDirectory = fnoutil.AppDataDir("fnowallet", false)
+	newlineBytes        = []byte{'\n'}
+)
+
+func fatalf(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, format, args...)
+	os.Stderr.Write(newlineBytes)
+	os.Exit(1)
+}
+
+// Flags.
+var opts = struct {
+	TestNet            bool     `long:"testnet" description:"Use the test fonero network"`
+	SimNet             bool     `long:"simnet" description:"Use the simulation fonero network"`
+	RPCConnect         string   `short:"c" long:"connect" description:"Hostname[:port] of wallet gRPC server"`
+	RPCCertificateFile string   `long:"cafile" description:"Wallet gRPC TLS certificate"`
+	BeginHeight        int32    `long:"begin" description:"Height of the first block to report"`
+	EndHeight          int32    `long:"end" description:"Height of the last block to report (default main chain tip)"`
+	Users              []string `long:"user" description:"Voting address of a user to report (may be repeated, default all users)"`
+	Output             string   `short:"o" long:"output" description:"File to write the CSV report to (default stdout)"`
+}{
+	RPCConnect:         "localhost",
+	RPCCertificateFile: filepath.Join(walletDataDirectory, "rpc.cert"),
+}
+
+// normalizeAddress returns the normalized form of the address, adding a default
+// port if necessary.  An error is returned if the address, even without a port,
+// is not valid.
+func normalizeAddress(addr string, defaultPort string) (hostport string, err error) {
+	// If the first SplitHostPort errors because of a missing port and not
+	// for an invalid host, add the port.  If the second SplitHostPort
+	// fails, then a port is not missing and the original error should be
+	// returned.
+	host, port, origErr := net.SplitHostPort(addr)
+	if origErr == nil {
+		return net.JoinHostPort(host, port), nil
+	}
+	addr = net.JoinHostPort(addr, defaultPort)
+	_, _, err = net.SplitHostPort(addr)
+	if err != nil {
+		return "", origErr
+	}
+	return addr, nil
+}
+
+// Parse and validate flags.
+func init() {
+	_, err := flags.Parse(&opts)
+	if err != nil {
+		os.Exit(1)
+	}
+
+	if opts.TestNet && opts.SimNet {
+		fatalf("Multiple fonero networks may not be used simultaneously")
+	}
+	activeNet := &netparams.MainNetParams
+	if opts.TestNet {
+		activeNet = &netparams.TestNetParams
+	} else if opts.SimNet {
+		activeNet = &netparams.SimNetParams
+	}
+
+	rpcConnect, err := normalizeAddress(opts.RPCConnect, activeNet.GRPCServerPort)
+	if err != nil {
+		fatalf("Invalid RPC network address `%v`: %v", opts.RPCConnect, err)
+	}
+	opts.RPCConnect = rpcConnect
+
+	if opts.BeginHeight < 0 || opts.EndHeight < 0 {
+		fatalf("Block heights must be non-negative")
+	}
+}
+
+var csvHeader = []string{
+	"user",
+	"tickets",
+	"voted",
+	"revoked",
+	"live",
+	"expired",
+	"fees_earned",
+	"fees_outstanding",
+}
+
+func csvRecord(user string, fees *pb.StakePoolFees) []string {
+	return []string{
+		user,
+		strconv.FormatUint(uint64(fees.Tickets), 10),
+		strconv.FormatUint(uint64(fees.Voted), 10),
+		strconv.FormatUint(uint64(fees.Revoked), 10),
+		strconv.FormatUint(uint64(fees.Live), 10),
+		strconv.FormatUint(uint64(fees.Expired), 10),
+		strconv.FormatFloat(fnoutil.Amount(fees.FeesEarned).ToCoin(), 'f', -1, 64),
+		strconv.FormatFloat(fnoutil.Amount(fees.FeesOutstanding).ToCoin(), 'f', -1, 64),
+	}
+}
+
+func main() {
+	err := run()
+	if err != nil {
+		fatalf("%v", err)
+	}
+}
+
+func run() error {
+	creds, err := credentials.NewClientTLSFromFile(opts.RPCCertificateFile, "localhost")
+	if err != nil {
+		return err
+	}
+	conn, err := grpc.Dial(opts.RPCConnect, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	var out io.Writer = os.Stdout
+	if opts.Output != "" {
+		f, err := os.Create(opts.Output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+
+	c := pb.NewWalletServiceClient(conn)
+	stream, err := c.StakePoolFeeReport(context.Background(), &pb.StakePoolFeeReportRequest{
+		BeginHeight: opts.BeginHeight,
+		EndHeight:   opts.EndHeight,
+		Users:       opts.Users,
+	})
+	if err != nil {
+		return err
+	}
+
+	w := csv.NewWriter(out)
+	err = w.Write(csvHeader)
+	if err != nil {
+		return err
+	}
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		switch {
+		case resp.UserFees != nil:
+			err = w.Write(csvRecord(resp.UserFees.User, resp.UserFees.Fees))
+		case resp.Totals != nil:
+			fmt.Fprintf(os.Stderr, "Reported %d users from block %d to %d\n",
+				resp.Totals.Users, resp.Totals.BeginHeight, resp.Totals.EndHeight)
+			err = w.Write(csvRecord("total", resp.Totals.Fees))
+		}
+		if err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
@@ -0,0 +1,141 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// ticketbuyersim simulates the ticket buyer over the main chain block headers
+// recorded by a wallet and reports what a ticket buyer configuration would have
+// bought, spent, and earned.  The wallet database is opened directly, so the
+// wallet must not be running.
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/fonero-project/fnod/chaincfg"
+	"github.com/fonero-project/fnod/fnoutil"
+	"github.com/fonero-project/fnod/wire"
+	"github.com/fonero-project/fnowallet/loader"
+	"github.com/fonero-project/fnowallet/netparams"
+	"github.com/fonero-project/fnowallet/ticketbuyer/v2"
+	"github.com/fonero-project/fnowallet/wallet"
+	"github.com/fonero-project/fnowallet/wallet/txrules"
+	"github.com/jessevdk/go-flags"
+)
+
+var (
+	walletDataDirectory = fnoutil.AppDataDir("fnowallet", false)
+	newlineBytes        = []byte{'\n'}
+)
+
+func fatalf(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, format, args...)
+	os.Stderr.Write(newlineBytes)
+	os.Exit(1)
+}
+
+// Flags.
+var opts = struct {
+	TestNet       bool    `long:"testnet" description:"Use the test fonero network"`
+	SimNet        bool    `long:"simnet" description:"Use the simulation fonero network"`
+	AppDataDir    string  `short:"A" long:"appdata" description:"Wallet application data directory"`
+	WalletPass    string  `long:"walletpass" default-mask:"-" description:"The public wallet password"`
+	Balance       float64 `long:"balance" description:"Starting balance of the simulated purchasing account"`
+	Maintain      float64 `long:"balancetomaintainabsolute" description:"Amount of funds to keep in the simulated account"`
+	TicketFeeRate float64 `long:"ticketfee" description:"Fee rate (per kB) paid by ticket purchases"`
+	PoolFees      float64 `long:"poolfees" description:"Stake pool fee percentage (e.g. 1.00 for 1.00% fee)"`
+	BeginHeight   int32   `long:"begin" description:"Height of the first block to simulate"`
+	EndHeight     int32   `long:"end" description:"Height of the last block to simulate (default main chain tip)"`
+}{
+	AppDataDir:    walletDataDirectory,
+	WalletPass:    wallet.InsecurePubPassphrase,
+	TicketFeeRate: txrules.DefaultRelayFeePerKb.ToCoin(),
+}
+
+var activeNet = &netparams.MainNetParams
+
+// Parse and validate flags.
+func init() {
+	_, err := flags.Parse(&opts)
+	if err != nil {
+		os.Exit(1)
+	}
+
+	if opts.TestNet && opts.SimNet {
+		fatalf("Multiple fonero networks may not be used simultaneously")
+	}
+	if opts.TestNet {
+		activeNet = &netparams.TestNetParams
+	} else if opts.SimNet {
+		activeNet = &netparams.SimNetParams
+	}
+
+	if opts.BeginHeight < 1 || opts.EndHeight < 0 {
+		fatalf("Begin height must be positive and end height non-negative")
+	}
+	if opts.Balance <= 0 {
+		fatalf("A positive starting balance must be specified")
+	}
+}
+
+// networkDir returns the directory name of a network directory to hold wallet
+// files.
+func networkDir(dataDir string, chainParams *chaincfg.Params) string {
+	netname := chainParams.Name
+	// Be cautious of v2+ testnets being named only "testnet".
+	switch chainParams.Net {
+	case 0x48e7a065: // testnet2
+		netname = "testnet2"
+	case wire.TestNet:
+		netname = "testnet3"
+	}
+	return filepath.Join(dataDir, netname)
+}
+
+func amount(coins float64, name string) fnoutil.Amount {
+	a, err := fnoutil.NewAmount(coins)
+	if err != nil {
+		fatalf("Invalid %s: %v", name, err)
+	}
+	return a
+}
+
+func main() {
+	cfg := &ticketbuyer.SimConfig{
+		Config: ticketbuyer.Config{
+			Maintain: amount(opts.Maintain, "balance to maintain"),
+			PoolFees: opts.PoolFees,
+		},
+		Balance:       amount(opts.Balance, "balance"),
+		TicketFeeRate: amount(opts.TicketFeeRate, "ticket fee rate"),
+		BeginHeight:   opts.BeginHeight,
+		EndHeight:     opts.EndHeight,
+	}
+
+	dbDir := networkDir(opts.AppDataDir, activeNet.Params)
+	l := loader.NewLoader(activeNet.Params, dbDir, &loader.StakeOptions{},
+		wallet.DefaultGapLimit, false, txrules.DefaultRelayFeePerKb.ToCoin(),
+		wallet.DefaultAccountGapLimit)
+	w, err := l.OpenExistingWallet([]byte(opts.WalletPass))
+	if err != nil {
+		fatalf("Failed to open wallet: %v", err)
+	}
+	res, err := ticketbuyer.Simulate(context.Background(), w, cfg)
+	l.UnloadWallet()
+	if err != nil {
+		fatalf("Simulation failed: %v", err)
+	}
+
+	fmt.Printf("Blocks:            %d-%d\n", res.BeginHeight, res.EndHeight)
+	fmt.Printf("Tickets purchased: %d\n", res.Purchased)
+	fmt.Printf("Spent:             %v\n", res.Spent)
+	fmt.Printf("Ticket fees:       %v\n", res.Fees)
+	fmt.Printf("Expected voted:    %.2f\n", res.Voted)
+	fmt.Printf("Expected expired:  %.2f\n", res.Expired)
+	fmt.Printf("Expected live:     %.2f\n", res.Live)
+	fmt.Printf("Expected earned:   %v\n", res.Earned)
+	fmt.Printf("Immature:          %v\n", res.Immature)
+	fmt.Printf("Ending balance:    %v\n", res.Balance)
+}
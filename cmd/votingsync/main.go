@@ -0,0 +1,215 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// votingsync manages a voting-only wallet which votes the tickets purchased by
+// another (cold) wallet.  Both wallets are accessed over gRPC.  The voting
+// account is first copied from the purchasing wallet to the voting wallet with
+// --import.  Afterwards, each run copies the tickets voting with the account
+// from the purchasing wallet to the voting wallet.
+package main
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+
+	"github.com/fonero-project/fnod/fnoutil"
+	"github.com/fonero-project/fnowallet/netparams"
+	pb "github.com/fonero-project/fnowallet/rpc/walletrpc"
+	"github.com/jessevdk/go-flags"
+	"golang.org/x/crypto/ssh/terminal"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+var (
+	walletDataDirectory = fnoutil.AppDataDir("fnowallet", false)
+	newlineBytes        = []byte{'\n'}
+)
+
+func fatalf(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, format, args...)
+	os.Stderr.Write(newlineBytes)
+	os.Exit(1)
+}
+
+// Flags.
+var opts = struct {
+	TestNet                  bool   `long:"testnet" description:"Use the test fonero network"`
+	SimNet                   bool   `long:"simnet" description:"Use the simulation fonero network"`
+	RPCConnect               string `short:"c" long:"connect" description:"Hostname[:port] of the ticket purchasing wallet gRPC server"`
+	RPCCertificateFile       string `long:"cafile" description:"Ticket purchasing wallet gRPC TLS certificate"`
+	VotingRPCConnect         string `long:"votingconnect" description:"Hostname[:port] of the voting wallet gRPC server"`
+	VotingRPCCertificateFile string `long:"votingcafile" description:"Voting wallet gRPC TLS certificate"`
+	Account                  uint32 `long:"account" description:"Voting account number of the ticket purchasing wallet"`
+	VotingAccount            uint32 `long:"votingaccount" description:"Account number of the imported voting account in the voting wallet"`
+	Import                   bool   `long:"import" description:"Import the voting account into the voting wallet instead of syncing tickets"`
+	AccountName              string `long:"name" description:"Name of the voting account created by --import"`
+}{
+	RPCConnect:               "localhost",
+	RPCCertificateFile:       filepath.Join(walletDataDirectory, "rpc.cert"),
+	VotingRPCCertificateFile: filepath.Join(walletDataDirectory, "rpc.cert"),
+	AccountName:              "voting",
+}
+
+// normalizeAddress returns the normalized form of the address, adding a default
+// port if necessary.  An error is returned if the address, even without a port,
+// is not valid.
+func normalizeAddress(addr string, defaultPort string) (hostport string, err error) {
+	// If the first SplitHostPort errors because of a missing port and not
+	// for an invalid host, add the port.  If the second SplitHostPort
+	// fails, then a port is not missing and the original error should be
+	// returned.
+	host, port, origErr := net.SplitHostPort(addr)
+	if origErr == nil {
+		return net.JoinHostPort(host, port), nil
+	}
+	addr = net.JoinHostPort(addr, defaultPort)
+	_, _, err = net.SplitHostPort(addr)
+	if err != nil {
+		return "", origErr
+	}
+	return addr, nil
+}
+
+// Parse and validate flags.
+func init() {
+	_, err := flags.Parse(&opts)
+	if err != nil {
+		os.Exit(1)
+	}
+
+	if opts.TestNet && opts.SimNet {
+		fatalf("Multiple fonero networks may not be used simultaneously")
+	}
+	activeNet := &netparams.MainNetParams
+	if opts.TestNet {
+		activeNet = &netparams.TestNetParams
+	} else if opts.SimNet {
+		activeNet = &netparams.SimNetParams
+	}
+
+	if opts.VotingRPCConnect == "" {
+		fatalf("The voting wallet must be specified with --votingconnect")
+	}
+	rpcConnect, err := normalizeAddress(opts.RPCConnect, activeNet.GRPCServerPort)
+	if err != nil {
+		fatalf("Invalid RPC network address `%v`: %v", opts.RPCConnect, err)
+	}
+	opts.RPCConnect = rpcConnect
+	rpcConnect, err = normalizeAddress(opts.VotingRPCConnect, activeNet.GRPCServerPort)
+	if err != nil {
+		fatalf("Invalid RPC network address `%v`: %v", opts.VotingRPCConnect, err)
+	}
+	opts.VotingRPCConnect = rpcConnect
+	if opts.RPCConnect == opts.VotingRPCConnect {
+		fatalf("The ticket purchasing and voting wallets must be different")
+	}
+}
+
+func dial(addr, certFile string) (*grpc.ClientConn, error) {
+	creds, err := credentials.NewClientTLSFromFile(certFile, "localhost")
+	if err != nil {
+		return nil, err
+	}
+	return grpc.Dial(addr, grpc.WithTransportCredentials(creds))
+}
+
+func promptSecret(what string) ([]byte, error) {
+	fmt.Printf("%s: ", what)
+	fd := int(os.Stdin.Fd())
+	input, err := terminal.ReadPassword(fd)
+	fmt.Println()
+	return input, err
+}
+
+func main() {
+	err := run()
+	if err != nil {
+		fatalf("%v", err)
+	}
+}
+
+func run() error {
+	conn, err := dial(opts.RPCConnect, opts.RPCCertificateFile)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	votingConn, err := dial(opts.VotingRPCConnect, opts.VotingRPCCertificateFile)
+	if err != nil {
+		return err
+	}
+	defer votingConn.Close()
+
+	c := pb.NewWalletServiceClient(conn)
+	votingC := pb.NewWalletServiceClient(votingConn)
+	ctx := context.Background()
+
+	if opts.Import {
+		return importAccount(ctx, c, votingC)
+	}
+	return syncTickets(ctx, c, votingC)
+}
+
+// importAccount copies the voting account from the ticket purchasing wallet to
+// the voting wallet.  The account key is never written to the terminal.
+func importAccount(ctx context.Context, c, votingC pb.WalletServiceClient) error {
+	pass, err := promptSecret("Ticket purchasing wallet private passphrase")
+	if err != nil {
+		return err
+	}
+	votingPass, err := promptSecret("Voting wallet private passphrase")
+	if err != nil {
+		return err
+	}
+
+	exportResp, err := c.ExportVotingAccount(ctx, &pb.ExportVotingAccountRequest{
+		Passphrase: pass,
+		Account:    opts.Account,
+	})
+	if err != nil {
+		return fmt.Errorf("export voting account: %v", err)
+	}
+	importResp, err := votingC.ImportVotingAccount(ctx, &pb.ImportVotingAccountRequest{
+		Passphrase:   votingPass,
+		Name:         opts.AccountName,
+		AccountXpriv: exportResp.AccountXpriv,
+	})
+	if err != nil {
+		return fmt.Errorf("import voting account: %v", err)
+	}
+
+	fmt.Printf("Imported account %d of the ticket purchasing wallet as "+
+		"voting wallet account %d (%q)\n", opts.Account, importResp.Account,
+		opts.AccountName)
+	return nil
+}
+
+// syncTickets copies the tickets voting with the voting account from the ticket
+// purchasing wallet to the voting wallet.
+func syncTickets(ctx context.Context, c, votingC pb.WalletServiceClient) error {
+	ticketsResp, err := c.VotingAccountTickets(ctx, &pb.VotingAccountTicketsRequest{
+		Account: opts.Account,
+	})
+	if err != nil {
+		return fmt.Errorf("voting account tickets: %v", err)
+	}
+	if len(ticketsResp.Tickets) == 0 {
+		fmt.Println("No tickets vote with the voting account")
+		return nil
+	}
+	_, err = votingC.AddVotingTickets(ctx, &pb.AddVotingTicketsRequest{
+		Account: opts.VotingAccount,
+		Tickets: ticketsResp.Tickets,
+	})
+	if err != nil {
+		return fmt.Errorf("add voting tickets: %v", err)
+	}
+
+	fmt.Printf("Synced %d tickets to the voting wallet\n", len(ticketsResp.Tickets))
+	return nil
+}
@@ -17,9 +17,12 @@ import (
 	"strings"
 
 	"github.com/fonero-project/fnod/fnoutil"
+	"github.com/fonero-project/fnowallet/auditlog"
 	"github.com/fonero-project/fnowallet/errors"
 	"github.com/fonero-project/fnowallet/internal/cfgutil"
+	"github.com/fonero-project/fnowallet/internal/ratelimit"
 	"github.com/fonero-project/fnowallet/netparams"
+	"github.com/fonero-project/fnowallet/rpc/legacyrpc"
 	"github.com/fonero-project/fnowallet/ticketbuyer"
 	"github.com/fonero-project/fnowallet/version"
 	"github.com/fonero-project/fnowallet/wallet"
@@ -38,6 +41,7 @@ const (
 	defaultRPCMaxWebsockets    = 25
 	defaultEnableTicketBuyer   = false
 	defaultEnableVoting        = false
+	defaultEnableRevoker       = false
 	defaultReuseAddresses      = false
 	defaultRollbackTest        = false
 	defaultPruneTickets        = false
@@ -69,6 +73,10 @@ const (
 	defaultBalanceToMaintainAbsolute                = 0
 	defaultBalanceToMaintainRelative                = 0.3
 
+	// revoker options
+	defaultRevokerMaxFee    fnoutil.Amount = 1e6
+	defaultRevokerBatchSize                = 20
+
 	walletDbName = "wallet.db"
 )
 
@@ -86,8 +94,9 @@ type config struct {
 	ConfigFile         *cfgutil.ExplicitString `short:"C" long:"configfile" description:"Path to configuration file"`
 	ShowVersion        bool                    `short:"V" long:"version" description:"Display version information and exit"`
 	Create             bool                    `long:"create" description:"Create the wallet if it does not exist"`
-	CreateTemp         bool                    `long:"createtemp" description:"Create a temporary simulation wallet (pass=password) in the data directory indicated; must call with --appdata"`
+	CreateTemp         bool                    `long:"createtemp" description:"Create a temporary in-memory simulation wallet (pass=password) for the data directory indicated; must call with --appdata"`
 	CreateWatchingOnly bool                    `long:"createwatchingonly" description:"Create the wallet and instantiate it as watching only with an HD extended pubkey"`
+	VerifySeed         bool                    `long:"verifyseed" description:"Verify a backup of the seed matches the existing wallet and exit"`
 	AppDataDir         *cfgutil.ExplicitString `short:"A" long:"appdata" description:"Application data directory for wallet config, databases and logs"`
 	TestNet            bool                    `long:"testnet" description:"Use the test network"`
 	SimNet             bool                    `long:"simnet" description:"Use the simulation test network"`
@@ -96,15 +105,20 @@ type config struct {
 	LogDir             *cfgutil.ExplicitString `long:"logdir" description:"Directory to log output."`
 	Profile            []string                `long:"profile" description:"Enable HTTP profiling this interface/port"`
 	MemProfile         string                  `long:"memprofile" description:"Write mem profile to the specified file"`
+	AuditLogFile       string                  `long:"auditlog" description:"Append a hash-chained audit log of sensitive wallet operations to this file (disabled by default)"`
+	auditLog           *auditlog.Log
+	dbDriver           string
 
 	// Wallet options
 	WalletPass          string               `long:"walletpass" default-mask:"-" description:"The public wallet password -- Only required if the wallet was created with one"`
 	PromptPass          bool                 `long:"promptpass" description:"The private wallet password is prompted for at start up, so the wallet starts unlocked without a time limit"`
 	Pass                string               `long:"pass" description:"The private wallet passphrase"`
+	ScopedPass          bool                 `long:"scopedpass" description:"Only unlock the private keys used for tickets, votes, and revocations with the pass option"`
 	PromptPublicPass    bool                 `long:"promptpublicpass" description:"The public wallet password is prompted for at start up"`
 	DisallowFree        bool                 `long:"disallowfree" description:"Force transactions to always include a fee"`
 	EnableTicketBuyer   bool                 `long:"enableticketbuyer" description:"Enable the automatic ticket buyer"`
 	EnableVoting        bool                 `long:"enablevoting" description:"Enable creation of votes and revocations for owned tickets"`
+	EnableRevoker       bool                 `long:"enablerevoker" description:"Enable automatic revocation of missed and expired tickets"`
 	ReuseAddresses      bool                 `long:"reuseaddresses" description:"Reuse addresses for ticket purchase to cut down on address overuse"`
 	PurchaseAccount     string               `long:"purchaseaccount" description:"Name of the account to buy tickets from"`
 	PoolAddress         *cfgutil.AddressFlag `long:"pooladdress" description:"The ticket pool address where ticket fees will go to"`
@@ -115,7 +129,13 @@ type config struct {
 	RelayFee            *cfgutil.AmountFlag  `long:"txfee" description:"Sets the wallet's tx fee per kb"`
 	TicketFee           *cfgutil.AmountFlag  `long:"ticketfee" description:"Sets the wallet's ticket fee per kb"`
 	AccountGapLimit     int                  `long:"accountgaplimit" description:"Number of accounts that can be created in a row without using any of them"`
+	PruneCFilters       int32                `long:"prunecfilters" description:"Remove committed filters of blocks this many blocks below the main chain tip, fetching them again when rescanning (disabled by default)"`
+	SpendLimits         []string             `long:"spendlimit" description:"Maximum amount an account may spend outside of the account during any 24 hour period in the form account:amount"`
+	SpendDestinations   []string             `long:"spenddestination" description:"Address outside of the account which an account may pay in the form account:address (default any address)"`
+	SpendMaxFeeRates    []string             `long:"spendmaxfeerate" description:"Maximum fee rate per kB of transactions spending an account in the form account:rate"`
+	SpendApprovals      []string             `long:"spendapproval" description:"Hold transactions spending at least an amount from an account until approved with a passphrase in the form account:amount:salt$hash (see cmd/rpcauth)"`
 	legacyTicketBuyer   bool
+	spendPolicies       map[string]*wallet.SpendPolicy
 
 	// RPC client options
 	RPCConnect       string                  `short:"c" long:"rpcconnect" description:"Hostname/IP and port of fnod RPC server to connect to"`
@@ -152,6 +172,18 @@ type config struct {
 	LegacyRPCMaxWebsockets int64                   `long:"rpcmaxwebsockets" description:"Max number of legacy JSON-RPC websocket connections"`
 	Username               string                  `short:"u" long:"username" description:"Username for legacy JSON-RPC and fnod authentication (if fnodusername is unset)"`
 	Password               string                  `short:"P" long:"password" default-mask:"-" description:"Password for legacy JSON-RPC and fnod authentication (if fnodpassword is unset)"`
+	RPCAuth                []string                `long:"rpcauth" default-mask:"-" description:"Additional legacy JSON-RPC user authenticated by a salted password hash in the form user:salt$hash (see cmd/rpcauth)"`
+	RPCUserMethods         []string                `long:"rpcusermethods" description:"Legacy JSON-RPC methods an rpcauth user may call in the form user:method,method,... (default all methods)"`
+	RPCUserAccounts        []string                `long:"rpcuseraccounts" description:"Accounts an rpcauth user may access in the form user:account,account,... (default all accounts)"`
+	GRPCClientCAFile       string                  `long:"grpcclientcafile" description:"File containing root certificates to authenticate gRPC client certificates"`
+	GRPCClientPermissions  []string                `long:"grpcclientpermission" description:"Permission (readonly, invoice, full) of gRPC clients authenticated by a certificate with this common name in the form commonname:permission (certificates without a permission are denied)"`
+	GRPCAuthTokens         []string                `long:"grpcauthtoken" default-mask:"-" description:"Bearer token authenticating gRPC clients in the form permission:token"`
+	GatewayListeners       []string                `long:"gatewaylisten" description:"Listen for HTTP/JSON gateway connections to the gRPC services on this interface/port (disabled by default)"`
+	GatewayOrigins         []string                `long:"gatewayorigin" description:"Browser origin allowed to make cross-origin gateway requests"`
+	RateLimits             []string                `long:"ratelimit" description:"Limit the request rate of each RPC client for a class of methods (read, write, rescan, stream, sign) in the form class:rate:burst, where rate is requests per second"`
+	legacyRPCUsers         []legacyrpc.User
+	grpcAuth               *grpcAuthorizer
+	rateLimiter            *ratelimit.Limiter
 
 	// IPC options
 	PipeTx            *uint `long:"pipetx" description:"File descriptor or handle of write end pipe to enable child -> parent process communication"`
@@ -161,6 +193,8 @@ type config struct {
 	TBOpts ticketBuyerOptions `group:"Ticket Buyer Options" namespace:"ticketbuyer"`
 	tbCfg  ticketbuyer.Config
 
+	RevokerOpts revokerOptions `group:"Revoker Options" namespace:"revoker"`
+
 	// Deprecated options
 	DataDir         *cfgutil.ExplicitString `short:"b" long:"datadir" default-mask:"-" description:"DEPRECATED -- use appdata instead"`
 	PruneTickets    bool                    `long:"prunetickets" description:"DEPRECATED -- old tickets are always pruned"`
@@ -173,6 +207,7 @@ type config struct {
 type ticketBuyerOptions struct {
 	BalanceToMaintainAbsolute *cfgutil.AmountFlag  `long:"balancetomaintainabsolute" description:"Amount of funds to keep in wallet when stake mining"`
 	VotingAddress             *cfgutil.AddressFlag `long:"votingaddress" description:"Purchase tickets with voting rights assigned to this address"`
+	WaitForLowerPrice         bool                 `long:"waitforlowerprice" description:"Delay purchases while the ticket price of the next window is expected to be lower"`
 
 	// Deprecated options
 	AvgPriceMode              string              `long:"avgpricemode" description:"DEPRECATED -- The mode to use for calculating the average price if pricetarget is disabled (vwap, pool, dual)"`
@@ -195,6 +230,12 @@ type ticketBuyerOptions struct {
 	DontWaitForTickets        bool                `long:"dontwaitfortickets" description:"DEPRECATED -- Don't wait until your last round of tickets have entered the blockchain to attempt to purchase more"`
 }
 
+type revokerOptions struct {
+	FeeRate   *cfgutil.AmountFlag `long:"feerate" description:"Fee per KB paid by revocations (default: txfee)"`
+	MaxFee    *cfgutil.AmountFlag `long:"maxfee" description:"Maximum fee paid by a single revocation, 0 to disable"`
+	BatchSize int                 `long:"batchsize" description:"Maximum number of revocations published per block, 0 to disable"`
+}
+
 // cleanAndExpandPath expands environement variables and leading ~ in the
 // passed path, cleans the result, and returns it.
 func cleanAndExpandPath(path string) string {
@@ -358,6 +399,7 @@ func loadConfig(ctx context.Context) (*config, []string, error) {
 		LegacyRPCMaxWebsockets: defaultRPCMaxWebsockets,
 		EnableTicketBuyer:      defaultEnableTicketBuyer,
 		EnableVoting:           defaultEnableVoting,
+		EnableRevoker:          defaultEnableRevoker,
 		ReuseAddresses:         defaultReuseAddresses,
 		PruneTickets:           defaultPruneTickets,
 		PurchaseAccount:        defaultPurchaseAccount,
@@ -397,6 +439,13 @@ func loadConfig(ctx context.Context) (*config, []string, error) {
 			PriceTarget:               cfgutil.NewAmountFlag(defaultPriceTarget),
 			BalanceToMaintainRelative: defaultBalanceToMaintainRelative,
 		},
+
+		// Revoker Options
+		RevokerOpts: revokerOptions{
+			FeeRate:   cfgutil.NewAmountFlag(0),
+			MaxFee:    cfgutil.NewAmountFlag(defaultRevokerMaxFee),
+			BatchSize: defaultRevokerBatchSize,
+		},
 	}
 
 	// Pre-parse the command line options to see if an alternative config
@@ -673,6 +722,16 @@ func loadConfig(ctx context.Context) (*config, []string, error) {
 		return loadConfigError(err)
 	}
 
+	// Sanity check PruneCFilters
+	if cfg.PruneCFilters < 0 || (cfg.PruneCFilters != 0 &&
+		cfg.PruneCFilters < wallet.MinCFilterPruneDepth) {
+		str := "%s: prunecfilters must be zero or at least %d: %v"
+		err := errors.Errorf(str, funcName, wallet.MinCFilterPruneDepth,
+			cfg.PruneCFilters)
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+
 	// Exit if you try to use a simulation wallet with a standard
 	// data directory.
 	if !(cfg.AppDataDir.ExplicitlySet() || cfg.DataDir.ExplicitlySet()) && cfg.CreateTemp {
@@ -708,6 +767,14 @@ func loadConfig(ctx context.Context) (*config, []string, error) {
 		return loadConfigError(err)
 	}
 
+	if cfg.VerifySeed && (cfg.Create || cfg.CreateTemp || cfg.CreateWatchingOnly) {
+		err := errors.Errorf("The flag --verifyseed can not be specified " +
+			"together with wallet creation flags. Use --help for more " +
+			"information.")
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+
 	dbFileExists, err := cfgutil.FileExists(dbPath)
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
@@ -766,6 +833,22 @@ func loadConfig(ctx context.Context) (*config, []string, error) {
 		}
 
 		// Created successfully, so exit now with success.
+		os.Exit(0)
+	} else if cfg.VerifySeed {
+		if !dbFileExists {
+			err := errors.Errorf("The wallet does not exist.  A seed " +
+				"can only be verified against an existing wallet.")
+			fmt.Fprintln(os.Stderr, err)
+			return loadConfigError(err)
+		}
+
+		os.Stdout.Sync()
+		err := verifySeed(ctx, &cfg)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "Unable to verify seed:", err)
+			return loadConfigError(err)
+		}
+
 		os.Exit(0)
 	} else if !dbFileExists && !cfg.NoInitialLoad {
 		err := errors.Errorf("The wallet does not exist.  Run with the " +
@@ -903,6 +986,19 @@ func loadConfig(ctx context.Context) (*config, []string, error) {
 		return loadConfigError(err)
 	}
 
+	cfg.GatewayListeners, err = cfgutil.NormalizeAddresses(
+		cfg.GatewayListeners, activeNet.GatewayPort)
+	if err != nil {
+		fmt.Fprintf(os.Stderr,
+			"Invalid network address in gateway listeners: %v\n", err)
+		return loadConfigError(err)
+	}
+	if len(cfg.GatewayListeners) != 0 && cfg.DisableServerTLS {
+		err := errors.Errorf("%s: the gateway requires server TLS", funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+
 	// Both RPC servers may not listen on the same interface/port, with the
 	// exception of listeners using port 0.
 	if len(cfg.LegacyRPCListeners) > 0 && len(cfg.GRPCListeners) > 0 {
@@ -952,6 +1048,62 @@ func loadConfig(ctx context.Context) (*config, []string, error) {
 	cfg.CAFile.Value = cleanAndExpandPath(cfg.CAFile.Value)
 	cfg.RPCCert.Value = cleanAndExpandPath(cfg.RPCCert.Value)
 	cfg.RPCKey.Value = cleanAndExpandPath(cfg.RPCKey.Value)
+	cfg.GRPCClientCAFile = cleanAndExpandPath(cfg.GRPCClientCAFile)
+	cfg.AuditLogFile = cleanAndExpandPath(cfg.AuditLogFile)
+
+	// Parse the spend policies of accounts.
+	cfg.spendPolicies, err = parseSpendPolicies(cfg.SpendLimits,
+		cfg.SpendDestinations, cfg.SpendMaxFeeRates, cfg.SpendApprovals,
+		activeNet.Params)
+	if err != nil {
+		err := errors.Errorf("%s: %v", funcName, err)
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+
+	// Parse the additional legacy RPC users and their restrictions.
+	cfg.legacyRPCUsers, err = parseLegacyRPCUsers(cfg.RPCAuth,
+		cfg.RPCUserMethods, cfg.RPCUserAccounts)
+	if err != nil {
+		err := errors.Errorf("%s: %v", funcName, err)
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+
+	// Parse the gRPC client authentication and permissions.
+	cfg.grpcAuth, err = newGRPCAuthorizer(cfg.GRPCClientCAFile,
+		cfg.GRPCClientPermissions, cfg.GRPCAuthTokens)
+	if err != nil {
+		err := errors.Errorf("%s: %v", funcName, err)
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+	if cfg.grpcAuth != nil && cfg.DisableServerTLS {
+		err := errors.Errorf("%s: gRPC client authentication requires "+
+			"server TLS", funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+	if cfg.grpcAuth == nil && len(cfg.GatewayListeners) != 0 {
+		err := errors.Errorf("%s: the gateway requires gRPC client "+
+			"certificates (--grpcclientcafile) or bearer tokens "+
+			"(--grpcauthtoken)", funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+
+	// Parse the RPC rate limits.
+	limits := make(map[string]ratelimit.Limit, len(cfg.RateLimits))
+	for _, l := range cfg.RateLimits {
+		class, limit, err := ratelimit.ParseLimit(l)
+		if err != nil {
+			err := errors.Errorf("%s: %v", funcName, err)
+			fmt.Fprintln(os.Stderr, err)
+			return loadConfigError(err)
+		}
+		limits[class] = limit
+	}
+	cfg.rateLimiter = ratelimit.New(limits)
 
 	// If the fnod username or password are unset, use the same auth as for
 	// the client.  The two settings were previously shared for fnod and
@@ -1031,3 +1183,67 @@ func loadConfig(ctx context.Context) (*config, []string, error) {
 
 	return &cfg, remainingArgs, nil
 }
+
+// parseLegacyRPCUsers parses the rpcauth users and the allowed methods and
+// accounts of each user.  Restrictions are in the form user:item,item,...
+func parseLegacyRPCUsers(rpcauth, methods, accounts []string) ([]legacyrpc.User, error) {
+	users := make([]legacyrpc.User, 0, len(rpcauth))
+	index := make(map[string]int, len(rpcauth))
+	for _, s := range rpcauth {
+		u, err := legacyrpc.ParseRPCAuth(s)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := index[u.Name]; ok {
+			return nil, errors.Errorf("duplicate rpcauth user %q", u.Name)
+		}
+		index[u.Name] = len(users)
+		users = append(users, *u)
+	}
+	// Restricted items are appended to the user field returned by field.
+	// Restricting a user to no items leaves the field non-nil and empty.
+	restrict := func(opt string, rs []string, field func(u *legacyrpc.User) *[]string) error {
+		for _, r := range rs {
+			colon := strings.IndexByte(r, ':')
+			if colon == -1 {
+				return errors.Errorf("%s %q is not in the form "+
+					"user:item,item,...", opt, r)
+			}
+			i, ok := index[r[:colon]]
+			if !ok {
+				return errors.Errorf("%s: no rpcauth user %q", opt, r[:colon])
+			}
+			items := field(&users[i])
+			if *items == nil {
+				*items = []string{}
+			}
+			for _, item := range strings.Split(r[colon+1:], ",") {
+				if item = strings.TrimSpace(item); item != "" {
+					*items = append(*items, item)
+				}
+			}
+		}
+		return nil
+	}
+	err := restrict("rpcusermethods", methods, func(u *legacyrpc.User) *[]string {
+		return &u.Methods
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i := range users {
+		for _, m := range users[i].Methods {
+			if !legacyrpc.IsMethod(m) {
+				return nil, errors.Errorf("rpcusermethods: unknown method %q "+
+					"for user %q", m, users[i].Name)
+			}
+		}
+	}
+	err = restrict("rpcuseraccounts", accounts, func(u *legacyrpc.User) *[]string {
+		return &u.Accounts
+	})
+	if err != nil {
+		return nil, err
+	}
+	return users, nil
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/fonero-project/fnowallet/rpc/legacyrpc"
+)
+
+func TestParseLegacyRPCUsers(t *testing.T) {
+	t.Parallel()
+	const salt = "cb77f0957de88ff388cf"
+	rpcauth := []string{fmt.Sprintf("dashboard:%s$%x", salt,
+		legacyrpc.RPCAuthHash(salt, "password"))}
+
+	users, err := parseLegacyRPCUsers(rpcauth,
+		[]string{"dashboard:getbalance, getnewaddress", "dashboard:subscribe"},
+		[]string{"dashboard:shop"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(users) != 1 {
+		t.Fatalf("parsed %d users", len(users))
+	}
+	u := users[0]
+	if fmt.Sprint(u.Methods) != "[getbalance getnewaddress subscribe]" {
+		t.Errorf("parsed methods %v", u.Methods)
+	}
+	if fmt.Sprint(u.Accounts) != "[shop]" {
+		t.Errorf("parsed accounts %v", u.Accounts)
+	}
+
+	invalid := []struct {
+		methods, accounts []string
+	}{
+		{methods: []string{"dashboard:getbalanse"}},
+		{methods: []string{"dashboard:getbalance,getblock"}},
+		{methods: []string{"other:getbalance"}},
+		{methods: []string{"getbalance"}},
+		{accounts: []string{"other:shop"}},
+	}
+	for _, test := range invalid {
+		_, err := parseLegacyRPCUsers(rpcauth, test.methods, test.accounts)
+		if err == nil {
+			t.Errorf("methods %q accounts %q: invalid restrictions were accepted",
+				test.methods, test.accounts)
+		}
+	}
+}
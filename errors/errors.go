@@ -70,6 +70,7 @@ const (
 	Protocol                        // Protocol violation
 	NoPeers                         // Fonero network is unreachable due to lack of peers or fnod RPC connections
 	Deployment                      // Inactive consensus deployment
+	Unsupported                     // Operation is not supported on this platform
 )
 
 func (k Kind) String() string {
@@ -116,6 +117,8 @@ func (k Kind) String() string {
 		return "Fonero network is unreachable"
 	case Deployment:
 		return "inactive deployment"
+	case Unsupported:
+		return "unsupported operation"
 	default:
 		return "unknown error kind"
 	}
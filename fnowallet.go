@@ -22,18 +22,21 @@ import (
 	"github.com/fonero-project/fnod/addrmgr"
 	"github.com/fonero-project/fnod/chaincfg"
 	fnorpcclient "github.com/fonero-project/fnod/rpcclient"
+	"github.com/fonero-project/fnowallet/auditlog"
 	"github.com/fonero-project/fnowallet/chain"
 	"github.com/fonero-project/fnowallet/errors"
 	"github.com/fonero-project/fnowallet/internal/prompt"
 	"github.com/fonero-project/fnowallet/internal/zero"
 	ldr "github.com/fonero-project/fnowallet/loader"
 	"github.com/fonero-project/fnowallet/p2p"
+	"github.com/fonero-project/fnowallet/revoker"
 	"github.com/fonero-project/fnowallet/rpc/legacyrpc"
 	"github.com/fonero-project/fnowallet/rpc/rpcserver"
 	"github.com/fonero-project/fnowallet/spv"
 	"github.com/fonero-project/fnowallet/ticketbuyer/v2"
 	"github.com/fonero-project/fnowallet/version"
 	"github.com/fonero-project/fnowallet/wallet"
+	"github.com/fonero-project/fnowallet/wallet/udb"
 )
 
 func init() {
@@ -145,6 +148,20 @@ func run(ctx context.Context) error {
 		return ctx.Err()
 	}
 
+	// Open the audit log of sensitive operations if enabled.  It is closed
+	// after the wallet and RPC servers are stopped.
+	if cfg.AuditLogFile != "" {
+		cfg.auditLog, err = auditlog.Open(cfg.AuditLogFile)
+		if err != nil {
+			log.Errorf("Unable to open audit log: %v", err)
+			return err
+		}
+		defer cfg.auditLog.Close()
+		seq, hash := cfg.auditLog.Head()
+		log.Infof("Opened audit log %s at entry %d (hash %s)", cfg.AuditLogFile,
+			seq, hash)
+	}
+
 	// Create the loader which is used to load and unload the wallet.  If
 	// --noinitialload is not set, this function is responsible for loading the
 	// wallet.  Otherwise, loading is deferred so it can be performed over RPC.
@@ -160,6 +177,12 @@ func run(ctx context.Context) error {
 	}
 	loader := ldr.NewLoader(activeNet.Params, dbDir, stakeOptions,
 		cfg.GapLimit, cfg.AllowHighFees, cfg.RelayFee.ToCoin(), cfg.AccountGapLimit)
+	loader.SetAuditLog(cfg.auditLog)
+	loader.SetCFilterPruneDepth(cfg.PruneCFilters)
+	loader.SetSpendPolicies(cfg.spendPolicies)
+	if cfg.dbDriver != "" {
+		loader.SetDatabaseDriver(cfg.dbDriver)
+	}
 
 	// Stop any services started by the loader after the shutdown procedure is
 	// initialized and this function returns.
@@ -170,6 +193,10 @@ func run(ctx context.Context) error {
 		} else if err == nil {
 			log.Infof("Closed wallet")
 		}
+		err = loader.UnloadNamedWallets()
+		if err != nil {
+			log.Errorf("Failed to close named wallets: %v", err)
+		}
 	}()
 
 	// Open the wallet when --noinitialload was not set.
@@ -224,7 +251,14 @@ func run(ctx context.Context) error {
 		// the wallet only over RPC, disable this feature for them.
 		if cfg.Pass != "" {
 			passphrase = []byte(cfg.Pass)
-			err = w.Unlock(passphrase, nil)
+			if cfg.ScopedPass {
+				scope := &udb.UnlockScope{
+					Uses: udb.KeyUseTicket | udb.KeyUseVote,
+				}
+				err = w.UnlockScoped(passphrase, scope, nil)
+			} else {
+				err = w.Unlock(passphrase, nil)
+			}
 			if err != nil {
 				log.Errorf("Incorrect passphrase in pass config setting.")
 				return err
@@ -248,6 +282,7 @@ func run(ctx context.Context) error {
 				c.VotingAddr = cfg.TBOpts.VotingAddress.Address
 				c.PoolFeeAddr = cfg.PoolAddress.Address
 				c.PoolFees = cfg.PoolFees
+				c.WaitForLowerPrice = cfg.TBOpts.WaitForLowerPrice
 			})
 			log.Infof("Starting ticket buyer")
 			tbdone := make(chan struct{})
@@ -260,6 +295,26 @@ func run(ctx context.Context) error {
 			}()
 			defer func() { <-tbdone }()
 		}
+
+		// Start the automatic revoker.
+		if cfg.EnableRevoker {
+			r := revoker.New(w)
+			r.AccessConfig(func(c *revoker.Config) {
+				c.FeeRate = cfg.RevokerOpts.FeeRate.Amount
+				c.MaxFee = cfg.RevokerOpts.MaxFee.Amount
+				c.BatchSize = cfg.RevokerOpts.BatchSize
+			})
+			log.Infof("Starting ticket revoker")
+			rdone := make(chan struct{})
+			go func() {
+				err := r.Run(ctx, passphrase)
+				if err != nil && err != context.Canceled {
+					log.Errorf("Ticket revoking ended: %v", err)
+				}
+				rdone <- struct{}{}
+			}()
+			defer func() { <-rdone }()
+		}
 	}
 
 	if done(ctx) {
@@ -273,7 +328,7 @@ func run(ctx context.Context) error {
 	//
 	// Servers will be associated with a loaded wallet if it has already been
 	// loaded, or after it is loaded later on.
-	gRPCServer, jsonRPCServer, err := startRPCServers(loader)
+	gRPCServer, jsonRPCServer, err := startRPCServers(ctx, loader)
 	if err != nil {
 		log.Errorf("Unable to create RPC servers: %v", err)
 		return err
@@ -421,6 +476,7 @@ func spvLoop(ctx context.Context, w *wallet.Wallet, loader *ldr.Loader) {
 	}
 	w.SetNetworkBackend(syncer)
 	loader.SetNetworkBackend(syncer)
+	loader.SetSyncer(syncer)
 	for {
 		err := syncer.Run(ctx)
 		if done(ctx) {
@@ -456,9 +512,13 @@ func rpcClientConnectLoop(ctx context.Context, passphrase []byte, jsonRPCServer
 			return
 		}
 
-		n := chain.BackendFromRPCClient(chainClient.Client)
+		// The syncer of the loaded wallet also synchronizes every named
+		// wallet opened by the loader.
+		syncer := chain.NewRPCSyncer(w, chainClient)
+		n := syncer.Backend()
 		w.SetNetworkBackend(n)
 		loader.SetNetworkBackend(n)
+		loader.SetSyncer(syncer)
 
 		if cfg.EnableTicketBuyer && cfg.legacyTicketBuyer {
 			err = loader.StartTicketPurchase(passphrase, &cfg.tbCfg)
@@ -470,7 +530,6 @@ func rpcClientConnectLoop(ctx context.Context, passphrase []byte, jsonRPCServer
 		// Run wallet synchronization until it is cancelled or errors.  If the
 		// context was cancelled, return immediately instead of trying to
 		// reconnect.
-		syncer := chain.NewRPCSyncer(w, chainClient)
 		err = syncer.Run(ctx, true)
 		if errors.Match(errors.E(context.Canceled), err) {
 			return
@@ -483,6 +542,7 @@ func rpcClientConnectLoop(ctx context.Context, passphrase []byte, jsonRPCServer
 		// occurs.
 		w.SetNetworkBackend(nil)
 		loader.SetNetworkBackend(nil)
+		loader.SetSyncer(nil)
 		loader.StopTicketPurchase()
 	}
 }
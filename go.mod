@@ -11,6 +11,7 @@ require (
 	golang.org/x/crypto v0.0.0-20190222235706-ffb98f73852f
 	golang.org/x/net v0.0.0-20190213061140-3a22650c66bd
 	golang.org/x/sync v0.0.0-20181221193216-37e7f081c4d4
+	golang.org/x/text v0.3.0
 	google.golang.org/genproto v0.0.0-20190219182410-082222b4a5c5 // indirect
 	google.golang.org/grpc v1.18.0
 )
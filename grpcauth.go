@@ -0,0 +1,243 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/fonero-project/fnowallet/errors"
+	"github.com/fonero-project/fnowallet/rpc/rpcserver"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// grpcAuthToken is a bearer token authorizing gRPC clients.  Only the hash of
+// the token is kept so that tokens can be compared in constant time.
+type grpcAuthToken struct {
+	hash [sha256.Size]byte
+	perm rpcserver.Permission
+}
+
+// grpcAuthorizer authenticates gRPC clients by their verified TLS client
+// certificate or a bearer token and checks the permission of the client to call
+// each method.  A nil authorizer allows all clients to call every method.
+type grpcAuthorizer struct {
+	clientCAs *x509.CertPool
+	certPerms map[string]rpcserver.Permission // Keyed by subject common name
+	tokens    []grpcAuthToken
+}
+
+// newGRPCAuthorizer creates the gRPC client authorizer from the application
+// config.  Client certificate permissions are in the form
+// <commonname>:<permission> and tokens in the form <permission>:<token>.  The
+// returned authorizer is nil when no client authentication is configured.
+func newGRPCAuthorizer(caFile string, certPerms, tokens []string) (*grpcAuthorizer, error) {
+	if caFile == "" && len(certPerms) != 0 {
+		return nil, errors.New("gRPC client certificate permissions " +
+			"require a client CA file")
+	}
+	if caFile != "" && len(certPerms) == 0 {
+		return nil, errors.New("gRPC client CA file requires a permission " +
+			"for each client certificate common name")
+	}
+	if caFile == "" && len(tokens) == 0 {
+		return nil, nil
+	}
+
+	a := &grpcAuthorizer{
+		certPerms: make(map[string]rpcserver.Permission, len(certPerms)),
+		tokens:    make([]grpcAuthToken, 0, len(tokens)),
+	}
+	if caFile != "" {
+		pem, err := ioutil.ReadFile(caFile)
+		if err != nil {
+			return nil, err
+		}
+		a.clientCAs = x509.NewCertPool()
+		if !a.clientCAs.AppendCertsFromPEM(pem) {
+			return nil, errors.Errorf("no certificates found in %s", caFile)
+		}
+	}
+	for _, s := range certPerms {
+		i := strings.LastIndexByte(s, ':')
+		if i == -1 {
+			return nil, errors.Errorf("gRPC client permission %q is not "+
+				"in the form <commonname>:<permission>", s)
+		}
+		perm, err := rpcserver.ParsePermission(s[i+1:])
+		if err != nil {
+			return nil, err
+		}
+		a.certPerms[s[:i]] = perm
+	}
+	for _, s := range tokens {
+		i := strings.IndexByte(s, ':')
+		if i == -1 || i == len(s)-1 {
+			return nil, errors.New("gRPC auth tokens must be in the form " +
+				"<permission>:<token>")
+		}
+		perm, err := rpcserver.ParsePermission(s[:i])
+		if err != nil {
+			return nil, err
+		}
+		a.tokens = append(a.tokens, grpcAuthToken{
+			hash: sha256.Sum256([]byte(s[i+1:])),
+			perm: perm,
+		})
+	}
+	return a, nil
+}
+
+// configureTLS requires or requests verified client certificates when a client
+// CA is configured.  Certificates are only required when there are no tokens
+// to authenticate clients with instead.
+func (a *grpcAuthorizer) configureTLS(c *tls.Config) {
+	if a == nil || a.clientCAs == nil {
+		return
+	}
+	c.ClientCAs = a.clientCAs
+	if len(a.tokens) == 0 {
+		c.ClientAuth = tls.RequireAndVerifyClientCert
+	} else {
+		c.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+}
+
+// tokenPermission returns the permission of the bearer token in the
+// authorization metadata of the request.  ok is false when no token was
+// provided.
+func (a *grpcAuthorizer) tokenPermission(ctx context.Context) (perm rpcserver.Permission, ok bool, err error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	auth := md["authorization"]
+	if len(auth) == 0 {
+		return 0, false, nil
+	}
+	const prefix = "Bearer "
+	if len(auth) != 1 || !strings.HasPrefix(auth[0], prefix) {
+		return 0, false, status.Errorf(codes.Unauthenticated,
+			"authorization must be a single bearer token")
+	}
+	hash := sha256.Sum256([]byte(auth[0][len(prefix):]))
+	found := false
+	for i := range a.tokens {
+		t := &a.tokens[i]
+		if subtle.ConstantTimeCompare(hash[:], t.hash[:]) == 1 && !found {
+			perm = t.perm
+			found = true
+		}
+	}
+	if !found {
+		return 0, false, status.Errorf(codes.Unauthenticated, "invalid bearer token")
+	}
+	return perm, true, nil
+}
+
+// certPermission returns the permission of the verified client certificate of
+// the request.  ok is false when no verified certificate was provided.
+// Certificates without a configured permission for their common name are
+// denied.
+func (a *grpcAuthorizer) certPermission(ctx context.Context) (perm rpcserver.Permission, ok bool, err error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return 0, false, nil
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 ||
+		len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return 0, false, nil
+	}
+	cn := tlsInfo.State.VerifiedChains[0][0].Subject.CommonName
+	perm, ok = a.certPerms[cn]
+	if !ok {
+		return 0, false, status.Errorf(codes.PermissionDenied,
+			"no permission is configured for client certificate %q", cn)
+	}
+	return perm, true, nil
+}
+
+// authorize returns nil when the client of the request may call the method and
+// a gRPC error when it may not.  Bearer tokens take precedence over client
+// certificates.
+func (a *grpcAuthorizer) authorize(ctx context.Context, method string) error {
+	if a == nil {
+		return nil
+	}
+	perm, ok, err := a.tokenPermission(ctx)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		perm, ok, err = a.certPermission(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	if !ok {
+		return status.Errorf(codes.Unauthenticated, "client credentials are required")
+	}
+	return rpcserver.CheckPermission(method, perm)
+}
+
+// gatewayTokenHash is the hash of the bearer token used by the gateway to call
+// its gRPC server.  Calls made by the gateway are not authorized or rate
+// limited by the gRPC server as the gateway checks its own clients.
+var gatewayTokenHash [sha256.Size]byte
+
+// newGatewayToken creates the random bearer token used by the gateway to call
+// its gRPC server.
+func newGatewayToken() (string, error) {
+	var b [32]byte
+	_, err := rand.Read(b[:])
+	if err != nil {
+		return "", err
+	}
+	token := hex.EncodeToString(b[:])
+	gatewayTokenHash = sha256.Sum256([]byte(token))
+	return token, nil
+}
+
+// authorizeGatewayCall authorizes calls to the gRPC server of the gateway,
+// which only accepts calls made by the gateway with the internal gateway token.
+// The gateway clients were already authorized and rate limited by
+// gatewayAuthorize.
+func authorizeGatewayCall(ctx context.Context, method string) error {
+	if !grpcCallerFromContext(ctx).gateway {
+		return status.Errorf(codes.Unauthenticated, "gateway credentials are required")
+	}
+	return nil
+}
+
+// gatewayContext returns a context describing the credentials and remote
+// address of a gateway request in the same way as for gRPC requests, so that
+// gateway clients are authenticated and rate limited like gRPC clients.
+func gatewayContext(r *http.Request) context.Context {
+	ctx := r.Context()
+	if auth := r.Header["Authorization"]; len(auth) != 0 {
+		ctx = metadata.NewIncomingContext(ctx, metadata.MD{"authorization": auth})
+	}
+	p := &peer.Peer{Addr: gatewayAddr(r.RemoteAddr)}
+	if r.TLS != nil {
+		p.AuthInfo = credentials.TLSInfo{State: *r.TLS}
+	}
+	return peer.NewContext(ctx, p)
+}
+
+// gatewayAddr is the remote address of a gateway client.
+type gatewayAddr string
+
+func (a gatewayAddr) Network() string { return "tcp" }
+func (a gatewayAddr) String() string  { return string(a) }
@@ -0,0 +1,135 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net"
+	"testing"
+
+	"github.com/fonero-project/fnowallet/rpc/rpcserver"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func certContext(cn string) context.Context {
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: cn}}
+	p := &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 1},
+		AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{
+			VerifiedChains: [][]*x509.Certificate{{cert}},
+		}},
+	}
+	return peer.NewContext(context.Background(), p)
+}
+
+func TestGRPCAuthorize(t *testing.T) {
+	t.Parallel()
+	a := &grpcAuthorizer{
+		certPerms: map[string]rpcserver.Permission{
+			"dashboard": rpcserver.ReadOnly,
+			"admin":     rpcserver.Full,
+		},
+		tokens: []grpcAuthToken{{
+			hash: sha256.Sum256([]byte("invoicetoken")),
+			perm: rpcserver.Invoice,
+		}},
+	}
+	const (
+		readOnlyMethod = "/walletrpc.WalletService/Balance"
+		invoiceMethod  = "/walletrpc.WalletService/NextAddress"
+		fullMethod     = "/walletrpc.WalletService/SignTransaction"
+	)
+	noCert := peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 1},
+	})
+	token := func(ctx context.Context, tok string) context.Context {
+		md := metadata.MD{"authorization": []string{"Bearer " + tok}}
+		return metadata.NewIncomingContext(ctx, md)
+	}
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		method string
+		code   codes.Code
+	}{
+		{"configured cert, allowed", certContext("dashboard"), readOnlyMethod, codes.OK},
+		{"configured cert, denied", certContext("dashboard"), invoiceMethod, codes.PermissionDenied},
+		{"full cert", certContext("admin"), fullMethod, codes.OK},
+		{"unknown cert", certContext("other"), readOnlyMethod, codes.PermissionDenied},
+		{"unknown cert, full method", certContext("other"), fullMethod, codes.PermissionDenied},
+		{"no cert", noCert, readOnlyMethod, codes.Unauthenticated},
+		{"no peer", context.Background(), readOnlyMethod, codes.Unauthenticated},
+		{"token", token(noCert, "invoicetoken"), invoiceMethod, codes.OK},
+		{"token, denied", token(noCert, "invoicetoken"), fullMethod, codes.PermissionDenied},
+		{"token precedes cert", token(certContext("admin"), "invoicetoken"), fullMethod, codes.PermissionDenied},
+		{"invalid token", token(certContext("admin"), "wrong"), readOnlyMethod, codes.Unauthenticated},
+	}
+	for _, test := range tests {
+		err := a.authorize(test.ctx, test.method)
+		if code := status.Code(err); code != test.code {
+			t.Errorf("%s: authorize returned code %v, expected %v (err: %v)",
+				test.name, code, test.code, err)
+		}
+	}
+
+	// A nil authorizer allows every client.
+	var none *grpcAuthorizer
+	if err := none.authorize(noCert, fullMethod); err != nil {
+		t.Errorf("nil authorizer: %v", err)
+	}
+}
+
+func TestNewGRPCAuthorizer(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		caFile    string
+		certPerms []string
+		tokens    []string
+		valid     bool
+	}{
+		{"no auth", "", nil, nil, true},
+		{"tokens", "", nil, []string{"readonly:abc", "full:a:b"}, true},
+		{"perms without CA", "", []string{"dashboard:readonly"}, nil, false},
+		{"CA without perms", "clients.cert", nil, nil, false},
+		{"bad token", "", nil, []string{"readonly"}, false},
+		{"empty token", "", nil, []string{"readonly:"}, false},
+		{"unknown token permission", "", nil, []string{"admin:abc"}, false},
+	}
+	for _, test := range tests {
+		a, err := newGRPCAuthorizer(test.caFile, test.certPerms, test.tokens)
+		if test.valid && err != nil {
+			t.Errorf("%s: %v", test.name, err)
+		}
+		if !test.valid && err == nil {
+			t.Errorf("%s: invalid config was accepted", test.name)
+		}
+		if test.name == "no auth" && a != nil {
+			t.Errorf("%s: authorizer is not nil", test.name)
+		}
+	}
+}
+
+func TestStartGatewayRequiresAuth(t *testing.T) {
+	defer func(c *config) { cfg = c }(cfg)
+	cfg = &config{GatewayListeners: []string{"127.0.0.1:0"}}
+
+	// The gateway would serve every method to any client without gRPC
+	// client authentication, so it must not start.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if err := startGateway(ctx, tls.Certificate{}); err == nil {
+		t.Fatal("gateway started without client authentication")
+	}
+}
@@ -11,10 +11,14 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 	"unicode"
 
 	"github.com/fonero-project/fnod/hdkeychain"
+	"github.com/fonero-project/fnowallet/bip39"
+	"github.com/fonero-project/fnowallet/wallet/udb"
 	"github.com/fonero-project/fnowallet/walletseed"
 	"golang.org/x/crypto/ssh/terminal"
 )
@@ -232,12 +236,149 @@ func PublicPass(reader *bufio.Reader, privPass []byte,
 	return pubPass, nil
 }
 
+// promptInt prompts the user for an integer between min and max inclusive.
+// The function will repeat the prompt to the user until they enter a valid
+// response.
+func promptInt(reader *bufio.Reader, prefix string, min, max int) (int, error) {
+	for {
+		fmt.Printf("%s (%d-%d): ", prefix, min, max)
+		reply, err := reader.ReadString('\n')
+		if err != nil {
+			return 0, err
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(reply))
+		if err == nil && n >= min && n <= max {
+			return n, nil
+		}
+	}
+}
+
+// promptStored prompts the user to enter "OK" once they have stored a secret
+// described by what.  The prompt is repeated until "OK" is entered.
+func promptStored(reader *bufio.Reader, what string) error {
+	for {
+		fmt.Printf(`Once you have stored the %s in a safe `+
+			`and secure location, enter "OK" to continue: `, what)
+		confirm, err := reader.ReadString('\n')
+		if err != nil {
+			return err
+		}
+		confirm = strings.TrimSpace(confirm)
+		confirm = strings.Trim(confirm, `"`)
+		if strings.EqualFold("OK", confirm) {
+			return nil
+		}
+	}
+}
+
+// printWords prints a mnemonic word list six words per line.
+func printWords(words []string) {
+	for i, w := range words {
+		fmt.Printf("%v ", w)
+
+		if (i+1)%6 == 0 {
+			fmt.Printf("\n")
+		}
+	}
+	fmt.Printf("\n")
+}
+
+// scanSeedInput reads a seed or seed share entered over multiple lines and
+// followed by a blank line.
+func scanSeedInput() string {
+	// Use scanner instead of buffio.Reader so we can choose choose
+	// more complicated ending condition rather than just a single
+	// newline.
+	var seedStr string
+	scanner := bufio.NewScanner(os.Stdin)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			break
+		}
+		seedStr += " " + line
+	}
+	seedStrTrimmed := strings.TrimSpace(seedStr)
+	return collapseSpace(seedStrTrimmed)
+}
+
+// seedShares splits a seed into shares and displays each share in turn so that
+// each may be recorded by a different person.
+func seedShares(reader *bufio.Reader, seed []byte) error {
+	threshold, err := promptInt(reader, "Number of shares required to "+
+		"restore the seed", 2, 255)
+	if err != nil {
+		return err
+	}
+	count, err := promptInt(reader, "Total number of shares", threshold, 255)
+	if err != nil {
+		return err
+	}
+	shares, err := walletseed.SplitSeed(seed, threshold, count)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("The seed has been split into %d shares.  Any %d shares "+
+		"restore the wallet,\nbut fewer shares reveal nothing about "+
+		"the seed.\n", count, threshold)
+	for _, share := range shares {
+		fmt.Printf("\nSeed share %d of %d is:\n", share.Index, count)
+		printWords(strings.Fields(share.EncodeMnemonic()))
+		err := promptStored(reader, fmt.Sprintf("seed share %d", share.Index))
+		if err != nil {
+			return err
+		}
+	}
+	fmt.Printf("\nIMPORTANT: Keep at least %d seed shares in safe places "+
+		"as you\nwill NOT be able to restore your wallet without them.\n",
+		threshold)
+	return nil
+}
+
+// combineSeedShares prompts for the remaining shares of a split seed after the
+// first share has been entered and returns the recovered seed.
+func combineSeedShares(first *walletseed.SeedShare) ([]byte, error) {
+	shares := []*walletseed.SeedShare{first}
+	for len(shares) < int(first.Threshold) {
+		fmt.Printf("Enter seed share %d of %d required shares "+
+			"(followed by a blank line): ", len(shares)+1, first.Threshold)
+		share, err := walletseed.DecodeSeedShare(scanSeedInput())
+		if err != nil {
+			fmt.Printf("Input error: %v\n", err)
+			continue
+		}
+		shares = append(shares, share)
+	}
+	return walletseed.CombineSeedShares(shares)
+}
+
+// bip39Seed prompts for the optional passphrase of a BIP0039 mnemonic and
+// derives the seed of the mnemonic and passphrase.
+func bip39Seed(reader *bufio.Reader, mnemonic string) ([]byte, error) {
+	usePass, err := promptListBool(reader, "Does the BIP0039 mnemonic "+
+		"use a passphrase?", "no")
+	if err != nil {
+		return nil, err
+	}
+	var pass []byte
+	if usePass {
+		pass, err = PassPrompt(reader, "Enter the BIP0039 passphrase", false)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return bip39.Seed(mnemonic, string(pass))
+}
+
 // Seed prompts the user whether they want to use an existing wallet generation
 // seed.  When the user answers no, a seed will be generated and displayed to
-// the user along with prompting them for confirmation.  When the user answers
-// yes, a the user is prompted for it.  All prompts are repeated until the user
-// enters a valid response. The bool returned indicates if the wallet was
-// restored from a given seed or not.
+// the user along with prompting them for confirmation, or split into shares
+// which are displayed in turn.  When the user answers yes, a the user is
+// prompted for it, for its shares, or for a BIP0039 mnemonic and its optional
+// passphrase.  All prompts are repeated until the user enters a valid
+// response. The bool returned indicates if the wallet was restored from a given
+// seed or not.
 func Seed(reader *bufio.Reader) (seed []byte, imported bool, err error) {
 	// Ascertain the wallet generation seed.
 	useUserSeed, err := promptListBool(reader, "Do you have an "+
@@ -251,18 +392,25 @@ func Seed(reader *bufio.Reader) (seed []byte, imported bool, err error) {
 			return nil, false, err
 		}
 
+		split, err := promptListBool(reader, "Do you want to split the "+
+			"seed into shares held by different people?", "no")
+		if err != nil {
+			return nil, false, err
+		}
+		if split {
+			err := seedShares(reader, seed)
+			if err != nil {
+				return nil, false, err
+			}
+			return seed, false, nil
+		}
+
 		seedStrSplit := walletseed.EncodeMnemonicSlice(seed)
 
 		fmt.Println("Your wallet generation seed is:")
-		for i := 0; i < hdkeychain.RecommendedSeedLen+1; i++ {
-			fmt.Printf("%v ", seedStrSplit[i])
+		printWords(seedStrSplit)
 
-			if (i+1)%6 == 0 {
-				fmt.Printf("\n")
-			}
-		}
-
-		fmt.Printf("\n\nHex: %x\n", seed)
+		fmt.Printf("\nHex: %x\n", seed)
 		fmt.Println("IMPORTANT: Keep the seed in a safe place as you\n" +
 			"will NOT be able to restore your wallet without it.")
 		fmt.Println("Please keep in mind that anyone who has access\n" +
@@ -270,44 +418,53 @@ func Seed(reader *bufio.Reader) (seed []byte, imported bool, err error) {
 			"giving them access to all your funds, so it is\n" +
 			"imperative that you keep it in a secure location.")
 
-		for {
-			fmt.Print(`Once you have stored the seed in a safe ` +
-				`and secure location, enter "OK" to continue: `)
-			confirmSeed, err := reader.ReadString('\n')
-			if err != nil {
-				return nil, false, err
-			}
-			confirmSeed = strings.TrimSpace(confirmSeed)
-			confirmSeed = strings.Trim(confirmSeed, `"`)
-			if strings.EqualFold("OK", confirmSeed) {
-				break
-			}
+		err = promptStored(reader, "seed")
+		if err != nil {
+			return nil, false, err
 		}
 
 		return seed, false, nil
 	}
 
+	seed, err = ExistingSeed(reader)
+	if err != nil {
+		return nil, false, err
+	}
+	fmt.Printf("\nSeed input successful. \nHex: %x\n", seed)
+	return seed, true, nil
+}
+
+// ExistingSeed prompts the user for an existing wallet generation seed, its
+// shares, or a BIP0039 mnemonic and its optional passphrase.  The prompt is
+// repeated until the user enters a valid seed.
+func ExistingSeed(reader *bufio.Reader) ([]byte, error) {
 	for {
-		fmt.Print("Enter existing wallet seed " +
+		fmt.Print("Enter existing wallet seed or seed share " +
 			"(followed by a blank line): ")
 
-		// Use scanner instead of buffio.Reader so we can choose choose
-		// more complicated ending condition rather than just a single
-		// newline.
-		var seedStr string
-		scanner := bufio.NewScanner(os.Stdin)
-		for scanner.Scan() {
-			line := scanner.Text()
-			if line == "" {
-				break
+		seedStrTrimmed := scanSeedInput()
+
+		// Seed shares use a stronger checksum than seeds, so the input is
+		// first decoded as a share.
+		if share, err := walletseed.DecodeSeedShare(seedStrTrimmed); err == nil {
+			seed, err := combineSeedShares(share)
+			if err != nil {
+				fmt.Printf("Input error: %v\n", err)
+				continue
 			}
-			seedStr += " " + line
+			return seed, nil
+		}
+
+		// BIP0039 mnemonics include a checksum and are decoded before
+		// PGP word list seeds.
+		if _, err := bip39.DecodeMnemonic(seedStrTrimmed); err == nil {
+			return bip39Seed(reader, seedStrTrimmed)
 		}
-		seedStrTrimmed := strings.TrimSpace(seedStr)
-		seedStrTrimmed = collapseSpace(seedStrTrimmed)
+
 		wordCount := strings.Count(seedStrTrimmed, " ") + 1
 
 		var seed []byte
+		var err error
 		if wordCount == 1 {
 			if len(seedStrTrimmed)%2 != 0 {
 				seedStrTrimmed = "0" + seedStrTrimmed
@@ -325,16 +482,15 @@ func Seed(reader *bufio.Reader) (seed []byte, imported bool, err error) {
 		if err != nil || len(seed) < hdkeychain.MinSeedBytes ||
 			len(seed) > hdkeychain.MaxSeedBytes {
 			fmt.Printf("Invalid seed specified.  Must be a "+
-				"word seed (usually 33 words) using the PGP wordlist or "+
+				"word seed (usually 33 words) using the PGP wordlist, "+
+				"a BIP0039 mnemonic (12 to 24 words), or "+
 				"hexadecimal value that is at least %d bits and "+
 				"at most %d bits\n", hdkeychain.MinSeedBytes*8,
 				hdkeychain.MaxSeedBytes*8)
 			continue
 		}
 
-		fmt.Printf("\nSeed input successful. \nHex: %x\n", seed)
-
-		return seed, true, nil
+		return seed, nil
 	}
 }
 
@@ -381,6 +537,37 @@ func Setup(r *bufio.Reader, insecurePubPass, configPubPass []byte) (privPass, pu
 	return
 }
 
+// Birthday prompts the user for the birthday of a restored wallet, as either
+// the date the wallet was created (in YYYY-MM-DD format) or a block height.  A
+// nil birthday is returned if the user does not enter one, in which case the
+// entire block chain is searched for wallet transactions.  The prompt is
+// repeated until the user enters a valid response.
+func Birthday(reader *bufio.Reader) (*udb.Birthday, error) {
+	for {
+		fmt.Print("Enter the date the wallet was created (YYYY-MM-DD) or a " +
+			"block height, or leave blank to search the entire block chain: ")
+		reply, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		reply = strings.TrimSpace(reply)
+		if reply == "" {
+			return nil, nil
+		}
+		if t, err := time.Parse("2006-01-02", reply); err == nil {
+			if t.Unix() <= 0 || t.After(time.Now()) {
+				fmt.Println("Input error: invalid wallet creation date")
+				continue
+			}
+			return &udb.Birthday{Time: t}, nil
+		}
+		if h, err := strconv.ParseInt(reply, 10, 32); err == nil && h >= 0 {
+			return &udb.Birthday{Height: int32(h)}, nil
+		}
+		fmt.Println("Input error: enter a date or block height")
+	}
+}
+
 // collapseSpace takes a string and replaces any repeated areas of whitespace
 // with a single space character.
 func collapseSpace(in string) string {
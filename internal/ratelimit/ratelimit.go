@@ -0,0 +1,189 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package ratelimit limits the request rate of RPC clients using a token
+// bucket for each client and class of methods.
+package ratelimit
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fonero-project/fnowallet/errors"
+)
+
+// Method classes.  RPC servers classify each of their methods so that
+// expensive methods may be limited independently of cheap ones.
+const (
+	Read   = "read"   // Cheap queries
+	Write  = "write"  // Methods modifying the wallet which are not otherwise classified
+	Rescan = "rescan" // Expensive rescans, discovery, and reports
+	Stream = "stream" // Streaming methods and notification subscriptions
+	Sign   = "sign"   // Methods creating signatures or sending funds
+)
+
+// Classes lists every method class.
+var Classes = []string{Read, Write, Rescan, Stream, Sign}
+
+// Limit describes a token bucket.  Rate tokens are added each second, up to
+// Burst tokens, and each request takes a single token.
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+// ParseLimit parses a limit for a method class in the form class:rate:burst.
+// The rate is the number of requests allowed each second and may be
+// fractional.
+func ParseLimit(s string) (class string, l Limit, err error) {
+	const op errors.Op = "ratelimit.ParseLimit"
+	parts := strings.Split(s, ":")
+	if len(parts) != 3 {
+		return "", Limit{}, errors.E(op, errors.Invalid,
+			errors.Errorf("rate limit %q is not in the form class:rate:burst", s))
+	}
+	class = parts[0]
+	known := false
+	for _, c := range Classes {
+		if c == class {
+			known = true
+			break
+		}
+	}
+	if !known {
+		return "", Limit{}, errors.E(op, errors.Invalid,
+			errors.Errorf("unknown method class %q", class))
+	}
+	l.Rate, err = strconv.ParseFloat(parts[1], 64)
+	if err != nil || l.Rate <= 0 {
+		return "", Limit{}, errors.E(op, errors.Invalid,
+			errors.Errorf("invalid rate %q", parts[1]))
+	}
+	l.Burst, err = strconv.Atoi(parts[2])
+	if err != nil || l.Burst < 1 {
+		return "", Limit{}, errors.E(op, errors.Invalid,
+			errors.Errorf("invalid burst %q", parts[2]))
+	}
+	return class, l, nil
+}
+
+type bucketKey struct {
+	client, class string
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// Stats counts the allowed and limited requests of a method class.
+type Stats struct {
+	Allowed uint64 `json:"allowed"`
+	Limited uint64 `json:"limited"`
+}
+
+// sweepSize is the number of buckets after which full buckets are removed.
+const sweepSize = 4096
+
+// Limiter limits the request rate of each client by method class.  Classes
+// without a limit are not limited, and a nil Limiter allows every request.
+type Limiter struct {
+	mu      sync.Mutex
+	limits  map[string]Limit
+	buckets map[bucketKey]*bucket
+	stats   map[string]*Stats
+	now     func() time.Time
+}
+
+// New creates a Limiter with limits keyed by method class.  The Limiter is nil
+// when there are no limits.
+func New(limits map[string]Limit) *Limiter {
+	if len(limits) == 0 {
+		return nil
+	}
+	l := &Limiter{
+		limits:  limits,
+		buckets: make(map[bucketKey]*bucket),
+		stats:   make(map[string]*Stats, len(Classes)),
+		now:     time.Now,
+	}
+	for _, c := range Classes {
+		l.stats[c] = new(Stats)
+	}
+	return l
+}
+
+// Allow takes a token from the bucket of a client and method class and
+// returns whether the request is allowed.  Clients are identified by any
+// string describing their credentials or remote address.
+func (l *Limiter) Allow(client, class string) bool {
+	if l == nil {
+		return true
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	stats, ok := l.stats[class]
+	if !ok {
+		stats = new(Stats)
+		l.stats[class] = stats
+	}
+	limit, ok := l.limits[class]
+	if !ok {
+		stats.Allowed++
+		return true
+	}
+
+	now := l.now()
+	k := bucketKey{client, class}
+	b, ok := l.buckets[k]
+	if !ok {
+		if len(l.buckets) >= sweepSize {
+			l.sweep(now)
+		}
+		b = &bucket{tokens: float64(limit.Burst), last: now}
+		l.buckets[k] = b
+	} else {
+		b.tokens += now.Sub(b.last).Seconds() * limit.Rate
+		if b.tokens > float64(limit.Burst) {
+			b.tokens = float64(limit.Burst)
+		}
+		b.last = now
+	}
+	if b.tokens < 1 {
+		stats.Limited++
+		return false
+	}
+	b.tokens--
+	stats.Allowed++
+	return true
+}
+
+// sweep removes buckets which have refilled since their last request, as they
+// are identical to new buckets.
+func (l *Limiter) sweep(now time.Time) {
+	for k, b := range l.buckets {
+		limit := l.limits[k.class]
+		tokens := b.tokens + now.Sub(b.last).Seconds()*limit.Rate
+		if tokens >= float64(limit.Burst) {
+			delete(l.buckets, k)
+		}
+	}
+}
+
+// Stats returns the counts of allowed and limited requests by method class.
+func (l *Limiter) Stats() map[string]Stats {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	stats := make(map[string]Stats, len(l.stats))
+	for c, s := range l.stats {
+		stats[c] = *s
+	}
+	l.mu.Unlock()
+	return stats
+}
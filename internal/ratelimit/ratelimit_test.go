@@ -0,0 +1,61 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package ratelimit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLimiter(t *testing.T) {
+	var nilLimiter *Limiter
+	if !nilLimiter.Allow("client", Rescan) {
+		t.Fatal("nil limiter denied request")
+	}
+
+	class, limit, err := ParseLimit("rescan:0.5:2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if class != Rescan || limit != (Limit{Rate: 0.5, Burst: 2}) {
+		t.Fatalf("parsed wrong limit %s %+v", class, limit)
+	}
+	for _, bad := range []string{"rescan:1", "unknown:1:1", "rescan:0:1", "rescan:1:0", "rescan:x:1"} {
+		if _, _, err := ParseLimit(bad); err == nil {
+			t.Errorf("parsed invalid limit %q", bad)
+		}
+	}
+
+	now := time.Unix(1000, 0)
+	l := New(map[string]Limit{class: limit})
+	l.now = func() time.Time { return now }
+
+	allow := func(client, class string, want bool) {
+		t.Helper()
+		if got := l.Allow(client, class); got != want {
+			t.Fatalf("Allow(%q, %q) at %v = %v, want %v", client, class,
+				now.Unix(), got, want)
+		}
+	}
+	allow("a", Rescan, true)
+	allow("a", Rescan, true)
+	allow("a", Rescan, false)
+	allow("b", Rescan, true) // Separate bucket for each client
+	allow("a", Read, true)   // Unlimited class
+	now = now.Add(time.Second)
+	allow("a", Rescan, false) // Half a token
+	now = now.Add(time.Second)
+	allow("a", Rescan, true)
+	allow("a", Rescan, false)
+	now = now.Add(time.Hour)
+	allow("a", Rescan, true) // Refilled only up to the burst
+	allow("a", Rescan, true)
+	allow("a", Rescan, false)
+
+	stats := l.Stats()
+	if stats[Rescan] != (Stats{Allowed: 6, Limited: 4}) || stats[Read] != (Stats{Allowed: 1}) {
+		t.Errorf("wrong stats %+v", stats)
+	}
+}
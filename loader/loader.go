@@ -11,11 +11,13 @@ import (
 
 	"github.com/fonero-project/fnod/chaincfg"
 	"github.com/fonero-project/fnod/fnoutil"
+	"github.com/fonero-project/fnowallet/auditlog"
 	"github.com/fonero-project/fnowallet/chain"
 	"github.com/fonero-project/fnowallet/errors"
 	"github.com/fonero-project/fnowallet/ticketbuyer"
 	"github.com/fonero-project/fnowallet/wallet"
 	_ "github.com/fonero-project/fnowallet/wallet/drivers/bdb" // driver loaded during init
+	"github.com/fonero-project/fnowallet/wallet/udb"
 )
 
 const (
@@ -33,20 +35,27 @@ const (
 type Loader struct {
 	callbacks   []func(*wallet.Wallet)
 	backend     wallet.NetworkBackend
+	syncer      Syncer
 	chainParams *chaincfg.Params
 	dbDirPath   string
 	wallet      *wallet.Wallet
 	db          wallet.DB
 	dbDriver    string
 
+	// Named wallets opened in addition to the default wallet.
+	named map[string]*namedWallet
+
 	purchaseManager *ticketbuyer.PurchaseManager
 	ntfnClient      wallet.MainTipChangedNotificationsClient
 
-	stakeOptions    *StakeOptions
-	gapLimit        int
-	accountGapLimit int
-	allowHighFees   bool
-	relayFee        float64
+	stakeOptions      *StakeOptions
+	gapLimit          int
+	accountGapLimit   int
+	allowHighFees     bool
+	relayFee          float64
+	auditLog          *auditlog.Log
+	cfilterPruneDepth int32
+	spendPolicies     map[string]*wallet.SpendPolicy
 
 	mu sync.Mutex
 }
@@ -78,11 +87,57 @@ func NewLoader(chainParams *chaincfg.Params, dbDirPath string, stakeOptions *Sta
 	}
 }
 
-// SetDatabaseDriver specifies the database to be used by walletdb
+// SetDatabaseDriver specifies the database to be used by walletdb.  The driver
+// must be registered, and must accept the wallet database path to create, open,
+// and check for existing databases, as the bdb and memdb drivers do.
 func (l *Loader) SetDatabaseDriver(driver string) {
 	l.dbDriver = driver
 }
 
+// SetAuditLog specifies the audit log of sensitive operations performed by
+// loaded wallets.
+func (l *Loader) SetAuditLog(auditLog *auditlog.Log) {
+	l.auditLog = auditLog
+}
+
+// SetCFilterPruneDepth enables pruning of the committed filters of blocks the
+// depth below the main chain tip by loaded wallets.  Pruning is disabled when
+// depth is zero.
+func (l *Loader) SetCFilterPruneDepth(depth int32) {
+	l.cfilterPruneDepth = depth
+}
+
+// SetSpendPolicies restricts spending from the accounts of loaded wallets by
+// policies keyed by account name.
+func (l *Loader) SetSpendPolicies(policies map[string]*wallet.SpendPolicy) {
+	l.spendPolicies = policies
+}
+
+// walletConfig returns the configuration used to open a wallet from its
+// database.
+func (l *Loader) walletConfig(db wallet.DB, pubPassphrase []byte) *wallet.Config {
+	so := l.stakeOptions
+	return &wallet.Config{
+		DB:                  db,
+		PubPassphrase:       pubPassphrase,
+		VotingEnabled:       so.VotingEnabled,
+		AddressReuse:        so.AddressReuse,
+		VotingAddress:       so.VotingAddress,
+		PoolAddress:         so.PoolAddress,
+		PoolFees:            so.PoolFees,
+		TicketFee:           so.TicketFee,
+		GapLimit:            l.gapLimit,
+		AccountGapLimit:     l.accountGapLimit,
+		StakePoolColdExtKey: so.StakePoolColdExtKey,
+		AllowHighFees:       l.allowHighFees,
+		RelayFee:            l.relayFee,
+		Params:              l.chainParams,
+		AuditLog:            l.auditLog,
+		CFilterPruneDepth:   l.cfilterPruneDepth,
+		SpendPolicies:       l.spendPolicies,
+	}
+}
+
 // onLoaded executes each added callback and prevents loader from loading any
 // additional wallets.  Requires mutex to be locked.
 func (l *Loader) onLoaded(w *wallet.Wallet, db wallet.DB) {
@@ -111,8 +166,9 @@ func (l *Loader) RunAfterLoad(fn func(*wallet.Wallet)) {
 }
 
 // CreateWatchingOnlyWallet creates a new watch-only wallet using the provided
-// extended public key and public passphrase.
-func (l *Loader) CreateWatchingOnlyWallet(extendedPubKey string, pubPass []byte) (w *wallet.Wallet, err error) {
+// extended public key and public passphrase.  The birthday is optional.  If
+// non-nil, blocks preceding it are not searched for wallet transactions.
+func (l *Loader) CreateWatchingOnlyWallet(extendedPubKey string, pubPass []byte, birthday *udb.Birthday) (w *wallet.Wallet, err error) {
 	const op errors.Op = "loader.CreateWatchingOnlyWallet"
 
 	defer l.mu.Unlock()
@@ -139,7 +195,7 @@ func (l *Loader) CreateWatchingOnlyWallet(extendedPubKey string, pubPass []byte)
 	}
 
 	dbPath := filepath.Join(l.dbDirPath, walletDbName)
-	exists, err := fileExists(dbPath)
+	exists, err := l.dbExists(dbPath)
 	if err != nil {
 		return nil, errors.E(op, err)
 	}
@@ -167,30 +223,13 @@ func (l *Loader) CreateWatchingOnlyWallet(extendedPubKey string, pubPass []byte)
 	}
 
 	// Initialize the watch-only database for the wallet before opening.
-	err = wallet.CreateWatchOnly(db, extendedPubKey, pubPass, l.chainParams)
+	err = wallet.CreateWatchOnly(db, extendedPubKey, pubPass, birthday, l.chainParams)
 	if err != nil {
 		return nil, errors.E(op, err)
 	}
 
 	// Open the watch-only wallet.
-	so := l.stakeOptions
-	cfg := &wallet.Config{
-		DB:                  db,
-		PubPassphrase:       pubPass,
-		VotingEnabled:       so.VotingEnabled,
-		AddressReuse:        so.AddressReuse,
-		VotingAddress:       so.VotingAddress,
-		PoolAddress:         so.PoolAddress,
-		PoolFees:            so.PoolFees,
-		TicketFee:           so.TicketFee,
-		GapLimit:            l.gapLimit,
-		AccountGapLimit:     l.accountGapLimit,
-		StakePoolColdExtKey: so.StakePoolColdExtKey,
-		AllowHighFees:       l.allowHighFees,
-		RelayFee:            l.relayFee,
-		Params:              l.chainParams,
-	}
-	w, err = wallet.Open(cfg)
+	w, err = wallet.Open(l.walletConfig(db, pubPass))
 	if err != nil {
 		return nil, errors.E(op, err)
 	}
@@ -202,8 +241,10 @@ func (l *Loader) CreateWatchingOnlyWallet(extendedPubKey string, pubPass []byte)
 
 // CreateNewWallet creates a new wallet using the provided public and private
 // passphrases.  The seed is optional.  If non-nil, addresses are derived from
-// this seed.  If nil, a secure random seed is generated.
-func (l *Loader) CreateNewWallet(pubPassphrase, privPassphrase, seed []byte) (w *wallet.Wallet, err error) {
+// this seed.  If nil, a secure random seed is generated.  The birthday is
+// optional.  If non-nil, blocks preceding it are not searched for wallet
+// transactions.
+func (l *Loader) CreateNewWallet(pubPassphrase, privPassphrase, seed []byte, birthday *udb.Birthday) (w *wallet.Wallet, err error) {
 	const op errors.Op = "loader.CreateNewWallet"
 
 	defer l.mu.Unlock()
@@ -230,7 +271,7 @@ func (l *Loader) CreateNewWallet(pubPassphrase, privPassphrase, seed []byte) (w
 	}
 
 	dbPath := filepath.Join(l.dbDirPath, walletDbName)
-	exists, err := fileExists(dbPath)
+	exists, err := l.dbExists(dbPath)
 	if err != nil {
 		return nil, errors.E(op, err)
 	}
@@ -258,30 +299,13 @@ func (l *Loader) CreateNewWallet(pubPassphrase, privPassphrase, seed []byte) (w
 	}
 
 	// Initialize the newly created database for the wallet before opening.
-	err = wallet.Create(db, pubPassphrase, privPassphrase, seed, l.chainParams)
+	err = wallet.Create(db, pubPassphrase, privPassphrase, seed, birthday, l.chainParams)
 	if err != nil {
 		return nil, errors.E(op, err)
 	}
 
 	// Open the newly-created wallet.
-	so := l.stakeOptions
-	cfg := &wallet.Config{
-		DB:                  db,
-		PubPassphrase:       pubPassphrase,
-		VotingEnabled:       so.VotingEnabled,
-		AddressReuse:        so.AddressReuse,
-		VotingAddress:       so.VotingAddress,
-		PoolAddress:         so.PoolAddress,
-		PoolFees:            so.PoolFees,
-		TicketFee:           so.TicketFee,
-		GapLimit:            l.gapLimit,
-		AccountGapLimit:     l.accountGapLimit,
-		StakePoolColdExtKey: so.StakePoolColdExtKey,
-		AllowHighFees:       l.allowHighFees,
-		RelayFee:            l.relayFee,
-		Params:              l.chainParams,
-	}
-	w, err = wallet.Open(cfg)
+	w, err = wallet.Open(l.walletConfig(db, pubPassphrase))
 	if err != nil {
 		return nil, errors.E(op, err)
 	}
@@ -325,24 +349,7 @@ func (l *Loader) OpenExistingWallet(pubPassphrase []byte) (w *wallet.Wallet, rer
 		}
 	}()
 
-	so := l.stakeOptions
-	cfg := &wallet.Config{
-		DB:                  db,
-		PubPassphrase:       pubPassphrase,
-		VotingEnabled:       so.VotingEnabled,
-		AddressReuse:        so.AddressReuse,
-		VotingAddress:       so.VotingAddress,
-		PoolAddress:         so.PoolAddress,
-		PoolFees:            so.PoolFees,
-		TicketFee:           so.TicketFee,
-		GapLimit:            l.gapLimit,
-		AccountGapLimit:     l.accountGapLimit,
-		StakePoolColdExtKey: so.StakePoolColdExtKey,
-		AllowHighFees:       l.allowHighFees,
-		RelayFee:            l.relayFee,
-		Params:              l.chainParams,
-	}
-	w, err = wallet.Open(cfg)
+	w, err = wallet.Open(l.walletConfig(db, pubPassphrase))
 	if err != nil {
 		return nil, errors.E(op, err)
 	}
@@ -357,12 +364,12 @@ func (l *Loader) DbDirPath() string {
 	return l.dbDirPath
 }
 
-// WalletExists returns whether a file exists at the loader's database path.
-// This may return an error for unexpected I/O failures.
+// WalletExists returns whether a wallet database exists at the loader's
+// database path.  This may return an error for unexpected I/O failures.
 func (l *Loader) WalletExists() (bool, error) {
 	const op errors.Op = "loader.WalletExists"
 	dbPath := filepath.Join(l.dbDirPath, walletDbName)
-	exists, err := fileExists(dbPath)
+	exists, err := l.dbExists(dbPath)
 	if err != nil {
 		return false, errors.E(op, err)
 	}
@@ -407,6 +414,40 @@ func (l *Loader) UnloadWallet() error {
 	return nil
 }
 
+// CompactDatabase compacts the database of the loaded wallet, replacing the
+// database file with a copy without free pages while the wallet remains loaded,
+// and returns the file sizes before and after compaction.  Returns with
+// errors.Invalid if the wallet has not been loaded, and with errors.Unsupported
+// if the operating system does not allow replacing the open database file.
+func (l *Loader) CompactDatabase() (before, after int64, err error) {
+	const op errors.Op = "loader.CompactDatabase"
+
+	// The loader remains locked for the copy and swap so the wallet can not be
+	// unloaded and its database closed during compaction.
+	defer l.mu.Unlock()
+	l.mu.Lock()
+
+	if l.db == nil {
+		return 0, 0, errors.E(op, errors.Invalid, "wallet is unopened")
+	}
+	before, after, err = l.compactDB(l.db, filepath.Join(l.dbDirPath, walletDbName))
+	if err != nil {
+		return 0, 0, errors.E(op, err)
+	}
+	return before, after, nil
+}
+
+// DatabaseFileSize returns the size of the wallet database file.  Returns with
+// errors.Invalid if the database driver does not store databases in files.
+func (l *Loader) DatabaseFileSize() (int64, error) {
+	const op errors.Op = "loader.DatabaseFileSize"
+	size, err := l.dbFileSize(filepath.Join(l.dbDirPath, walletDbName))
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	return size, nil
+}
+
 // SetNetworkBackend associates the loader with a wallet network backend.
 func (l *Loader) SetNetworkBackend(n wallet.NetworkBackend) {
 	l.mu.Lock()
@@ -414,6 +455,37 @@ func (l *Loader) SetNetworkBackend(n wallet.NetworkBackend) {
 	l.mu.Unlock()
 }
 
+// Syncer synchronizes wallets in addition to the wallet it was created for.
+// It is implemented by the SPV and RPC syncers.
+type Syncer interface {
+	// AddWallet synchronizes an additional wallet and returns its network
+	// backend.
+	AddWallet(w *wallet.Wallet) wallet.NetworkBackend
+
+	// RemoveWallet stops synchronizing a wallet added with AddWallet.
+	RemoveWallet(w *wallet.Wallet)
+}
+
+// SetSyncer associates the loader with the syncer of the default wallet.  Every
+// loaded named wallet is synchronized by this syncer, which processes blocks and
+// rescans for all wallets.  Named wallets are removed from any previous syncer,
+// and are not synchronized while the syncer is nil.
+func (l *Loader) SetSyncer(s Syncer) {
+	l.mu.Lock()
+	for _, nw := range l.named {
+		if l.syncer != nil {
+			l.syncer.RemoveWallet(nw.wallet)
+		}
+		var n wallet.NetworkBackend
+		if s != nil {
+			n = s.AddWallet(nw.wallet)
+		}
+		nw.wallet.SetNetworkBackend(n)
+	}
+	l.syncer = s
+	l.mu.Unlock()
+}
+
 // NetworkBackend returns the associated wallet network backend, if any, and a
 // bool describing whether a non-nil network backend was set.
 func (l *Loader) NetworkBackend() (n wallet.NetworkBackend, ok bool) {
@@ -492,13 +564,43 @@ func (l *Loader) PurchaseManager() *ticketbuyer.PurchaseManager {
 	return pm
 }
 
-func fileExists(filePath string) (bool, error) {
-	_, err := os.Stat(filePath)
+// dbExists returns whether a wallet database exists at the path for the
+// loader's database driver.
+func (l *Loader) dbExists(dbPath string) (bool, error) {
+	return wallet.DBExists(l.dbDriver, dbPath)
+}
+
+// dbFileSize returns the size of the database file at dbPath.
+func (l *Loader) dbFileSize(dbPath string) (int64, error) {
+	if l.dbDriver != defaultDbDriver {
+		return 0, errors.E(errors.Invalid, errors.Errorf("database driver %q "+
+			"does not store databases in files", l.dbDriver))
+	}
+	fi, err := os.Stat(dbPath)
 	if err != nil {
 		if os.IsNotExist(err) {
-			return false, nil
+			return 0, errors.E(errors.NotExist, err)
 		}
-		return false, err
+		return 0, errors.E(errors.IO, err)
+	}
+	return fi.Size(), nil
+}
+
+// compactDB compacts an opened database stored at dbPath and returns the file
+// sizes before and after compaction.  Requires the mutex to be locked.
+func (l *Loader) compactDB(db wallet.DB, dbPath string) (before, after int64, err error) {
+	before, err = l.dbFileSize(dbPath)
+	if err != nil {
+		return 0, 0, err
+	}
+	err = wallet.CompactDB(db, l.dbDriver, dbPath)
+	if err != nil {
+		return 0, 0, err
+	}
+	after, err = l.dbFileSize(dbPath)
+	if err != nil {
+		return 0, 0, err
 	}
-	return true, nil
+	log.Infof("Compacted wallet database %s from %d to %d bytes", dbPath, before, after)
+	return before, after, nil
 }
@@ -0,0 +1,359 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package loader
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/fonero-project/fnowallet/errors"
+	"github.com/fonero-project/fnowallet/wallet"
+	"github.com/fonero-project/fnowallet/wallet/udb"
+)
+
+// Named wallets are opened in addition to the default wallet, each from its own
+// database in a subdirectory of the named wallets directory.  They are
+// synchronized by the loader's syncer together with the default wallet, but do
+// not run the ticket purchaser, which only serves the default wallet.
+
+const (
+	namedWalletsDir  = "wallets"
+	maxWalletNameLen = 64
+)
+
+// namedWallet is a named wallet opened by the loader.
+type namedWallet struct {
+	wallet *wallet.Wallet
+	db     wallet.DB
+}
+
+// WalletStatus describes a wallet found in the loader's database directory.
+type WalletStatus struct {
+	// Name is the name of the wallet.  The default wallet has an empty
+	// name.
+	Name string
+
+	// Loaded describes whether the wallet is currently opened.
+	Loaded bool
+}
+
+// checkWalletName returns an error if name may not name a wallet.  Names are
+// used as directory names and are limited to letters, digits, hyphens, and
+// underscores.
+func checkWalletName(name string) error {
+	if name == "" || len(name) > maxWalletNameLen {
+		return errors.E(errors.Invalid, errors.Errorf("wallet name must be "+
+			"between 1 and %d characters", maxWalletNameLen))
+	}
+	for _, c := range name {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9',
+			c == '-', c == '_':
+		default:
+			return errors.E(errors.Invalid, errors.Errorf("invalid character %q "+
+				"in wallet name", c))
+		}
+	}
+	return nil
+}
+
+// namedWalletDir returns the directory of a named wallet's database.
+func (l *Loader) namedWalletDir(name string) string {
+	return filepath.Join(l.dbDirPath, namedWalletsDir, name)
+}
+
+// createNamedWallet creates the database of a named wallet, initializes it
+// with create, and opens the wallet.  Requires the mutex to be locked.
+func (l *Loader) createNamedWallet(name string, pubPassphrase []byte,
+	create func(db wallet.DB) error) (w *wallet.Wallet, err error) {
+
+	if err := checkWalletName(name); err != nil {
+		return nil, err
+	}
+	if _, ok := l.named[name]; ok {
+		return nil, errors.E(errors.Exist, "wallet already opened")
+	}
+
+	dbDir := l.namedWalletDir(name)
+	dbPath := filepath.Join(dbDir, walletDbName)
+	exists, err := l.dbExists(dbPath)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, errors.E(errors.Exist, "wallet DB exists")
+	}
+	err = os.MkdirAll(dbDir, 0700)
+	if err != nil {
+		return nil, err
+	}
+	db, err := wallet.CreateDB(l.dbDriver, dbPath)
+	if err != nil {
+		return nil, err
+	}
+
+	// Close and remove the new database if the wallet can not be created
+	// and opened.
+	defer func() {
+		if err != nil {
+			db.Close()
+			_ = os.Remove(dbPath)
+		}
+	}()
+
+	err = create(db)
+	if err != nil {
+		return nil, err
+	}
+	w, err = wallet.Open(l.walletConfig(db, pubPassphrase))
+	if err != nil {
+		return nil, err
+	}
+
+	l.onNamedLoaded(name, w, db)
+	return w, nil
+}
+
+// onNamedLoaded starts a named wallet and adds it to the loader's syncer.
+// Requires the mutex to be locked.
+func (l *Loader) onNamedLoaded(name string, w *wallet.Wallet, db wallet.DB) {
+	w.Start()
+	if l.syncer != nil {
+		w.SetNetworkBackend(l.syncer.AddWallet(w))
+	}
+	if l.named == nil {
+		l.named = make(map[string]*namedWallet)
+	}
+	l.named[name] = &namedWallet{wallet: w, db: db}
+}
+
+// CreateNamedWallet creates and opens a new named wallet as CreateNewWallet
+// does for the default wallet.
+func (l *Loader) CreateNamedWallet(name string, pubPassphrase, privPassphrase, seed []byte, birthday *udb.Birthday) (*wallet.Wallet, error) {
+	const op errors.Op = "loader.CreateNamedWallet"
+
+	defer l.mu.Unlock()
+	l.mu.Lock()
+
+	w, err := l.createNamedWallet(name, pubPassphrase, func(db wallet.DB) error {
+		return wallet.Create(db, pubPassphrase, privPassphrase, seed, birthday, l.chainParams)
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return w, nil
+}
+
+// CreateNamedWatchingOnlyWallet creates and opens a new named watching-only
+// wallet as CreateWatchingOnlyWallet does for the default wallet.
+func (l *Loader) CreateNamedWatchingOnlyWallet(name, extendedPubKey string, pubPass []byte, birthday *udb.Birthday) (*wallet.Wallet, error) {
+	const op errors.Op = "loader.CreateNamedWatchingOnlyWallet"
+
+	defer l.mu.Unlock()
+	l.mu.Lock()
+
+	w, err := l.createNamedWallet(name, pubPass, func(db wallet.DB) error {
+		return wallet.CreateWatchOnly(db, extendedPubKey, pubPass, birthday, l.chainParams)
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return w, nil
+}
+
+// OpenNamedWallet opens an existing named wallet with its public passphrase.
+// Any number of named wallets may be open at the same time as the default
+// wallet.
+func (l *Loader) OpenNamedWallet(name string, pubPassphrase []byte) (w *wallet.Wallet, rerr error) {
+	const op errors.Op = "loader.OpenNamedWallet"
+
+	if err := checkWalletName(name); err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	defer l.mu.Unlock()
+	l.mu.Lock()
+
+	if _, ok := l.named[name]; ok {
+		return nil, errors.E(op, errors.Exist, "wallet already opened")
+	}
+
+	dbPath := filepath.Join(l.namedWalletDir(name), walletDbName)
+	exists, err := l.dbExists(dbPath)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if !exists {
+		return nil, errors.E(op, errors.NotExist, errors.Errorf("wallet %q does not exist", name))
+	}
+	db, err := wallet.OpenDB(l.dbDriver, dbPath)
+	if err != nil {
+		log.Errorf("Failed to open database of wallet %q: %v", name, err)
+		return nil, errors.E(op, err)
+	}
+	defer func() {
+		if rerr != nil {
+			db.Close()
+		}
+	}()
+
+	w, err = wallet.Open(l.walletConfig(db, pubPassphrase))
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	l.onNamedLoaded(name, w, db)
+	return w, nil
+}
+
+// NamedWallet returns an opened named wallet, if any, and a bool for whether
+// the wallet is opened.
+func (l *Loader) NamedWallet(name string) (*wallet.Wallet, bool) {
+	l.mu.Lock()
+	nw, ok := l.named[name]
+	l.mu.Unlock()
+	if !ok {
+		return nil, false
+	}
+	return nw.wallet, true
+}
+
+// UnloadNamedWallet stops an opened named wallet and closes its database.
+// Returns with errors.Invalid if the wallet is not opened.
+func (l *Loader) UnloadNamedWallet(name string) error {
+	const op errors.Op = "loader.UnloadNamedWallet"
+
+	defer l.mu.Unlock()
+	l.mu.Lock()
+
+	nw, ok := l.named[name]
+	if !ok {
+		return errors.E(op, errors.Invalid, errors.Errorf("wallet %q is unopened", name))
+	}
+
+	if l.syncer != nil {
+		l.syncer.RemoveWallet(nw.wallet)
+	}
+	nw.wallet.SetNetworkBackend(nil)
+	nw.wallet.Stop()
+	nw.wallet.WaitForShutdown()
+	delete(l.named, name)
+	err := nw.db.Close()
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// NamedWalletExists returns whether the database of a named wallet exists.
+func (l *Loader) NamedWalletExists(name string) (bool, error) {
+	const op errors.Op = "loader.NamedWalletExists"
+	if err := checkWalletName(name); err != nil {
+		return false, errors.E(op, err)
+	}
+	exists, err := l.dbExists(filepath.Join(l.namedWalletDir(name), walletDbName))
+	if err != nil {
+		return false, errors.E(op, err)
+	}
+	return exists, nil
+}
+
+// CompactNamedDatabase compacts the database of an opened named wallet as
+// CompactDatabase does for the default wallet.
+func (l *Loader) CompactNamedDatabase(name string) (before, after int64, err error) {
+	const op errors.Op = "loader.CompactNamedDatabase"
+
+	defer l.mu.Unlock()
+	l.mu.Lock()
+
+	nw, ok := l.named[name]
+	if !ok {
+		return 0, 0, errors.E(op, errors.Invalid, errors.Errorf("wallet %q is unopened", name))
+	}
+
+	dbPath := filepath.Join(l.namedWalletDir(name), walletDbName)
+	before, after, err = l.compactDB(nw.db, dbPath)
+	if err != nil {
+		return 0, 0, errors.E(op, err)
+	}
+	return before, after, nil
+}
+
+// NamedDatabaseFileSize returns the size of the database file of a named
+// wallet as DatabaseFileSize does for the default wallet.
+func (l *Loader) NamedDatabaseFileSize(name string) (int64, error) {
+	const op errors.Op = "loader.NamedDatabaseFileSize"
+	if err := checkWalletName(name); err != nil {
+		return 0, errors.E(op, err)
+	}
+	size, err := l.dbFileSize(filepath.Join(l.namedWalletDir(name), walletDbName))
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	return size, nil
+}
+
+// ListWallets returns the default wallet, when it exists, and every named
+// wallet found in the loader's database directory, sorted by name.
+func (l *Loader) ListWallets() ([]WalletStatus, error) {
+	const op errors.Op = "loader.ListWallets"
+
+	defer l.mu.Unlock()
+	l.mu.Lock()
+
+	var wallets []WalletStatus
+	exists, err := l.dbExists(filepath.Join(l.dbDirPath, walletDbName))
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if exists || l.wallet != nil {
+		wallets = append(wallets, WalletStatus{Name: "", Loaded: l.wallet != nil})
+	}
+
+	dirs, err := ioutil.ReadDir(filepath.Join(l.dbDirPath, namedWalletsDir))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, errors.E(op, err)
+	}
+	for _, fi := range dirs {
+		name := fi.Name()
+		if !fi.IsDir() || checkWalletName(name) != nil {
+			continue
+		}
+		exists, err := l.dbExists(filepath.Join(l.namedWalletDir(name), walletDbName))
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		_, loaded := l.named[name]
+		if exists || loaded {
+			wallets = append(wallets, WalletStatus{Name: name, Loaded: loaded})
+		}
+	}
+	sort.Slice(wallets, func(i, j int) bool {
+		return wallets[i].Name < wallets[j].Name
+	})
+	return wallets, nil
+}
+
+// UnloadNamedWallets unloads every opened named wallet.  The first error
+// encountered is returned after attempting to unload each wallet.
+func (l *Loader) UnloadNamedWallets() error {
+	l.mu.Lock()
+	names := make([]string, 0, len(l.named))
+	for name := range l.named {
+		names = append(names, name)
+	}
+	l.mu.Unlock()
+
+	var firstErr error
+	for _, name := range names {
+		err := l.UnloadNamedWallet(name)
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
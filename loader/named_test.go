@@ -0,0 +1,201 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package loader
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/fonero-project/fnod/chaincfg"
+	"github.com/fonero-project/fnowallet/errors"
+	"github.com/fonero-project/fnowallet/wallet"
+)
+
+var (
+	pubPassphrase  = []byte(wallet.InsecurePubPassphrase)
+	privPassphrase = []byte("private")
+)
+
+func testLoader(t *testing.T) (l *Loader, teardown func()) {
+	dir, err := ioutil.TempDir("", "loader")
+	if err != nil {
+		t.Fatal(err)
+	}
+	l = NewLoader(&chaincfg.SimNetParams, dir, &StakeOptions{}, 20, false, 1e-4, 20)
+	teardown = func() {
+		if err := l.UnloadNamedWallets(); err != nil {
+			t.Error(err)
+		}
+		os.RemoveAll(dir)
+	}
+	return l, teardown
+}
+
+func TestNamedWallets(t *testing.T) {
+	l, teardown := testLoader(t)
+	defer teardown()
+
+	for _, name := range []string{"", "a/b", "..", "a b", strings.Repeat("a", maxWalletNameLen+1)} {
+		_, err := l.CreateNamedWallet(name, pubPassphrase, privPassphrase, nil, nil)
+		if !errors.Is(errors.Invalid, err) {
+			t.Errorf("CreateNamedWallet(%q): expected Invalid, got %v", name, err)
+		}
+	}
+
+	w, err := l.CreateNamedWallet("a", pubPassphrase, privPassphrase, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = l.CreateNamedWallet("a", pubPassphrase, privPassphrase, nil, nil)
+	if !errors.Is(errors.Exist, err) {
+		t.Errorf("CreateNamedWallet of opened wallet: expected Exist, got %v", err)
+	}
+	if nw, ok := l.NamedWallet("a"); !ok || nw != w {
+		t.Errorf("NamedWallet did not return the created wallet")
+	}
+	if _, ok := l.LoadedWallet(); ok {
+		t.Errorf("named wallet loaded as the default wallet")
+	}
+
+	wallets, err := l.ListWallets()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []WalletStatus{{Name: "a", Loaded: true}}
+	if !reflect.DeepEqual(wallets, want) {
+		t.Errorf("ListWallets: got %v, want %v", wallets, want)
+	}
+
+	err = l.UnloadNamedWallet("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = l.UnloadNamedWallet("a")
+	if !errors.Is(errors.Invalid, err) {
+		t.Errorf("UnloadNamedWallet of unopened wallet: expected Invalid, got %v", err)
+	}
+	if _, ok := l.NamedWallet("a"); ok {
+		t.Errorf("NamedWallet returned an unloaded wallet")
+	}
+	_, err = l.CreateNamedWallet("a", pubPassphrase, privPassphrase, nil, nil)
+	if !errors.Is(errors.Exist, err) {
+		t.Errorf("CreateNamedWallet of existing wallet: expected Exist, got %v", err)
+	}
+
+	exists, err := l.NamedWalletExists("a")
+	if err != nil || !exists {
+		t.Errorf("NamedWalletExists: got %v, %v", exists, err)
+	}
+	exists, err = l.NamedWalletExists("b")
+	if err != nil || exists {
+		t.Errorf("NamedWalletExists of missing wallet: got %v, %v", exists, err)
+	}
+
+	_, err = l.OpenNamedWallet("b", pubPassphrase)
+	if !errors.Is(errors.NotExist, err) {
+		t.Errorf("OpenNamedWallet of missing wallet: expected NotExist, got %v", err)
+	}
+	_, err = l.OpenNamedWallet("a", pubPassphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = l.OpenNamedWallet("a", pubPassphrase)
+	if !errors.Is(errors.Exist, err) {
+		t.Errorf("OpenNamedWallet of opened wallet: expected Exist, got %v", err)
+	}
+
+	_, err = l.CreateNewWallet(pubPassphrase, privPassphrase, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.UnloadWallet()
+	_, err = l.CreateNamedWallet("b", pubPassphrase, privPassphrase, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = l.UnloadNamedWallet("b")
+	if err != nil {
+		t.Fatal(err)
+	}
+	wallets, err = l.ListWallets()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = []WalletStatus{{Name: "", Loaded: true}, {Name: "a", Loaded: true}, {Name: "b", Loaded: false}}
+	if !reflect.DeepEqual(wallets, want) {
+		t.Errorf("ListWallets: got %v, want %v", wallets, want)
+	}
+}
+
+type testBackend struct {
+	wallet.NetworkBackend
+}
+
+// testSyncer records the wallets it synchronizes.
+type testSyncer struct {
+	wallets map[*wallet.Wallet]*testBackend
+}
+
+func (s *testSyncer) AddWallet(w *wallet.Wallet) wallet.NetworkBackend {
+	b := new(testBackend)
+	s.wallets[w] = b
+	return b
+}
+
+func (s *testSyncer) RemoveWallet(w *wallet.Wallet) {
+	delete(s.wallets, w)
+}
+
+func TestNamedWalletSyncer(t *testing.T) {
+	l, teardown := testLoader(t)
+	defer teardown()
+
+	a, err := l.CreateNamedWallet("a", pubPassphrase, privPassphrase, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := a.NetworkBackend(); err == nil {
+		t.Errorf("wallet has a network backend without a syncer")
+	}
+
+	// Opened wallets are added when the syncer is set, and wallets opened
+	// later are added when loaded.
+	s := &testSyncer{wallets: make(map[*wallet.Wallet]*testBackend)}
+	l.SetSyncer(s)
+	b, err := l.CreateNamedWallet("b", pubPassphrase, privPassphrase, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, w := range []*wallet.Wallet{a, b} {
+		n, err := w.NetworkBackend()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n != s.wallets[w] {
+			t.Errorf("wallet does not use the backend returned by the syncer")
+		}
+	}
+
+	// Unloaded wallets are removed from the syncer.
+	err = l.UnloadNamedWallet("b")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := s.wallets[b]; ok || len(s.wallets) != 1 {
+		t.Errorf("unloaded wallet was not removed from the syncer")
+	}
+
+	// Every wallet is removed from the syncer when it is unset.
+	l.SetSyncer(nil)
+	if len(s.wallets) != 0 {
+		t.Errorf("wallets were not removed from the syncer")
+	}
+	if _, err := a.NetworkBackend(); err == nil {
+		t.Errorf("wallet has a network backend after the syncer was unset")
+	}
+}
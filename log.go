@@ -15,6 +15,7 @@ import (
 	"github.com/fonero-project/fnowallet/chain"
 	"github.com/fonero-project/fnowallet/loader"
 	"github.com/fonero-project/fnowallet/p2p"
+	"github.com/fonero-project/fnowallet/revoker"
 	"github.com/fonero-project/fnowallet/rpc/legacyrpc"
 	"github.com/fonero-project/fnowallet/rpc/rpcserver"
 	"github.com/fonero-project/fnowallet/spv"
@@ -58,6 +59,7 @@ var (
 	loaderLog    = backendLog.Logger("LODR")
 	walletLog    = backendLog.Logger("WLLT")
 	tkbyLog      = backendLog.Logger("TKBY")
+	rvkrLog      = backendLog.Logger("RVKR")
 	syncLog      = backendLog.Logger("SYNC")
 	grpcLog      = backendLog.Logger("GRPC")
 	legacyRPCLog = backendLog.Logger("RPCS")
@@ -71,6 +73,7 @@ func init() {
 	udb.UseLogger(walletLog)
 	ticketbuyer.UseLogger(tkbyLog)
 	ticketbuyerv2.UseLogger(tkbyLog)
+	revoker.UseLogger(rvkrLog)
 	chain.UseLogger(syncLog)
 	fnorpcclient.UseLogger(syncLog)
 	spv.UseLogger(syncLog)
@@ -86,6 +89,7 @@ var subsystemLoggers = map[string]slog.Logger{
 	"LODR": loaderLog,
 	"WLLT": walletLog,
 	"TKBY": tkbyLog,
+	"RVKR": rvkrLog,
 	"SYNC": syncLog,
 	"GRPC": grpcLog,
 	"RPCS": legacyRPCLog,
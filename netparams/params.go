@@ -14,6 +14,7 @@ type Params struct {
 	JSONRPCClientPort string
 	JSONRPCServerPort string
 	GRPCServerPort    string
+	GatewayPort       string
 }
 
 // MainNetParams contains parameters specific running fnowallet and
@@ -23,6 +24,7 @@ var MainNetParams = Params{
 	JSONRPCClientPort: "9209",
 	JSONRPCServerPort: "9210",
 	GRPCServerPort:    "9211",
+	GatewayPort:       "9212",
 }
 
 // TestNetParams contains parameters specific running fnowallet and
@@ -32,6 +34,7 @@ var TestNetParams = Params{
 	JSONRPCClientPort: "19209",
 	JSONRPCServerPort: "19210",
 	GRPCServerPort:    "19211",
+	GatewayPort:       "19212",
 }
 
 // SimNetParams contains parameters specific to the simulation test network
@@ -41,4 +44,5 @@ var SimNetParams = Params{
 	JSONRPCClientPort: "19656",
 	JSONRPCServerPort: "19657",
 	GRPCServerPort:    "19658",
+	GatewayPort:       "19659",
 }
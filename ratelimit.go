@@ -0,0 +1,121 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/fonero-project/fnowallet/auditlog"
+	"github.com/fonero-project/fnowallet/rpc/rpcserver"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// Metadata keys describing the HTTP client of a call made by the gateway.
+// They are only trusted on calls authenticated by the gateway token.
+const (
+	gatewayClientKey = "fnowallet-gateway-client"
+	gatewayRemoteKey = "fnowallet-gateway-remote"
+)
+
+// grpcCaller describes the client of a gRPC request.  When client
+// authentication is enabled, clients are identified by their bearer token or
+// verified certificate, and otherwise by their remote address.  Calls made by
+// the gateway are described by the identity and remote address of the gateway
+// client.
+type grpcCaller struct {
+	client  string
+	remote  string
+	gateway bool
+}
+
+// grpcCallerFromContext describes the client of the gRPC request of ctx.
+func grpcCallerFromContext(ctx context.Context) grpcCaller {
+	var c grpcCaller
+	p, ok := peer.FromContext(ctx)
+	if ok {
+		c.remote = p.Addr.String()
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	const prefix = "Bearer "
+	if auth := md["authorization"]; len(auth) == 1 && strings.HasPrefix(auth[0], prefix) {
+		hash := sha256.Sum256([]byte(auth[0][len(prefix):]))
+		if subtle.ConstantTimeCompare(hash[:], gatewayTokenHash[:]) == 1 {
+			c.gateway = true
+			c.client, c.remote = "", ""
+			if v := md[gatewayClientKey]; len(v) == 1 {
+				c.client = v[0]
+			}
+			if v := md[gatewayRemoteKey]; len(v) == 1 {
+				c.remote = v[0]
+			}
+			return c
+		}
+		if cfg.grpcAuth != nil {
+			c.client = "grpctoken:" + hex.EncodeToString(hash[:8])
+			return c
+		}
+	}
+	if !ok {
+		c.client = "grpcaddr:unknown"
+		return c
+	}
+	if cfg.grpcAuth != nil {
+		tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+		if ok && len(tlsInfo.State.VerifiedChains) != 0 &&
+			len(tlsInfo.State.VerifiedChains[0]) != 0 {
+			c.client = "grpccert:" + tlsInfo.State.VerifiedChains[0][0].Subject.CommonName
+			return c
+		}
+	}
+	host, _, err := net.SplitHostPort(c.remote)
+	if err != nil {
+		host = c.remote
+	}
+	c.client = "grpcaddr:" + host
+	return c
+}
+
+// checkGRPCRateLimit returns a ResourceExhausted error when the client of a
+// gRPC or gateway request exceeds the rate limit of the method's class.
+func checkGRPCRateLimit(ctx context.Context, method string) error {
+	if cfg.rateLimiter == nil {
+		return nil
+	}
+	c := grpcCallerFromContext(ctx)
+	class := rpcserver.MethodClass(method)
+	if !cfg.rateLimiter.Allow(c.client, class) {
+		return status.Errorf(codes.ResourceExhausted,
+			"rate limit exceeded for %s methods", class)
+	}
+	return nil
+}
+
+// gatewayAuthorize authorizes and rate limits gateway requests in the same
+// way as gRPC requests.  The identity and remote address of the client are
+// added to the metadata of the gRPC call so that it may be audited.
+func gatewayAuthorize(r *http.Request, method string) (context.Context, error) {
+	ctx := gatewayContext(r)
+	err := cfg.grpcAuth.authorize(ctx, method)
+	if err == nil {
+		err = checkGRPCRateLimit(ctx, method)
+	}
+	c := grpcCallerFromContext(ctx)
+	if err != nil {
+		auditGRPCCaller(auditlog.SourceGateway, c, method, err)
+		return nil, err
+	}
+	return metadata.AppendToOutgoingContext(r.Context(),
+		gatewayClientKey, c.client, gatewayRemoteKey, c.remote), nil
+}
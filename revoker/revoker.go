@@ -0,0 +1,169 @@
+// Copyright (c) 2018 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package revoker provides an automated revoker for missed and expired tickets.
+package revoker
+
+import (
+	"context"
+	"sync"
+
+	"github.com/fonero-project/fnod/fnoutil"
+	"github.com/fonero-project/fnowallet/errors"
+	"github.com/fonero-project/fnowallet/wallet"
+	"github.com/fonero-project/fnowallet/wallet/udb"
+)
+
+// unlockScope is the scope of the wallet unlock required to sign revocations.
+var unlockScope = &udb.UnlockScope{Uses: udb.KeyUseVote}
+
+// Config modifies the behavior of Revoker.
+type Config struct {
+	// Fee rate (per kB) paid by revocations; the wallet relay fee is used
+	// when zero
+	FeeRate fnoutil.Amount
+
+	// Maximum fee paid by a single revocation; zero disables the ceiling
+	MaxFee fnoutil.Amount
+
+	// Maximum number of revocations published per block; zero disables the
+	// limit
+	BatchSize int
+}
+
+// Revoker is an automated ticket revoker.  After each new main chain tip block,
+// it revokes all tickets controlled by the wallet which are past expiry or were
+// missed.  It works with both RPC and SPV network backends.
+type Revoker struct {
+	wallet *wallet.Wallet
+
+	cfg Config
+	mu  sync.Mutex
+}
+
+// New returns a new Revoker to revoke tickets from a wallet using the default
+// config.
+func New(w *wallet.Wallet) *Revoker {
+	return &Revoker{wallet: w}
+}
+
+// Run executes the revoker.  If the private passphrase is incorrect, or ever
+// becomes incorrect due to a wallet passphrase change, Run exits with an
+// errors.Passphrase error.  The wallet is only unlocked for the private keys
+// used for revocations, and only while revocations are created.
+func (r *Revoker) Run(ctx context.Context, passphrase []byte) error {
+	// Check the passphrase before waiting for blocks.
+	relock, err := r.unlock(passphrase)
+	if err != nil {
+		return err
+	}
+	relock()
+
+	c := r.wallet.NtfnServer.MainTipChangedNotifications()
+	defer c.Done()
+
+	var mu sync.Mutex
+	var done bool
+	var errc = make(chan error, 1)
+	for {
+		select {
+		case <-ctx.Done():
+			mu.Lock()
+			done = true
+			mu.Unlock()
+			return ctx.Err()
+		case n := <-c.C:
+			if len(n.AttachedBlocks) == 0 {
+				continue
+			}
+			go func() {
+				defer mu.Unlock()
+				mu.Lock()
+				if done {
+					return
+				}
+				err := r.revoke(ctx, passphrase)
+				if err != nil {
+					log.Errorf("Ticket revocation failed: %v", err)
+					if errors.Is(errors.Passphrase, err) {
+						errc <- err
+						done = true
+					}
+				}
+			}()
+		case err := <-errc:
+			return err
+		}
+	}
+}
+
+// unlock unlocks the wallet for signing revocations.  The unlock is revoked
+// when the returned function is called, without revoking other unlocks of the
+// same scope.
+func (r *Revoker) unlock(passphrase []byte) (relock func(), err error) {
+	grant, err := r.wallet.GrantUnlock(passphrase, unlockScope, nil)
+	if err != nil {
+		return nil, err
+	}
+	return func() { r.wallet.RevokeUnlock(grant) }, nil
+}
+
+func (r *Revoker) revoke(ctx context.Context, passphrase []byte) error {
+	w := r.wallet
+
+	// Don't revoke tickets when transactions are not synced through the tip
+	// block, as tickets may already have been voted or revoked.
+	rp, err := w.RescanPoint()
+	if err != nil {
+		return err
+	}
+	if rp != nil {
+		log.Debugf("Skipping revocations: transactions are not synced")
+		return nil
+	}
+
+	n, err := w.NetworkBackend()
+	if err != nil {
+		return err
+	}
+
+	// Unlock the wallet with the current passphrase until the revocations
+	// are created.  If the passphase is changed, the Run exits and Revoker
+	// must be restarted with the new passphrase.
+	relock, err := r.unlock(passphrase)
+	if err != nil {
+		return err
+	}
+	defer relock()
+
+	r.mu.Lock()
+	policy := wallet.RevocationPolicy{
+		FeeRate:   r.cfg.FeeRate,
+		MaxFee:    r.cfg.MaxFee,
+		BatchSize: r.cfg.BatchSize,
+	}
+	r.mu.Unlock()
+
+	results, err := w.RevokeTicketsWithPolicy(ctx, n, &policy)
+	if err != nil {
+		return err
+	}
+	for i := range results {
+		if err := results[i].Err; err != nil {
+			log.Warnf("Unable to revoke %v ticket %v: %v", results[i].Reason,
+				&results[i].Ticket, err)
+		}
+	}
+	return nil
+}
+
+// AccessConfig runs f with the current config passed as a parameter.  The
+// config is protected by a mutex and this function is safe for concurrent
+// access to read or modify the config.  It is unsafe to leak a pointer to the
+// config, but a copy of *cfg is legal.
+func (r *Revoker) AccessConfig(f func(cfg *Config)) {
+	r.mu.Lock()
+	f(&r.cfg)
+	r.mu.Unlock()
+}
@@ -0,0 +1,338 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package gateway provides an HTTP/JSON gateway to the wallet gRPC services.
+//
+// Each method of the WalletService, WalletLoaderService, TicketBuyerV2Service,
+// and VotingService services is served at the route /v1/<service>/<method>.
+// Requests and responses are the JSON encodings of the protobuf messages.
+// Methods are called with POST requests with a JSON content type, which
+// browsers do not send cross-origin without a CORS preflight.  Results of server
+// streaming methods are sent as server-sent events, and these methods may also
+// be called by GET requests with default request fields.  Methods with client
+// streams are not supported.
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"reflect"
+
+	pb "github.com/fonero-project/fnowallet/rpc/walletrpc"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxRequestSize is the maximum size of a JSON request body.
+const maxRequestSize = 1024 * 1024 * 8
+
+// AuthorizeFunc authorizes an HTTP request to call the full gRPC method name
+// (`/package.service/method`).  A gRPC status error is returned when the
+// client may not call the method.  Otherwise, the returned context, which is
+// derived from the request's context, is used for the gRPC call and may carry
+// outgoing metadata describing the client.
+type AuthorizeFunc func(r *http.Request, fullMethod string) (context.Context, error)
+
+// Options modifies the behavior of the gateway.
+type Options struct {
+	// Authorize is called before every method call.  All requests are
+	// denied when nil.
+	Authorize AuthorizeFunc
+
+	// AllowedOrigins are the browser origins permitted to make cross-origin
+	// requests.
+	AllowedOrigins []string
+}
+
+// method describes a gRPC method served by the gateway.
+type method struct {
+	fullName  string
+	fn        reflect.Value // Method of the gRPC client
+	reqType   reflect.Type  // Nil for methods with client streams
+	streaming bool
+}
+
+// Gateway is an http.Handler which translates JSON requests to calls of the
+// gRPC services.
+type Gateway struct {
+	methods        map[string]*method // Keyed by route
+	authorize      AuthorizeFunc
+	allowedOrigins map[string]struct{}
+	marshaler      jsonpb.Marshaler
+}
+
+var (
+	contextType      = reflect.TypeOf((*context.Context)(nil)).Elem()
+	clientStreamType = reflect.TypeOf((*grpc.ClientStream)(nil)).Elem()
+)
+
+// New creates a gateway calling the gRPC services over conn.
+func New(conn *grpc.ClientConn, opts *Options) *Gateway {
+	g := &Gateway{
+		methods:        make(map[string]*method),
+		authorize:      opts.Authorize,
+		allowedOrigins: make(map[string]struct{}, len(opts.AllowedOrigins)),
+		marshaler:      jsonpb.Marshaler{OrigName: true, EmitDefaults: true},
+	}
+	for _, o := range opts.AllowedOrigins {
+		g.allowedOrigins[o] = struct{}{}
+	}
+	g.register("WalletService", pb.NewWalletServiceClient(conn),
+		(*pb.WalletServiceClient)(nil))
+	g.register("WalletLoaderService", pb.NewWalletLoaderServiceClient(conn),
+		(*pb.WalletLoaderServiceClient)(nil))
+	g.register("TicketBuyerV2Service", pb.NewTicketBuyerV2ServiceClient(conn),
+		(*pb.TicketBuyerV2ServiceClient)(nil))
+	g.register("VotingService", pb.NewVotingServiceClient(conn),
+		(*pb.VotingServiceClient)(nil))
+	return g
+}
+
+// register adds routes for every method of the client interface iface, which
+// must be a pointer to the generated client interface type.
+func (g *Gateway) register(service string, client interface{}, iface interface{}) {
+	t := reflect.TypeOf(iface).Elem()
+	v := reflect.ValueOf(client)
+	for i := 0; i < t.NumMethod(); i++ {
+		name := t.Method(i).Name
+		fn := v.MethodByName(name)
+		m := &method{
+			fullName: "/walletrpc." + service + "/" + name,
+			fn:       fn,
+		}
+		// Unary and server streaming methods take the context, request
+		// message, and call options.  Methods with client streams only
+		// take the context and call options.
+		ft := fn.Type()
+		if ft.NumIn() == 3 && ft.In(0) == contextType {
+			m.reqType = ft.In(1).Elem()
+			m.streaming = ft.Out(0).Implements(clientStreamType)
+		}
+		g.methods["/v1/"+service+"/"+name] = m
+	}
+}
+
+// httpStatus returns the HTTP status code describing a gRPC status code.
+func httpStatus(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return http.StatusRequestTimeout
+	case codes.InvalidArgument, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.FailedPrecondition:
+		return http.StatusPreconditionFailed
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// errorJSON returns the JSON object describing an error.
+func errorJSON(err error) []byte {
+	st := status.Convert(err)
+	b, err := (&jsonpb.Marshaler{OrigName: true}).MarshalToString(st.Proto())
+	if err != nil {
+		return []byte(fmt.Sprintf(`{"code":%d}`, st.Code()))
+	}
+	return []byte(b)
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	writeErrorStatus(w, httpStatus(status.Code(err)), err)
+}
+
+// writeErrorStatus writes the JSON description of err with an HTTP status
+// which does not follow from the gRPC status code.
+func writeErrorStatus(w http.ResponseWriter, code int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	w.Write(errorJSON(err))
+}
+
+// checkRequest checks that the HTTP method and content type of a request are
+// allowed for calls of m.  Unary methods are only called by POST requests with
+// a JSON body, so plain cross-site form submissions and GET requests can not
+// call them.
+func checkRequest(w http.ResponseWriter, r *http.Request, m *method) bool {
+	allow := "POST, OPTIONS"
+	if m.streaming {
+		allow = "GET, POST, OPTIONS"
+	}
+	switch {
+	case r.Method == http.MethodGet && m.streaming:
+		return true
+	case r.Method != http.MethodPost:
+		w.Header().Set("Allow", allow)
+		writeErrorStatus(w, http.StatusMethodNotAllowed, status.Errorf(
+			codes.InvalidArgument, "method %s may not be called with %s",
+			m.fullName, r.Method))
+		return false
+	}
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil || mediaType != "application/json" {
+		writeErrorStatus(w, http.StatusUnsupportedMediaType, status.Errorf(
+			codes.InvalidArgument, "request content type must be application/json"))
+		return false
+	}
+	return true
+}
+
+// setCORSHeaders allows cross-origin requests from the allowed origins.
+func (g *Gateway) setCORSHeaders(w http.ResponseWriter, r *http.Request) {
+	origin := r.Header.Get("Origin")
+	if _, ok := g.allowedOrigins[origin]; !ok || origin == "" {
+		return
+	}
+	h := w.Header()
+	h.Set("Access-Control-Allow-Origin", origin)
+	h.Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
+	h.Set("Access-Control-Allow-Headers", "Authorization, Content-Type")
+	h.Add("Vary", "Origin")
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (g *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	g.setCORSHeaders(w, r)
+	switch r.Method {
+	case http.MethodOptions:
+		w.WriteHeader(http.StatusNoContent)
+		return
+	case http.MethodGet, http.MethodPost:
+	default:
+		w.Header().Set("Allow", "GET, POST, OPTIONS")
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	m, ok := g.methods[r.URL.Path]
+	if !ok {
+		writeError(w, status.Errorf(codes.NotFound, "unknown method %s", r.URL.Path))
+		return
+	}
+	if m.reqType == nil {
+		writeError(w, status.Errorf(codes.Unimplemented,
+			"method %s has a client stream and is not supported by the gateway",
+			m.fullName))
+		return
+	}
+	if !checkRequest(w, r, m) {
+		return
+	}
+	if g.authorize == nil {
+		writeError(w, status.Errorf(codes.Unauthenticated,
+			"gateway client authentication is not configured"))
+		return
+	}
+	ctx, err := g.authorize(r, m.fullName)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// GET requests of streaming methods use default request fields.
+	req := reflect.New(m.reqType)
+	var body []byte
+	if r.Method == http.MethodPost {
+		body, err = ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxRequestSize))
+		if err != nil {
+			writeError(w, status.Errorf(codes.InvalidArgument, "read request: %v", err))
+			return
+		}
+	}
+	if len(bytes.TrimSpace(body)) != 0 {
+		err = jsonpb.Unmarshal(bytes.NewReader(body), req.Interface().(proto.Message))
+		if err != nil {
+			writeError(w, status.Errorf(codes.InvalidArgument, "decode request: %v", err))
+			return
+		}
+	}
+
+	out := m.fn.Call([]reflect.Value{reflect.ValueOf(ctx), req})
+	if err, _ := out[1].Interface().(error); err != nil {
+		writeError(w, err)
+		return
+	}
+	if m.streaming {
+		g.serveEvents(w, r, out[0])
+		return
+	}
+	var buf bytes.Buffer
+	err = g.marshaler.Marshal(&buf, out[0].Interface().(proto.Message))
+	if err != nil {
+		writeError(w, status.Errorf(codes.Internal, "encode response: %v", err))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(buf.Bytes())
+}
+
+// serveEvents sends each message received from a server stream as a
+// server-sent event.  An end event is sent after the final message, and an
+// error event describes the error of a failed stream.
+func (g *Gateway) serveEvents(w http.ResponseWriter, r *http.Request, stream reflect.Value) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeError(w, status.Errorf(codes.Unimplemented, "streaming is not supported"))
+		return
+	}
+	h := w.Header()
+	h.Set("Content-Type", "text/event-stream")
+	h.Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	recv := stream.MethodByName("Recv")
+	var buf bytes.Buffer
+	for {
+		out := recv.Call(nil)
+		if err, _ := out[1].Interface().(error); err != nil {
+			switch {
+			case err == io.EOF:
+				io.WriteString(w, "event: end\ndata: {}\n\n")
+			case r.Context().Err() != nil:
+				// Client disconnected.
+				return
+			default:
+				fmt.Fprintf(w, "event: error\ndata: %s\n\n", errorJSON(err))
+			}
+			flusher.Flush()
+			return
+		}
+		buf.Reset()
+		err := g.marshaler.Marshal(&buf, out[0].Interface().(proto.Message))
+		if err != nil {
+			fmt.Fprintf(w, "event: error\ndata: %s\n\n", errorJSON(
+				status.Errorf(codes.Internal, "encode response: %v", err)))
+			flusher.Flush()
+			return
+		}
+		fmt.Fprintf(w, "data: %s\n\n", buf.Bytes())
+		flusher.Flush()
+	}
+}
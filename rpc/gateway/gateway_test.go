@@ -0,0 +1,221 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package gateway
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	pb "github.com/fonero-project/fnowallet/rpc/walletrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type walletServer struct {
+	pb.WalletServiceServer // Unimplemented methods panic
+}
+
+func (*walletServer) Balance(ctx context.Context, req *pb.BalanceRequest) (*pb.BalanceResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if c := md["client"]; len(c) != 1 || c[0] != "tester" {
+		return nil, status.Errorf(codes.Internal, "missing client metadata %v", md)
+	}
+	if req.AccountNumber != 1 {
+		return nil, status.Errorf(codes.NotFound, "account %d", req.AccountNumber)
+	}
+	return &pb.BalanceResponse{Total: 100, Spendable: 50}, nil
+}
+
+func (*walletServer) TransactionNotifications(req *pb.TransactionNotificationsRequest,
+	svr pb.WalletService_TransactionNotificationsServer) error {
+
+	for i := 0; i < 2; i++ {
+		err := svr.Send(&pb.TransactionNotificationsResponse{
+			UnminedTransactionHashes: [][]byte{{byte(i)}},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestGateway(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := grpc.NewServer()
+	pb.RegisterWalletServiceServer(server, &walletServer{})
+	go server.Serve(lis)
+	defer server.Stop()
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	authorize := func(r *http.Request, method string) (context.Context, error) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			return nil, status.Errorf(codes.Unauthenticated, "no token")
+		}
+		return metadata.AppendToOutgoingContext(r.Context(), "client", "tester"), nil
+	}
+	g := New(conn, &Options{Authorize: authorize})
+	s := httptest.NewServer(g)
+	defer s.Close()
+
+	request := func(method, path, contentType, body string, auth bool) *http.Response {
+		t.Helper()
+		req, err := http.NewRequest(method, s.URL+path, strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if contentType != "" {
+			req.Header.Set("Content-Type", contentType)
+		}
+		if auth {
+			req.Header.Set("Authorization", "Bearer token")
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp
+	}
+	call := func(path, body string, auth bool) *http.Response {
+		t.Helper()
+		return request("POST", path, "application/json", body, auth)
+	}
+	read := func(resp *http.Response) string {
+		t.Helper()
+		defer resp.Body.Close()
+		var sb strings.Builder
+		sc := bufio.NewScanner(resp.Body)
+		for sc.Scan() {
+			sb.WriteString(sc.Text())
+			sb.WriteByte('\n')
+		}
+		return sb.String()
+	}
+
+	resp := call("/v1/WalletService/Balance", `{"account_number":1}`, true)
+	body := read(resp)
+	if resp.StatusCode != http.StatusOK || !strings.Contains(body, `"total":"100"`) ||
+		!strings.Contains(body, `"spendable":"50"`) {
+		t.Errorf("unexpected balance response %d %s", resp.StatusCode, body)
+	}
+
+	resp = call("/v1/WalletService/Balance", `{"account_number":2}`, true)
+	body = read(resp)
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("expected not found status, got %d %s", resp.StatusCode, body)
+	}
+
+	resp = call("/v1/WalletService/Balance", `{}`, false)
+	read(resp)
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("expected unauthorized status, got %d", resp.StatusCode)
+	}
+
+	resp = call("/v1/WalletService/Balance", `{"account_number":`, true)
+	read(resp)
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("expected bad request status, got %d", resp.StatusCode)
+	}
+
+	resp = call("/v1/WalletService/NoSuchMethod", ``, true)
+	read(resp)
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("expected not found status, got %d", resp.StatusCode)
+	}
+
+	resp = call("/v1/WalletService/ConfirmationNotifications", ``, true)
+	read(resp)
+	if resp.StatusCode != http.StatusNotImplemented {
+		t.Errorf("expected not implemented status, got %d", resp.StatusCode)
+	}
+
+	want := "data: {\"attached_blocks\":[],\"detached_blocks\":[],\"unmined_transactions\":[],\"unmined_transaction_hashes\":[\"AA==\"]}\n\n" +
+		"data: {\"attached_blocks\":[],\"detached_blocks\":[],\"unmined_transactions\":[],\"unmined_transaction_hashes\":[\"AQ==\"]}\n\n" +
+		"event: end\ndata: {}\n\n"
+	for _, method := range []string{"POST", "GET"} {
+		resp = request(method, "/v1/WalletService/TransactionNotifications",
+			"application/json", ``, true)
+		if ct := resp.Header.Get("Content-Type"); ct != "text/event-stream" {
+			t.Errorf("%s: wrong event stream content type %q", method, ct)
+		}
+		body = read(resp)
+		if body != want {
+			t.Errorf("%s: unexpected events:\n%s\nwant:\n%s", method, body, want)
+		}
+	}
+
+	// Unary methods may only be called by POST requests with a JSON body.
+	// Requests which a browser would send cross-site without a CORS
+	// preflight are rejected before the method is called.
+	rejected := []struct {
+		method, contentType string
+		status              int
+	}{
+		{"GET", "", http.StatusMethodNotAllowed},
+		{"GET", "application/json", http.StatusMethodNotAllowed},
+		{"PUT", "application/json", http.StatusMethodNotAllowed},
+		{"POST", "", http.StatusUnsupportedMediaType},
+		{"POST", "text/plain", http.StatusUnsupportedMediaType},
+		{"POST", "application/x-www-form-urlencoded", http.StatusUnsupportedMediaType},
+		{"POST", "multipart/form-data; boundary=x", http.StatusUnsupportedMediaType},
+	}
+	for _, r := range rejected {
+		resp = request(r.method, "/v1/WalletService/Balance", r.contentType,
+			`{"account_number":1}`, true)
+		read(resp)
+		if resp.StatusCode != r.status {
+			t.Errorf("%s %q: expected status %d, got %d", r.method,
+				r.contentType, r.status, resp.StatusCode)
+		}
+	}
+	resp = request("POST", "/v1/WalletService/Balance",
+		"application/json; charset=utf-8", `{"account_number":1}`, true)
+	read(resp)
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("expected OK status with charset parameter, got %d", resp.StatusCode)
+	}
+	resp = request("POST", "/v1/WalletService/TransactionNotifications",
+		"text/plain", ``, true)
+	read(resp)
+	if resp.StatusCode != http.StatusUnsupportedMediaType {
+		t.Errorf("expected unsupported media type status for streaming "+
+			"method, got %d", resp.StatusCode)
+	}
+}
+
+func TestGatewayRequiresAuthorization(t *testing.T) {
+	conn, err := grpc.Dial("127.0.0.1:0", grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	// Without an authorization function, no method is called.
+	s := httptest.NewServer(New(conn, &Options{}))
+	defer s.Close()
+	resp, err := http.Post(s.URL+"/v1/WalletService/Balance", "application/json",
+		strings.NewReader(`{}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("expected unauthorized status, got %d", resp.StatusCode)
+	}
+}
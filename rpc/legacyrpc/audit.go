@@ -0,0 +1,46 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package legacyrpc
+
+import (
+	"context"
+
+	"github.com/fonero-project/fnod/fnojson"
+	"github.com/fonero-project/fnowallet/auditlog"
+	"github.com/fonero-project/fnowallet/internal/ratelimit"
+)
+
+// audit records a call of a method which is not in the read class in the
+// audit log.
+func (s *Server) audit(ctx context.Context, user *authUser, method string, jsonErr *fnojson.RPCError) {
+	if s.auditLog == nil || methodClass(method) == ratelimit.Read {
+		return
+	}
+	e := &auditlog.Entry{
+		Source:    auditlog.SourceJSONRPC,
+		Operation: method,
+		Client:    clientID(ctx, user),
+		Remote:    remoteAddr(ctx),
+	}
+	if jsonErr != nil {
+		e.Error = jsonErr.Message
+	}
+	if err := s.auditLog.Append(e); err != nil {
+		log.Errorf("Failed to write audit log entry for %s: %v", method, err)
+	}
+}
+
+// audited wraps a handler so that the call is recorded in the audit log after
+// it returns.
+func (s *Server) audited(ctx context.Context, user *authUser, method string, h lazyHandler) lazyHandler {
+	if s.auditLog == nil || methodClass(method) == ratelimit.Read {
+		return h
+	}
+	return func() (interface{}, *fnojson.RPCError) {
+		res, jsonErr := h()
+		s.audit(ctx, user, method, jsonErr)
+		return res, jsonErr
+	}
+}
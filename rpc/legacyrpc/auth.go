@@ -0,0 +1,223 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package legacyrpc
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/fonero-project/fnod/fnojson"
+	"github.com/fonero-project/fnowallet/errors"
+)
+
+// User describes an additional user of the legacy RPC server.  Users are
+// authenticated by a salted password hash rather than a plaintext password,
+// using the same rpcauth format as bitcoind, and may be restricted to a subset
+// of methods and accounts.
+type User struct {
+	Name string
+	Salt string
+	Hash []byte // HMAC-SHA256 of the password keyed by the salt
+
+	// Allowed methods and account names.  All methods or accounts are
+	// allowed when nil.
+	Methods  []string
+	Accounts []string
+}
+
+// RPCAuthHash returns the salted hash of a user password.  The hash is
+// HMAC-SHA256 of the password keyed by the salt.
+func RPCAuthHash(salt, password string) []byte {
+	mac := hmac.New(sha256.New, []byte(salt))
+	mac.Write([]byte(password))
+	return mac.Sum(nil)
+}
+
+// ParseRPCAuth parses a user from the rpcauth format <name>:<salt>$<hash>,
+// where hash is the hex encoding of RPCAuthHash(salt, password).
+func ParseRPCAuth(s string) (*User, error) {
+	const op errors.Op = "legacyrpc.ParseRPCAuth"
+	colon := strings.IndexByte(s, ':')
+	dollar := strings.LastIndexByte(s, '$')
+	if colon < 1 || dollar < colon {
+		return nil, errors.E(op, errors.Invalid, "rpcauth must be in the form <name>:<salt>$<hash>")
+	}
+	hash, err := hex.DecodeString(s[dollar+1:])
+	if err != nil || len(hash) != sha256.Size {
+		return nil, errors.E(op, errors.Invalid, "rpcauth hash must be a hex-encoded HMAC-SHA256")
+	}
+	return &User{
+		Name: s[:colon],
+		Salt: s[colon+1 : dollar],
+		Hash: hash,
+	}, nil
+}
+
+// authUser is an authenticated client of the server.  The methods and
+// accounts maps are nil when unrestricted.
+type authUser struct {
+	name     string
+	methods  map[string]struct{}
+	accounts map[string]struct{}
+}
+
+// fullUser is the user authenticated by the server's username and password.
+var fullUser = &authUser{}
+
+func newAuthUser(u *User) *authUser {
+	a := &authUser{name: u.Name}
+	if u.Methods != nil {
+		a.methods = make(map[string]struct{}, len(u.Methods))
+		for _, m := range u.Methods {
+			a.methods[m] = struct{}{}
+		}
+	}
+	if u.Accounts != nil {
+		a.accounts = make(map[string]struct{}, len(u.Accounts))
+		for _, acct := range u.Accounts {
+			a.accounts[acct] = struct{}{}
+		}
+	}
+	return a
+}
+
+// accountIndependentMethods are the methods which neither refer to accounts
+// nor reveal or modify anything about the wallet's accounts, addresses, or
+// transactions.  Notification subscriptions are included as notifications are
+// filtered by the allowed accounts.
+var accountIndependentMethods = map[string]struct{}{
+	"createmultisig":   {},
+	"getbestblock":     {},
+	"getbestblockhash": {},
+	"getblockcount":    {},
+	"getticketfee":     {},
+	"getwalletfee":     {},
+	"help":             {},
+	"subscribe":        {},
+	"unsubscribe":      {},
+	"verifymessage":    {},
+	"version":          {},
+	"walletislocked":   {},
+}
+
+// IsMethod returns whether method names a method of the server, including the
+// websocket-only methods, which may be named in the method allowlist of a user.
+// Methods passed through to the consensus RPC server are not included.
+func IsMethod(method string) bool {
+	switch method {
+	case "stop", "subscribe", "unsubscribe":
+		return true
+	}
+	_, ok := handlers[method]
+	return ok
+}
+
+// authorize returns an error when the user is not permitted to call the
+// request.  Users restricted to accounts may only call the methods which refer
+// to accounts, with allowed accounts, and the methods which are independent of
+// accounts.  All other methods are denied.
+func (u *authUser) authorize(req *fnojson.Request) *fnojson.RPCError {
+	if u.methods != nil {
+		if _, ok := u.methods[req.Method]; !ok {
+			return rpcErrorf(fnojson.ErrRPCInvalidRequest.Code,
+				"method %s is not permitted for user %s", req.Method, u.name)
+		}
+	}
+	if u.accounts == nil {
+		return nil
+	}
+	if _, ok := accountIndependentMethods[req.Method]; ok {
+		return nil
+	}
+	var accounts []string
+	if _, ok := handlers[req.Method]; ok {
+		cmd, err := fnojson.UnmarshalCmd(req)
+		if err != nil {
+			return fnojson.ErrRPCInvalidRequest
+		}
+		accounts = requestAccounts(cmd)
+	}
+	if accounts == nil {
+		return rpcErrorf(fnojson.ErrRPCInvalidRequest.Code,
+			"method %s is not permitted for user %s restricted to accounts",
+			req.Method, u.name)
+	}
+	for _, acct := range accounts {
+		if _, ok := u.accounts[acct]; !ok {
+			return rpcErrorf(fnojson.ErrRPCInvalidRequest.Code,
+				"account %q is not permitted for user %s", acct, u.name)
+		}
+	}
+	return nil
+}
+
+// accountAllowed returns whether the user may access the named account.
+func (u *authUser) accountAllowed(account string) bool {
+	if u.accounts == nil {
+		return true
+	}
+	_, ok := u.accounts[account]
+	return ok
+}
+
+// requestAccounts returns the names of the accounts accessed by a command.
+// Omitted accounts are described by the method's default, which is either the
+// default account or "*" for all accounts.  nil is returned for commands which
+// do not refer to accounts.
+func requestAccounts(cmd interface{}) []string {
+	opt := func(acct *string, def string) string {
+		if acct == nil {
+			return def
+		}
+		return *acct
+	}
+	switch cmd := cmd.(type) {
+	case *fnojson.AccountAddressIndexCmd:
+		return []string{cmd.Account}
+	case *fnojson.AccountSyncAddressIndexCmd:
+		return []string{cmd.Account}
+	case *fnojson.ConsolidateCmd:
+		return []string{opt(cmd.Account, "default")}
+	case *fnojson.CreateNewAccountCmd:
+		return []string{cmd.Account}
+	case *fnojson.GetAccountAddressCmd:
+		return []string{cmd.Account}
+	case *fnojson.GetAddressesByAccountCmd:
+		return []string{cmd.Account}
+	case *fnojson.GetBalanceCmd:
+		return []string{opt(cmd.Account, "*")}
+	case *fnojson.GetMasterPubkeyCmd:
+		return []string{opt(cmd.Account, "default")}
+	case *fnojson.GetNewAddressCmd:
+		return []string{opt(cmd.Account, "default")}
+	case *fnojson.GetRawChangeAddressCmd:
+		return []string{opt(cmd.Account, "default")}
+	case *fnojson.GetReceivedByAccountCmd:
+		return []string{cmd.Account}
+	case *fnojson.GetUnconfirmedBalanceCmd:
+		return []string{opt(cmd.Account, "default")}
+	case *fnojson.ListAddressTransactionsCmd:
+		return []string{opt(cmd.Account, "*")}
+	case *fnojson.ListAllTransactionsCmd:
+		return []string{opt(cmd.Account, "*")}
+	case *fnojson.ListTransactionsCmd:
+		return []string{opt(cmd.Account, "*")}
+	case *fnojson.PurchaseTicketCmd:
+		return []string{cmd.FromAccount}
+	case *fnojson.RenameAccountCmd:
+		return []string{cmd.OldAccount, cmd.NewAccount}
+	case *fnojson.SendFromCmd:
+		return []string{cmd.FromAccount}
+	case *fnojson.SendManyCmd:
+		return []string{cmd.FromAccount}
+	case *fnojson.SendToAddressCmd, *fnojson.SendToMultiSigCmd:
+		return []string{"default"}
+	case *fnojson.ListSinceBlockCmd, *fnojson.ListUnspentCmd:
+		return []string{"*"}
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package legacyrpc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/fonero-project/fnod/fnojson"
+)
+
+func TestRPCAuth(t *testing.T) {
+	const salt, password = "cb77f0957de88ff388cf", "hunter2"
+	rpcauth := fmt.Sprintf("alice:%s$%x", salt, RPCAuthHash(salt, password))
+	u, err := ParseRPCAuth(rpcauth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Name != "alice" || u.Salt != salt {
+		t.Fatalf("parsed wrong user %q salt %q", u.Name, u.Salt)
+	}
+	u.Methods = []string{"getbalance"}
+
+	s := &Server{users: map[string]*rpcAuthUser{
+		u.Name: {salt: u.Salt, hash: u.Hash, user: newAuthUser(u)},
+	}}
+	if s.checkRPCAuth("alice", "hunter3") != nil {
+		t.Error("authenticated with wrong password")
+	}
+	if s.checkRPCAuth("bob", password) != nil {
+		t.Error("authenticated unknown user")
+	}
+	user := s.checkRPCAuth("alice", password)
+	if user == nil {
+		t.Fatal("failed to authenticate with correct password")
+	}
+	if err := user.authorize(&fnojson.Request{Method: "getbalance"}); err != nil {
+		t.Errorf("allowed method denied: %v", err)
+	}
+	if err := user.authorize(&fnojson.Request{Method: "sendtoaddress"}); err == nil {
+		t.Error("method outside allowlist permitted")
+	}
+
+	for _, bad := range []string{"alice", ":salt$00", "alice:salt$zz", "alice:salt$00"} {
+		if _, err := ParseRPCAuth(bad); err == nil {
+			t.Errorf("parsed invalid rpcauth %q", bad)
+		}
+	}
+}
+
+func TestAccountRestrictedUser(t *testing.T) {
+	u := newAuthUser(&User{Name: "shop", Accounts: []string{"shop"}})
+	tests := []struct {
+		method  string
+		params  []interface{}
+		allowed bool
+	}{
+		{"getbalance", []interface{}{"shop"}, true},
+		{"getbalance", []interface{}{"default"}, false},
+		{"getbalance", nil, false},
+		{"getnewaddress", []interface{}{"shop"}, true},
+		{"getnewaddress", nil, false},
+		{"listtransactions", []interface{}{"shop"}, true},
+		{"listunspent", nil, false},
+		{"getblockcount", nil, true},
+		{"help", nil, true},
+		{"dumpprivkey", []interface{}{"Ssj6Sd54j11JM8qpenCwfwnKD73dsjm68ru"}, false},
+		{"importprivkey", []interface{}{"PmQdMn8xafwaQouk8ngs1CccRCB1ZmsqQxBaxNR4vhQi5a5QB5716"}, false},
+		{"sweepaccount", []interface{}{"shop", "Ssj6Sd54j11JM8qpenCwfwnKD73dsjm68ru"}, false},
+		{"signrawtransaction", []interface{}{"00"}, false},
+		{"walletpassphrase", []interface{}{"pass", 60}, false},
+		{"gettickets", []interface{}{false}, false},
+		{"getstakeinfo", nil, false},
+		{"listreceivedbyaccount", nil, false},
+		{"stop", nil, false},
+		{"getblock", []interface{}{"00"}, false}, // passthrough
+	}
+	for _, test := range tests {
+		req, err := fnojson.NewRequest(1, test.method, test.params)
+		if err != nil {
+			t.Fatalf("%s: %v", test.method, err)
+		}
+		err = u.authorize(req)
+		if test.allowed && err != nil {
+			t.Errorf("%s %v: denied: %v", test.method, test.params, err)
+		}
+		if !test.allowed && err == nil {
+			t.Errorf("%s %v: permitted", test.method, test.params)
+		}
+	}
+
+	// Users without account restrictions may call any method.
+	full := newAuthUser(&User{Name: "full"})
+	req, err := fnojson.NewRequest(1, "dumpprivkey", []interface{}{"Ssj6Sd54j11JM8qpenCwfwnKD73dsjm68ru"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := full.authorize(req); err != nil {
+		t.Errorf("unrestricted user denied: %v", err)
+	}
+}
+
+func TestIsMethod(t *testing.T) {
+	for _, m := range []string{"getbalance", "stop", "subscribe", "walletpassphrase"} {
+		if !IsMethod(m) {
+			t.Errorf("%s is not a method", m)
+		}
+	}
+	for _, m := range []string{"getbalanse", "", "GetBalance"} {
+		if IsMethod(m) {
+			t.Errorf("%q is a method", m)
+		}
+	}
+}
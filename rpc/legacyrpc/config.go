@@ -4,11 +4,25 @@
 
 package legacyrpc
 
+import (
+	"github.com/fonero-project/fnowallet/auditlog"
+	"github.com/fonero-project/fnowallet/internal/ratelimit"
+)
+
 // Options contains the required options for running the legacy RPC server.
 type Options struct {
 	Username string
 	Password string
+	Users    []User
 
 	MaxPOSTClients      int64
 	MaxWebsocketClients int64
+
+	// RateLimiter limits the request rate of clients by method class.
+	// Requests are not limited when nil.
+	RateLimiter *ratelimit.Limiter
+
+	// AuditLog records calls of methods which are not in the read class.
+	// Calls are not recorded when nil.
+	AuditLog *auditlog.Log
 }
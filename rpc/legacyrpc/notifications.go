@@ -0,0 +1,499 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package legacyrpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+
+	"github.com/fonero-project/fnod/chaincfg/chainhash"
+	"github.com/fonero-project/fnod/fnojson"
+	"github.com/fonero-project/fnod/wire"
+	"github.com/fonero-project/fnowallet/wallet"
+)
+
+// Notification topics which websocket clients may subscribe to.
+const (
+	topicTransactions  = "transactions"
+	topicConfirmations = "confirmations"
+	topicBlocks        = "blocks"
+	topicVotes         = "votes"
+	topicBalances      = "balances"
+)
+
+var allTopics = []string{
+	topicTransactions,
+	topicConfirmations,
+	topicBlocks,
+	topicVotes,
+	topicBalances,
+}
+
+// confirmationsStopAfter is the number of confirmations after which a
+// transaction is no longer watched for confirmation notifications.
+const confirmationsStopAfter = 6
+
+// Notification method names.
+const (
+	ntfnNewTransaction    = "newtransaction"
+	ntfnTxConfirmations   = "txconfirmations"
+	ntfnBlockConnected    = "blockconnected"
+	ntfnBlockDisconnected = "blockdisconnected"
+	ntfnTicketVoted       = "ticketvoted"
+	ntfnAccountBalance    = "accountbalance"
+)
+
+// wsNotification is a JSON-RPC notification, a request without an id, sent to
+// websocket clients.
+type wsNotification struct {
+	Jsonrpc string        `json:"jsonrpc"`
+	Method  string        `json:"method"`
+	Params  []interface{} `json:"params"`
+	ID      interface{}   `json:"id"`
+}
+
+// NewTransactionNtfn describes a new mined or unmined wallet transaction.
+// Only the inputs and outputs of accounts which the client may access are
+// included.
+type NewTransactionNtfn struct {
+	TxID        string                  `json:"txid"`
+	Hex         string                  `json:"hex"`
+	Type        string                  `json:"type"`
+	Fee         float64                 `json:"fee"`
+	Time        int64                   `json:"time"`
+	BlockHash   string                  `json:"blockhash,omitempty"`
+	BlockHeight int32                   `json:"blockheight"` // -1 when unmined
+	Inputs      []NewTransactionNtfnIn  `json:"inputs"`
+	Outputs     []NewTransactionNtfnOut `json:"outputs"`
+}
+
+// NewTransactionNtfnIn describes a transaction input debiting a wallet account.
+type NewTransactionNtfnIn struct {
+	Index   uint32  `json:"index"`
+	Account string  `json:"account"`
+	Amount  float64 `json:"amount"`
+}
+
+// NewTransactionNtfnOut describes a transaction output controlled by a wallet
+// account.
+type NewTransactionNtfnOut struct {
+	Index    uint32  `json:"index"`
+	Account  string  `json:"account"`
+	Internal bool    `json:"internal"`
+	Amount   float64 `json:"amount"`
+	Address  string  `json:"address,omitempty"`
+}
+
+// TxConfirmationsNtfn describes the number of confirmations of a transaction,
+// or -1 when the transaction was removed from the wallet or main chain.
+type TxConfirmationsNtfn struct {
+	TxID          string `json:"txid"`
+	Confirmations int32  `json:"confirmations"`
+	BlockHash     string `json:"blockhash,omitempty"`
+	BlockHeight   int32  `json:"blockheight"` // -1 when unmined
+}
+
+// BlockConnectedNtfn describes a block attached to the main chain.
+type BlockConnectedNtfn struct {
+	Hash   string `json:"hash"`
+	Height uint32 `json:"height"`
+	Header string `json:"header"`
+}
+
+// BlockDisconnectedNtfn describes a block removed from the main chain.
+type BlockDisconnectedNtfn struct {
+	Hash string `json:"hash"`
+}
+
+// TicketVotedNtfn describes a vote of a wallet ticket.
+type TicketVotedNtfn struct {
+	Ticket      string `json:"ticket"`
+	Vote        string `json:"vote"`
+	BlockHash   string `json:"blockhash,omitempty"`
+	BlockHeight int32  `json:"blockheight"` // -1 when unmined
+}
+
+// AccountBalanceNtfn describes the new total (zero confirmation) balance of an
+// account.
+type AccountBalanceNtfn struct {
+	Account      string  `json:"account"`
+	TotalBalance float64 `json:"totalbalance"`
+}
+
+// wsSubscriptions records the notification topics of a websocket client.  The
+// goroutines delivering notifications run while any topic is subscribed.
+type wsSubscriptions struct {
+	mu     sync.Mutex
+	topics map[string]struct{}
+	cancel context.CancelFunc
+}
+
+func (s *wsSubscriptions) subscribed(topic string) bool {
+	s.mu.Lock()
+	_, ok := s.topics[topic]
+	s.mu.Unlock()
+	return ok
+}
+
+// list returns the subscribed topics.
+func (s *wsSubscriptions) list() []string {
+	s.mu.Lock()
+	l := make([]string, 0, len(s.topics))
+	for _, t := range allTopics {
+		if _, ok := s.topics[t]; ok {
+			l = append(l, t)
+		}
+	}
+	s.mu.Unlock()
+	return l
+}
+
+// stop unsubscribes from all topics and cancels notification delivery.
+func (s *wsSubscriptions) stop() {
+	s.mu.Lock()
+	s.topics = nil
+	if s.cancel != nil {
+		s.cancel()
+		s.cancel = nil
+	}
+	s.mu.Unlock()
+}
+
+// parseTopics parses the topic list of a subscribe or unsubscribe request.
+func parseTopics(req *fnojson.Request) ([]string, *fnojson.RPCError) {
+	topics := make([]string, 0, len(req.Params))
+	for _, p := range req.Params {
+		var t string
+		err := json.Unmarshal(p, &t)
+		if err != nil {
+			return nil, rpcErrorf(fnojson.ErrRPCInvalidParameter,
+				"topics must be strings")
+		}
+		known := false
+		for _, k := range allTopics {
+			if t == k {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return nil, rpcErrorf(fnojson.ErrRPCInvalidParameter,
+				"unknown notification topic %q", t)
+		}
+		topics = append(topics, t)
+	}
+	return topics, nil
+}
+
+// websocketSubscribe handles the subscribe and unsubscribe requests of a
+// websocket client.  Unsubscribing without topics removes all subscriptions.
+// The currently subscribed topics are returned.
+func (s *Server) websocketSubscribe(ctx context.Context, wsc *websocketClient, req *fnojson.Request) (interface{}, *fnojson.RPCError) {
+	topics, jsonErr := parseTopics(req)
+	if jsonErr != nil {
+		return nil, jsonErr
+	}
+
+	subs := &wsc.subs
+	if req.Method == "unsubscribe" {
+		if len(topics) == 0 {
+			subs.stop()
+			return subs.list(), nil
+		}
+		subs.mu.Lock()
+		for _, t := range topics {
+			delete(subs.topics, t)
+		}
+		empty := len(subs.topics) == 0
+		subs.mu.Unlock()
+		if empty {
+			subs.stop()
+		}
+		return subs.list(), nil
+	}
+
+	if len(topics) == 0 {
+		return nil, rpcErrorf(fnojson.ErrRPCInvalidParameter,
+			"no notification topics")
+	}
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+	subs.mu.Lock()
+	if subs.topics == nil {
+		subs.topics = make(map[string]struct{})
+	}
+	for _, t := range topics {
+		subs.topics[t] = struct{}{}
+	}
+	if subs.cancel == nil {
+		var nctx context.Context
+		nctx, subs.cancel = context.WithCancel(ctx)
+		wsc.wg.Add(2)
+		confs := w.NtfnServer.ConfirmationNotifications(nctx)
+		go s.websocketNotifyTransactions(nctx, wsc, w, confs)
+		go s.websocketNotifyConfirmations(nctx, wsc, confs)
+	}
+	subs.mu.Unlock()
+	return subs.list(), nil
+}
+
+// sendNotification marshals and sends a notification to the websocket client.
+func (s *Server) sendNotification(ctx context.Context, wsc *websocketClient, method string, params ...interface{}) error {
+	n := &wsNotification{
+		Jsonrpc: "1.0",
+		Method:  method,
+		Params:  params,
+	}
+	b, err := json.Marshal(n)
+	if err != nil {
+		log.Errorf("Unable to marshal %s notification to client %s: %v",
+			method, remoteAddr(ctx), err)
+		return nil
+	}
+	return wsc.send(b)
+}
+
+// websocketNotifyTransactions delivers the transaction, block, vote, and
+// balance notifications of the wallet to a websocket client, and watches new
+// transactions for confirmation notifications.
+func (s *Server) websocketNotifyTransactions(ctx context.Context, wsc *websocketClient,
+	w *wallet.Wallet, confs *wallet.ConfirmationNotificationsClient) {
+
+	defer wsc.wg.Done()
+
+	txs := w.NtfnServer.TransactionNotifications()
+	defer txs.Done()
+
+	accountNames := make(map[uint32]string)
+	accountName := func(account uint32) string {
+		name, ok := accountNames[account]
+		if !ok {
+			var err error
+			name, err = w.AccountName(account)
+			if err != nil {
+				log.Errorf("Cannot find name of account %d: %v", account, err)
+			}
+			accountNames[account] = name
+		}
+		return name
+	}
+	for {
+		var n *wallet.TransactionNotifications
+		select {
+		case n = <-txs.C:
+		case <-ctx.Done():
+			return
+		}
+
+		var notifications []wsNotification
+		add := func(method string, params interface{}) {
+			notifications = append(notifications, wsNotification{
+				Jsonrpc: "1.0",
+				Method:  method,
+				Params:  []interface{}{params},
+			})
+		}
+		var watch []*chainhash.Hash
+		addTx := func(tx *wallet.TransactionSummary, block *wire.BlockHeader) {
+			// Transactions of accounts the client may not access are
+			// neither notified nor watched for confirmations.
+			ntfn, ok := txNotification(wsc.user, tx, block, accountName)
+			if !ok {
+				return
+			}
+			if wsc.subs.subscribed(topicConfirmations) {
+				watch = append(watch, tx.Hash)
+			}
+			if wsc.subs.subscribed(topicTransactions) &&
+				(len(ntfn.Inputs) != 0 || len(ntfn.Outputs) != 0) {
+				add(ntfnNewTransaction, ntfn)
+			}
+			if tx.Type == wallet.TransactionTypeVote && wsc.subs.subscribed(topicVotes) {
+				var vote wire.MsgTx
+				err := vote.Deserialize(bytes.NewReader(tx.Transaction))
+				if err != nil || len(vote.TxIn) < 2 {
+					log.Errorf("Cannot decode vote %v", tx.Hash)
+					return
+				}
+				add(ntfnTicketVoted, &TicketVotedNtfn{
+					Ticket:      vote.TxIn[1].PreviousOutPoint.Hash.String(),
+					Vote:        tx.Hash.String(),
+					BlockHash:   ntfn.BlockHash,
+					BlockHeight: ntfn.BlockHeight,
+				})
+			}
+		}
+
+		if wsc.subs.subscribed(topicBlocks) {
+			for _, h := range n.DetachedBlocks {
+				add(ntfnBlockDisconnected, &BlockDisconnectedNtfn{
+					Hash: h.String(),
+				})
+			}
+		}
+		for i := range n.AttachedBlocks {
+			b := &n.AttachedBlocks[i]
+			if wsc.subs.subscribed(topicBlocks) {
+				var header bytes.Buffer
+				err := b.Header.Serialize(&header)
+				if err != nil {
+					log.Errorf("Cannot serialize block header: %v", err)
+					continue
+				}
+				add(ntfnBlockConnected, &BlockConnectedNtfn{
+					Hash:   b.Header.BlockHash().String(),
+					Height: b.Header.Height,
+					Header: hex.EncodeToString(header.Bytes()),
+				})
+			}
+			for j := range b.Transactions {
+				addTx(&b.Transactions[j], b.Header)
+			}
+		}
+		for i := range n.UnminedTransactions {
+			addTx(&n.UnminedTransactions[i], nil)
+		}
+		if wsc.subs.subscribed(topicBalances) {
+			for _, bal := range n.NewBalances {
+				acct := accountName(bal.Account)
+				if !wsc.user.accountAllowed(acct) {
+					continue
+				}
+				add(ntfnAccountBalance, &AccountBalanceNtfn{
+					Account:      acct,
+					TotalBalance: bal.TotalBalance.ToCoin(),
+				})
+			}
+		}
+
+		for i := range notifications {
+			n := &notifications[i]
+			if s.sendNotification(ctx, wsc, n.Method, n.Params...) != nil {
+				return
+			}
+		}
+		if len(watch) != 0 {
+			confs.Watch(watch, confirmationsStopAfter)
+		}
+
+		// Account names may be changed by renameaccount, so the cache is
+		// only used for the duration of a single notification.
+		for k := range accountNames {
+			delete(accountNames, k)
+		}
+	}
+}
+
+// txNotification creates the new transaction notification of a wallet
+// transaction for a user.  Only the inputs and outputs of accounts the user may
+// access are included.  ok is false when the user is restricted to accounts and
+// the transaction debits or credits none of them, in which case nothing about
+// the transaction, including votes and confirmations, may be notified.
+func txNotification(u *authUser, tx *wallet.TransactionSummary, block *wire.BlockHeader,
+	accountName func(uint32) string) (ntfn *NewTransactionNtfn, ok bool) {
+
+	ntfn = &NewTransactionNtfn{
+		TxID:        tx.Hash.String(),
+		Hex:         hex.EncodeToString(tx.Transaction),
+		Type:        txTypeString(tx.Type),
+		Fee:         tx.Fee.ToCoin(),
+		Time:        tx.Timestamp,
+		BlockHeight: -1,
+		Inputs:      []NewTransactionNtfnIn{},
+		Outputs:     []NewTransactionNtfnOut{},
+	}
+	if block != nil {
+		ntfn.BlockHash = block.BlockHash().String()
+		ntfn.BlockHeight = int32(block.Height)
+	}
+	for _, in := range tx.MyInputs {
+		acct := accountName(in.PreviousAccount)
+		if !u.accountAllowed(acct) {
+			continue
+		}
+		ntfn.Inputs = append(ntfn.Inputs, NewTransactionNtfnIn{
+			Index:   in.Index,
+			Account: acct,
+			Amount:  in.PreviousAmount.ToCoin(),
+		})
+	}
+	for _, out := range tx.MyOutputs {
+		acct := accountName(out.Account)
+		if !u.accountAllowed(acct) {
+			continue
+		}
+		var addr string
+		if out.Address != nil {
+			addr = out.Address.EncodeAddress()
+		}
+		ntfn.Outputs = append(ntfn.Outputs, NewTransactionNtfnOut{
+			Index:    out.Index,
+			Account:  acct,
+			Internal: out.Internal,
+			Amount:   out.Amount.ToCoin(),
+			Address:  addr,
+		})
+	}
+	ok = u.accounts == nil || len(ntfn.Inputs) != 0 || len(ntfn.Outputs) != 0
+	return ntfn, ok
+}
+
+// websocketNotifyConfirmations delivers confirmation notifications of the
+// transactions watched by websocketNotifyTransactions.
+func (s *Server) websocketNotifyConfirmations(ctx context.Context, wsc *websocketClient,
+	confs *wallet.ConfirmationNotificationsClient) {
+
+	defer wsc.wg.Done()
+
+	for {
+		results, err := confs.Recv()
+		if err != nil {
+			if ctx.Err() == nil {
+				log.Errorf("Confirmation notifications for client %s failed: %v",
+					remoteAddr(ctx), err)
+			}
+			return
+		}
+		if len(results) == 0 || !wsc.subs.subscribed(topicConfirmations) {
+			continue
+		}
+		ntfns := make([]TxConfirmationsNtfn, len(results))
+		for i, r := range results {
+			ntfns[i] = TxConfirmationsNtfn{
+				TxID:          r.TxHash.String(),
+				Confirmations: r.Confirmations,
+				BlockHeight:   r.BlockHeight,
+			}
+			if r.BlockHash != nil {
+				ntfns[i].BlockHash = r.BlockHash.String()
+			}
+		}
+		if s.sendNotification(ctx, wsc, ntfnTxConfirmations, ntfns) != nil {
+			return
+		}
+	}
+}
+
+// txTypeString returns the name of a wallet transaction type used by
+// notifications.
+func txTypeString(t wallet.TransactionType) string {
+	switch t {
+	case wallet.TransactionTypeCoinbase:
+		return "coinbase"
+	case wallet.TransactionTypeTicketPurchase:
+		return "ticket"
+	case wallet.TransactionTypeVote:
+		return "vote"
+	case wallet.TransactionTypeRevocation:
+		return "revocation"
+	default:
+		return "regular"
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package legacyrpc
+
+import (
+	"testing"
+
+	"github.com/fonero-project/fnod/chaincfg/chainhash"
+	"github.com/fonero-project/fnowallet/wallet"
+)
+
+func TestTxNotificationAccounts(t *testing.T) {
+	names := map[uint32]string{0: "default", 1: "shop"}
+	accountName := func(account uint32) string { return names[account] }
+
+	// A payment from the default account to the shop account, a transaction
+	// of only the default account, and a vote of a default account ticket.
+	payment := &wallet.TransactionSummary{
+		Hash:      &chainhash.Hash{1},
+		MyInputs:  []wallet.TransactionSummaryInput{{Index: 0, PreviousAccount: 0, PreviousAmount: 5e8}},
+		MyOutputs: []wallet.TransactionSummaryOutput{{Index: 0, Account: 1, Amount: 2e8}, {Index: 1, Account: 0, Internal: true, Amount: 3e8}},
+	}
+	private := &wallet.TransactionSummary{
+		Hash:      &chainhash.Hash{2},
+		MyInputs:  []wallet.TransactionSummaryInput{{Index: 0, PreviousAccount: 0, PreviousAmount: 5e8}},
+		MyOutputs: []wallet.TransactionSummaryOutput{{Index: 0, Account: 0, Amount: 5e8}},
+	}
+	vote := &wallet.TransactionSummary{
+		Hash:      &chainhash.Hash{3},
+		Type:      wallet.TransactionTypeVote,
+		MyInputs:  []wallet.TransactionSummaryInput{{Index: 1, PreviousAccount: 0, PreviousAmount: 1e8}},
+		MyOutputs: []wallet.TransactionSummaryOutput{{Index: 2, Account: 0, Amount: 1e8}},
+	}
+
+	shop := newAuthUser(&User{Name: "shop", Accounts: []string{"shop"}})
+	ntfn, ok := txNotification(shop, payment, nil, accountName)
+	if !ok {
+		t.Fatal("payment to the shop account was not notified")
+	}
+	if len(ntfn.Inputs) != 0 || len(ntfn.Outputs) != 1 || ntfn.Outputs[0].Account != "shop" {
+		t.Errorf("payment notification includes other accounts: %+v", ntfn)
+	}
+	if ntfn.BlockHeight != -1 {
+		t.Errorf("unmined transaction has block height %d", ntfn.BlockHeight)
+	}
+	for _, tx := range []*wallet.TransactionSummary{private, vote} {
+		if _, ok := txNotification(shop, tx, nil, accountName); ok {
+			t.Errorf("transaction %v of another account was notified", tx.Hash)
+		}
+	}
+
+	full := fullUser
+	for _, tx := range []*wallet.TransactionSummary{payment, private, vote} {
+		ntfn, ok := txNotification(full, tx, nil, accountName)
+		if !ok {
+			t.Errorf("transaction %v was not notified to an unrestricted user", tx.Hash)
+			continue
+		}
+		if len(ntfn.Inputs) != len(tx.MyInputs) || len(ntfn.Outputs) != len(tx.MyOutputs) {
+			t.Errorf("transaction %v notification is missing inputs or outputs", tx.Hash)
+		}
+	}
+}
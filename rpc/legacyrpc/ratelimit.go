@@ -0,0 +1,119 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package legacyrpc
+
+import (
+	"context"
+	"net"
+
+	"github.com/fonero-project/fnod/fnojson"
+	"github.com/fonero-project/fnowallet/internal/ratelimit"
+)
+
+// errRPCRateLimited is the JSON-RPC error code of requests denied by a rate
+// limit.  It is in the range reserved for implementation-defined server
+// errors.
+const errRPCRateLimited fnojson.RPCErrorCode = -32029
+
+// methodClasses records the rate limit class of methods which are not in the
+// write class.
+var methodClasses = map[string]string{
+	"rescanwallet":      ratelimit.Rescan,
+	"importprivkey":     ratelimit.Rescan,
+	"importscript":      ratelimit.Rescan,
+	"stakepooluserinfo": ratelimit.Rescan,
+
+	"subscribe": ratelimit.Stream,
+
+	"dumpprivkey":         ratelimit.Sign,
+	"generatevote":        ratelimit.Sign,
+	"purchaseticket":      ratelimit.Sign,
+	"redeemmultisigout":   ratelimit.Sign,
+	"redeemmultisigouts":  ratelimit.Sign,
+	"revoketickets":       ratelimit.Sign,
+	"sendfrom":            ratelimit.Sign,
+	"sendmany":            ratelimit.Sign,
+	"sendtoaddress":       ratelimit.Sign,
+	"sendtomultisig":      ratelimit.Sign,
+	"signmessage":         ratelimit.Sign,
+	"signrawtransaction":  ratelimit.Sign,
+	"signrawtransactions": ratelimit.Sign,
+	"sweepaccount":        ratelimit.Sign,
+
+	"accountaddressindex":     ratelimit.Read,
+	"getaccount":              ratelimit.Read,
+	"getaddressesbyaccount":   ratelimit.Read,
+	"getbalance":              ratelimit.Read,
+	"getbestblock":            ratelimit.Read,
+	"getbestblockhash":        ratelimit.Read,
+	"getblockcount":           ratelimit.Read,
+	"getinfo":                 ratelimit.Read,
+	"getmasterpubkey":         ratelimit.Read,
+	"getmultisigoutinfo":      ratelimit.Read,
+	"getreceivedbyaccount":    ratelimit.Read,
+	"getreceivedbyaddress":    ratelimit.Read,
+	"getstakeinfo":            ratelimit.Read,
+	"getticketfee":            ratelimit.Read,
+	"gettickets":              ratelimit.Read,
+	"gettransaction":          ratelimit.Read,
+	"getunconfirmedbalance":   ratelimit.Read,
+	"getvotechoices":          ratelimit.Read,
+	"getwalletfee":            ratelimit.Read,
+	"help":                    ratelimit.Read,
+	"listaccounts":            ratelimit.Read,
+	"listaddresstransactions": ratelimit.Read,
+	"listalltransactions":     ratelimit.Read,
+	"listlockunspent":         ratelimit.Read,
+	"listreceivedbyaccount":   ratelimit.Read,
+	"listreceivedbyaddress":   ratelimit.Read,
+	"listscripts":             ratelimit.Read,
+	"listsinceblock":          ratelimit.Read,
+	"listtransactions":        ratelimit.Read,
+	"listunspent":             ratelimit.Read,
+	"ticketsforaddress":       ratelimit.Read,
+	"validateaddress":         ratelimit.Read,
+	"verifymessage":           ratelimit.Read,
+	"version":                 ratelimit.Read,
+	"walletinfo":              ratelimit.Read,
+	"walletislocked":          ratelimit.Read,
+}
+
+// methodClass returns the rate limit class of a method.
+func methodClass(method string) string {
+	if c, ok := methodClasses[method]; ok {
+		return c
+	}
+	return ratelimit.Write
+}
+
+// clientID identifies the client of a request.  Users authenticated by
+// rpcauth are identified by their name and all other clients by their remote
+// address.
+func clientID(ctx context.Context, user *authUser) string {
+	if user.name != "" {
+		return "rpcuser:" + user.name
+	}
+	host, _, err := net.SplitHostPort(remoteAddr(ctx))
+	if err != nil {
+		host = remoteAddr(ctx)
+	}
+	return "rpcaddr:" + host
+}
+
+// checkRateLimit returns an error when the request of a client exceeds the
+// rate limit of the method's class.
+func (s *Server) checkRateLimit(ctx context.Context, user *authUser, method string) *fnojson.RPCError {
+	if s.rateLimiter == nil {
+		return nil
+	}
+	class := methodClass(method)
+	if !s.rateLimiter.Allow(clientID(ctx, user), class) {
+		log.Warnf("RPC method %v from %v exceeded the %s rate limit", method,
+			remoteAddr(ctx), class)
+		return rpcErrorf(errRPCRateLimited,
+			"rate limit exceeded for %s methods", class)
+	}
+	return nil
+}
@@ -7,6 +7,7 @@ package legacyrpc
 
 import (
 	"context"
+	"crypto/hmac"
 	"crypto/sha256"
 	"crypto/subtle"
 	"encoding/base64"
@@ -21,7 +22,9 @@ import (
 
 	"github.com/fonero-project/fnod/chaincfg"
 	"github.com/fonero-project/fnod/fnojson"
+	"github.com/fonero-project/fnowallet/auditlog"
 	"github.com/fonero-project/fnowallet/errors"
+	"github.com/fonero-project/fnowallet/internal/ratelimit"
 	"github.com/fonero-project/fnowallet/loader"
 	"github.com/fonero-project/fnowallet/ticketbuyer"
 	"github.com/gorilla/websocket"
@@ -30,16 +33,19 @@ import (
 type websocketClient struct {
 	conn          *websocket.Conn
 	authenticated bool
+	user          *authUser
 	allRequests   chan []byte
 	responses     chan []byte
 	quit          chan struct{} // closed on disconnect
 	wg            sync.WaitGroup
+	subs          wsSubscriptions
 }
 
-func newWebsocketClient(c *websocket.Conn, authenticated bool) *websocketClient {
+func newWebsocketClient(c *websocket.Conn, user *authUser) *websocketClient {
 	return &websocketClient{
 		conn:          c,
-		authenticated: authenticated,
+		authenticated: user != nil,
+		user:          user,
 		allRequests:   make(chan []byte),
 		responses:     make(chan []byte),
 		quit:          make(chan struct{}),
@@ -64,10 +70,14 @@ type Server struct {
 	handlerMu         sync.Mutex
 	listeners         []net.Listener
 	authsha           [sha256.Size]byte
+	fullAuth          bool // Whether authsha authenticates clients
+	users             map[string]*rpcAuthUser
 	upgrader          websocket.Upgrader
 
 	maxPostClients      int64 // Max concurrent HTTP POST clients.
 	maxWebsocketClients int64 // Max concurrent websocket clients.
+	rateLimiter         *ratelimit.Limiter
+	auditLog            *auditlog.Log
 
 	wg      sync.WaitGroup
 	quit    chan struct{}
@@ -80,6 +90,13 @@ type Server struct {
 	handlers map[string]handler
 }
 
+// rpcAuthUser is a user authenticated by a salted password hash.
+type rpcAuthUser struct {
+	salt string
+	hash []byte
+	user *authUser
+}
+
 type handler struct {
 	fn     func(*Server, interface{}) (interface{}, error)
 	noHelp bool
@@ -107,11 +124,15 @@ func NewServer(opts *Options, activeNet *chaincfg.Params, walletLoader *loader.L
 		walletLoader:        walletLoader,
 		maxPostClients:      opts.MaxPOSTClients,
 		maxWebsocketClients: opts.MaxWebsocketClients,
+		rateLimiter:         opts.RateLimiter,
+		auditLog:            opts.AuditLog,
 		listeners:           listeners,
 		ticketbuyerConfig:   ticketBuyerConfig,
 		// A hash of the HTTP basic auth string is used for a constant
 		// time comparison.
-		authsha: sha256.Sum256(httpBasicAuth(opts.Username, opts.Password)),
+		authsha:  sha256.Sum256(httpBasicAuth(opts.Username, opts.Password)),
+		fullAuth: opts.Username != "" && opts.Password != "",
+		users:    make(map[string]*rpcAuthUser, len(opts.Users)),
 		upgrader: websocket.Upgrader{
 			// Allow all origins.
 			CheckOrigin: func(r *http.Request) bool { return true },
@@ -120,6 +141,14 @@ func NewServer(opts *Options, activeNet *chaincfg.Params, walletLoader *loader.L
 		requestShutdownChan: make(chan struct{}, 1),
 		activeNet:           activeNet,
 	}
+	for i := range opts.Users {
+		u := &opts.Users[i]
+		server.users[u.Name] = &rpcAuthUser{
+			salt: u.Salt,
+			hash: u.Hash,
+			user: newAuthUser(u),
+		}
+	}
 
 	serveMux.Handle("/", throttledFn(opts.MaxPOSTClients,
 		func(w http.ResponseWriter, r *http.Request) {
@@ -127,24 +156,24 @@ func NewServer(opts *Options, activeNet *chaincfg.Params, walletLoader *loader.L
 			w.Header().Set("Content-Type", "application/json")
 			r.Close = true
 
-			if err := server.checkAuthHeader(r); err != nil {
+			user, err := server.checkAuthHeader(r)
+			if err != nil {
 				log.Warnf("Failed authentication attempt from client %s",
 					r.RemoteAddr)
 				jsonAuthFail(w)
 				return
 			}
 			server.wg.Add(1)
-			server.postClientRPC(w, r)
+			server.postClientRPC(w, r, user)
 			server.wg.Done()
 		}))
 
 	serveMux.Handle("/ws", throttledFn(opts.MaxWebsocketClients,
 		func(w http.ResponseWriter, r *http.Request) {
 			ctx := withRemoteAddr(r.Context(), r.RemoteAddr)
-			authenticated := false
-			switch server.checkAuthHeader(r) {
+			user, err := server.checkAuthHeader(r)
+			switch err {
 			case nil:
-				authenticated = true
 			case errNoAuth:
 				// nothing
 			default:
@@ -162,7 +191,7 @@ func NewServer(opts *Options, activeNet *chaincfg.Params, walletLoader *loader.L
 					r.RemoteAddr, err)
 				return
 			}
-			wsc := newWebsocketClient(conn, authenticated)
+			wsc := newWebsocketClient(conn, user)
 			server.websocketClientRPC(ctx, wsc)
 		}))
 
@@ -240,9 +269,22 @@ func (s *Server) Stop() {
 // NOTE: These handlers do not handle special cases, such as the authenticate
 // method.  Each of these must be checked beforehand (the method is already
 // known) and handled accordingly.
-func (s *Server) handlerClosure(ctx context.Context, request *fnojson.Request) lazyHandler {
+func (s *Server) handlerClosure(ctx context.Context, user *authUser, request *fnojson.Request) lazyHandler {
 	log.Infof("RPC method %v invoked by %v", request.Method, remoteAddr(ctx))
-	return lazyApplyHandler(s, request)
+	if jsonErr := user.authorize(request); jsonErr != nil {
+		log.Warnf("RPC method %v denied to %v: %v", request.Method,
+			remoteAddr(ctx), jsonErr.Message)
+		s.audit(ctx, user, request.Method, jsonErr)
+		return func() (interface{}, *fnojson.RPCError) {
+			return nil, jsonErr
+		}
+	}
+	if jsonErr := s.checkRateLimit(ctx, user, request.Method); jsonErr != nil {
+		return func() (interface{}, *fnojson.RPCError) {
+			return nil, jsonErr
+		}
+	}
+	return s.audited(ctx, user, request.Method, lazyApplyHandler(s, request))
 }
 
 // errNoAuth represents an error where authentication could not succeed
@@ -250,21 +292,41 @@ func (s *Server) handlerClosure(ctx context.Context, request *fnojson.Request) l
 var errNoAuth = errors.E("missing Authorization header")
 
 // checkAuthHeader checks the HTTP Basic authentication supplied by a client
-// in the HTTP request r.
+// in the HTTP request r and returns the authenticated user.
 //
 // The authentication comparison is time constant.
-func (s *Server) checkAuthHeader(r *http.Request) error {
+func (s *Server) checkAuthHeader(r *http.Request) (*authUser, error) {
 	authhdr := r.Header["Authorization"]
 	if len(authhdr) == 0 {
-		return errNoAuth
+		return nil, errNoAuth
 	}
 
 	authsha := sha256.Sum256([]byte(authhdr[0]))
 	cmp := subtle.ConstantTimeCompare(authsha[:], s.authsha[:])
-	if cmp != 1 {
-		return errors.New("invalid Authorization header")
+	if cmp == 1 && s.fullAuth {
+		return fullUser, nil
+	}
+
+	username, password, ok := r.BasicAuth()
+	if ok {
+		if user := s.checkRPCAuth(username, password); user != nil {
+			return user, nil
+		}
+	}
+	return nil, errors.New("invalid Authorization header")
+}
+
+// checkRPCAuth returns the user authenticated by a salted password hash, or
+// nil if the username or password are incorrect.
+func (s *Server) checkRPCAuth(username, password string) *authUser {
+	u, ok := s.users[username]
+	if !ok {
+		return nil
+	}
+	if !hmac.Equal(RPCAuthHash(u.salt, password), u.hash) {
+		return nil
 	}
-	return nil
+	return u.user
 }
 
 // throttledFn wraps an http.HandlerFunc with throttling of concurrent active
@@ -303,23 +365,27 @@ func idPointer(id interface{}) (p *interface{}) {
 	return
 }
 
-// invalidAuth checks whether a websocket request is a valid (parsable)
+// websocketAuth checks whether a websocket request is a valid (parsable)
 // authenticate request and checks the supplied username and passphrase
-// against the server auth.
-func (s *Server) invalidAuth(req *fnojson.Request) bool {
+// against the server auth.  The authenticated user is returned, or nil if the
+// request or credentials are invalid.
+func (s *Server) websocketAuth(req *fnojson.Request) *authUser {
 	cmd, err := fnojson.UnmarshalCmd(req)
 	if err != nil {
-		return false
+		return nil
 	}
 	authCmd, ok := cmd.(*fnojson.AuthenticateCmd)
 	if !ok {
-		return false
+		return nil
 	}
 	// Check credentials.
 	login := authCmd.Username + ":" + authCmd.Passphrase
 	auth := "Basic " + base64.StdEncoding.EncodeToString([]byte(login))
 	authSha := sha256.Sum256([]byte(auth))
-	return subtle.ConstantTimeCompare(authSha[:], s.authsha[:]) != 1
+	if subtle.ConstantTimeCompare(authSha[:], s.authsha[:]) == 1 && s.fullAuth {
+		return fullUser
+	}
+	return s.checkRPCAuth(authCmd.Username, authCmd.Passphrase)
 }
 
 func (s *Server) websocketClientRead(ctx context.Context, wsc *websocketClient) {
@@ -380,17 +446,19 @@ out:
 			if req.Method == "authenticate" {
 				log.Infof("RPC method authenticate invoked by %s",
 					remoteAddr(ctx))
-				switch {
-				case wsc.authenticated:
+				if wsc.authenticated {
 					log.Warnf("Multiple authentication attempts from %s",
 						remoteAddr(ctx))
 					break out
-				case s.invalidAuth(&req):
+				}
+				user := s.websocketAuth(&req)
+				if user == nil {
 					log.Warnf("Failed authentication attempt from %s",
 						remoteAddr(ctx))
 					break out
 				}
 				wsc.authenticated = true
+				wsc.user = user
 				resp := makeResponse(req.ID, nil, nil)
 				// Expected to never fail.
 				mresp, err := json.Marshal(resp)
@@ -412,6 +480,19 @@ out:
 			switch req.Method {
 			case "stop":
 				log.Infof("RPC method stop invoked by %s", remoteAddr(ctx))
+				if jsonErr := wsc.user.authorize(&req); jsonErr != nil {
+					resp := makeResponse(req.ID, nil, jsonErr)
+					mresp, err := json.Marshal(resp)
+					// Expected to never fail.
+					if err != nil {
+						panic(err)
+					}
+					err = wsc.send(mresp)
+					if err != nil {
+						break out
+					}
+					continue
+				}
 				resp := makeResponse(req.ID,
 					"fnowallet stopping.", nil)
 				mresp, err := json.Marshal(resp)
@@ -426,9 +507,29 @@ out:
 				s.requestProcessShutdown()
 				break out
 
+			case "subscribe", "unsubscribe":
+				var result interface{}
+				jsonErr := wsc.user.authorize(&req)
+				if jsonErr == nil {
+					jsonErr = s.checkRateLimit(ctx, wsc.user, req.Method)
+				}
+				if jsonErr == nil {
+					result, jsonErr = s.websocketSubscribe(ctx, wsc, &req)
+				}
+				mresp, err := fnojson.MarshalResponse(req.Jsonrpc, req.ID, result, jsonErr)
+				if err != nil {
+					log.Errorf("Unable to marshal response to client %s: %v",
+						remoteAddr(ctx), err)
+					continue
+				}
+				err = wsc.send(mresp)
+				if err != nil {
+					break out
+				}
+
 			default:
 				req := req // Copy for the closure
-				f := s.handlerClosure(ctx, &req)
+				f := s.handlerClosure(ctx, wsc.user, &req)
 				wsc.wg.Add(1)
 				go func() {
 					resp, jsonErr := f()
@@ -448,7 +549,9 @@ out:
 		}
 	}
 
-	// allow client to disconnect after all handler goroutines are done
+	// allow client to disconnect after all handler and notification
+	// goroutines are done
+	wsc.subs.stop()
 	wsc.wg.Wait()
 	close(wsc.responses)
 	s.wg.Done()
@@ -515,7 +618,7 @@ func (s *Server) websocketClientRPC(ctx context.Context, wsc *websocketClient) {
 const maxRequestSize = 1024 * 1024 * 4
 
 // postClientRPC processes and replies to a JSON-RPC client request.
-func (s *Server) postClientRPC(w http.ResponseWriter, r *http.Request) {
+func (s *Server) postClientRPC(w http.ResponseWriter, r *http.Request, user *authUser) {
 	ctx := withRemoteAddr(r.Context(), r.RemoteAddr)
 
 	body := http.MaxBytesReader(w, r.Body, maxRequestSize)
@@ -551,8 +654,9 @@ func (s *Server) postClientRPC(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Create the response and error from the request.  Two special cases
-	// are handled for the authenticate and stop request methods.
+	// Create the response and error from the request.  Special cases are
+	// handled for the authenticate, stop, and notification subscription
+	// request methods.
 	var res interface{}
 	var jsonErr *fnojson.RPCError
 	var stop bool
@@ -562,12 +666,18 @@ func (s *Server) postClientRPC(w http.ResponseWriter, r *http.Request) {
 			r.RemoteAddr)
 		// Drop it.
 		return
+	case "subscribe", "unsubscribe":
+		jsonErr = rpcErrorf(fnojson.ErrRPCInvalidRequest.Code,
+			"notifications require a websocket connection")
 	case "stop":
 		log.Infof("RPC method stop invoked by %s", r.RemoteAddr)
-		stop = true
-		res = "fnowallet stopping"
+		jsonErr = user.authorize(&req)
+		if jsonErr == nil {
+			stop = true
+			res = "fnowallet stopping"
+		}
 	default:
-		res, jsonErr = s.handlerClosure(ctx, &req)()
+		res, jsonErr = s.handlerClosure(ctx, user, &req)()
 	}
 
 	// Marshal and send.
@@ -0,0 +1,123 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package rpcserver
+
+import (
+	"strings"
+
+	"github.com/fonero-project/fnowallet/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Permission describes the methods an authenticated client may call.  Each
+// permission includes every method allowed by the permissions before it.
+type Permission int
+
+// Permissions.
+const (
+	// ReadOnly allows methods which query the wallet and chain without
+	// modifying the wallet or revealing secrets.
+	ReadOnly Permission = iota
+
+	// Invoice allows read-only methods and the creation of addresses for
+	// receiving payments.
+	Invoice
+
+	// Full allows all methods.
+	Full
+)
+
+var permissionNames = [...]string{
+	ReadOnly: "readonly",
+	Invoice:  "invoice",
+	Full:     "full",
+}
+
+func (p Permission) String() string {
+	if p < 0 || int(p) >= len(permissionNames) {
+		return "unknown"
+	}
+	return permissionNames[p]
+}
+
+// ParsePermission returns the permission named by s.
+func ParsePermission(s string) (Permission, error) {
+	for p, name := range permissionNames {
+		if strings.EqualFold(s, name) {
+			return Permission(p), nil
+		}
+	}
+	return 0, errors.E(errors.Invalid, errors.Errorf("unknown permission %q", s))
+}
+
+// methodPermissions records the permission required by full gRPC method names
+// (`/package.service/method`) that do not require the Full permission.
+var methodPermissions = map[string]Permission{
+	"/walletrpc.VersionService/Version": ReadOnly,
+
+	"/walletrpc.WalletService/Ping":                      ReadOnly,
+	"/walletrpc.WalletService/Network":                   ReadOnly,
+	"/walletrpc.WalletService/AccountNumber":             ReadOnly,
+	"/walletrpc.WalletService/Accounts":                  ReadOnly,
+	"/walletrpc.WalletService/Balance":                   ReadOnly,
+	"/walletrpc.WalletService/GetAccountExtendedPubKey":  ReadOnly,
+	"/walletrpc.WalletService/GetTransaction":            ReadOnly,
+	"/walletrpc.WalletService/GetTransactions":           ReadOnly,
+	"/walletrpc.WalletService/GetTicket":                 ReadOnly,
+	"/walletrpc.WalletService/GetTickets":                ReadOnly,
+	"/walletrpc.WalletService/VotingAccountTickets":      ReadOnly,
+	"/walletrpc.WalletService/TicketPrice":               ReadOnly,
+	"/walletrpc.WalletService/TicketPriceForecast":       ReadOnly,
+	"/walletrpc.WalletService/StakeInfo":                 ReadOnly,
+	"/walletrpc.WalletService/BlockInfo":                 ReadOnly,
+	"/walletrpc.WalletService/BestBlock":                 ReadOnly,
+	"/walletrpc.WalletService/TransactionNotifications":  ReadOnly,
+	"/walletrpc.WalletService/AccountNotifications":      ReadOnly,
+	"/walletrpc.WalletService/ConfirmationNotifications": ReadOnly,
+	"/walletrpc.WalletService/RevocationNotifications":   ReadOnly,
+	"/walletrpc.WalletService/UnspentOutputs":            ReadOnly,
+	"/walletrpc.WalletService/ValidateAddress":           ReadOnly,
+	"/walletrpc.WalletService/CommittedTickets":          ReadOnly,
+	"/walletrpc.WalletService/StakePoolFeeReport":        ReadOnly,
+	"/walletrpc.WalletService/PendingTransactions":       ReadOnly,
+	"/walletrpc.WalletService/NextAddress":               Invoice,
+
+	"/walletrpc.WalletLoaderService/WalletExists": ReadOnly,
+	"/walletrpc.WalletLoaderService/ListWallets":  ReadOnly,
+	"/walletrpc.WalletLoaderService/DatabaseSize": ReadOnly,
+
+	"/walletrpc.TicketBuyerService/TicketBuyerConfig": ReadOnly,
+
+	"/walletrpc.AgendaService/Agendas": ReadOnly,
+
+	"/walletrpc.VotingService/VoteChoices": ReadOnly,
+
+	"/walletrpc.MessageVerificationService/VerifyMessage": ReadOnly,
+
+	"/walletrpc.DecodeMessageService/DecodeRawTransaction": ReadOnly,
+}
+
+// MethodPermission returns the permission required to call a full gRPC method
+// name.  Methods which are not known to be safe for restricted clients require
+// the Full permission.
+func MethodPermission(method string) Permission {
+	p, ok := methodPermissions[method]
+	if !ok {
+		return Full
+	}
+	return p
+}
+
+// CheckPermission returns nil when a client with permission p may call the
+// method and a gRPC error when it may not.
+func CheckPermission(method string, p Permission) error {
+	required := MethodPermission(method)
+	if p < required {
+		return status.Errorf(codes.PermissionDenied,
+			"method %s requires %v permission", method, required)
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package rpcserver
+
+import (
+	"testing"
+
+	"github.com/fonero-project/fnowallet/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestParsePermission(t *testing.T) {
+	for _, p := range []Permission{ReadOnly, Invoice, Full} {
+		parsed, err := ParsePermission(p.String())
+		if err != nil {
+			t.Errorf("%v: %v", p, err)
+		}
+		if parsed != p {
+			t.Errorf("parsed %v as %v", p, parsed)
+		}
+	}
+	if p, err := ParsePermission("ReadOnly"); err != nil || p != ReadOnly {
+		t.Errorf("permission names are not case insensitive")
+	}
+	if _, err := ParsePermission("admin"); !errors.Is(errors.Invalid, err) {
+		t.Errorf("unknown permission: expected Invalid error, got %v", err)
+	}
+}
+
+func TestCheckPermission(t *testing.T) {
+	tests := []struct {
+		method  string
+		allowed Permission // least permission allowed to call the method
+	}{
+		{"/walletrpc.VersionService/Version", ReadOnly},
+		{"/walletrpc.WalletService/Balance", ReadOnly},
+		{"/walletrpc.WalletService/NextAddress", Invoice},
+		{"/walletrpc.WalletService/SignTransaction", Full},
+		{"/walletrpc.WalletService/ChangePassphrase", Full},
+		{"/walletrpc.WalletLoaderService/OpenWallet", Full},
+		{"/walletrpc.UnknownService/Unknown", Full},
+	}
+	for _, test := range tests {
+		for _, p := range []Permission{ReadOnly, Invoice, Full} {
+			err := CheckPermission(test.method, p)
+			switch {
+			case p >= test.allowed && err != nil:
+				t.Errorf("%s with %v permission: %v", test.method, p, err)
+			case p < test.allowed && status.Code(err) != codes.PermissionDenied:
+				t.Errorf("%s with %v permission: expected PermissionDenied, got %v",
+					test.method, p, err)
+			}
+		}
+	}
+}
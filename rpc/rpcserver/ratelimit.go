@@ -0,0 +1,53 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package rpcserver
+
+import (
+	"github.com/fonero-project/fnowallet/internal/ratelimit"
+)
+
+// methodClasses records the rate limit class of full gRPC method names which
+// are not classified by their permission.
+var methodClasses = map[string]string{
+	"/walletrpc.WalletService/Rescan":                     ratelimit.Rescan,
+	"/walletrpc.WalletService/StakePoolFeeReport":         ratelimit.Rescan,
+	"/walletrpc.WalletLoaderService/DiscoverAddresses":    ratelimit.Rescan,
+	"/walletrpc.WalletLoaderService/FetchHeaders":         ratelimit.Rescan,
+	"/walletrpc.WalletLoaderService/FetchMissingCFilters": ratelimit.Rescan,
+
+	"/walletrpc.WalletService/GetTransactions":                     ratelimit.Stream,
+	"/walletrpc.WalletService/GetTickets":                          ratelimit.Stream,
+	"/walletrpc.WalletService/TransactionNotifications":            ratelimit.Stream,
+	"/walletrpc.WalletService/AccountNotifications":                ratelimit.Stream,
+	"/walletrpc.WalletService/ConfirmationNotifications":           ratelimit.Stream,
+	"/walletrpc.WalletService/RevocationNotifications":             ratelimit.Stream,
+	"/walletrpc.WalletLoaderService/SubscribeToBlockNotifications": ratelimit.Stream,
+	"/walletrpc.WalletLoaderService/SpvSync":                       ratelimit.Stream,
+	"/walletrpc.WalletLoaderService/RpcSync":                       ratelimit.Stream,
+	"/walletrpc.TicketBuyerV2Service/RunTicketBuyer":               ratelimit.Stream,
+
+	"/walletrpc.WalletService/SignTransaction":  ratelimit.Sign,
+	"/walletrpc.WalletService/SignTransactions": ratelimit.Sign,
+	"/walletrpc.WalletService/CreateSignature":  ratelimit.Sign,
+	"/walletrpc.WalletService/SignMessage":      ratelimit.Sign,
+	"/walletrpc.WalletService/SignMessages":     ratelimit.Sign,
+	"/walletrpc.WalletService/PurchaseTickets":  ratelimit.Sign,
+	"/walletrpc.WalletService/RevokeTickets":    ratelimit.Sign,
+
+	"/walletrpc.WalletService/ApprovePendingTransaction": ratelimit.Sign,
+}
+
+// MethodClass returns the rate limit class of a full gRPC method name.
+// Methods which are not otherwise classified are in the read class when they
+// only require the ReadOnly permission and the write class when they do not.
+func MethodClass(method string) string {
+	if c, ok := methodClasses[method]; ok {
+		return c
+	}
+	if MethodPermission(method) == ReadOnly {
+		return ratelimit.Read
+	}
+	return ratelimit.Write
+}
@@ -41,6 +41,7 @@ import (
 	"github.com/fonero-project/fnod/rpcclient"
 	"github.com/fonero-project/fnod/txscript"
 	"github.com/fonero-project/fnod/wire"
+	"github.com/fonero-project/fnowallet/bip39"
 	"github.com/fonero-project/fnowallet/chain"
 	"github.com/fonero-project/fnowallet/errors"
 	"github.com/fonero-project/fnowallet/internal/cfgutil"
@@ -62,9 +63,9 @@ import (
 
 // Public API version constants
 const (
-	semverString = "5.6.0"
+	semverString = "5.25.0"
 	semverMajor  = 5
-	semverMinor  = 6
+	semverMinor  = 25
 	semverPatch  = 0
 )
 
@@ -112,6 +113,10 @@ func errorCode(err error) codes.Code {
 		case errors.Protocol:
 		case errors.NoPeers:
 			return codes.Unavailable
+		case errors.Deployment:
+			return codes.FailedPrecondition
+		case errors.Unsupported:
+			return codes.Unimplemented
 		default:
 			inner = err.Err
 			for {
@@ -228,32 +233,37 @@ var (
 	votingService              votingServer
 	messageVerificationService messageVerificationServer
 	decodeMessageService       decodeMessageServer
+
+	// The wallet and voting services are registered through dispatchers
+	// which serve requests for named wallets as well as the default wallet.
+	walletDispatcherService walletDispatcher
+	votingDispatcherService votingDispatcher
 )
 
 // RegisterServices registers implementations of each gRPC service and registers
 // it with the server.  Not all service are ready to be used after registration.
 func RegisterServices(server *grpc.Server) {
 	pb.RegisterVersionServiceServer(server, &versionService)
-	pb.RegisterWalletServiceServer(server, &walletService)
+	pb.RegisterWalletServiceServer(server, &walletDispatcherService)
 	pb.RegisterWalletLoaderServiceServer(server, &loaderService)
 	pb.RegisterSeedServiceServer(server, &seedService)
 	pb.RegisterTicketBuyerServiceServer(server, &ticketBuyerService)
 	pb.RegisterTicketBuyerV2ServiceServer(server, &ticketBuyerV2Service)
 	pb.RegisterAgendaServiceServer(server, &agendaService)
-	pb.RegisterVotingServiceServer(server, &votingService)
+	pb.RegisterVotingServiceServer(server, &votingDispatcherService)
 	pb.RegisterMessageVerificationServiceServer(server, &messageVerificationService)
 	pb.RegisterDecodeMessageServiceServer(server, &decodeMessageService)
 }
 
 var serviceMap = map[string]interface{}{
 	"walletrpc.VersionService":             &versionService,
-	"walletrpc.WalletService":              &walletService,
+	"walletrpc.WalletService":              &walletDispatcherService,
 	"walletrpc.WalletLoaderService":        &loaderService,
 	"walletrpc.SeedService":                &seedService,
 	"walletrpc.TicketBuyerService":         &ticketBuyerService,
 	"walletrpc.TicketBuyerV2Service":       &ticketBuyerV2Service,
 	"walletrpc.AgendaService":              &agendaService,
-	"walletrpc.VotingService":              &votingService,
+	"walletrpc.VotingService":              &votingDispatcherService,
 	"walletrpc.MessageVerificationService": &messageVerificationService,
 	"walletrpc.DecodeMessageService":       &decodeMessageService,
 }
@@ -432,11 +442,24 @@ func (s *walletServer) Rescan(req *pb.RescanRequest, svr pb.WalletService_Rescan
 func (s *walletServer) NextAccount(ctx context.Context, req *pb.NextAccountRequest) (
 	*pb.NextAccountResponse, error) {
 
-	defer zero.Bytes(req.Passphrase)
+	defer func() {
+		zero.Bytes(req.Passphrase)
+		zero.Bytes(req.AccountPassphrase)
+	}()
 
 	if req.AccountName == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "account name may not be empty")
 	}
+	if req.VotingAccount && len(req.AccountPassphrase) != 0 {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"voting accounts may not be protected by an account passphrase")
+	}
+	if len(req.AccountPassphrase) != 0 && !req.AcknowledgeNotSeedRecoverable {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"accounts with an account passphrase are not recoverable from the "+
+				"wallet seed and must be backed up with ExportAccountKey; "+
+				"set acknowledge_not_seed_recoverable to create one")
+	}
 
 	lock := make(chan time.Time, 1)
 	defer func() {
@@ -447,7 +470,12 @@ func (s *walletServer) NextAccount(ctx context.Context, req *pb.NextAccountReque
 		return nil, translateError(err)
 	}
 
-	account, err := s.wallet.NextAccount(req.AccountName)
+	var account uint32
+	if req.VotingAccount {
+		account, err = s.wallet.NextVotingAccount(req.AccountName)
+	} else {
+		account, err = s.wallet.NextAccountWithPassphrase(req.AccountName, req.AccountPassphrase)
+	}
 	if err != nil {
 		return nil, translateError(err)
 	}
@@ -634,6 +662,171 @@ func (s *walletServer) ImportScript(ctx context.Context,
 	return &pb.ImportScriptResponse{P2ShAddress: p2sh.String(), Redeemable: redeemable}, nil
 }
 
+func (s *walletServer) ExportVotingAccount(ctx context.Context,
+	req *pb.ExportVotingAccountRequest) (*pb.ExportVotingAccountResponse, error) {
+
+	defer zero.Bytes(req.Passphrase)
+
+	lock := make(chan time.Time, 1)
+	defer func() {
+		lock <- time.Time{} // send matters, not the value
+	}()
+	err := s.wallet.Unlock(req.Passphrase, lock)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	xpriv, err := s.wallet.ExportVotingAccount(req.Account)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	defer xpriv.Zero()
+
+	return &pb.ExportVotingAccountResponse{AccountXpriv: xpriv.String()}, nil
+}
+
+func (s *walletServer) ImportVotingAccount(ctx context.Context,
+	req *pb.ImportVotingAccountRequest) (*pb.ImportVotingAccountResponse, error) {
+
+	defer zero.Bytes(req.Passphrase)
+
+	if req.Name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "account name may not be empty")
+	}
+	xpriv, err := hdkeychain.NewKeyFromString(req.AccountXpriv)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "account_xpriv: %v", err)
+	}
+	defer xpriv.Zero()
+
+	lock := make(chan time.Time, 1)
+	defer func() {
+		lock <- time.Time{} // send matters, not the value
+	}()
+	err = s.wallet.Unlock(req.Passphrase, lock)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	account, err := s.wallet.ImportVotingAccount(req.Name, xpriv)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return &pb.ImportVotingAccountResponse{Account: account}, nil
+}
+
+func (s *walletServer) ExportAccountKey(ctx context.Context,
+	req *pb.ExportAccountKeyRequest) (*pb.ExportAccountKeyResponse, error) {
+
+	defer func() {
+		zero.Bytes(req.Passphrase)
+		zero.Bytes(req.AccountPassphrase)
+	}()
+
+	lock := make(chan time.Time, 1)
+	defer func() {
+		lock <- time.Time{} // send matters, not the value
+	}()
+	err := s.wallet.Unlock(req.Passphrase, lock)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	xpriv, err := s.wallet.ExportAccountKey(req.Account, req.AccountPassphrase)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	defer xpriv.Zero()
+
+	return &pb.ExportAccountKeyResponse{AccountXpriv: xpriv.String()}, nil
+}
+
+func (s *walletServer) ImportAccountKey(ctx context.Context,
+	req *pb.ImportAccountKeyRequest) (*pb.ImportAccountKeyResponse, error) {
+
+	defer func() {
+		zero.Bytes(req.Passphrase)
+		zero.Bytes(req.AccountPassphrase)
+	}()
+
+	if req.Name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "account name may not be empty")
+	}
+	xpriv, err := hdkeychain.NewKeyFromString(req.AccountXpriv)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "account_xpriv: %v", err)
+	}
+	defer xpriv.Zero()
+
+	lock := make(chan time.Time, 1)
+	defer func() {
+		lock <- time.Time{} // send matters, not the value
+	}()
+	err = s.wallet.Unlock(req.Passphrase, lock)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	account, err := s.wallet.ImportAccountKey(req.Name, xpriv, req.AccountPassphrase)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return &pb.ImportAccountKeyResponse{Account: account}, nil
+}
+
+func (s *walletServer) VotingAccountTickets(ctx context.Context,
+	req *pb.VotingAccountTicketsRequest) (*pb.VotingAccountTicketsResponse, error) {
+
+	tickets, err := s.wallet.VotingAccountTickets(req.Account)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	resp := &pb.VotingAccountTicketsResponse{
+		Tickets: make([]*pb.VotingTicket, 0, len(tickets)),
+	}
+	for _, t := range tickets {
+		b, err := t.Ticket.Bytes()
+		if err != nil {
+			return nil, translateError(err)
+		}
+		resp.Tickets = append(resp.Tickets, &pb.VotingTicket{
+			Ticket: b,
+			Branch: t.Branch,
+			Child:  t.Child,
+		})
+	}
+	return resp, nil
+}
+
+func (s *walletServer) AddVotingTickets(ctx context.Context,
+	req *pb.AddVotingTicketsRequest) (*pb.AddVotingTicketsResponse, error) {
+
+	tickets := make([]wallet.VotingTicket, 0, len(req.Tickets))
+	for _, t := range req.Tickets {
+		ticket := new(wire.MsgTx)
+		err := ticket.Deserialize(bytes.NewReader(t.Ticket))
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"Bytes do not represent a valid raw transaction: %v", err)
+		}
+		tickets = append(tickets, wallet.VotingTicket{
+			Ticket: ticket,
+			Branch: t.Branch,
+			Child:  t.Child,
+		})
+	}
+
+	err := s.wallet.AddVotingTickets(req.Account, tickets)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return &pb.AddVotingTicketsResponse{}, nil
+}
+
 func (s *walletServer) Balance(ctx context.Context, req *pb.BalanceRequest) (
 	*pb.BalanceResponse, error) {
 
@@ -693,6 +886,28 @@ func (s *walletServer) TicketPrice(ctx context.Context, req *pb.TicketPriceReque
 	}, nil
 }
 
+func marshalStakeDifficultyForecast(f *wallet.StakeDifficultyForecast) *pb.TicketPriceForecastResponse_Window {
+	return &pb.TicketPriceForecastResponse_Window{
+		Height:              f.Height,
+		MinTicketPrice:      int64(f.Min),
+		ExpectedTicketPrice: int64(f.Expected),
+		MaxTicketPrice:      int64(f.Max),
+	}
+}
+
+func (s *walletServer) TicketPriceForecast(ctx context.Context, req *pb.TicketPriceForecastRequest) (*pb.TicketPriceForecastResponse, error) {
+	f, err := s.wallet.TicketPriceForecast()
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &pb.TicketPriceForecastResponse{
+		Height:          f.Height,
+		TicketPrice:     int64(f.CurrentPrice),
+		NextWindow:      marshalStakeDifficultyForecast(&f.NextWindow),
+		FollowingWindow: marshalStakeDifficultyForecast(&f.FollowingWindow),
+	}, nil
+}
+
 func (s *walletServer) StakeInfo(ctx context.Context, req *pb.StakeInfoRequest) (*pb.StakeInfoResponse, error) {
 	var chainClient *rpcclient.Client
 	if n, err := s.wallet.NetworkBackend(); err == nil {
@@ -813,6 +1028,222 @@ func (s *walletServer) SweepAccount(ctx context.Context, req *pb.SweepAccountReq
 	return res, nil
 }
 
+func marshalStakePoolFees(fees *wallet.StakePoolFees) *pb.StakePoolFees {
+	return &pb.StakePoolFees{
+		Tickets:         uint32(fees.Tickets),
+		Voted:           uint32(fees.Voted),
+		Revoked:         uint32(fees.Revoked),
+		Live:            uint32(fees.Live),
+		Expired:         uint32(fees.Expired),
+		FeesEarned:      int64(fees.Earned),
+		FeesOutstanding: int64(fees.Outstanding),
+	}
+}
+
+func (s *walletServer) StakePoolFeeReport(req *pb.StakePoolFeeReportRequest,
+	svr pb.WalletService_StakePoolFeeReportServer) error {
+
+	if req.BeginHeight < 0 || req.EndHeight < 0 {
+		return status.Errorf(codes.InvalidArgument, "block heights must be non-negative")
+	}
+	users := make([]fnoutil.Address, 0, len(req.Users))
+	for _, a := range req.Users {
+		addr, err := decodeAddress(a, s.wallet.ChainParams())
+		if err != nil {
+			return err
+		}
+		users = append(users, addr)
+	}
+
+	totals, err := s.wallet.StakePoolFeeReport(svr.Context(), req.BeginHeight,
+		req.EndHeight, users, func(u *wallet.StakePoolUserFees) error {
+			return svr.Send(&pb.StakePoolFeeReportResponse{
+				UserFees: &pb.StakePoolFeeReportResponse_UserFees{
+					User: u.User.EncodeAddress(),
+					Fees: marshalStakePoolFees(&u.StakePoolFees),
+				},
+			})
+		})
+	if err != nil {
+		return translateError(err)
+	}
+
+	return svr.Send(&pb.StakePoolFeeReportResponse{
+		Totals: &pb.StakePoolFeeReportResponse_Totals{
+			Users:       uint32(totals.Users),
+			BeginHeight: totals.BeginHeight,
+			EndHeight:   totals.EndHeight,
+			Fees:        marshalStakePoolFees(&totals.StakePoolFees),
+		},
+	})
+}
+
+// maxAuditLogEntries is the maximum number of entries returned by AuditLog.
+const maxAuditLogEntries = 1000
+
+func (s *walletServer) AuditLog(ctx context.Context, req *pb.AuditLogRequest) (
+	*pb.AuditLogResponse, error) {
+
+	auditLog := s.wallet.AuditLog()
+	if auditLog == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "audit log is disabled")
+	}
+	count := int(req.Count)
+	if count == 0 || count > maxAuditLogEntries {
+		count = maxAuditLogEntries
+	}
+	entries, err := auditLog.Entries(req.StartSeq, count)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	resp := &pb.AuditLogResponse{
+		Entries: make([]*pb.AuditLogResponse_Entry, 0, len(entries)),
+	}
+	resp.HeadSeq, resp.HeadHash = auditLog.Head()
+	for i := range entries {
+		e := &entries[i]
+		resp.Entries = append(resp.Entries, &pb.AuditLogResponse_Entry{
+			Seq:           e.Seq,
+			Time:          e.Time,
+			Source:        e.Source,
+			Operation:     e.Operation,
+			Client:        e.Client,
+			RemoteAddress: e.Remote,
+			Details:       e.Details,
+			Error:         e.Error,
+			PrevHash:      e.PrevHash,
+			Hash:          e.Hash,
+		})
+	}
+	return resp, nil
+}
+
+func (s *walletServer) PendingTransactions(ctx context.Context, req *pb.PendingTransactionsRequest) (
+	*pb.PendingTransactionsResponse, error) {
+
+	pending, err := s.wallet.PendingTransactions()
+	if err != nil {
+		return nil, translateError(err)
+	}
+	resp := &pb.PendingTransactionsResponse{
+		Transactions: make([]*pb.PendingTransactionsResponse_PendingTransaction, 0, len(pending)),
+	}
+	for _, p := range pending {
+		var buf bytes.Buffer
+		buf.Grow(p.Tx.SerializeSize())
+		err := p.Tx.Serialize(&buf)
+		if err != nil {
+			return nil, translateError(err)
+		}
+		txHash := p.Tx.TxHash()
+		resp.Transactions = append(resp.Transactions, &pb.PendingTransactionsResponse_PendingTransaction{
+			TransactionHash: txHash[:],
+			Transaction:     buf.Bytes(),
+			Accounts:        p.Accounts,
+			Amount:          int64(p.Amount),
+			Created:         p.Created.Unix(),
+		})
+	}
+	return resp, nil
+}
+
+func (s *walletServer) ApprovePendingTransaction(ctx context.Context, req *pb.ApprovePendingTransactionRequest) (
+	*pb.ApprovePendingTransactionResponse, error) {
+
+	defer zero.Bytes(req.ApprovalPassphrase)
+
+	txHash, err := chainhash.NewHash(req.TransactionHash)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	n, err := s.requireNetworkBackend()
+	if err != nil {
+		return nil, err
+	}
+	err = s.wallet.ApprovePendingTransaction(txHash, req.ApprovalPassphrase, n)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &pb.ApprovePendingTransactionResponse{}, nil
+}
+
+func (s *walletServer) RejectPendingTransaction(ctx context.Context, req *pb.RejectPendingTransactionRequest) (
+	*pb.RejectPendingTransactionResponse, error) {
+
+	txHash, err := chainhash.NewHash(req.TransactionHash)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	err = s.wallet.RejectPendingTransaction(txHash)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &pb.RejectPendingTransactionResponse{}, nil
+}
+
+func (s *walletServer) UnlockWallet(ctx context.Context, req *pb.UnlockWalletRequest) (
+	*pb.UnlockWalletResponse, error) {
+
+	defer func() {
+		zero.Bytes(req.Passphrase)
+		for _, p := range req.AccountPassphrases {
+			zero.Bytes(p)
+		}
+	}()
+
+	scope := &udb.UnlockScope{
+		Accounts:   req.Accounts,
+		Signatures: req.Signatures,
+	}
+	for _, name := range req.Uses {
+		use, err := udb.ParseKeyUse(name)
+		if err != nil {
+			return nil, translateError(err)
+		}
+		scope.Uses |= use
+	}
+
+	var lock <-chan time.Time
+	if req.Timeout != 0 {
+		lock = time.After(time.Duration(req.Timeout) * time.Second)
+	}
+	err := s.wallet.UnlockScopedAccounts(req.Passphrase, scope, lock,
+		req.AccountPassphrases)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &pb.UnlockWalletResponse{}, nil
+}
+
+func (s *walletServer) LockWallet(ctx context.Context, req *pb.LockWalletRequest) (
+	*pb.LockWalletResponse, error) {
+
+	s.wallet.Lock()
+	return &pb.LockWalletResponse{}, nil
+}
+
+func (s *walletServer) LockAccount(ctx context.Context, req *pb.LockAccountRequest) (
+	*pb.LockAccountResponse, error) {
+
+	err := s.wallet.LockAccount(req.Account)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &pb.LockAccountResponse{}, nil
+}
+
+func (s *walletServer) VerifySeed(ctx context.Context, req *pb.VerifySeedRequest) (
+	*pb.VerifySeedResponse, error) {
+
+	defer zero.Bytes(req.Seed)
+
+	matches, err := s.wallet.VerifySeed(req.Seed)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &pb.VerifySeedResponse{Matches: matches}, nil
+}
+
 func (s *walletServer) BlockInfo(ctx context.Context, req *pb.BlockInfoRequest) (*pb.BlockInfoResponse, error) {
 	var blockID *wallet.BlockIdentifier
 	switch {
@@ -1309,6 +1740,55 @@ func (s *walletServer) GetTickets(req *pb.GetTicketsRequest,
 	return nil
 }
 
+// Bounds of the KDF parameters accepted by ChangePassphrase.  The minimums
+// match the defaults of wallets created before the KDF could be changed, and
+// the maximums limit each derivation to 4 GiB of memory.
+const (
+	minScryptN       = 1 << 18
+	maxScryptR       = 32
+	maxScryptP       = 16
+	minArgon2Memory  = 64 * 1024       // KiB
+	maxArgon2Memory  = 4 * 1024 * 1024 // KiB
+	maxArgon2Time    = 32
+	maxArgon2Threads = 255
+	maxKDFMemory     = 4 << 30
+)
+
+// checkKDFOptions returns an InvalidArgument error if the parameters of a KDF
+// are too weak, too expensive, or invalid.
+func checkKDFOptions(o *udb.ScryptOptions) error {
+	switch o.KDF {
+	case udb.KDFScrypt:
+		if o.N < minScryptN || o.N&(o.N-1) != 0 {
+			return status.Errorf(codes.InvalidArgument,
+				"scrypt N must be a power of two no less than %d", minScryptN)
+		}
+		if o.R < 1 || o.R > maxScryptR || o.P < 1 || o.P > maxScryptP {
+			return status.Errorf(codes.InvalidArgument,
+				"scrypt r must be between 1 and %d and p between 1 and %d",
+				maxScryptR, maxScryptP)
+		}
+		if uint64(128*o.R)*uint64(o.N) > maxKDFMemory {
+			return status.Errorf(codes.InvalidArgument,
+				"scrypt parameters require more than 4 GiB of memory")
+		}
+	case udb.KDFArgon2id:
+		if o.N < minArgon2Memory || o.N > maxArgon2Memory {
+			return status.Errorf(codes.InvalidArgument,
+				"Argon2id memory must be between %d and %d KiB",
+				minArgon2Memory, maxArgon2Memory)
+		}
+		if o.R < 1 || o.R > maxArgon2Time || o.P < 1 || o.P > maxArgon2Threads {
+			return status.Errorf(codes.InvalidArgument,
+				"Argon2id passes must be between 1 and %d and threads between 1 and %d",
+				maxArgon2Time, maxArgon2Threads)
+		}
+	default:
+		return status.Errorf(codes.InvalidArgument, "Unknown KDF (%d)", o.KDF)
+	}
+	return nil
+}
+
 func (s *walletServer) ChangePassphrase(ctx context.Context, req *pb.ChangePassphraseRequest) (
 	*pb.ChangePassphraseResponse, error) {
 
@@ -1322,10 +1802,39 @@ func (s *walletServer) ChangePassphrase(ctx context.Context, req *pb.ChangePassp
 		newPass = req.NewPassphrase
 	)
 
+	var config *udb.ScryptOptions
+	switch req.Kdf {
+	case pb.ChangePassphraseRequest_DEFAULT:
+	case pb.ChangePassphraseRequest_SCRYPT, pb.ChangePassphraseRequest_ARGON2ID:
+		if req.Key != pb.ChangePassphraseRequest_PRIVATE {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"KDF may only be set for the private passphrase")
+		}
+		kdf := udb.KDFScrypt
+		if req.Kdf == pb.ChangePassphraseRequest_ARGON2ID {
+			kdf = udb.KDFArgon2id
+		}
+		config, _ = udb.DefaultScryptOptions(kdf)
+		if req.KdfN != 0 {
+			config.N = int(req.KdfN)
+		}
+		if req.KdfR != 0 {
+			config.R = int(req.KdfR)
+		}
+		if req.KdfP != 0 {
+			config.P = int(req.KdfP)
+		}
+		if err := checkKDFOptions(config); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "Unknown KDF (%d)", req.Kdf)
+	}
+
 	var err error
 	switch req.Key {
 	case pb.ChangePassphraseRequest_PRIVATE:
-		err = s.wallet.ChangePrivatePassphrase(oldPass, newPass)
+		err = s.wallet.ChangePrivatePassphraseKDF(oldPass, newPass, config)
 	case pb.ChangePassphraseRequest_PUBLIC:
 		if len(oldPass) == 0 {
 			oldPass = []byte(wallet.InsecurePubPassphrase)
@@ -1334,6 +1843,8 @@ func (s *walletServer) ChangePassphrase(ctx context.Context, req *pb.ChangePassp
 			newPass = []byte(wallet.InsecurePubPassphrase)
 		}
 		err = s.wallet.ChangePublicPassphrase(oldPass, newPass)
+	case pb.ChangePassphraseRequest_ACCOUNT:
+		err = s.wallet.ChangeAccountPassphrase(req.Account, oldPass, newPass)
 	default:
 		return nil, status.Errorf(codes.InvalidArgument, "Unknown key type (%d)", req.Key)
 	}
@@ -2078,6 +2589,48 @@ func (s *walletServer) AccountNotifications(req *pb.AccountNotificationsRequest,
 	}
 }
 
+func (s *walletServer) RevocationNotifications(req *pb.RevocationNotificationsRequest,
+	svr pb.WalletService_RevocationNotificationsServer) error {
+
+	n := s.wallet.NtfnServer.RevocationNotifications()
+	defer n.Done()
+
+	ctxDone := svr.Context().Done()
+	for {
+		select {
+		case v := <-n.C:
+			resp := pb.RevocationNotificationsResponse{
+				Height:  v.Height,
+				Results: make([]*pb.RevocationNotificationsResponse_Result, len(v.Results)),
+			}
+			for i := range v.Results {
+				r := &v.Results[i]
+				result := &pb.RevocationNotificationsResponse_Result{
+					TicketHash: r.Ticket[:],
+					Fee:        int64(r.Fee),
+				}
+				if r.Reason == wallet.RevocationReasonExpired {
+					result.Reason = pb.RevocationNotificationsResponse_Result_EXPIRED
+				}
+				if r.Revocation != nil {
+					result.RevocationHash = r.Revocation[:]
+				}
+				if r.Err != nil {
+					result.Error = r.Err.Error()
+				}
+				resp.Results[i] = result
+			}
+			err := svr.Send(&resp)
+			if err != nil {
+				return translateError(err)
+			}
+
+		case <-ctxDone:
+			return nil
+		}
+	}
+}
+
 func (s *walletServer) ConfirmationNotifications(svr pb.WalletService_ConfirmationNotificationsServer) error {
 	c := s.wallet.NtfnServer.ConfirmationNotifications(svr.Context())
 	errOut := make(chan error, 2)
@@ -2214,20 +2767,24 @@ func (t *ticketbuyerV2Server) RunTicketBuyer(req *pb.RunTicketBuyerRequest, svr
 		c.VotingAddr = votingAddress
 		c.PoolFeeAddr = poolAddress
 		c.PoolFees = req.PoolFees
+		c.WaitForLowerPrice = req.WaitForLowerPrice
 	})
 
-	lock := make(chan time.Time, 1)
-
-	lockWallet := func() {
-		lock <- time.Time{}
-		zero.Bytes(req.Passphrase)
+	// Only unlock the private keys of the purchasing and voting accounts
+	// for tickets and votes.  The grant is revoked without affecting other
+	// unlocks of the same scope.
+	scope := &udb.UnlockScope{
+		Accounts: []uint32{req.Account, req.VotingAccount},
+		Uses:     udb.KeyUseTicket | udb.KeyUseVote,
 	}
-
-	err = wallet.Unlock(req.Passphrase, lock)
+	grant, err := wallet.GrantUnlock(req.Passphrase, scope, nil)
 	if err != nil {
 		return translateError(err)
 	}
-	defer lockWallet()
+	defer func() {
+		wallet.RevokeUnlock(grant)
+		zero.Bytes(req.Passphrase)
+	}()
 
 	err = tb.Run(svr.Context(), req.Passphrase)
 	if err != nil {
@@ -2252,7 +2809,11 @@ func (t *ticketbuyerServer) StartAutoBuyer(ctx context.Context, req *pb.StartAut
 	if !ok {
 		return nil, status.Errorf(codes.FailedPrecondition, "Wallet has not been loaded")
 	}
-	err := wallet.Unlock(req.Passphrase, nil)
+	scope := &udb.UnlockScope{
+		Accounts: []uint32{req.Account},
+		Uses:     udb.KeyUseTicket | udb.KeyUseVote,
+	}
+	err := wallet.UnlockScoped(req.Passphrase, scope, nil)
 	if err != nil {
 		return nil, translateError(err)
 	}
@@ -2366,12 +2927,36 @@ func (s *loaderServer) CreateWallet(ctx context.Context, req *pb.CreateWalletReq
 		pubPassphrase = []byte(wallet.InsecurePubPassphrase)
 	}
 
-	// Seed is required.
-	if len(req.Seed) == 0 {
+	// Either a seed or a BIP0039 mnemonic is required.
+	seed := req.Seed
+	switch {
+	case len(req.Seed) != 0 && req.Bip39Mnemonic != "":
+		return nil, status.Errorf(codes.InvalidArgument, "seed and BIP0039 mnemonic are mutually exclusive")
+	case req.Bip39Mnemonic != "":
+		var err error
+		seed, err = bip39.Seed(req.Bip39Mnemonic, req.Bip39Passphrase)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		defer zero.Bytes(seed)
+	case len(req.Seed) == 0:
 		return nil, status.Errorf(codes.InvalidArgument, "seed is a required parameter")
 	}
 
-	_, err := s.loader.CreateNewWallet(pubPassphrase, req.PrivatePassphrase, req.Seed)
+	birthday, err := requestBirthday(req.BirthdayTime, req.BirthdayHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	name, named, err := requestWalletName(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if named {
+		_, err = s.loader.CreateNamedWallet(name, pubPassphrase, req.PrivatePassphrase, seed, birthday)
+	} else {
+		_, err = s.loader.CreateNewWallet(pubPassphrase, req.PrivatePassphrase, seed, birthday)
+	}
 	if err != nil {
 		return nil, translateError(err)
 	}
@@ -2388,7 +2973,20 @@ func (s *loaderServer) CreateWatchingOnlyWallet(ctx context.Context, req *pb.Cre
 		pubPassphrase = []byte(wallet.InsecurePubPassphrase)
 	}
 
-	_, err := s.loader.CreateWatchingOnlyWallet(req.ExtendedPubKey, pubPassphrase)
+	birthday, err := requestBirthday(req.BirthdayTime, req.BirthdayHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	name, named, err := requestWalletName(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if named {
+		_, err = s.loader.CreateNamedWatchingOnlyWallet(name, req.ExtendedPubKey, pubPassphrase, birthday)
+	} else {
+		_, err = s.loader.CreateWatchingOnlyWallet(req.ExtendedPubKey, pubPassphrase, birthday)
+	}
 	if err != nil {
 		return nil, translateError(err)
 	}
@@ -2396,6 +2994,23 @@ func (s *loaderServer) CreateWatchingOnlyWallet(ctx context.Context, req *pb.Cre
 	return &pb.CreateWatchingOnlyWalletResponse{}, nil
 }
 
+// requestBirthday returns the wallet birthday described by the birthday time
+// (as a unix timestamp) and block height of a request, or nil if neither is
+// set.
+func requestBirthday(unixTime int64, height int32) (*udb.Birthday, error) {
+	switch {
+	case unixTime != 0 && height != 0:
+		return nil, status.Errorf(codes.InvalidArgument, "birthday time and height are mutually exclusive")
+	case unixTime < 0 || height < 0:
+		return nil, status.Errorf(codes.InvalidArgument, "birthday may not be negative")
+	case unixTime != 0:
+		return &udb.Birthday{Time: time.Unix(unixTime, 0)}, nil
+	case height != 0:
+		return &udb.Birthday{Height: height}, nil
+	}
+	return nil, nil
+}
+
 func (s *loaderServer) OpenWallet(ctx context.Context, req *pb.OpenWalletRequest) (
 	*pb.OpenWalletResponse, error) {
 
@@ -2405,7 +3020,16 @@ func (s *loaderServer) OpenWallet(ctx context.Context, req *pb.OpenWalletRequest
 		pubPassphrase = []byte(wallet.InsecurePubPassphrase)
 	}
 
-	w, err := s.loader.OpenExistingWallet(pubPassphrase)
+	name, named, err := requestWalletName(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var w *wallet.Wallet
+	if named {
+		w, err = s.loader.OpenNamedWallet(name, pubPassphrase)
+	} else {
+		w, err = s.loader.OpenExistingWallet(pubPassphrase)
+	}
 	if err != nil {
 		return nil, translateError(err)
 	}
@@ -2418,7 +3042,16 @@ func (s *loaderServer) OpenWallet(ctx context.Context, req *pb.OpenWalletRequest
 func (s *loaderServer) WalletExists(ctx context.Context, req *pb.WalletExistsRequest) (
 	*pb.WalletExistsResponse, error) {
 
-	exists, err := s.loader.WalletExists()
+	name, named, err := requestWalletName(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var exists bool
+	if named {
+		exists, err = s.loader.NamedWalletExists(name)
+	} else {
+		exists, err = s.loader.WalletExists()
+	}
 	if err != nil {
 		return nil, translateError(err)
 	}
@@ -2428,7 +3061,15 @@ func (s *loaderServer) WalletExists(ctx context.Context, req *pb.WalletExistsReq
 func (s *loaderServer) CloseWallet(ctx context.Context, req *pb.CloseWalletRequest) (
 	*pb.CloseWalletResponse, error) {
 
-	err := s.loader.UnloadWallet()
+	name, named, err := requestWalletName(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if named {
+		err = s.loader.UnloadNamedWallet(name)
+	} else {
+		err = s.loader.UnloadWallet()
+	}
 	if errors.Is(errors.Invalid, err) {
 		return nil, status.Errorf(codes.FailedPrecondition, "Wallet is not loaded")
 	}
@@ -2493,9 +3134,9 @@ func (s *loaderServer) StartConsensusRpc(ctx context.Context, req *pb.StartConse
 func (s *loaderServer) DiscoverAddresses(ctx context.Context, req *pb.DiscoverAddressesRequest) (
 	*pb.DiscoverAddressesResponse, error) {
 
-	wallet, ok := s.loader.LoadedWallet()
-	if !ok {
-		return nil, status.Errorf(codes.FailedPrecondition, "Wallet has not been loaded")
+	wallet, _, err := s.loadedWallet(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	s.mu.Lock()
@@ -2522,7 +3163,6 @@ func (s *loaderServer) DiscoverAddresses(ctx context.Context, req *pb.DiscoverAd
 	}
 	n := chain.BackendFromRPCClient(chainClient.Client)
 	startHash := wallet.ChainParams().GenesisHash
-	var err error
 	if req.StartingBlockHash != nil {
 		startHash, err = chainhash.NewHash(req.StartingBlockHash)
 		if err != nil {
@@ -2540,14 +3180,14 @@ func (s *loaderServer) DiscoverAddresses(ctx context.Context, req *pb.DiscoverAd
 func (s *loaderServer) FetchMissingCFilters(ctx context.Context, req *pb.FetchMissingCFiltersRequest) (
 	*pb.FetchMissingCFiltersResponse, error) {
 
-	wallet, ok := s.loader.LoadedWallet()
-	if !ok {
-		return nil, status.Errorf(codes.FailedPrecondition, "Wallet has not been loaded")
+	wallet, _, err := s.loadedWallet(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	n := chain.BackendFromRPCClient(s.rpcClient.Client)
 	// Fetch any missing main chain compact filters.
-	err := wallet.FetchMissingCFilters(ctx, n)
+	err = wallet.FetchMissingCFilters(ctx, n)
 	if err != nil {
 		return nil, err
 	}
@@ -2616,14 +3256,19 @@ func (s *loaderServer) RpcSync(req *pb.RpcSyncRequest, svr pb.WalletLoaderServic
 		s.mu.Unlock()
 	}
 
-	n := chain.BackendFromRPCClient(chainClient.Client)
+	// The syncer of the default wallet also synchronizes every named wallet
+	// opened by the loader.
+	syncer := chain.NewRPCSyncer(wallet, chainClient)
+	n := syncer.Backend()
 	s.loader.SetNetworkBackend(n)
 	wallet.SetNetworkBackend(n)
+	s.loader.SetSyncer(syncer)
 
 	// Disassociate the RPC client from all subsystems until reconnection
 	// occurs.
 	defer wallet.SetNetworkBackend(nil)
 	defer s.loader.SetNetworkBackend(nil)
+	defer s.loader.SetSyncer(nil)
 	defer s.loader.StopTicketPurchase()
 
 	ntfns := &chain.Notifications{
@@ -2722,7 +3367,6 @@ func (s *loaderServer) RpcSync(req *pb.RpcSyncRequest, svr pb.WalletLoaderServic
 			_ = svr.Send(resp)
 		},
 	}
-	syncer := chain.NewRPCSyncer(wallet, chainClient)
 	syncer.SetNotifications(ntfns)
 
 	// Run wallet synchronization until it is cancelled or errors.  If the
@@ -2895,11 +3539,15 @@ func (s *loaderServer) SpvSync(req *pb.SpvSyncRequest, svr pb.WalletLoaderServic
 		syncer.SetPersistantPeers(spvConnects)
 	}
 
+	// The syncer of the default wallet also synchronizes every named wallet
+	// opened by the loader.
 	wallet.SetNetworkBackend(syncer)
 	s.loader.SetNetworkBackend(syncer)
+	s.loader.SetSyncer(syncer)
 
 	defer wallet.SetNetworkBackend(nil)
 	defer s.loader.SetNetworkBackend(nil)
+	defer s.loader.SetSyncer(nil)
 
 	err := syncer.Run(svr.Context())
 	if err != nil {
@@ -2914,9 +3562,9 @@ func (s *loaderServer) SpvSync(req *pb.SpvSyncRequest, svr pb.WalletLoaderServic
 }
 
 func (s *loaderServer) RescanPoint(ctx context.Context, req *pb.RescanPointRequest) (*pb.RescanPointResponse, error) {
-	wallet, ok := s.loader.LoadedWallet()
-	if !ok {
-		return nil, status.Errorf(codes.FailedPrecondition, "Wallet has not been loaded")
+	wallet, _, err := s.loadedWallet(ctx)
+	if err != nil {
+		return nil, err
 	}
 	rescanPoint, err := wallet.RescanPoint()
 	if err != nil {
@@ -2956,8 +3604,9 @@ func (s *loaderServer) SubscribeToBlockNotifications(ctx context.Context, req *p
 	// a backwards-compatible way to improve error handling and provide more
 	// control over how long the synchronization task runs.
 	syncer := chain.NewRPCSyncer(wallet, chainClient)
+	wallet.SetNetworkBackend(syncer.Backend())
+	s.loader.SetSyncer(syncer)
 	go syncer.Run(context.Background(), false)
-	wallet.SetNetworkBackend(chain.BackendFromRPCClient(chainClient.Client))
 
 	return &pb.SubscribeToBlockNotificationsResponse{}, nil
 }
@@ -2965,9 +3614,9 @@ func (s *loaderServer) SubscribeToBlockNotifications(ctx context.Context, req *p
 func (s *loaderServer) FetchHeaders(ctx context.Context, req *pb.FetchHeadersRequest) (
 	*pb.FetchHeadersResponse, error) {
 
-	wallet, ok := s.loader.LoadedWallet()
-	if !ok {
-		return nil, status.Errorf(codes.FailedPrecondition, "Wallet has not been loaded")
+	wallet, _, err := s.loadedWallet(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	s.mu.Lock()
@@ -2996,9 +3645,75 @@ func (s *loaderServer) FetchHeaders(ctx context.Context, req *pb.FetchHeadersReq
 	return res, nil
 }
 
+func (s *loaderServer) CompactDatabase(ctx context.Context, req *pb.CompactDatabaseRequest) (
+	*pb.CompactDatabaseResponse, error) {
+
+	name, named, err := requestWalletName(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var before, after int64
+	if named {
+		before, after, err = s.loader.CompactNamedDatabase(name)
+	} else {
+		before, after, err = s.loader.CompactDatabase()
+	}
+	if errors.Is(errors.Invalid, err) {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &pb.CompactDatabaseResponse{
+		SizeBefore: before,
+		SizeAfter:  after,
+	}, nil
+}
+
+func (s *loaderServer) DatabaseSize(ctx context.Context, req *pb.DatabaseSizeRequest) (
+	*pb.DatabaseSizeResponse, error) {
+
+	wallet, name, err := s.loadedWallet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sizes, err := wallet.DatabaseSizes()
+	if err != nil {
+		return nil, translateError(err)
+	}
+	var fileSize int64
+	if name != "" {
+		fileSize, err = s.loader.NamedDatabaseFileSize(name)
+	} else {
+		fileSize, err = s.loader.DatabaseFileSize()
+	}
+	// Databases which are not stored in files are reported with a zero
+	// file size.
+	if err != nil && !errors.Is(errors.Invalid, err) {
+		return nil, translateError(err)
+	}
+
+	resp := &pb.DatabaseSizeResponse{
+		FileSize:   fileSize,
+		Categories: make([]*pb.DatabaseSizeResponse_Category, len(sizes)),
+	}
+	for i, size := range sizes {
+		resp.Categories[i] = &pb.DatabaseSizeResponse_Category{
+			Name:  size.Category,
+			Keys:  size.Keys,
+			Bytes: size.Bytes,
+		}
+	}
+	return resp, nil
+}
+
 func (s *seedServer) GenerateRandomSeed(ctx context.Context, req *pb.GenerateRandomSeedRequest) (
 	*pb.GenerateRandomSeedResponse, error) {
 
+	if req.Encoding == pb.SeedEncoding_BIP39 {
+		return generateBIP39Seed(req)
+	}
+
 	seedSize := req.SeedLength
 	if seedSize == 0 {
 		seedSize = hdkeychain.RecommendedSeedLen
@@ -3021,14 +3736,95 @@ func (s *seedServer) GenerateRandomSeed(ctx context.Context, req *pb.GenerateRan
 	return res, nil
 }
 
+// generateBIP39Seed creates a random BIP0039 mnemonic and derives its seed.
+// The seed length of the request is the length of the mnemonic's entropy.
+func generateBIP39Seed(req *pb.GenerateRandomSeedRequest) (*pb.GenerateRandomSeedResponse, error) {
+	entropySize := req.SeedLength
+	if entropySize == 0 {
+		entropySize = bip39.RecommendedEntropyBytes
+	}
+	if entropySize < bip39.MinEntropyBytes || entropySize > bip39.MaxEntropyBytes || entropySize%4 != 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid BIP0039 entropy length")
+	}
+
+	entropy := make([]byte, entropySize)
+	defer zero.Bytes(entropy)
+	_, err := rand.Read(entropy)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to read cryptographically-random data for seed: %v", err)
+	}
+	mnemonic, err := bip39.EncodeMnemonic(entropy)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	seed, err := bip39.Seed(mnemonic, req.Bip39Passphrase)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	res := &pb.GenerateRandomSeedResponse{
+		SeedBytes:    seed,
+		SeedHex:      hex.EncodeToString(seed),
+		SeedMnemonic: mnemonic,
+	}
+	return res, nil
+}
+
 func (s *seedServer) DecodeSeed(ctx context.Context, req *pb.DecodeSeedRequest) (*pb.DecodeSeedResponse, error) {
-	seed, err := walletseed.DecodeUserInput(req.UserInput)
+	var seed []byte
+	var err error
+	switch req.Encoding {
+	case pb.SeedEncoding_PGP_WORDLIST:
+		seed, err = walletseed.DecodeUserInput(req.UserInput)
+	case pb.SeedEncoding_BIP39:
+		seed, err = bip39.Seed(req.UserInput, req.Bip39Passphrase)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown seed encoding %v", req.Encoding)
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
 	return &pb.DecodeSeedResponse{DecodedSeed: seed}, nil
 }
 
+func (s *seedServer) GenerateSeedShares(ctx context.Context, req *pb.GenerateSeedSharesRequest) (
+	*pb.GenerateSeedSharesResponse, error) {
+
+	defer zero.Bytes(req.Seed)
+
+	if req.Count > 255 {
+		return nil, status.Errorf(codes.InvalidArgument, "share count exceeds 255")
+	}
+	shares, err := walletseed.SplitSeed(req.Seed, int(req.Threshold), int(req.Count))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	mnemonics := make([]string, len(shares))
+	for i, share := range shares {
+		mnemonics[i] = share.EncodeMnemonic()
+		zero.Bytes(share.Value)
+	}
+	return &pb.GenerateSeedSharesResponse{ShareMnemonics: mnemonics}, nil
+}
+
+func (s *seedServer) CombineSeedShares(ctx context.Context, req *pb.CombineSeedSharesRequest) (
+	*pb.CombineSeedSharesResponse, error) {
+
+	shares := make([]*walletseed.SeedShare, len(req.ShareMnemonics))
+	for i, mnemonic := range req.ShareMnemonics {
+		share, err := walletseed.DecodeSeedShare(mnemonic)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "share %d: %v", i, err)
+		}
+		shares[i] = share
+	}
+	seed, err := walletseed.CombineSeedShares(shares)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	return &pb.CombineSeedSharesResponse{DecodedSeed: seed}, nil
+}
+
 // requirePurchaseManager checks whether the ticket buyer is running, returning
 // a gRPC error when it is not.
 func (t *ticketbuyerServer) requirePurchaseManager() (*ticketbuyer.PurchaseManager, error) {
@@ -0,0 +1,64 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package rpcserver
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/fonero-project/fnowallet/wallet/udb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSemverString(t *testing.T) {
+	want := fmt.Sprintf("%d.%d.%d", semverMajor, semverMinor, semverPatch)
+	if semverString != want {
+		t.Errorf("semverString %q does not match version components %q",
+			semverString, want)
+	}
+}
+
+func TestCheckKDFOptions(t *testing.T) {
+	scrypt, _ := udb.DefaultScryptOptions(udb.KDFScrypt)
+	argon2id, _ := udb.DefaultScryptOptions(udb.KDFArgon2id)
+	with := func(o *udb.ScryptOptions, n, r, p int) *udb.ScryptOptions {
+		c := *o
+		c.N, c.R, c.P = n, r, p
+		return &c
+	}
+
+	tests := []struct {
+		name  string
+		o     *udb.ScryptOptions
+		valid bool
+	}{
+		{"scrypt default", scrypt, true},
+		{"scrypt maximum memory", with(scrypt, 1<<22, 8, 1), true},
+		{"scrypt N below minimum", with(scrypt, 1<<17, 8, 1), false},
+		{"scrypt N not power of two", with(scrypt, 1<<18+1, 8, 1), false},
+		{"scrypt excessive memory", with(scrypt, 1<<23, 8, 1), false},
+		{"scrypt zero r", with(scrypt, 1<<18, 0, 1), false},
+		{"scrypt excessive r", with(scrypt, 1<<18, maxScryptR+1, 1), false},
+		{"scrypt excessive p", with(scrypt, 1<<18, 8, maxScryptP+1), false},
+		{"argon2id default", argon2id, true},
+		{"argon2id maximum memory", with(argon2id, maxArgon2Memory, 1, 1), true},
+		{"argon2id memory below minimum", with(argon2id, minArgon2Memory-1, 3, 4), false},
+		{"argon2id excessive memory", with(argon2id, maxArgon2Memory+1, 3, 4), false},
+		{"argon2id zero passes", with(argon2id, minArgon2Memory, 0, 4), false},
+		{"argon2id excessive passes", with(argon2id, minArgon2Memory, maxArgon2Time+1, 4), false},
+		{"argon2id excessive threads", with(argon2id, minArgon2Memory, 3, maxArgon2Threads+1), false},
+		{"unknown KDF", &udb.ScryptOptions{KDF: 2, N: 1 << 18, R: 8, P: 1}, false},
+	}
+	for _, test := range tests {
+		err := checkKDFOptions(test.o)
+		switch {
+		case test.valid && err != nil:
+			t.Errorf("%s: %v", test.name, err)
+		case !test.valid && status.Code(err) != codes.InvalidArgument:
+			t.Errorf("%s: expected InvalidArgument, got %v", test.name, err)
+		}
+	}
+}
@@ -0,0 +1,643 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package rpcserver
+
+import (
+	"context"
+	"strings"
+
+	"github.com/fonero-project/fnowallet/wallet"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/fonero-project/fnowallet/rpc/walletrpc"
+)
+
+// Requests select a named wallet opened by the loader with the wallet metadata
+// key.  Requests without the key, or with an empty name, are served by the
+// default wallet.  The WalletService and VotingService implementations are
+// registered through dispatchers which serve each request with the selected
+// wallet.
+const walletMetadataKey = "wallet"
+
+// defaultWalletOnly records the full gRPC method names and services which only
+// serve the default wallet.  The syncer started for the default wallet
+// synchronizes every named wallet, so named wallets are never synchronized on
+// their own.
+var defaultWalletOnly = map[string]bool{
+	"walletrpc.TicketBuyerService":                                 true,
+	"walletrpc.TicketBuyerV2Service":                               true,
+	"/walletrpc.WalletLoaderService/RpcSync":                       true,
+	"/walletrpc.WalletLoaderService/SpvSync":                       true,
+	"/walletrpc.WalletLoaderService/SubscribeToBlockNotifications": true,
+}
+
+// requestWalletName returns the name of the wallet selected by the request
+// metadata, and whether a named wallet is selected.
+func requestWalletName(ctx context.Context) (name string, named bool, err error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false, nil
+	}
+	names := md.Get(walletMetadataKey)
+	switch len(names) {
+	case 0:
+		return "", false, nil
+	case 1:
+		return names[0], names[0] != "", nil
+	default:
+		return "", false, status.Errorf(codes.InvalidArgument,
+			"request metadata selects multiple wallets")
+	}
+}
+
+// CheckWalletSelection returns a gRPC error when the request metadata selects
+// a named wallet for a method which only serves the default wallet.
+func CheckWalletSelection(ctx context.Context, method string) error {
+	_, named, err := requestWalletName(ctx)
+	if err != nil || !named {
+		return err
+	}
+	service := strings.TrimPrefix(method, "/")
+	if i := strings.IndexRune(service, '/'); i != -1 {
+		service = service[:i]
+	}
+	if defaultWalletOnly[method] || defaultWalletOnly[service] {
+		return status.Errorf(codes.Unimplemented,
+			"method %s does not serve named wallets", method)
+	}
+	return nil
+}
+
+// namedWallet returns the opened named wallet, or a gRPC error when it is not
+// opened.
+func namedWallet(name string) (*wallet.Wallet, error) {
+	if !loaderService.checkReady() {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"service walletrpc.WalletLoaderService is not ready")
+	}
+	w, ok := loaderService.loader.NamedWallet(name)
+	if !ok {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"wallet %q has not been loaded", name)
+	}
+	return w, nil
+}
+
+// loadedWallet returns the wallet selected by the request metadata and the name
+// of a selected named wallet.
+func (s *loaderServer) loadedWallet(ctx context.Context) (w *wallet.Wallet, name string, err error) {
+	name, named, err := requestWalletName(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	if named {
+		w, err := namedWallet(name)
+		return w, name, err
+	}
+	w, ok := s.loader.LoadedWallet()
+	if !ok {
+		return nil, "", status.Errorf(codes.FailedPrecondition, "Wallet has not been loaded")
+	}
+	return w, "", nil
+}
+
+func (s *loaderServer) ListWallets(ctx context.Context, req *pb.ListWalletsRequest) (
+	*pb.ListWalletsResponse, error) {
+
+	wallets, err := s.loader.ListWallets()
+	if err != nil {
+		return nil, translateError(err)
+	}
+	resp := &pb.ListWalletsResponse{
+		Wallets: make([]*pb.ListWalletsResponse_Wallet, len(wallets)),
+	}
+	for i, w := range wallets {
+		resp.Wallets[i] = &pb.ListWalletsResponse_Wallet{
+			Name:   w.Name,
+			Loaded: w.Loaded,
+		}
+	}
+	return resp, nil
+}
+
+// walletDispatcher implements the WalletService by serving each request with
+// the wallet selected by the request metadata.
+type walletDispatcher struct{}
+
+func (d *walletDispatcher) server(ctx context.Context) (*walletServer, error) {
+	name, named, err := requestWalletName(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !named {
+		if !walletService.checkReady() {
+			return nil, status.Errorf(codes.FailedPrecondition,
+				"service walletrpc.WalletService is not ready")
+		}
+		return &walletService, nil
+	}
+	w, err := namedWallet(name)
+	if err != nil {
+		return nil, err
+	}
+	return &walletServer{ready: 1, wallet: w}, nil
+}
+
+// votingDispatcher implements the VotingService by serving each request with
+// the wallet selected by the request metadata.
+type votingDispatcher struct{}
+
+func (d *votingDispatcher) server(ctx context.Context) (*votingServer, error) {
+	name, named, err := requestWalletName(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !named {
+		if !votingService.checkReady() {
+			return nil, status.Errorf(codes.FailedPrecondition,
+				"service walletrpc.VotingService is not ready")
+		}
+		return &votingService, nil
+	}
+	w, err := namedWallet(name)
+	if err != nil {
+		return nil, err
+	}
+	return &votingServer{ready: 1, wallet: w}, nil
+}
+
+func (d *walletDispatcher) Ping(ctx context.Context, req *pb.PingRequest) (*pb.PingResponse, error) {
+	s, err := d.server(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.Ping(ctx, req)
+}
+
+func (d *walletDispatcher) Network(ctx context.Context, req *pb.NetworkRequest) (*pb.NetworkResponse, error) {
+	s, err := d.server(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.Network(ctx, req)
+}
+
+func (d *walletDispatcher) AccountNumber(ctx context.Context, req *pb.AccountNumberRequest) (*pb.AccountNumberResponse, error) {
+	s, err := d.server(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.AccountNumber(ctx, req)
+}
+
+func (d *walletDispatcher) Accounts(ctx context.Context, req *pb.AccountsRequest) (*pb.AccountsResponse, error) {
+	s, err := d.server(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.Accounts(ctx, req)
+}
+
+func (d *walletDispatcher) Balance(ctx context.Context, req *pb.BalanceRequest) (*pb.BalanceResponse, error) {
+	s, err := d.server(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.Balance(ctx, req)
+}
+
+func (d *walletDispatcher) GetAccountExtendedPubKey(ctx context.Context, req *pb.GetAccountExtendedPubKeyRequest) (*pb.GetAccountExtendedPubKeyResponse, error) {
+	s, err := d.server(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetAccountExtendedPubKey(ctx, req)
+}
+
+func (d *walletDispatcher) GetTransaction(ctx context.Context, req *pb.GetTransactionRequest) (*pb.GetTransactionResponse, error) {
+	s, err := d.server(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetTransaction(ctx, req)
+}
+
+func (d *walletDispatcher) GetTransactions(req *pb.GetTransactionsRequest, svr pb.WalletService_GetTransactionsServer) error {
+	s, err := d.server(svr.Context())
+	if err != nil {
+		return err
+	}
+	return s.GetTransactions(req, svr)
+}
+
+func (d *walletDispatcher) GetTicket(ctx context.Context, req *pb.GetTicketRequest) (*pb.GetTicketsResponse, error) {
+	s, err := d.server(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetTicket(ctx, req)
+}
+
+func (d *walletDispatcher) GetTickets(req *pb.GetTicketsRequest, svr pb.WalletService_GetTicketsServer) error {
+	s, err := d.server(svr.Context())
+	if err != nil {
+		return err
+	}
+	return s.GetTickets(req, svr)
+}
+
+func (d *walletDispatcher) VotingAccountTickets(ctx context.Context, req *pb.VotingAccountTicketsRequest) (*pb.VotingAccountTicketsResponse, error) {
+	s, err := d.server(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.VotingAccountTickets(ctx, req)
+}
+
+func (d *walletDispatcher) TicketPrice(ctx context.Context, req *pb.TicketPriceRequest) (*pb.TicketPriceResponse, error) {
+	s, err := d.server(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.TicketPrice(ctx, req)
+}
+
+func (d *walletDispatcher) TicketPriceForecast(ctx context.Context, req *pb.TicketPriceForecastRequest) (*pb.TicketPriceForecastResponse, error) {
+	s, err := d.server(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.TicketPriceForecast(ctx, req)
+}
+
+func (d *walletDispatcher) StakeInfo(ctx context.Context, req *pb.StakeInfoRequest) (*pb.StakeInfoResponse, error) {
+	s, err := d.server(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.StakeInfo(ctx, req)
+}
+
+func (d *walletDispatcher) BlockInfo(ctx context.Context, req *pb.BlockInfoRequest) (*pb.BlockInfoResponse, error) {
+	s, err := d.server(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.BlockInfo(ctx, req)
+}
+
+func (d *walletDispatcher) BestBlock(ctx context.Context, req *pb.BestBlockRequest) (*pb.BestBlockResponse, error) {
+	s, err := d.server(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.BestBlock(ctx, req)
+}
+
+func (d *walletDispatcher) TransactionNotifications(req *pb.TransactionNotificationsRequest, svr pb.WalletService_TransactionNotificationsServer) error {
+	s, err := d.server(svr.Context())
+	if err != nil {
+		return err
+	}
+	return s.TransactionNotifications(req, svr)
+}
+
+func (d *walletDispatcher) AccountNotifications(req *pb.AccountNotificationsRequest, svr pb.WalletService_AccountNotificationsServer) error {
+	s, err := d.server(svr.Context())
+	if err != nil {
+		return err
+	}
+	return s.AccountNotifications(req, svr)
+}
+
+func (d *walletDispatcher) ConfirmationNotifications(svr pb.WalletService_ConfirmationNotificationsServer) error {
+	s, err := d.server(svr.Context())
+	if err != nil {
+		return err
+	}
+	return s.ConfirmationNotifications(svr)
+}
+
+func (d *walletDispatcher) RevocationNotifications(req *pb.RevocationNotificationsRequest, svr pb.WalletService_RevocationNotificationsServer) error {
+	s, err := d.server(svr.Context())
+	if err != nil {
+		return err
+	}
+	return s.RevocationNotifications(req, svr)
+}
+
+func (d *walletDispatcher) ChangePassphrase(ctx context.Context, req *pb.ChangePassphraseRequest) (*pb.ChangePassphraseResponse, error) {
+	s, err := d.server(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.ChangePassphrase(ctx, req)
+}
+
+func (d *walletDispatcher) RenameAccount(ctx context.Context, req *pb.RenameAccountRequest) (*pb.RenameAccountResponse, error) {
+	s, err := d.server(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.RenameAccount(ctx, req)
+}
+
+func (d *walletDispatcher) Rescan(req *pb.RescanRequest, svr pb.WalletService_RescanServer) error {
+	s, err := d.server(svr.Context())
+	if err != nil {
+		return err
+	}
+	return s.Rescan(req, svr)
+}
+
+func (d *walletDispatcher) NextAccount(ctx context.Context, req *pb.NextAccountRequest) (*pb.NextAccountResponse, error) {
+	s, err := d.server(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.NextAccount(ctx, req)
+}
+
+func (d *walletDispatcher) NextAddress(ctx context.Context, req *pb.NextAddressRequest) (*pb.NextAddressResponse, error) {
+	s, err := d.server(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.NextAddress(ctx, req)
+}
+
+func (d *walletDispatcher) ImportPrivateKey(ctx context.Context, req *pb.ImportPrivateKeyRequest) (*pb.ImportPrivateKeyResponse, error) {
+	s, err := d.server(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.ImportPrivateKey(ctx, req)
+}
+
+func (d *walletDispatcher) ImportScript(ctx context.Context, req *pb.ImportScriptRequest) (*pb.ImportScriptResponse, error) {
+	s, err := d.server(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.ImportScript(ctx, req)
+}
+
+func (d *walletDispatcher) ExportVotingAccount(ctx context.Context, req *pb.ExportVotingAccountRequest) (*pb.ExportVotingAccountResponse, error) {
+	s, err := d.server(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.ExportVotingAccount(ctx, req)
+}
+
+func (d *walletDispatcher) ImportVotingAccount(ctx context.Context, req *pb.ImportVotingAccountRequest) (*pb.ImportVotingAccountResponse, error) {
+	s, err := d.server(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.ImportVotingAccount(ctx, req)
+}
+
+func (d *walletDispatcher) ExportAccountKey(ctx context.Context, req *pb.ExportAccountKeyRequest) (*pb.ExportAccountKeyResponse, error) {
+	s, err := d.server(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.ExportAccountKey(ctx, req)
+}
+
+func (d *walletDispatcher) ImportAccountKey(ctx context.Context, req *pb.ImportAccountKeyRequest) (*pb.ImportAccountKeyResponse, error) {
+	s, err := d.server(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.ImportAccountKey(ctx, req)
+}
+
+func (d *walletDispatcher) AddVotingTickets(ctx context.Context, req *pb.AddVotingTicketsRequest) (*pb.AddVotingTicketsResponse, error) {
+	s, err := d.server(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.AddVotingTickets(ctx, req)
+}
+
+func (d *walletDispatcher) FundTransaction(ctx context.Context, req *pb.FundTransactionRequest) (*pb.FundTransactionResponse, error) {
+	s, err := d.server(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.FundTransaction(ctx, req)
+}
+
+func (d *walletDispatcher) UnspentOutputs(req *pb.UnspentOutputsRequest, svr pb.WalletService_UnspentOutputsServer) error {
+	s, err := d.server(svr.Context())
+	if err != nil {
+		return err
+	}
+	return s.UnspentOutputs(req, svr)
+}
+
+func (d *walletDispatcher) ConstructTransaction(ctx context.Context, req *pb.ConstructTransactionRequest) (*pb.ConstructTransactionResponse, error) {
+	s, err := d.server(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.ConstructTransaction(ctx, req)
+}
+
+func (d *walletDispatcher) SignTransaction(ctx context.Context, req *pb.SignTransactionRequest) (*pb.SignTransactionResponse, error) {
+	s, err := d.server(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.SignTransaction(ctx, req)
+}
+
+func (d *walletDispatcher) SignTransactions(ctx context.Context, req *pb.SignTransactionsRequest) (*pb.SignTransactionsResponse, error) {
+	s, err := d.server(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.SignTransactions(ctx, req)
+}
+
+func (d *walletDispatcher) CreateSignature(ctx context.Context, req *pb.CreateSignatureRequest) (*pb.CreateSignatureResponse, error) {
+	s, err := d.server(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.CreateSignature(ctx, req)
+}
+
+func (d *walletDispatcher) PublishTransaction(ctx context.Context, req *pb.PublishTransactionRequest) (*pb.PublishTransactionResponse, error) {
+	s, err := d.server(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.PublishTransaction(ctx, req)
+}
+
+func (d *walletDispatcher) PublishUnminedTransactions(ctx context.Context, req *pb.PublishUnminedTransactionsRequest) (*pb.PublishUnminedTransactionsResponse, error) {
+	s, err := d.server(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.PublishUnminedTransactions(ctx, req)
+}
+
+func (d *walletDispatcher) PurchaseTickets(ctx context.Context, req *pb.PurchaseTicketsRequest) (*pb.PurchaseTicketsResponse, error) {
+	s, err := d.server(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.PurchaseTickets(ctx, req)
+}
+
+func (d *walletDispatcher) RevokeTickets(ctx context.Context, req *pb.RevokeTicketsRequest) (*pb.RevokeTicketsResponse, error) {
+	s, err := d.server(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.RevokeTickets(ctx, req)
+}
+
+func (d *walletDispatcher) LoadActiveDataFilters(ctx context.Context, req *pb.LoadActiveDataFiltersRequest) (*pb.LoadActiveDataFiltersResponse, error) {
+	s, err := d.server(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.LoadActiveDataFilters(ctx, req)
+}
+
+func (d *walletDispatcher) SignMessage(ctx context.Context, req *pb.SignMessageRequest) (*pb.SignMessageResponse, error) {
+	s, err := d.server(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.SignMessage(ctx, req)
+}
+
+func (d *walletDispatcher) SignMessages(ctx context.Context, req *pb.SignMessagesRequest) (*pb.SignMessagesResponse, error) {
+	s, err := d.server(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.SignMessages(ctx, req)
+}
+
+func (d *walletDispatcher) ValidateAddress(ctx context.Context, req *pb.ValidateAddressRequest) (*pb.ValidateAddressResponse, error) {
+	s, err := d.server(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.ValidateAddress(ctx, req)
+}
+
+func (d *walletDispatcher) CommittedTickets(ctx context.Context, req *pb.CommittedTicketsRequest) (*pb.CommittedTicketsResponse, error) {
+	s, err := d.server(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.CommittedTickets(ctx, req)
+}
+
+func (d *walletDispatcher) SweepAccount(ctx context.Context, req *pb.SweepAccountRequest) (*pb.SweepAccountResponse, error) {
+	s, err := d.server(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.SweepAccount(ctx, req)
+}
+
+func (d *walletDispatcher) StakePoolFeeReport(req *pb.StakePoolFeeReportRequest, svr pb.WalletService_StakePoolFeeReportServer) error {
+	s, err := d.server(svr.Context())
+	if err != nil {
+		return err
+	}
+	return s.StakePoolFeeReport(req, svr)
+}
+
+func (d *walletDispatcher) AuditLog(ctx context.Context, req *pb.AuditLogRequest) (*pb.AuditLogResponse, error) {
+	s, err := d.server(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.AuditLog(ctx, req)
+}
+
+func (d *walletDispatcher) PendingTransactions(ctx context.Context, req *pb.PendingTransactionsRequest) (*pb.PendingTransactionsResponse, error) {
+	s, err := d.server(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.PendingTransactions(ctx, req)
+}
+
+func (d *walletDispatcher) ApprovePendingTransaction(ctx context.Context, req *pb.ApprovePendingTransactionRequest) (*pb.ApprovePendingTransactionResponse, error) {
+	s, err := d.server(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.ApprovePendingTransaction(ctx, req)
+}
+
+func (d *walletDispatcher) RejectPendingTransaction(ctx context.Context, req *pb.RejectPendingTransactionRequest) (*pb.RejectPendingTransactionResponse, error) {
+	s, err := d.server(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.RejectPendingTransaction(ctx, req)
+}
+
+func (d *walletDispatcher) UnlockWallet(ctx context.Context, req *pb.UnlockWalletRequest) (*pb.UnlockWalletResponse, error) {
+	s, err := d.server(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.UnlockWallet(ctx, req)
+}
+
+func (d *walletDispatcher) LockWallet(ctx context.Context, req *pb.LockWalletRequest) (*pb.LockWalletResponse, error) {
+	s, err := d.server(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.LockWallet(ctx, req)
+}
+
+func (d *walletDispatcher) LockAccount(ctx context.Context, req *pb.LockAccountRequest) (*pb.LockAccountResponse, error) {
+	s, err := d.server(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.LockAccount(ctx, req)
+}
+
+func (d *walletDispatcher) VerifySeed(ctx context.Context, req *pb.VerifySeedRequest) (*pb.VerifySeedResponse, error) {
+	s, err := d.server(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.VerifySeed(ctx, req)
+}
+
+func (d *votingDispatcher) VoteChoices(ctx context.Context, req *pb.VoteChoicesRequest) (*pb.VoteChoicesResponse, error) {
+	s, err := d.server(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.VoteChoices(ctx, req)
+}
+
+func (d *votingDispatcher) SetVoteChoices(ctx context.Context, req *pb.SetVoteChoicesRequest) (*pb.SetVoteChoicesResponse, error) {
+	s, err := d.server(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.SetVoteChoices(ctx, req)
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package rpcserver
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func walletContext(names ...string) context.Context {
+	ctx := context.Background()
+	if names == nil {
+		return ctx
+	}
+	md := metadata.MD{}
+	md[walletMetadataKey] = names
+	return metadata.NewIncomingContext(ctx, md)
+}
+
+func TestRequestWalletName(t *testing.T) {
+	tests := []struct {
+		ctx   context.Context
+		name  string
+		named bool
+		code  codes.Code
+	}{
+		{walletContext(), "", false, codes.OK},
+		{walletContext(""), "", false, codes.OK},
+		{walletContext("a"), "a", true, codes.OK},
+		{walletContext("a", "b"), "", false, codes.InvalidArgument},
+	}
+	for i, test := range tests {
+		name, named, err := requestWalletName(test.ctx)
+		if status.Code(err) != test.code {
+			t.Errorf("test %d: expected code %v, got %v", i, test.code, err)
+			continue
+		}
+		if name != test.name || named != test.named {
+			t.Errorf("test %d: got %q, %v; want %q, %v", i, name, named,
+				test.name, test.named)
+		}
+	}
+}
+
+func TestCheckWalletSelection(t *testing.T) {
+	tests := []struct {
+		ctx    context.Context
+		method string
+		code   codes.Code
+	}{
+		{walletContext(), "/walletrpc.WalletLoaderService/SpvSync", codes.OK},
+		{walletContext(""), "/walletrpc.WalletLoaderService/RpcSync", codes.OK},
+		{walletContext("a"), "/walletrpc.WalletService/Balance", codes.OK},
+		{walletContext("a"), "/walletrpc.VotingService/VoteChoices", codes.OK},
+		{walletContext("a"), "/walletrpc.WalletLoaderService/OpenWallet", codes.OK},
+		{walletContext("a"), "/walletrpc.WalletLoaderService/RpcSync", codes.Unimplemented},
+		{walletContext("a"), "/walletrpc.WalletLoaderService/SpvSync", codes.Unimplemented},
+		{walletContext("a"), "/walletrpc.WalletLoaderService/SubscribeToBlockNotifications", codes.Unimplemented},
+		{walletContext("a"), "/walletrpc.TicketBuyerV2Service/RunTicketBuyer", codes.Unimplemented},
+		{walletContext("a"), "/walletrpc.TicketBuyerService/StartAutoBuyer", codes.Unimplemented},
+		{walletContext("a", "b"), "/walletrpc.WalletService/Balance", codes.InvalidArgument},
+	}
+	for _, test := range tests {
+		err := CheckWalletSelection(test.ctx, test.method)
+		if status.Code(err) != test.code {
+			t.Errorf("%s: expected code %v, got %v", test.method, test.code, err)
+		}
+	}
+}
+
+func TestNamedWalletNotLoaded(t *testing.T) {
+	// Requests for named wallets fail while the loader service is not ready.
+	var d walletDispatcher
+	_, err := d.server(walletContext("a"))
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("expected FailedPrecondition, got %v", err)
+	}
+	var v votingDispatcher
+	_, err = v.server(walletContext("a"))
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("expected FailedPrecondition, got %v", err)
+	}
+}
@@ -82,7 +82,30 @@ func (x SyncNotificationType) String() string {
 	return proto.EnumName(SyncNotificationType_name, int32(x))
 }
 func (SyncNotificationType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{0}
+	return fileDescriptor_api_8495174e6b66f558, []int{0}
+}
+
+type SeedEncoding int32
+
+const (
+	SeedEncoding_PGP_WORDLIST SeedEncoding = 0
+	SeedEncoding_BIP39        SeedEncoding = 1
+)
+
+var SeedEncoding_name = map[int32]string{
+	0: "PGP_WORDLIST",
+	1: "BIP39",
+}
+var SeedEncoding_value = map[string]int32{
+	"PGP_WORDLIST": 0,
+	"BIP39":        1,
+}
+
+func (x SeedEncoding) String() string {
+	return proto.EnumName(SeedEncoding_name, int32(x))
+}
+func (SeedEncoding) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{1}
 }
 
 type TransactionDetails_TransactionType int32
@@ -114,7 +137,7 @@ func (x TransactionDetails_TransactionType) String() string {
 	return proto.EnumName(TransactionDetails_TransactionType_name, int32(x))
 }
 func (TransactionDetails_TransactionType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{2, 0}
+	return fileDescriptor_api_8495174e6b66f558, []int{2, 0}
 }
 
 type NextAddressRequest_Kind int32
@@ -137,7 +160,7 @@ func (x NextAddressRequest_Kind) String() string {
 	return proto.EnumName(NextAddressRequest_Kind_name, int32(x))
 }
 func (NextAddressRequest_Kind) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{19, 0}
+	return fileDescriptor_api_8495174e6b66f558, []int{19, 0}
 }
 
 type NextAddressRequest_GapPolicy int32
@@ -166,7 +189,7 @@ func (x NextAddressRequest_GapPolicy) String() string {
 	return proto.EnumName(NextAddressRequest_GapPolicy_name, int32(x))
 }
 func (NextAddressRequest_GapPolicy) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{19, 1}
+	return fileDescriptor_api_8495174e6b66f558, []int{19, 1}
 }
 
 type GetTicketsResponse_TicketDetails_TicketStatus int32
@@ -207,7 +230,7 @@ func (x GetTicketsResponse_TicketDetails_TicketStatus) String() string {
 	return proto.EnumName(GetTicketsResponse_TicketDetails_TicketStatus_name, int32(x))
 }
 func (GetTicketsResponse_TicketDetails_TicketStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{33, 0, 0}
+	return fileDescriptor_api_8495174e6b66f558, []int{33, 0, 0}
 }
 
 type ChangePassphraseRequest_Key int32
@@ -215,22 +238,51 @@ type ChangePassphraseRequest_Key int32
 const (
 	ChangePassphraseRequest_PRIVATE ChangePassphraseRequest_Key = 0
 	ChangePassphraseRequest_PUBLIC  ChangePassphraseRequest_Key = 1
+	ChangePassphraseRequest_ACCOUNT ChangePassphraseRequest_Key = 2
 )
 
 var ChangePassphraseRequest_Key_name = map[int32]string{
 	0: "PRIVATE",
 	1: "PUBLIC",
+	2: "ACCOUNT",
 }
 var ChangePassphraseRequest_Key_value = map[string]int32{
 	"PRIVATE": 0,
 	"PUBLIC":  1,
+	"ACCOUNT": 2,
 }
 
 func (x ChangePassphraseRequest_Key) String() string {
 	return proto.EnumName(ChangePassphraseRequest_Key_name, int32(x))
 }
 func (ChangePassphraseRequest_Key) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{40, 0}
+	return fileDescriptor_api_8495174e6b66f558, []int{40, 0}
+}
+
+type ChangePassphraseRequest_KDF int32
+
+const (
+	ChangePassphraseRequest_DEFAULT  ChangePassphraseRequest_KDF = 0
+	ChangePassphraseRequest_SCRYPT   ChangePassphraseRequest_KDF = 1
+	ChangePassphraseRequest_ARGON2ID ChangePassphraseRequest_KDF = 2
+)
+
+var ChangePassphraseRequest_KDF_name = map[int32]string{
+	0: "DEFAULT",
+	1: "SCRYPT",
+	2: "ARGON2ID",
+}
+var ChangePassphraseRequest_KDF_value = map[string]int32{
+	"DEFAULT":  0,
+	"SCRYPT":   1,
+	"ARGON2ID": 2,
+}
+
+func (x ChangePassphraseRequest_KDF) String() string {
+	return proto.EnumName(ChangePassphraseRequest_KDF_name, int32(x))
+}
+func (ChangePassphraseRequest_KDF) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{40, 1}
 }
 
 type ConstructTransactionRequest_OutputSelectionAlgorithm int32
@@ -253,7 +305,7 @@ func (x ConstructTransactionRequest_OutputSelectionAlgorithm) String() string {
 	return proto.EnumName(ConstructTransactionRequest_OutputSelectionAlgorithm_name, int32(x))
 }
 func (ConstructTransactionRequest_OutputSelectionAlgorithm) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{46, 0}
+	return fileDescriptor_api_8495174e6b66f558, []int{46, 0}
 }
 
 type CreateSignatureRequest_SigHashType int32
@@ -288,7 +340,7 @@ func (x CreateSignatureRequest_SigHashType) String() string {
 	return proto.EnumName(CreateSignatureRequest_SigHashType_name, int32(x))
 }
 func (CreateSignatureRequest_SigHashType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{52, 0}
+	return fileDescriptor_api_8495174e6b66f558, []int{52, 0}
 }
 
 type DecodedTransaction_Input_TreeType int32
@@ -314,7 +366,7 @@ func (x DecodedTransaction_Input_TreeType) String() string {
 	return proto.EnumName(DecodedTransaction_Input_TreeType_name, int32(x))
 }
 func (DecodedTransaction_Input_TreeType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{142, 0, 0}
+	return fileDescriptor_api_8495174e6b66f558, []int{142, 0, 0}
 }
 
 type DecodedTransaction_Output_ScriptClass int32
@@ -367,7 +419,7 @@ func (x DecodedTransaction_Output_ScriptClass) String() string {
 	return proto.EnumName(DecodedTransaction_Output_ScriptClass_name, int32(x))
 }
 func (DecodedTransaction_Output_ScriptClass) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{142, 1, 0}
+	return fileDescriptor_api_8495174e6b66f558, []int{142, 1, 0}
 }
 
 type ValidateAddressResponse_ScriptType int32
@@ -420,7 +472,30 @@ func (x ValidateAddressResponse_ScriptType) String() string {
 	return proto.EnumName(ValidateAddressResponse_ScriptType_name, int32(x))
 }
 func (ValidateAddressResponse_ScriptType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{146, 0}
+	return fileDescriptor_api_8495174e6b66f558, []int{146, 0}
+}
+
+type RevocationNotificationsResponse_Result_Reason int32
+
+const (
+	RevocationNotificationsResponse_Result_MISSED  RevocationNotificationsResponse_Result_Reason = 0
+	RevocationNotificationsResponse_Result_EXPIRED RevocationNotificationsResponse_Result_Reason = 1
+)
+
+var RevocationNotificationsResponse_Result_Reason_name = map[int32]string{
+	0: "MISSED",
+	1: "EXPIRED",
+}
+var RevocationNotificationsResponse_Result_Reason_value = map[string]int32{
+	"MISSED":  0,
+	"EXPIRED": 1,
+}
+
+func (x RevocationNotificationsResponse_Result_Reason) String() string {
+	return proto.EnumName(RevocationNotificationsResponse_Result_Reason_name, int32(x))
+}
+func (RevocationNotificationsResponse_Result_Reason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{159, 0, 0}
 }
 
 type VersionRequest struct {
@@ -433,7 +508,7 @@ func (m *VersionRequest) Reset()         { *m = VersionRequest{} }
 func (m *VersionRequest) String() string { return proto.CompactTextString(m) }
 func (*VersionRequest) ProtoMessage()    {}
 func (*VersionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{0}
+	return fileDescriptor_api_8495174e6b66f558, []int{0}
 }
 func (m *VersionRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VersionRequest.Unmarshal(m, b)
@@ -469,7 +544,7 @@ func (m *VersionResponse) Reset()         { *m = VersionResponse{} }
 func (m *VersionResponse) String() string { return proto.CompactTextString(m) }
 func (*VersionResponse) ProtoMessage()    {}
 func (*VersionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{1}
+	return fileDescriptor_api_8495174e6b66f558, []int{1}
 }
 func (m *VersionResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VersionResponse.Unmarshal(m, b)
@@ -548,7 +623,7 @@ func (m *TransactionDetails) Reset()         { *m = TransactionDetails{} }
 func (m *TransactionDetails) String() string { return proto.CompactTextString(m) }
 func (*TransactionDetails) ProtoMessage()    {}
 func (*TransactionDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{2}
+	return fileDescriptor_api_8495174e6b66f558, []int{2}
 }
 func (m *TransactionDetails) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TransactionDetails.Unmarshal(m, b)
@@ -630,7 +705,7 @@ func (m *TransactionDetails_Input) Reset()         { *m = TransactionDetails_Inp
 func (m *TransactionDetails_Input) String() string { return proto.CompactTextString(m) }
 func (*TransactionDetails_Input) ProtoMessage()    {}
 func (*TransactionDetails_Input) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{2, 0}
+	return fileDescriptor_api_8495174e6b66f558, []int{2, 0}
 }
 func (m *TransactionDetails_Input) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TransactionDetails_Input.Unmarshal(m, b)
@@ -687,7 +762,7 @@ func (m *TransactionDetails_Output) Reset()         { *m = TransactionDetails_Ou
 func (m *TransactionDetails_Output) String() string { return proto.CompactTextString(m) }
 func (*TransactionDetails_Output) ProtoMessage()    {}
 func (*TransactionDetails_Output) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{2, 1}
+	return fileDescriptor_api_8495174e6b66f558, []int{2, 1}
 }
 func (m *TransactionDetails_Output) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TransactionDetails_Output.Unmarshal(m, b)
@@ -764,7 +839,7 @@ func (m *BlockDetails) Reset()         { *m = BlockDetails{} }
 func (m *BlockDetails) String() string { return proto.CompactTextString(m) }
 func (*BlockDetails) ProtoMessage()    {}
 func (*BlockDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{3}
+	return fileDescriptor_api_8495174e6b66f558, []int{3}
 }
 func (m *BlockDetails) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockDetails.Unmarshal(m, b)
@@ -831,7 +906,7 @@ func (m *AccountBalance) Reset()         { *m = AccountBalance{} }
 func (m *AccountBalance) String() string { return proto.CompactTextString(m) }
 func (*AccountBalance) ProtoMessage()    {}
 func (*AccountBalance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{4}
+	return fileDescriptor_api_8495174e6b66f558, []int{4}
 }
 func (m *AccountBalance) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AccountBalance.Unmarshal(m, b)
@@ -875,7 +950,7 @@ func (m *PingRequest) Reset()         { *m = PingRequest{} }
 func (m *PingRequest) String() string { return proto.CompactTextString(m) }
 func (*PingRequest) ProtoMessage()    {}
 func (*PingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{5}
+	return fileDescriptor_api_8495174e6b66f558, []int{5}
 }
 func (m *PingRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PingRequest.Unmarshal(m, b)
@@ -905,7 +980,7 @@ func (m *PingResponse) Reset()         { *m = PingResponse{} }
 func (m *PingResponse) String() string { return proto.CompactTextString(m) }
 func (*PingResponse) ProtoMessage()    {}
 func (*PingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{6}
+	return fileDescriptor_api_8495174e6b66f558, []int{6}
 }
 func (m *PingResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PingResponse.Unmarshal(m, b)
@@ -935,7 +1010,7 @@ func (m *NetworkRequest) Reset()         { *m = NetworkRequest{} }
 func (m *NetworkRequest) String() string { return proto.CompactTextString(m) }
 func (*NetworkRequest) ProtoMessage()    {}
 func (*NetworkRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{7}
+	return fileDescriptor_api_8495174e6b66f558, []int{7}
 }
 func (m *NetworkRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NetworkRequest.Unmarshal(m, b)
@@ -966,7 +1041,7 @@ func (m *NetworkResponse) Reset()         { *m = NetworkResponse{} }
 func (m *NetworkResponse) String() string { return proto.CompactTextString(m) }
 func (*NetworkResponse) ProtoMessage()    {}
 func (*NetworkResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{8}
+	return fileDescriptor_api_8495174e6b66f558, []int{8}
 }
 func (m *NetworkResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NetworkResponse.Unmarshal(m, b)
@@ -1004,7 +1079,7 @@ func (m *AccountNumberRequest) Reset()         { *m = AccountNumberRequest{} }
 func (m *AccountNumberRequest) String() string { return proto.CompactTextString(m) }
 func (*AccountNumberRequest) ProtoMessage()    {}
 func (*AccountNumberRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{9}
+	return fileDescriptor_api_8495174e6b66f558, []int{9}
 }
 func (m *AccountNumberRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AccountNumberRequest.Unmarshal(m, b)
@@ -1042,7 +1117,7 @@ func (m *AccountNumberResponse) Reset()         { *m = AccountNumberResponse{} }
 func (m *AccountNumberResponse) String() string { return proto.CompactTextString(m) }
 func (*AccountNumberResponse) ProtoMessage()    {}
 func (*AccountNumberResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{10}
+	return fileDescriptor_api_8495174e6b66f558, []int{10}
 }
 func (m *AccountNumberResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AccountNumberResponse.Unmarshal(m, b)
@@ -1079,7 +1154,7 @@ func (m *AccountsRequest) Reset()         { *m = AccountsRequest{} }
 func (m *AccountsRequest) String() string { return proto.CompactTextString(m) }
 func (*AccountsRequest) ProtoMessage()    {}
 func (*AccountsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{11}
+	return fileDescriptor_api_8495174e6b66f558, []int{11}
 }
 func (m *AccountsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AccountsRequest.Unmarshal(m, b)
@@ -1112,7 +1187,7 @@ func (m *AccountsResponse) Reset()         { *m = AccountsResponse{} }
 func (m *AccountsResponse) String() string { return proto.CompactTextString(m) }
 func (*AccountsResponse) ProtoMessage()    {}
 func (*AccountsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{12}
+	return fileDescriptor_api_8495174e6b66f558, []int{12}
 }
 func (m *AccountsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AccountsResponse.Unmarshal(m, b)
@@ -1169,7 +1244,7 @@ func (m *AccountsResponse_Account) Reset()         { *m = AccountsResponse_Accou
 func (m *AccountsResponse_Account) String() string { return proto.CompactTextString(m) }
 func (*AccountsResponse_Account) ProtoMessage()    {}
 func (*AccountsResponse_Account) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{12, 0}
+	return fileDescriptor_api_8495174e6b66f558, []int{12, 0}
 }
 func (m *AccountsResponse_Account) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AccountsResponse_Account.Unmarshal(m, b)
@@ -1243,7 +1318,7 @@ func (m *RenameAccountRequest) Reset()         { *m = RenameAccountRequest{} }
 func (m *RenameAccountRequest) String() string { return proto.CompactTextString(m) }
 func (*RenameAccountRequest) ProtoMessage()    {}
 func (*RenameAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{13}
+	return fileDescriptor_api_8495174e6b66f558, []int{13}
 }
 func (m *RenameAccountRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RenameAccountRequest.Unmarshal(m, b)
@@ -1287,7 +1362,7 @@ func (m *RenameAccountResponse) Reset()         { *m = RenameAccountResponse{} }
 func (m *RenameAccountResponse) String() string { return proto.CompactTextString(m) }
 func (*RenameAccountResponse) ProtoMessage()    {}
 func (*RenameAccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{14}
+	return fileDescriptor_api_8495174e6b66f558, []int{14}
 }
 func (m *RenameAccountResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RenameAccountResponse.Unmarshal(m, b)
@@ -1319,7 +1394,7 @@ func (m *RescanRequest) Reset()         { *m = RescanRequest{} }
 func (m *RescanRequest) String() string { return proto.CompactTextString(m) }
 func (*RescanRequest) ProtoMessage()    {}
 func (*RescanRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{15}
+	return fileDescriptor_api_8495174e6b66f558, []int{15}
 }
 func (m *RescanRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RescanRequest.Unmarshal(m, b)
@@ -1364,7 +1439,7 @@ func (m *RescanResponse) Reset()         { *m = RescanResponse{} }
 func (m *RescanResponse) String() string { return proto.CompactTextString(m) }
 func (*RescanResponse) ProtoMessage()    {}
 func (*RescanResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{16}
+	return fileDescriptor_api_8495174e6b66f558, []int{16}
 }
 func (m *RescanResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RescanResponse.Unmarshal(m, b)
@@ -1392,18 +1467,21 @@ func (m *RescanResponse) GetRescannedThrough() int32 {
 }
 
 type NextAccountRequest struct {
-	Passphrase           []byte   `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
-	AccountName          string   `protobuf:"bytes,2,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Passphrase                    []byte   `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	AccountName                   string   `protobuf:"bytes,2,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	AccountPassphrase             []byte   `protobuf:"bytes,3,opt,name=account_passphrase,json=accountPassphrase,proto3" json:"account_passphrase,omitempty"`
+	VotingAccount                 bool     `protobuf:"varint,4,opt,name=voting_account,json=votingAccount,proto3" json:"voting_account,omitempty"`
+	AcknowledgeNotSeedRecoverable bool     `protobuf:"varint,5,opt,name=acknowledge_not_seed_recoverable,json=acknowledgeNotSeedRecoverable,proto3" json:"acknowledge_not_seed_recoverable,omitempty"`
+	XXX_NoUnkeyedLiteral          struct{} `json:"-"`
+	XXX_unrecognized              []byte   `json:"-"`
+	XXX_sizecache                 int32    `json:"-"`
 }
 
 func (m *NextAccountRequest) Reset()         { *m = NextAccountRequest{} }
 func (m *NextAccountRequest) String() string { return proto.CompactTextString(m) }
 func (*NextAccountRequest) ProtoMessage()    {}
 func (*NextAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{17}
+	return fileDescriptor_api_8495174e6b66f558, []int{17}
 }
 func (m *NextAccountRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NextAccountRequest.Unmarshal(m, b)
@@ -1437,6 +1515,27 @@ func (m *NextAccountRequest) GetAccountName() string {
 	return ""
 }
 
+func (m *NextAccountRequest) GetAccountPassphrase() []byte {
+	if m != nil {
+		return m.AccountPassphrase
+	}
+	return nil
+}
+
+func (m *NextAccountRequest) GetVotingAccount() bool {
+	if m != nil {
+		return m.VotingAccount
+	}
+	return false
+}
+
+func (m *NextAccountRequest) GetAcknowledgeNotSeedRecoverable() bool {
+	if m != nil {
+		return m.AcknowledgeNotSeedRecoverable
+	}
+	return false
+}
+
 type NextAccountResponse struct {
 	AccountNumber        uint32   `protobuf:"varint,1,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1448,7 +1547,7 @@ func (m *NextAccountResponse) Reset()         { *m = NextAccountResponse{} }
 func (m *NextAccountResponse) String() string { return proto.CompactTextString(m) }
 func (*NextAccountResponse) ProtoMessage()    {}
 func (*NextAccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{18}
+	return fileDescriptor_api_8495174e6b66f558, []int{18}
 }
 func (m *NextAccountResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NextAccountResponse.Unmarshal(m, b)
@@ -1488,7 +1587,7 @@ func (m *NextAddressRequest) Reset()         { *m = NextAddressRequest{} }
 func (m *NextAddressRequest) String() string { return proto.CompactTextString(m) }
 func (*NextAddressRequest) ProtoMessage()    {}
 func (*NextAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{19}
+	return fileDescriptor_api_8495174e6b66f558, []int{19}
 }
 func (m *NextAddressRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NextAddressRequest.Unmarshal(m, b)
@@ -1541,7 +1640,7 @@ func (m *NextAddressResponse) Reset()         { *m = NextAddressResponse{} }
 func (m *NextAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*NextAddressResponse) ProtoMessage()    {}
 func (*NextAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{20}
+	return fileDescriptor_api_8495174e6b66f558, []int{20}
 }
 func (m *NextAddressResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NextAddressResponse.Unmarshal(m, b)
@@ -1590,7 +1689,7 @@ func (m *ImportPrivateKeyRequest) Reset()         { *m = ImportPrivateKeyRequest
 func (m *ImportPrivateKeyRequest) String() string { return proto.CompactTextString(m) }
 func (*ImportPrivateKeyRequest) ProtoMessage()    {}
 func (*ImportPrivateKeyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{21}
+	return fileDescriptor_api_8495174e6b66f558, []int{21}
 }
 func (m *ImportPrivateKeyRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ImportPrivateKeyRequest.Unmarshal(m, b)
@@ -1655,7 +1754,7 @@ func (m *ImportPrivateKeyResponse) Reset()         { *m = ImportPrivateKeyRespon
 func (m *ImportPrivateKeyResponse) String() string { return proto.CompactTextString(m) }
 func (*ImportPrivateKeyResponse) ProtoMessage()    {}
 func (*ImportPrivateKeyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{22}
+	return fileDescriptor_api_8495174e6b66f558, []int{22}
 }
 func (m *ImportPrivateKeyResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ImportPrivateKeyResponse.Unmarshal(m, b)
@@ -1690,7 +1789,7 @@ func (m *ImportScriptRequest) Reset()         { *m = ImportScriptRequest{} }
 func (m *ImportScriptRequest) String() string { return proto.CompactTextString(m) }
 func (*ImportScriptRequest) ProtoMessage()    {}
 func (*ImportScriptRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{23}
+	return fileDescriptor_api_8495174e6b66f558, []int{23}
 }
 func (m *ImportScriptRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ImportScriptRequest.Unmarshal(m, b)
@@ -1757,7 +1856,7 @@ func (m *ImportScriptResponse) Reset()         { *m = ImportScriptResponse{} }
 func (m *ImportScriptResponse) String() string { return proto.CompactTextString(m) }
 func (*ImportScriptResponse) ProtoMessage()    {}
 func (*ImportScriptResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{24}
+	return fileDescriptor_api_8495174e6b66f558, []int{24}
 }
 func (m *ImportScriptResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ImportScriptResponse.Unmarshal(m, b)
@@ -1803,7 +1902,7 @@ func (m *BalanceRequest) Reset()         { *m = BalanceRequest{} }
 func (m *BalanceRequest) String() string { return proto.CompactTextString(m) }
 func (*BalanceRequest) ProtoMessage()    {}
 func (*BalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{25}
+	return fileDescriptor_api_8495174e6b66f558, []int{25}
 }
 func (m *BalanceRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BalanceRequest.Unmarshal(m, b)
@@ -1854,7 +1953,7 @@ func (m *BalanceResponse) Reset()         { *m = BalanceResponse{} }
 func (m *BalanceResponse) String() string { return proto.CompactTextString(m) }
 func (*BalanceResponse) ProtoMessage()    {}
 func (*BalanceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{26}
+	return fileDescriptor_api_8495174e6b66f558, []int{26}
 }
 func (m *BalanceResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BalanceResponse.Unmarshal(m, b)
@@ -1934,7 +2033,7 @@ func (m *GetTransactionRequest) Reset()         { *m = GetTransactionRequest{} }
 func (m *GetTransactionRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTransactionRequest) ProtoMessage()    {}
 func (*GetTransactionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{27}
+	return fileDescriptor_api_8495174e6b66f558, []int{27}
 }
 func (m *GetTransactionRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetTransactionRequest.Unmarshal(m, b)
@@ -1974,7 +2073,7 @@ func (m *GetTransactionResponse) Reset()         { *m = GetTransactionResponse{}
 func (m *GetTransactionResponse) String() string { return proto.CompactTextString(m) }
 func (*GetTransactionResponse) ProtoMessage()    {}
 func (*GetTransactionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{28}
+	return fileDescriptor_api_8495174e6b66f558, []int{28}
 }
 func (m *GetTransactionResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetTransactionResponse.Unmarshal(m, b)
@@ -2047,7 +2146,7 @@ func (m *GetTransactionsRequest) Reset()         { *m = GetTransactionsRequest{}
 func (m *GetTransactionsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTransactionsRequest) ProtoMessage()    {}
 func (*GetTransactionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{29}
+	return fileDescriptor_api_8495174e6b66f558, []int{29}
 }
 func (m *GetTransactionsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetTransactionsRequest.Unmarshal(m, b)
@@ -2121,7 +2220,7 @@ func (m *GetTransactionsResponse) Reset()         { *m = GetTransactionsResponse
 func (m *GetTransactionsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetTransactionsResponse) ProtoMessage()    {}
 func (*GetTransactionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{30}
+	return fileDescriptor_api_8495174e6b66f558, []int{30}
 }
 func (m *GetTransactionsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetTransactionsResponse.Unmarshal(m, b)
@@ -2166,7 +2265,7 @@ func (m *GetTicketRequest) Reset()         { *m = GetTicketRequest{} }
 func (m *GetTicketRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTicketRequest) ProtoMessage()    {}
 func (*GetTicketRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{31}
+	return fileDescriptor_api_8495174e6b66f558, []int{31}
 }
 func (m *GetTicketRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetTicketRequest.Unmarshal(m, b)
@@ -2208,7 +2307,7 @@ func (m *GetTicketsRequest) Reset()         { *m = GetTicketsRequest{} }
 func (m *GetTicketsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTicketsRequest) ProtoMessage()    {}
 func (*GetTicketsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{32}
+	return fileDescriptor_api_8495174e6b66f558, []int{32}
 }
 func (m *GetTicketsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetTicketsRequest.Unmarshal(m, b)
@@ -2275,7 +2374,7 @@ func (m *GetTicketsResponse) Reset()         { *m = GetTicketsResponse{} }
 func (m *GetTicketsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetTicketsResponse) ProtoMessage()    {}
 func (*GetTicketsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{33}
+	return fileDescriptor_api_8495174e6b66f558, []int{33}
 }
 func (m *GetTicketsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetTicketsResponse.Unmarshal(m, b)
@@ -2322,7 +2421,7 @@ func (m *GetTicketsResponse_TicketDetails) Reset()         { *m = GetTicketsResp
 func (m *GetTicketsResponse_TicketDetails) String() string { return proto.CompactTextString(m) }
 func (*GetTicketsResponse_TicketDetails) ProtoMessage()    {}
 func (*GetTicketsResponse_TicketDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{33, 0}
+	return fileDescriptor_api_8495174e6b66f558, []int{33, 0}
 }
 func (m *GetTicketsResponse_TicketDetails) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetTicketsResponse_TicketDetails.Unmarshal(m, b)
@@ -2376,7 +2475,7 @@ func (m *GetTicketsResponse_BlockDetails) Reset()         { *m = GetTicketsRespo
 func (m *GetTicketsResponse_BlockDetails) String() string { return proto.CompactTextString(m) }
 func (*GetTicketsResponse_BlockDetails) ProtoMessage()    {}
 func (*GetTicketsResponse_BlockDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{33, 1}
+	return fileDescriptor_api_8495174e6b66f558, []int{33, 1}
 }
 func (m *GetTicketsResponse_BlockDetails) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetTicketsResponse_BlockDetails.Unmarshal(m, b)
@@ -2427,7 +2526,7 @@ func (m *TicketPriceRequest) Reset()         { *m = TicketPriceRequest{} }
 func (m *TicketPriceRequest) String() string { return proto.CompactTextString(m) }
 func (*TicketPriceRequest) ProtoMessage()    {}
 func (*TicketPriceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{34}
+	return fileDescriptor_api_8495174e6b66f558, []int{34}
 }
 func (m *TicketPriceRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TicketPriceRequest.Unmarshal(m, b)
@@ -2459,7 +2558,7 @@ func (m *TicketPriceResponse) Reset()         { *m = TicketPriceResponse{} }
 func (m *TicketPriceResponse) String() string { return proto.CompactTextString(m) }
 func (*TicketPriceResponse) ProtoMessage()    {}
 func (*TicketPriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{35}
+	return fileDescriptor_api_8495174e6b66f558, []int{35}
 }
 func (m *TicketPriceResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TicketPriceResponse.Unmarshal(m, b)
@@ -2503,7 +2602,7 @@ func (m *StakeInfoRequest) Reset()         { *m = StakeInfoRequest{} }
 func (m *StakeInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*StakeInfoRequest) ProtoMessage()    {}
 func (*StakeInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{36}
+	return fileDescriptor_api_8495174e6b66f558, []int{36}
 }
 func (m *StakeInfoRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StakeInfoRequest.Unmarshal(m, b)
@@ -2544,7 +2643,7 @@ func (m *StakeInfoResponse) Reset()         { *m = StakeInfoResponse{} }
 func (m *StakeInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*StakeInfoResponse) ProtoMessage()    {}
 func (*StakeInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{37}
+	return fileDescriptor_api_8495174e6b66f558, []int{37}
 }
 func (m *StakeInfoResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StakeInfoResponse.Unmarshal(m, b)
@@ -2653,7 +2752,7 @@ func (m *BlockInfoRequest) Reset()         { *m = BlockInfoRequest{} }
 func (m *BlockInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*BlockInfoRequest) ProtoMessage()    {}
 func (*BlockInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{38}
+	return fileDescriptor_api_8495174e6b66f558, []int{38}
 }
 func (m *BlockInfoRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockInfoRequest.Unmarshal(m, b)
@@ -2704,7 +2803,7 @@ func (m *BlockInfoResponse) Reset()         { *m = BlockInfoResponse{} }
 func (m *BlockInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*BlockInfoResponse) ProtoMessage()    {}
 func (*BlockInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{39}
+	return fileDescriptor_api_8495174e6b66f558, []int{39}
 }
 func (m *BlockInfoResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockInfoResponse.Unmarshal(m, b)
@@ -2777,6 +2876,11 @@ type ChangePassphraseRequest struct {
 	Key                  ChangePassphraseRequest_Key `protobuf:"varint,1,opt,name=key,proto3,enum=walletrpc.ChangePassphraseRequest_Key" json:"key,omitempty"`
 	OldPassphrase        []byte                      `protobuf:"bytes,2,opt,name=old_passphrase,json=oldPassphrase,proto3" json:"old_passphrase,omitempty"`
 	NewPassphrase        []byte                      `protobuf:"bytes,3,opt,name=new_passphrase,json=newPassphrase,proto3" json:"new_passphrase,omitempty"`
+	Account              uint32                      `protobuf:"varint,4,opt,name=account,proto3" json:"account,omitempty"`
+	Kdf                  ChangePassphraseRequest_KDF `protobuf:"varint,5,opt,name=kdf,proto3,enum=walletrpc.ChangePassphraseRequest_KDF" json:"kdf,omitempty"`
+	KdfN                 uint32                      `protobuf:"varint,6,opt,name=kdf_n,json=kdfN,proto3" json:"kdf_n,omitempty"`
+	KdfR                 uint32                      `protobuf:"varint,7,opt,name=kdf_r,json=kdfR,proto3" json:"kdf_r,omitempty"`
+	KdfP                 uint32                      `protobuf:"varint,8,opt,name=kdf_p,json=kdfP,proto3" json:"kdf_p,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
 	XXX_unrecognized     []byte                      `json:"-"`
 	XXX_sizecache        int32                       `json:"-"`
@@ -2786,7 +2890,7 @@ func (m *ChangePassphraseRequest) Reset()         { *m = ChangePassphraseRequest
 func (m *ChangePassphraseRequest) String() string { return proto.CompactTextString(m) }
 func (*ChangePassphraseRequest) ProtoMessage()    {}
 func (*ChangePassphraseRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{40}
+	return fileDescriptor_api_8495174e6b66f558, []int{40}
 }
 func (m *ChangePassphraseRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChangePassphraseRequest.Unmarshal(m, b)
@@ -2827,6 +2931,41 @@ func (m *ChangePassphraseRequest) GetNewPassphrase() []byte {
 	return nil
 }
 
+func (m *ChangePassphraseRequest) GetAccount() uint32 {
+	if m != nil {
+		return m.Account
+	}
+	return 0
+}
+
+func (m *ChangePassphraseRequest) GetKdf() ChangePassphraseRequest_KDF {
+	if m != nil {
+		return m.Kdf
+	}
+	return ChangePassphraseRequest_DEFAULT
+}
+
+func (m *ChangePassphraseRequest) GetKdfN() uint32 {
+	if m != nil {
+		return m.KdfN
+	}
+	return 0
+}
+
+func (m *ChangePassphraseRequest) GetKdfR() uint32 {
+	if m != nil {
+		return m.KdfR
+	}
+	return 0
+}
+
+func (m *ChangePassphraseRequest) GetKdfP() uint32 {
+	if m != nil {
+		return m.KdfP
+	}
+	return 0
+}
+
 type ChangePassphraseResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -2837,7 +2976,7 @@ func (m *ChangePassphraseResponse) Reset()         { *m = ChangePassphraseRespon
 func (m *ChangePassphraseResponse) String() string { return proto.CompactTextString(m) }
 func (*ChangePassphraseResponse) ProtoMessage()    {}
 func (*ChangePassphraseResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{41}
+	return fileDescriptor_api_8495174e6b66f558, []int{41}
 }
 func (m *ChangePassphraseResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChangePassphraseResponse.Unmarshal(m, b)
@@ -2872,7 +3011,7 @@ func (m *FundTransactionRequest) Reset()         { *m = FundTransactionRequest{}
 func (m *FundTransactionRequest) String() string { return proto.CompactTextString(m) }
 func (*FundTransactionRequest) ProtoMessage()    {}
 func (*FundTransactionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{42}
+	return fileDescriptor_api_8495174e6b66f558, []int{42}
 }
 func (m *FundTransactionRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FundTransactionRequest.Unmarshal(m, b)
@@ -2940,7 +3079,7 @@ func (m *FundTransactionResponse) Reset()         { *m = FundTransactionResponse
 func (m *FundTransactionResponse) String() string { return proto.CompactTextString(m) }
 func (*FundTransactionResponse) ProtoMessage()    {}
 func (*FundTransactionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{43}
+	return fileDescriptor_api_8495174e6b66f558, []int{43}
 }
 func (m *FundTransactionResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FundTransactionResponse.Unmarshal(m, b)
@@ -3000,7 +3139,7 @@ func (m *FundTransactionResponse_PreviousOutput) Reset() {
 func (m *FundTransactionResponse_PreviousOutput) String() string { return proto.CompactTextString(m) }
 func (*FundTransactionResponse_PreviousOutput) ProtoMessage()    {}
 func (*FundTransactionResponse_PreviousOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{43, 0}
+	return fileDescriptor_api_8495174e6b66f558, []int{43, 0}
 }
 func (m *FundTransactionResponse_PreviousOutput) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FundTransactionResponse_PreviousOutput.Unmarshal(m, b)
@@ -3083,7 +3222,7 @@ func (m *UnspentOutputsRequest) Reset()         { *m = UnspentOutputsRequest{} }
 func (m *UnspentOutputsRequest) String() string { return proto.CompactTextString(m) }
 func (*UnspentOutputsRequest) ProtoMessage()    {}
 func (*UnspentOutputsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{44}
+	return fileDescriptor_api_8495174e6b66f558, []int{44}
 }
 func (m *UnspentOutputsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UnspentOutputsRequest.Unmarshal(m, b)
@@ -3149,7 +3288,7 @@ func (m *UnspentOutputResponse) Reset()         { *m = UnspentOutputResponse{} }
 func (m *UnspentOutputResponse) String() string { return proto.CompactTextString(m) }
 func (*UnspentOutputResponse) ProtoMessage()    {}
 func (*UnspentOutputResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{45}
+	return fileDescriptor_api_8495174e6b66f558, []int{45}
 }
 func (m *UnspentOutputResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UnspentOutputResponse.Unmarshal(m, b)
@@ -3241,7 +3380,7 @@ func (m *ConstructTransactionRequest) Reset()         { *m = ConstructTransactio
 func (m *ConstructTransactionRequest) String() string { return proto.CompactTextString(m) }
 func (*ConstructTransactionRequest) ProtoMessage()    {}
 func (*ConstructTransactionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{46}
+	return fileDescriptor_api_8495174e6b66f558, []int{46}
 }
 func (m *ConstructTransactionRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConstructTransactionRequest.Unmarshal(m, b)
@@ -3320,7 +3459,7 @@ func (m *ConstructTransactionRequest_OutputDestination) String() string {
 }
 func (*ConstructTransactionRequest_OutputDestination) ProtoMessage() {}
 func (*ConstructTransactionRequest_OutputDestination) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{46, 0}
+	return fileDescriptor_api_8495174e6b66f558, []int{46, 0}
 }
 func (m *ConstructTransactionRequest_OutputDestination) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConstructTransactionRequest_OutputDestination.Unmarshal(m, b)
@@ -3373,7 +3512,7 @@ func (m *ConstructTransactionRequest_Output) Reset()         { *m = ConstructTra
 func (m *ConstructTransactionRequest_Output) String() string { return proto.CompactTextString(m) }
 func (*ConstructTransactionRequest_Output) ProtoMessage()    {}
 func (*ConstructTransactionRequest_Output) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{46, 1}
+	return fileDescriptor_api_8495174e6b66f558, []int{46, 1}
 }
 func (m *ConstructTransactionRequest_Output) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConstructTransactionRequest_Output.Unmarshal(m, b)
@@ -3422,7 +3561,7 @@ func (m *ConstructTransactionResponse) Reset()         { *m = ConstructTransacti
 func (m *ConstructTransactionResponse) String() string { return proto.CompactTextString(m) }
 func (*ConstructTransactionResponse) ProtoMessage()    {}
 func (*ConstructTransactionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{47}
+	return fileDescriptor_api_8495174e6b66f558, []int{47}
 }
 func (m *ConstructTransactionResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConstructTransactionResponse.Unmarshal(m, b)
@@ -3496,7 +3635,7 @@ func (m *SignTransactionRequest) Reset()         { *m = SignTransactionRequest{}
 func (m *SignTransactionRequest) String() string { return proto.CompactTextString(m) }
 func (*SignTransactionRequest) ProtoMessage()    {}
 func (*SignTransactionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{48}
+	return fileDescriptor_api_8495174e6b66f558, []int{48}
 }
 func (m *SignTransactionRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignTransactionRequest.Unmarshal(m, b)
@@ -3553,7 +3692,7 @@ func (m *SignTransactionRequest_AdditionalScript) Reset() {
 func (m *SignTransactionRequest_AdditionalScript) String() string { return proto.CompactTextString(m) }
 func (*SignTransactionRequest_AdditionalScript) ProtoMessage()    {}
 func (*SignTransactionRequest_AdditionalScript) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{48, 0}
+	return fileDescriptor_api_8495174e6b66f558, []int{48, 0}
 }
 func (m *SignTransactionRequest_AdditionalScript) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignTransactionRequest_AdditionalScript.Unmarshal(m, b)
@@ -3613,7 +3752,7 @@ func (m *SignTransactionResponse) Reset()         { *m = SignTransactionResponse
 func (m *SignTransactionResponse) String() string { return proto.CompactTextString(m) }
 func (*SignTransactionResponse) ProtoMessage()    {}
 func (*SignTransactionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{49}
+	return fileDescriptor_api_8495174e6b66f558, []int{49}
 }
 func (m *SignTransactionResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignTransactionResponse.Unmarshal(m, b)
@@ -3660,7 +3799,7 @@ func (m *SignTransactionsRequest) Reset()         { *m = SignTransactionsRequest
 func (m *SignTransactionsRequest) String() string { return proto.CompactTextString(m) }
 func (*SignTransactionsRequest) ProtoMessage()    {}
 func (*SignTransactionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{50}
+	return fileDescriptor_api_8495174e6b66f558, []int{50}
 }
 func (m *SignTransactionsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignTransactionsRequest.Unmarshal(m, b)
@@ -3717,7 +3856,7 @@ func (m *SignTransactionsRequest_AdditionalScript) Reset() {
 func (m *SignTransactionsRequest_AdditionalScript) String() string { return proto.CompactTextString(m) }
 func (*SignTransactionsRequest_AdditionalScript) ProtoMessage()    {}
 func (*SignTransactionsRequest_AdditionalScript) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{50, 0}
+	return fileDescriptor_api_8495174e6b66f558, []int{50, 0}
 }
 func (m *SignTransactionsRequest_AdditionalScript) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignTransactionsRequest_AdditionalScript.Unmarshal(m, b)
@@ -3780,7 +3919,7 @@ func (m *SignTransactionsRequest_UnsignedTransaction) String() string {
 }
 func (*SignTransactionsRequest_UnsignedTransaction) ProtoMessage() {}
 func (*SignTransactionsRequest_UnsignedTransaction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{50, 1}
+	return fileDescriptor_api_8495174e6b66f558, []int{50, 1}
 }
 func (m *SignTransactionsRequest_UnsignedTransaction) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignTransactionsRequest_UnsignedTransaction.Unmarshal(m, b)
@@ -3818,7 +3957,7 @@ func (m *SignTransactionsResponse) Reset()         { *m = SignTransactionsRespon
 func (m *SignTransactionsResponse) String() string { return proto.CompactTextString(m) }
 func (*SignTransactionsResponse) ProtoMessage()    {}
 func (*SignTransactionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{51}
+	return fileDescriptor_api_8495174e6b66f558, []int{51}
 }
 func (m *SignTransactionsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignTransactionsResponse.Unmarshal(m, b)
@@ -3861,7 +4000,7 @@ func (m *SignTransactionsResponse_SignedTransaction) String() string {
 }
 func (*SignTransactionsResponse_SignedTransaction) ProtoMessage() {}
 func (*SignTransactionsResponse_SignedTransaction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{51, 0}
+	return fileDescriptor_api_8495174e6b66f558, []int{51, 0}
 }
 func (m *SignTransactionsResponse_SignedTransaction) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignTransactionsResponse_SignedTransaction.Unmarshal(m, b)
@@ -3911,7 +4050,7 @@ func (m *CreateSignatureRequest) Reset()         { *m = CreateSignatureRequest{}
 func (m *CreateSignatureRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateSignatureRequest) ProtoMessage()    {}
 func (*CreateSignatureRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{52}
+	return fileDescriptor_api_8495174e6b66f558, []int{52}
 }
 func (m *CreateSignatureRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateSignatureRequest.Unmarshal(m, b)
@@ -3985,7 +4124,7 @@ func (m *CreateSignatureResponse) Reset()         { *m = CreateSignatureResponse
 func (m *CreateSignatureResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateSignatureResponse) ProtoMessage()    {}
 func (*CreateSignatureResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{53}
+	return fileDescriptor_api_8495174e6b66f558, []int{53}
 }
 func (m *CreateSignatureResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateSignatureResponse.Unmarshal(m, b)
@@ -4030,7 +4169,7 @@ func (m *PublishTransactionRequest) Reset()         { *m = PublishTransactionReq
 func (m *PublishTransactionRequest) String() string { return proto.CompactTextString(m) }
 func (*PublishTransactionRequest) ProtoMessage()    {}
 func (*PublishTransactionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{54}
+	return fileDescriptor_api_8495174e6b66f558, []int{54}
 }
 func (m *PublishTransactionRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PublishTransactionRequest.Unmarshal(m, b)
@@ -4068,7 +4207,7 @@ func (m *PublishTransactionResponse) Reset()         { *m = PublishTransactionRe
 func (m *PublishTransactionResponse) String() string { return proto.CompactTextString(m) }
 func (*PublishTransactionResponse) ProtoMessage()    {}
 func (*PublishTransactionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{55}
+	return fileDescriptor_api_8495174e6b66f558, []int{55}
 }
 func (m *PublishTransactionResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PublishTransactionResponse.Unmarshal(m, b)
@@ -4105,7 +4244,7 @@ func (m *PublishUnminedTransactionsRequest) Reset()         { *m = PublishUnmine
 func (m *PublishUnminedTransactionsRequest) String() string { return proto.CompactTextString(m) }
 func (*PublishUnminedTransactionsRequest) ProtoMessage()    {}
 func (*PublishUnminedTransactionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{56}
+	return fileDescriptor_api_8495174e6b66f558, []int{56}
 }
 func (m *PublishUnminedTransactionsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PublishUnminedTransactionsRequest.Unmarshal(m, b)
@@ -4135,7 +4274,7 @@ func (m *PublishUnminedTransactionsResponse) Reset()         { *m = PublishUnmin
 func (m *PublishUnminedTransactionsResponse) String() string { return proto.CompactTextString(m) }
 func (*PublishUnminedTransactionsResponse) ProtoMessage()    {}
 func (*PublishUnminedTransactionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{57}
+	return fileDescriptor_api_8495174e6b66f558, []int{57}
 }
 func (m *PublishUnminedTransactionsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PublishUnminedTransactionsResponse.Unmarshal(m, b)
@@ -4176,7 +4315,7 @@ func (m *PurchaseTicketsRequest) Reset()         { *m = PurchaseTicketsRequest{}
 func (m *PurchaseTicketsRequest) String() string { return proto.CompactTextString(m) }
 func (*PurchaseTicketsRequest) ProtoMessage()    {}
 func (*PurchaseTicketsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{58}
+	return fileDescriptor_api_8495174e6b66f558, []int{58}
 }
 func (m *PurchaseTicketsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PurchaseTicketsRequest.Unmarshal(m, b)
@@ -4284,7 +4423,7 @@ func (m *PurchaseTicketsResponse) Reset()         { *m = PurchaseTicketsResponse
 func (m *PurchaseTicketsResponse) String() string { return proto.CompactTextString(m) }
 func (*PurchaseTicketsResponse) ProtoMessage()    {}
 func (*PurchaseTicketsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{59}
+	return fileDescriptor_api_8495174e6b66f558, []int{59}
 }
 func (m *PurchaseTicketsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PurchaseTicketsResponse.Unmarshal(m, b)
@@ -4322,7 +4461,7 @@ func (m *RevokeTicketsRequest) Reset()         { *m = RevokeTicketsRequest{} }
 func (m *RevokeTicketsRequest) String() string { return proto.CompactTextString(m) }
 func (*RevokeTicketsRequest) ProtoMessage()    {}
 func (*RevokeTicketsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{60}
+	return fileDescriptor_api_8495174e6b66f558, []int{60}
 }
 func (m *RevokeTicketsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RevokeTicketsRequest.Unmarshal(m, b)
@@ -4359,7 +4498,7 @@ func (m *RevokeTicketsResponse) Reset()         { *m = RevokeTicketsResponse{} }
 func (m *RevokeTicketsResponse) String() string { return proto.CompactTextString(m) }
 func (*RevokeTicketsResponse) ProtoMessage()    {}
 func (*RevokeTicketsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{61}
+	return fileDescriptor_api_8495174e6b66f558, []int{61}
 }
 func (m *RevokeTicketsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RevokeTicketsResponse.Unmarshal(m, b)
@@ -4389,7 +4528,7 @@ func (m *LoadActiveDataFiltersRequest) Reset()         { *m = LoadActiveDataFilt
 func (m *LoadActiveDataFiltersRequest) String() string { return proto.CompactTextString(m) }
 func (*LoadActiveDataFiltersRequest) ProtoMessage()    {}
 func (*LoadActiveDataFiltersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{62}
+	return fileDescriptor_api_8495174e6b66f558, []int{62}
 }
 func (m *LoadActiveDataFiltersRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LoadActiveDataFiltersRequest.Unmarshal(m, b)
@@ -4419,7 +4558,7 @@ func (m *LoadActiveDataFiltersResponse) Reset()         { *m = LoadActiveDataFil
 func (m *LoadActiveDataFiltersResponse) String() string { return proto.CompactTextString(m) }
 func (*LoadActiveDataFiltersResponse) ProtoMessage()    {}
 func (*LoadActiveDataFiltersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{63}
+	return fileDescriptor_api_8495174e6b66f558, []int{63}
 }
 func (m *LoadActiveDataFiltersResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LoadActiveDataFiltersResponse.Unmarshal(m, b)
@@ -4452,7 +4591,7 @@ func (m *SignMessageRequest) Reset()         { *m = SignMessageRequest{} }
 func (m *SignMessageRequest) String() string { return proto.CompactTextString(m) }
 func (*SignMessageRequest) ProtoMessage()    {}
 func (*SignMessageRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{64}
+	return fileDescriptor_api_8495174e6b66f558, []int{64}
 }
 func (m *SignMessageRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignMessageRequest.Unmarshal(m, b)
@@ -4504,7 +4643,7 @@ func (m *SignMessageResponse) Reset()         { *m = SignMessageResponse{} }
 func (m *SignMessageResponse) String() string { return proto.CompactTextString(m) }
 func (*SignMessageResponse) ProtoMessage()    {}
 func (*SignMessageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{65}
+	return fileDescriptor_api_8495174e6b66f558, []int{65}
 }
 func (m *SignMessageResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignMessageResponse.Unmarshal(m, b)
@@ -4543,7 +4682,7 @@ func (m *SignMessagesRequest) Reset()         { *m = SignMessagesRequest{} }
 func (m *SignMessagesRequest) String() string { return proto.CompactTextString(m) }
 func (*SignMessagesRequest) ProtoMessage()    {}
 func (*SignMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{66}
+	return fileDescriptor_api_8495174e6b66f558, []int{66}
 }
 func (m *SignMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignMessagesRequest.Unmarshal(m, b)
@@ -4589,7 +4728,7 @@ func (m *SignMessagesRequest_Message) Reset()         { *m = SignMessagesRequest
 func (m *SignMessagesRequest_Message) String() string { return proto.CompactTextString(m) }
 func (*SignMessagesRequest_Message) ProtoMessage()    {}
 func (*SignMessagesRequest_Message) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{66, 0}
+	return fileDescriptor_api_8495174e6b66f558, []int{66, 0}
 }
 func (m *SignMessagesRequest_Message) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignMessagesRequest_Message.Unmarshal(m, b)
@@ -4634,7 +4773,7 @@ func (m *SignMessagesResponse) Reset()         { *m = SignMessagesResponse{} }
 func (m *SignMessagesResponse) String() string { return proto.CompactTextString(m) }
 func (*SignMessagesResponse) ProtoMessage()    {}
 func (*SignMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{67}
+	return fileDescriptor_api_8495174e6b66f558, []int{67}
 }
 func (m *SignMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignMessagesResponse.Unmarshal(m, b)
@@ -4673,7 +4812,7 @@ func (m *SignMessagesResponse_SignReply) Reset()         { *m = SignMessagesResp
 func (m *SignMessagesResponse_SignReply) String() string { return proto.CompactTextString(m) }
 func (*SignMessagesResponse_SignReply) ProtoMessage()    {}
 func (*SignMessagesResponse_SignReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{67, 0}
+	return fileDescriptor_api_8495174e6b66f558, []int{67, 0}
 }
 func (m *SignMessagesResponse_SignReply) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignMessagesResponse_SignReply.Unmarshal(m, b)
@@ -4717,7 +4856,7 @@ func (m *TransactionNotificationsRequest) Reset()         { *m = TransactionNoti
 func (m *TransactionNotificationsRequest) String() string { return proto.CompactTextString(m) }
 func (*TransactionNotificationsRequest) ProtoMessage()    {}
 func (*TransactionNotificationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{68}
+	return fileDescriptor_api_8495174e6b66f558, []int{68}
 }
 func (m *TransactionNotificationsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TransactionNotificationsRequest.Unmarshal(m, b)
@@ -4763,7 +4902,7 @@ func (m *TransactionNotificationsResponse) Reset()         { *m = TransactionNot
 func (m *TransactionNotificationsResponse) String() string { return proto.CompactTextString(m) }
 func (*TransactionNotificationsResponse) ProtoMessage()    {}
 func (*TransactionNotificationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{69}
+	return fileDescriptor_api_8495174e6b66f558, []int{69}
 }
 func (m *TransactionNotificationsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TransactionNotificationsResponse.Unmarshal(m, b)
@@ -4821,7 +4960,7 @@ func (m *AccountNotificationsRequest) Reset()         { *m = AccountNotification
 func (m *AccountNotificationsRequest) String() string { return proto.CompactTextString(m) }
 func (*AccountNotificationsRequest) ProtoMessage()    {}
 func (*AccountNotificationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{70}
+	return fileDescriptor_api_8495174e6b66f558, []int{70}
 }
 func (m *AccountNotificationsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AccountNotificationsRequest.Unmarshal(m, b)
@@ -4856,7 +4995,7 @@ func (m *AccountNotificationsResponse) Reset()         { *m = AccountNotificatio
 func (m *AccountNotificationsResponse) String() string { return proto.CompactTextString(m) }
 func (*AccountNotificationsResponse) ProtoMessage()    {}
 func (*AccountNotificationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{71}
+	return fileDescriptor_api_8495174e6b66f558, []int{71}
 }
 func (m *AccountNotificationsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AccountNotificationsResponse.Unmarshal(m, b)
@@ -4923,7 +5062,7 @@ func (m *ConfirmationNotificationsRequest) Reset()         { *m = ConfirmationNo
 func (m *ConfirmationNotificationsRequest) String() string { return proto.CompactTextString(m) }
 func (*ConfirmationNotificationsRequest) ProtoMessage()    {}
 func (*ConfirmationNotificationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{72}
+	return fileDescriptor_api_8495174e6b66f558, []int{72}
 }
 func (m *ConfirmationNotificationsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfirmationNotificationsRequest.Unmarshal(m, b)
@@ -4968,7 +5107,7 @@ func (m *ConfirmationNotificationsResponse) Reset()         { *m = ConfirmationN
 func (m *ConfirmationNotificationsResponse) String() string { return proto.CompactTextString(m) }
 func (*ConfirmationNotificationsResponse) ProtoMessage()    {}
 func (*ConfirmationNotificationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{73}
+	return fileDescriptor_api_8495174e6b66f558, []int{73}
 }
 func (m *ConfirmationNotificationsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfirmationNotificationsResponse.Unmarshal(m, b)
@@ -5013,7 +5152,7 @@ func (m *ConfirmationNotificationsResponse_TransactionConfirmations) String() st
 }
 func (*ConfirmationNotificationsResponse_TransactionConfirmations) ProtoMessage() {}
 func (*ConfirmationNotificationsResponse_TransactionConfirmations) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{73, 0}
+	return fileDescriptor_api_8495174e6b66f558, []int{73, 0}
 }
 func (m *ConfirmationNotificationsResponse_TransactionConfirmations) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfirmationNotificationsResponse_TransactionConfirmations.Unmarshal(m, b)
@@ -5065,6 +5204,10 @@ type CreateWalletRequest struct {
 	PublicPassphrase     []byte   `protobuf:"bytes,1,opt,name=public_passphrase,json=publicPassphrase,proto3" json:"public_passphrase,omitempty"`
 	PrivatePassphrase    []byte   `protobuf:"bytes,2,opt,name=private_passphrase,json=privatePassphrase,proto3" json:"private_passphrase,omitempty"`
 	Seed                 []byte   `protobuf:"bytes,3,opt,name=seed,proto3" json:"seed,omitempty"`
+	Bip39Mnemonic        string   `protobuf:"bytes,4,opt,name=bip39_mnemonic,json=bip39Mnemonic,proto3" json:"bip39_mnemonic,omitempty"`
+	Bip39Passphrase      string   `protobuf:"bytes,5,opt,name=bip39_passphrase,json=bip39Passphrase,proto3" json:"bip39_passphrase,omitempty"`
+	BirthdayTime         int64    `protobuf:"varint,6,opt,name=birthday_time,json=birthdayTime,proto3" json:"birthday_time,omitempty"`
+	BirthdayHeight       int32    `protobuf:"varint,7,opt,name=birthday_height,json=birthdayHeight,proto3" json:"birthday_height,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -5074,7 +5217,7 @@ func (m *CreateWalletRequest) Reset()         { *m = CreateWalletRequest{} }
 func (m *CreateWalletRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateWalletRequest) ProtoMessage()    {}
 func (*CreateWalletRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{74}
+	return fileDescriptor_api_8495174e6b66f558, []int{74}
 }
 func (m *CreateWalletRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateWalletRequest.Unmarshal(m, b)
@@ -5115,6 +5258,34 @@ func (m *CreateWalletRequest) GetSeed() []byte {
 	return nil
 }
 
+func (m *CreateWalletRequest) GetBip39Mnemonic() string {
+	if m != nil {
+		return m.Bip39Mnemonic
+	}
+	return ""
+}
+
+func (m *CreateWalletRequest) GetBip39Passphrase() string {
+	if m != nil {
+		return m.Bip39Passphrase
+	}
+	return ""
+}
+
+func (m *CreateWalletRequest) GetBirthdayTime() int64 {
+	if m != nil {
+		return m.BirthdayTime
+	}
+	return 0
+}
+
+func (m *CreateWalletRequest) GetBirthdayHeight() int32 {
+	if m != nil {
+		return m.BirthdayHeight
+	}
+	return 0
+}
+
 type CreateWalletResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -5125,7 +5296,7 @@ func (m *CreateWalletResponse) Reset()         { *m = CreateWalletResponse{} }
 func (m *CreateWalletResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateWalletResponse) ProtoMessage()    {}
 func (*CreateWalletResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{75}
+	return fileDescriptor_api_8495174e6b66f558, []int{75}
 }
 func (m *CreateWalletResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateWalletResponse.Unmarshal(m, b)
@@ -5148,6 +5319,8 @@ var xxx_messageInfo_CreateWalletResponse proto.InternalMessageInfo
 type CreateWatchingOnlyWalletRequest struct {
 	ExtendedPubKey       string   `protobuf:"bytes,1,opt,name=extended_pub_key,json=extendedPubKey,proto3" json:"extended_pub_key,omitempty"`
 	PublicPassphrase     []byte   `protobuf:"bytes,2,opt,name=public_passphrase,json=publicPassphrase,proto3" json:"public_passphrase,omitempty"`
+	BirthdayTime         int64    `protobuf:"varint,3,opt,name=birthday_time,json=birthdayTime,proto3" json:"birthday_time,omitempty"`
+	BirthdayHeight       int32    `protobuf:"varint,4,opt,name=birthday_height,json=birthdayHeight,proto3" json:"birthday_height,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -5157,7 +5330,7 @@ func (m *CreateWatchingOnlyWalletRequest) Reset()         { *m = CreateWatchingO
 func (m *CreateWatchingOnlyWalletRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateWatchingOnlyWalletRequest) ProtoMessage()    {}
 func (*CreateWatchingOnlyWalletRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{76}
+	return fileDescriptor_api_8495174e6b66f558, []int{76}
 }
 func (m *CreateWatchingOnlyWalletRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateWatchingOnlyWalletRequest.Unmarshal(m, b)
@@ -5191,6 +5364,20 @@ func (m *CreateWatchingOnlyWalletRequest) GetPublicPassphrase() []byte {
 	return nil
 }
 
+func (m *CreateWatchingOnlyWalletRequest) GetBirthdayTime() int64 {
+	if m != nil {
+		return m.BirthdayTime
+	}
+	return 0
+}
+
+func (m *CreateWatchingOnlyWalletRequest) GetBirthdayHeight() int32 {
+	if m != nil {
+		return m.BirthdayHeight
+	}
+	return 0
+}
+
 type CreateWatchingOnlyWalletResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -5201,7 +5388,7 @@ func (m *CreateWatchingOnlyWalletResponse) Reset()         { *m = CreateWatching
 func (m *CreateWatchingOnlyWalletResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateWatchingOnlyWalletResponse) ProtoMessage()    {}
 func (*CreateWatchingOnlyWalletResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{77}
+	return fileDescriptor_api_8495174e6b66f558, []int{77}
 }
 func (m *CreateWatchingOnlyWalletResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateWatchingOnlyWalletResponse.Unmarshal(m, b)
@@ -5232,7 +5419,7 @@ func (m *OpenWalletRequest) Reset()         { *m = OpenWalletRequest{} }
 func (m *OpenWalletRequest) String() string { return proto.CompactTextString(m) }
 func (*OpenWalletRequest) ProtoMessage()    {}
 func (*OpenWalletRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{78}
+	return fileDescriptor_api_8495174e6b66f558, []int{78}
 }
 func (m *OpenWalletRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_OpenWalletRequest.Unmarshal(m, b)
@@ -5270,7 +5457,7 @@ func (m *OpenWalletResponse) Reset()         { *m = OpenWalletResponse{} }
 func (m *OpenWalletResponse) String() string { return proto.CompactTextString(m) }
 func (*OpenWalletResponse) ProtoMessage()    {}
 func (*OpenWalletResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{79}
+	return fileDescriptor_api_8495174e6b66f558, []int{79}
 }
 func (m *OpenWalletResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_OpenWalletResponse.Unmarshal(m, b)
@@ -5307,7 +5494,7 @@ func (m *CloseWalletRequest) Reset()         { *m = CloseWalletRequest{} }
 func (m *CloseWalletRequest) String() string { return proto.CompactTextString(m) }
 func (*CloseWalletRequest) ProtoMessage()    {}
 func (*CloseWalletRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{80}
+	return fileDescriptor_api_8495174e6b66f558, []int{80}
 }
 func (m *CloseWalletRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CloseWalletRequest.Unmarshal(m, b)
@@ -5337,7 +5524,7 @@ func (m *CloseWalletResponse) Reset()         { *m = CloseWalletResponse{} }
 func (m *CloseWalletResponse) String() string { return proto.CompactTextString(m) }
 func (*CloseWalletResponse) ProtoMessage()    {}
 func (*CloseWalletResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{81}
+	return fileDescriptor_api_8495174e6b66f558, []int{81}
 }
 func (m *CloseWalletResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CloseWalletResponse.Unmarshal(m, b)
@@ -5367,7 +5554,7 @@ func (m *WalletExistsRequest) Reset()         { *m = WalletExistsRequest{} }
 func (m *WalletExistsRequest) String() string { return proto.CompactTextString(m) }
 func (*WalletExistsRequest) ProtoMessage()    {}
 func (*WalletExistsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{82}
+	return fileDescriptor_api_8495174e6b66f558, []int{82}
 }
 func (m *WalletExistsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_WalletExistsRequest.Unmarshal(m, b)
@@ -5398,7 +5585,7 @@ func (m *WalletExistsResponse) Reset()         { *m = WalletExistsResponse{} }
 func (m *WalletExistsResponse) String() string { return proto.CompactTextString(m) }
 func (*WalletExistsResponse) ProtoMessage()    {}
 func (*WalletExistsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{83}
+	return fileDescriptor_api_8495174e6b66f558, []int{83}
 }
 func (m *WalletExistsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_WalletExistsResponse.Unmarshal(m, b)
@@ -5439,7 +5626,7 @@ func (m *StartConsensusRpcRequest) Reset()         { *m = StartConsensusRpcReque
 func (m *StartConsensusRpcRequest) String() string { return proto.CompactTextString(m) }
 func (*StartConsensusRpcRequest) ProtoMessage()    {}
 func (*StartConsensusRpcRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{84}
+	return fileDescriptor_api_8495174e6b66f558, []int{84}
 }
 func (m *StartConsensusRpcRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StartConsensusRpcRequest.Unmarshal(m, b)
@@ -5497,7 +5684,7 @@ func (m *StartConsensusRpcResponse) Reset()         { *m = StartConsensusRpcResp
 func (m *StartConsensusRpcResponse) String() string { return proto.CompactTextString(m) }
 func (*StartConsensusRpcResponse) ProtoMessage()    {}
 func (*StartConsensusRpcResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{85}
+	return fileDescriptor_api_8495174e6b66f558, []int{85}
 }
 func (m *StartConsensusRpcResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StartConsensusRpcResponse.Unmarshal(m, b)
@@ -5530,7 +5717,7 @@ func (m *DiscoverAddressesRequest) Reset()         { *m = DiscoverAddressesReque
 func (m *DiscoverAddressesRequest) String() string { return proto.CompactTextString(m) }
 func (*DiscoverAddressesRequest) ProtoMessage()    {}
 func (*DiscoverAddressesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{86}
+	return fileDescriptor_api_8495174e6b66f558, []int{86}
 }
 func (m *DiscoverAddressesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DiscoverAddressesRequest.Unmarshal(m, b)
@@ -5581,7 +5768,7 @@ func (m *DiscoverAddressesResponse) Reset()         { *m = DiscoverAddressesResp
 func (m *DiscoverAddressesResponse) String() string { return proto.CompactTextString(m) }
 func (*DiscoverAddressesResponse) ProtoMessage()    {}
 func (*DiscoverAddressesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{87}
+	return fileDescriptor_api_8495174e6b66f558, []int{87}
 }
 func (m *DiscoverAddressesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DiscoverAddressesResponse.Unmarshal(m, b)
@@ -5611,7 +5798,7 @@ func (m *FetchMissingCFiltersRequest) Reset()         { *m = FetchMissingCFilter
 func (m *FetchMissingCFiltersRequest) String() string { return proto.CompactTextString(m) }
 func (*FetchMissingCFiltersRequest) ProtoMessage()    {}
 func (*FetchMissingCFiltersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{88}
+	return fileDescriptor_api_8495174e6b66f558, []int{88}
 }
 func (m *FetchMissingCFiltersRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FetchMissingCFiltersRequest.Unmarshal(m, b)
@@ -5641,7 +5828,7 @@ func (m *FetchMissingCFiltersResponse) Reset()         { *m = FetchMissingCFilte
 func (m *FetchMissingCFiltersResponse) String() string { return proto.CompactTextString(m) }
 func (*FetchMissingCFiltersResponse) ProtoMessage()    {}
 func (*FetchMissingCFiltersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{89}
+	return fileDescriptor_api_8495174e6b66f558, []int{89}
 }
 func (m *FetchMissingCFiltersResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FetchMissingCFiltersResponse.Unmarshal(m, b)
@@ -5671,7 +5858,7 @@ func (m *SubscribeToBlockNotificationsRequest) Reset()         { *m = SubscribeT
 func (m *SubscribeToBlockNotificationsRequest) String() string { return proto.CompactTextString(m) }
 func (*SubscribeToBlockNotificationsRequest) ProtoMessage()    {}
 func (*SubscribeToBlockNotificationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{90}
+	return fileDescriptor_api_8495174e6b66f558, []int{90}
 }
 func (m *SubscribeToBlockNotificationsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SubscribeToBlockNotificationsRequest.Unmarshal(m, b)
@@ -5701,7 +5888,7 @@ func (m *SubscribeToBlockNotificationsResponse) Reset()         { *m = Subscribe
 func (m *SubscribeToBlockNotificationsResponse) String() string { return proto.CompactTextString(m) }
 func (*SubscribeToBlockNotificationsResponse) ProtoMessage()    {}
 func (*SubscribeToBlockNotificationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{91}
+	return fileDescriptor_api_8495174e6b66f558, []int{91}
 }
 func (m *SubscribeToBlockNotificationsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SubscribeToBlockNotificationsResponse.Unmarshal(m, b)
@@ -5731,7 +5918,7 @@ func (m *FetchHeadersRequest) Reset()         { *m = FetchHeadersRequest{} }
 func (m *FetchHeadersRequest) String() string { return proto.CompactTextString(m) }
 func (*FetchHeadersRequest) ProtoMessage()    {}
 func (*FetchHeadersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{92}
+	return fileDescriptor_api_8495174e6b66f558, []int{92}
 }
 func (m *FetchHeadersRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FetchHeadersRequest.Unmarshal(m, b)
@@ -5766,7 +5953,7 @@ func (m *FetchHeadersResponse) Reset()         { *m = FetchHeadersResponse{} }
 func (m *FetchHeadersResponse) String() string { return proto.CompactTextString(m) }
 func (*FetchHeadersResponse) ProtoMessage()    {}
 func (*FetchHeadersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{93}
+	return fileDescriptor_api_8495174e6b66f558, []int{93}
 }
 func (m *FetchHeadersResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FetchHeadersResponse.Unmarshal(m, b)
@@ -5833,7 +6020,7 @@ func (m *FetchHeadersNotification) Reset()         { *m = FetchHeadersNotificati
 func (m *FetchHeadersNotification) String() string { return proto.CompactTextString(m) }
 func (*FetchHeadersNotification) ProtoMessage()    {}
 func (*FetchHeadersNotification) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{94}
+	return fileDescriptor_api_8495174e6b66f558, []int{94}
 }
 func (m *FetchHeadersNotification) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FetchHeadersNotification.Unmarshal(m, b)
@@ -5879,7 +6066,7 @@ func (m *FetchMissingCFiltersNotification) Reset()         { *m = FetchMissingCF
 func (m *FetchMissingCFiltersNotification) String() string { return proto.CompactTextString(m) }
 func (*FetchMissingCFiltersNotification) ProtoMessage()    {}
 func (*FetchMissingCFiltersNotification) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{95}
+	return fileDescriptor_api_8495174e6b66f558, []int{95}
 }
 func (m *FetchMissingCFiltersNotification) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FetchMissingCFiltersNotification.Unmarshal(m, b)
@@ -5924,7 +6111,7 @@ func (m *RescanProgressNotification) Reset()         { *m = RescanProgressNotifi
 func (m *RescanProgressNotification) String() string { return proto.CompactTextString(m) }
 func (*RescanProgressNotification) ProtoMessage()    {}
 func (*RescanProgressNotification) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{96}
+	return fileDescriptor_api_8495174e6b66f558, []int{96}
 }
 func (m *RescanProgressNotification) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RescanProgressNotification.Unmarshal(m, b)
@@ -5963,7 +6150,7 @@ func (m *PeerNotification) Reset()         { *m = PeerNotification{} }
 func (m *PeerNotification) String() string { return proto.CompactTextString(m) }
 func (*PeerNotification) ProtoMessage()    {}
 func (*PeerNotification) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{97}
+	return fileDescriptor_api_8495174e6b66f558, []int{97}
 }
 func (m *PeerNotification) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PeerNotification.Unmarshal(m, b)
@@ -6013,7 +6200,7 @@ func (m *RpcSyncRequest) Reset()         { *m = RpcSyncRequest{} }
 func (m *RpcSyncRequest) String() string { return proto.CompactTextString(m) }
 func (*RpcSyncRequest) ProtoMessage()    {}
 func (*RpcSyncRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{98}
+	return fileDescriptor_api_8495174e6b66f558, []int{98}
 }
 func (m *RpcSyncRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RpcSyncRequest.Unmarshal(m, b)
@@ -6091,7 +6278,7 @@ func (m *RpcSyncResponse) Reset()         { *m = RpcSyncResponse{} }
 func (m *RpcSyncResponse) String() string { return proto.CompactTextString(m) }
 func (*RpcSyncResponse) ProtoMessage()    {}
 func (*RpcSyncResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{99}
+	return fileDescriptor_api_8495174e6b66f558, []int{99}
 }
 func (m *RpcSyncResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RpcSyncResponse.Unmarshal(m, b)
@@ -6166,7 +6353,7 @@ func (m *SpvSyncRequest) Reset()         { *m = SpvSyncRequest{} }
 func (m *SpvSyncRequest) String() string { return proto.CompactTextString(m) }
 func (*SpvSyncRequest) ProtoMessage()    {}
 func (*SpvSyncRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{100}
+	return fileDescriptor_api_8495174e6b66f558, []int{100}
 }
 func (m *SpvSyncRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SpvSyncRequest.Unmarshal(m, b)
@@ -6223,7 +6410,7 @@ func (m *SpvSyncResponse) Reset()         { *m = SpvSyncResponse{} }
 func (m *SpvSyncResponse) String() string { return proto.CompactTextString(m) }
 func (*SpvSyncResponse) ProtoMessage()    {}
 func (*SpvSyncResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{101}
+	return fileDescriptor_api_8495174e6b66f558, []int{101}
 }
 func (m *SpvSyncResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SpvSyncResponse.Unmarshal(m, b)
@@ -6295,7 +6482,7 @@ func (m *RescanPointRequest) Reset()         { *m = RescanPointRequest{} }
 func (m *RescanPointRequest) String() string { return proto.CompactTextString(m) }
 func (*RescanPointRequest) ProtoMessage()    {}
 func (*RescanPointRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{102}
+	return fileDescriptor_api_8495174e6b66f558, []int{102}
 }
 func (m *RescanPointRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RescanPointRequest.Unmarshal(m, b)
@@ -6326,7 +6513,7 @@ func (m *RescanPointResponse) Reset()         { *m = RescanPointResponse{} }
 func (m *RescanPointResponse) String() string { return proto.CompactTextString(m) }
 func (*RescanPointResponse) ProtoMessage()    {}
 func (*RescanPointResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{103}
+	return fileDescriptor_api_8495174e6b66f558, []int{103}
 }
 func (m *RescanPointResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RescanPointResponse.Unmarshal(m, b)
@@ -6354,17 +6541,19 @@ func (m *RescanPointResponse) GetRescanPointHash() []byte {
 }
 
 type GenerateRandomSeedRequest struct {
-	SeedLength           uint32   `protobuf:"varint,1,opt,name=seed_length,json=seedLength,proto3" json:"seed_length,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	SeedLength           uint32       `protobuf:"varint,1,opt,name=seed_length,json=seedLength,proto3" json:"seed_length,omitempty"`
+	Encoding             SeedEncoding `protobuf:"varint,2,opt,name=encoding,proto3,enum=walletrpc.SeedEncoding" json:"encoding,omitempty"`
+	Bip39Passphrase      string       `protobuf:"bytes,3,opt,name=bip39_passphrase,json=bip39Passphrase,proto3" json:"bip39_passphrase,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *GenerateRandomSeedRequest) Reset()         { *m = GenerateRandomSeedRequest{} }
 func (m *GenerateRandomSeedRequest) String() string { return proto.CompactTextString(m) }
 func (*GenerateRandomSeedRequest) ProtoMessage()    {}
 func (*GenerateRandomSeedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{104}
+	return fileDescriptor_api_8495174e6b66f558, []int{104}
 }
 func (m *GenerateRandomSeedRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GenerateRandomSeedRequest.Unmarshal(m, b)
@@ -6391,6 +6580,20 @@ func (m *GenerateRandomSeedRequest) GetSeedLength() uint32 {
 	return 0
 }
 
+func (m *GenerateRandomSeedRequest) GetEncoding() SeedEncoding {
+	if m != nil {
+		return m.Encoding
+	}
+	return SeedEncoding_PGP_WORDLIST
+}
+
+func (m *GenerateRandomSeedRequest) GetBip39Passphrase() string {
+	if m != nil {
+		return m.Bip39Passphrase
+	}
+	return ""
+}
+
 type GenerateRandomSeedResponse struct {
 	SeedBytes            []byte   `protobuf:"bytes,1,opt,name=seed_bytes,json=seedBytes,proto3" json:"seed_bytes,omitempty"`
 	SeedHex              string   `protobuf:"bytes,2,opt,name=seed_hex,json=seedHex,proto3" json:"seed_hex,omitempty"`
@@ -6404,7 +6607,7 @@ func (m *GenerateRandomSeedResponse) Reset()         { *m = GenerateRandomSeedRe
 func (m *GenerateRandomSeedResponse) String() string { return proto.CompactTextString(m) }
 func (*GenerateRandomSeedResponse) ProtoMessage()    {}
 func (*GenerateRandomSeedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{105}
+	return fileDescriptor_api_8495174e6b66f558, []int{105}
 }
 func (m *GenerateRandomSeedResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GenerateRandomSeedResponse.Unmarshal(m, b)
@@ -6446,17 +6649,19 @@ func (m *GenerateRandomSeedResponse) GetSeedMnemonic() string {
 }
 
 type DecodeSeedRequest struct {
-	UserInput            string   `protobuf:"bytes,1,opt,name=user_input,json=userInput,proto3" json:"user_input,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	UserInput            string       `protobuf:"bytes,1,opt,name=user_input,json=userInput,proto3" json:"user_input,omitempty"`
+	Encoding             SeedEncoding `protobuf:"varint,2,opt,name=encoding,proto3,enum=walletrpc.SeedEncoding" json:"encoding,omitempty"`
+	Bip39Passphrase      string       `protobuf:"bytes,3,opt,name=bip39_passphrase,json=bip39Passphrase,proto3" json:"bip39_passphrase,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *DecodeSeedRequest) Reset()         { *m = DecodeSeedRequest{} }
 func (m *DecodeSeedRequest) String() string { return proto.CompactTextString(m) }
 func (*DecodeSeedRequest) ProtoMessage()    {}
 func (*DecodeSeedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{106}
+	return fileDescriptor_api_8495174e6b66f558, []int{106}
 }
 func (m *DecodeSeedRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DecodeSeedRequest.Unmarshal(m, b)
@@ -6483,6 +6688,20 @@ func (m *DecodeSeedRequest) GetUserInput() string {
 	return ""
 }
 
+func (m *DecodeSeedRequest) GetEncoding() SeedEncoding {
+	if m != nil {
+		return m.Encoding
+	}
+	return SeedEncoding_PGP_WORDLIST
+}
+
+func (m *DecodeSeedRequest) GetBip39Passphrase() string {
+	if m != nil {
+		return m.Bip39Passphrase
+	}
+	return ""
+}
+
 type DecodeSeedResponse struct {
 	DecodedSeed          []byte   `protobuf:"bytes,1,opt,name=decoded_seed,json=decodedSeed,proto3" json:"decoded_seed,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -6494,7 +6713,7 @@ func (m *DecodeSeedResponse) Reset()         { *m = DecodeSeedResponse{} }
 func (m *DecodeSeedResponse) String() string { return proto.CompactTextString(m) }
 func (*DecodeSeedResponse) ProtoMessage()    {}
 func (*DecodeSeedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{107}
+	return fileDescriptor_api_8495174e6b66f558, []int{107}
 }
 func (m *DecodeSeedResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DecodeSeedResponse.Unmarshal(m, b)
@@ -6529,6 +6748,7 @@ type RunTicketBuyerRequest struct {
 	VotingAddress        string   `protobuf:"bytes,5,opt,name=voting_address,json=votingAddress,proto3" json:"voting_address,omitempty"`
 	PoolAddress          string   `protobuf:"bytes,6,opt,name=pool_address,json=poolAddress,proto3" json:"pool_address,omitempty"`
 	PoolFees             float64  `protobuf:"fixed64,7,opt,name=pool_fees,json=poolFees,proto3" json:"pool_fees,omitempty"`
+	WaitForLowerPrice    bool     `protobuf:"varint,8,opt,name=wait_for_lower_price,json=waitForLowerPrice,proto3" json:"wait_for_lower_price,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -6538,7 +6758,7 @@ func (m *RunTicketBuyerRequest) Reset()         { *m = RunTicketBuyerRequest{} }
 func (m *RunTicketBuyerRequest) String() string { return proto.CompactTextString(m) }
 func (*RunTicketBuyerRequest) ProtoMessage()    {}
 func (*RunTicketBuyerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{108}
+	return fileDescriptor_api_8495174e6b66f558, []int{108}
 }
 func (m *RunTicketBuyerRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RunTicketBuyerRequest.Unmarshal(m, b)
@@ -6607,6 +6827,13 @@ func (m *RunTicketBuyerRequest) GetPoolFees() float64 {
 	return 0
 }
 
+func (m *RunTicketBuyerRequest) GetWaitForLowerPrice() bool {
+	if m != nil {
+		return m.WaitForLowerPrice
+	}
+	return false
+}
+
 type RunTicketBuyerResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -6617,7 +6844,7 @@ func (m *RunTicketBuyerResponse) Reset()         { *m = RunTicketBuyerResponse{}
 func (m *RunTicketBuyerResponse) String() string { return proto.CompactTextString(m) }
 func (*RunTicketBuyerResponse) ProtoMessage()    {}
 func (*RunTicketBuyerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{109}
+	return fileDescriptor_api_8495174e6b66f558, []int{109}
 }
 func (m *RunTicketBuyerResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RunTicketBuyerResponse.Unmarshal(m, b)
@@ -6657,7 +6884,7 @@ func (m *StartAutoBuyerRequest) Reset()         { *m = StartAutoBuyerRequest{} }
 func (m *StartAutoBuyerRequest) String() string { return proto.CompactTextString(m) }
 func (*StartAutoBuyerRequest) ProtoMessage()    {}
 func (*StartAutoBuyerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{110}
+	return fileDescriptor_api_8495174e6b66f558, []int{110}
 }
 func (m *StartAutoBuyerRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StartAutoBuyerRequest.Unmarshal(m, b)
@@ -6757,7 +6984,7 @@ func (m *StartAutoBuyerResponse) Reset()         { *m = StartAutoBuyerResponse{}
 func (m *StartAutoBuyerResponse) String() string { return proto.CompactTextString(m) }
 func (*StartAutoBuyerResponse) ProtoMessage()    {}
 func (*StartAutoBuyerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{111}
+	return fileDescriptor_api_8495174e6b66f558, []int{111}
 }
 func (m *StartAutoBuyerResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StartAutoBuyerResponse.Unmarshal(m, b)
@@ -6787,7 +7014,7 @@ func (m *StopAutoBuyerRequest) Reset()         { *m = StopAutoBuyerRequest{} }
 func (m *StopAutoBuyerRequest) String() string { return proto.CompactTextString(m) }
 func (*StopAutoBuyerRequest) ProtoMessage()    {}
 func (*StopAutoBuyerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{112}
+	return fileDescriptor_api_8495174e6b66f558, []int{112}
 }
 func (m *StopAutoBuyerRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StopAutoBuyerRequest.Unmarshal(m, b)
@@ -6817,7 +7044,7 @@ func (m *StopAutoBuyerResponse) Reset()         { *m = StopAutoBuyerResponse{} }
 func (m *StopAutoBuyerResponse) String() string { return proto.CompactTextString(m) }
 func (*StopAutoBuyerResponse) ProtoMessage()    {}
 func (*StopAutoBuyerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{113}
+	return fileDescriptor_api_8495174e6b66f558, []int{113}
 }
 func (m *StopAutoBuyerResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StopAutoBuyerResponse.Unmarshal(m, b)
@@ -6847,7 +7074,7 @@ func (m *TicketBuyerConfigRequest) Reset()         { *m = TicketBuyerConfigReque
 func (m *TicketBuyerConfigRequest) String() string { return proto.CompactTextString(m) }
 func (*TicketBuyerConfigRequest) ProtoMessage()    {}
 func (*TicketBuyerConfigRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{114}
+	return fileDescriptor_api_8495174e6b66f558, []int{114}
 }
 func (m *TicketBuyerConfigRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TicketBuyerConfigRequest.Unmarshal(m, b)
@@ -6897,7 +7124,7 @@ func (m *TicketBuyerConfigResponse) Reset()         { *m = TicketBuyerConfigResp
 func (m *TicketBuyerConfigResponse) String() string { return proto.CompactTextString(m) }
 func (*TicketBuyerConfigResponse) ProtoMessage()    {}
 func (*TicketBuyerConfigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{115}
+	return fileDescriptor_api_8495174e6b66f558, []int{115}
 }
 func (m *TicketBuyerConfigResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TicketBuyerConfigResponse.Unmarshal(m, b)
@@ -7068,7 +7295,7 @@ func (m *SetAccountRequest) Reset()         { *m = SetAccountRequest{} }
 func (m *SetAccountRequest) String() string { return proto.CompactTextString(m) }
 func (*SetAccountRequest) ProtoMessage()    {}
 func (*SetAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{116}
+	return fileDescriptor_api_8495174e6b66f558, []int{116}
 }
 func (m *SetAccountRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetAccountRequest.Unmarshal(m, b)
@@ -7105,7 +7332,7 @@ func (m *SetAccountResponse) Reset()         { *m = SetAccountResponse{} }
 func (m *SetAccountResponse) String() string { return proto.CompactTextString(m) }
 func (*SetAccountResponse) ProtoMessage()    {}
 func (*SetAccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{117}
+	return fileDescriptor_api_8495174e6b66f558, []int{117}
 }
 func (m *SetAccountResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetAccountResponse.Unmarshal(m, b)
@@ -7136,7 +7363,7 @@ func (m *SetBalanceToMaintainRequest) Reset()         { *m = SetBalanceToMaintai
 func (m *SetBalanceToMaintainRequest) String() string { return proto.CompactTextString(m) }
 func (*SetBalanceToMaintainRequest) ProtoMessage()    {}
 func (*SetBalanceToMaintainRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{118}
+	return fileDescriptor_api_8495174e6b66f558, []int{118}
 }
 func (m *SetBalanceToMaintainRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetBalanceToMaintainRequest.Unmarshal(m, b)
@@ -7173,7 +7400,7 @@ func (m *SetBalanceToMaintainResponse) Reset()         { *m = SetBalanceToMainta
 func (m *SetBalanceToMaintainResponse) String() string { return proto.CompactTextString(m) }
 func (*SetBalanceToMaintainResponse) ProtoMessage()    {}
 func (*SetBalanceToMaintainResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{119}
+	return fileDescriptor_api_8495174e6b66f558, []int{119}
 }
 func (m *SetBalanceToMaintainResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetBalanceToMaintainResponse.Unmarshal(m, b)
@@ -7204,7 +7431,7 @@ func (m *SetMaxFeeRequest) Reset()         { *m = SetMaxFeeRequest{} }
 func (m *SetMaxFeeRequest) String() string { return proto.CompactTextString(m) }
 func (*SetMaxFeeRequest) ProtoMessage()    {}
 func (*SetMaxFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{120}
+	return fileDescriptor_api_8495174e6b66f558, []int{120}
 }
 func (m *SetMaxFeeRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetMaxFeeRequest.Unmarshal(m, b)
@@ -7241,7 +7468,7 @@ func (m *SetMaxFeeResponse) Reset()         { *m = SetMaxFeeResponse{} }
 func (m *SetMaxFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*SetMaxFeeResponse) ProtoMessage()    {}
 func (*SetMaxFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{121}
+	return fileDescriptor_api_8495174e6b66f558, []int{121}
 }
 func (m *SetMaxFeeResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetMaxFeeResponse.Unmarshal(m, b)
@@ -7272,7 +7499,7 @@ func (m *SetMaxPriceRelativeRequest) Reset()         { *m = SetMaxPriceRelativeR
 func (m *SetMaxPriceRelativeRequest) String() string { return proto.CompactTextString(m) }
 func (*SetMaxPriceRelativeRequest) ProtoMessage()    {}
 func (*SetMaxPriceRelativeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{122}
+	return fileDescriptor_api_8495174e6b66f558, []int{122}
 }
 func (m *SetMaxPriceRelativeRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetMaxPriceRelativeRequest.Unmarshal(m, b)
@@ -7309,7 +7536,7 @@ func (m *SetMaxPriceRelativeResponse) Reset()         { *m = SetMaxPriceRelative
 func (m *SetMaxPriceRelativeResponse) String() string { return proto.CompactTextString(m) }
 func (*SetMaxPriceRelativeResponse) ProtoMessage()    {}
 func (*SetMaxPriceRelativeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{123}
+	return fileDescriptor_api_8495174e6b66f558, []int{123}
 }
 func (m *SetMaxPriceRelativeResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetMaxPriceRelativeResponse.Unmarshal(m, b)
@@ -7340,7 +7567,7 @@ func (m *SetMaxPriceAbsoluteRequest) Reset()         { *m = SetMaxPriceAbsoluteR
 func (m *SetMaxPriceAbsoluteRequest) String() string { return proto.CompactTextString(m) }
 func (*SetMaxPriceAbsoluteRequest) ProtoMessage()    {}
 func (*SetMaxPriceAbsoluteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{124}
+	return fileDescriptor_api_8495174e6b66f558, []int{124}
 }
 func (m *SetMaxPriceAbsoluteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetMaxPriceAbsoluteRequest.Unmarshal(m, b)
@@ -7377,7 +7604,7 @@ func (m *SetMaxPriceAbsoluteResponse) Reset()         { *m = SetMaxPriceAbsolute
 func (m *SetMaxPriceAbsoluteResponse) String() string { return proto.CompactTextString(m) }
 func (*SetMaxPriceAbsoluteResponse) ProtoMessage()    {}
 func (*SetMaxPriceAbsoluteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{125}
+	return fileDescriptor_api_8495174e6b66f558, []int{125}
 }
 func (m *SetMaxPriceAbsoluteResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetMaxPriceAbsoluteResponse.Unmarshal(m, b)
@@ -7408,7 +7635,7 @@ func (m *SetVotingAddressRequest) Reset()         { *m = SetVotingAddressRequest
 func (m *SetVotingAddressRequest) String() string { return proto.CompactTextString(m) }
 func (*SetVotingAddressRequest) ProtoMessage()    {}
 func (*SetVotingAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{126}
+	return fileDescriptor_api_8495174e6b66f558, []int{126}
 }
 func (m *SetVotingAddressRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetVotingAddressRequest.Unmarshal(m, b)
@@ -7445,7 +7672,7 @@ func (m *SetVotingAddressResponse) Reset()         { *m = SetVotingAddressRespon
 func (m *SetVotingAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*SetVotingAddressResponse) ProtoMessage()    {}
 func (*SetVotingAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{127}
+	return fileDescriptor_api_8495174e6b66f558, []int{127}
 }
 func (m *SetVotingAddressResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetVotingAddressResponse.Unmarshal(m, b)
@@ -7476,7 +7703,7 @@ func (m *SetPoolAddressRequest) Reset()         { *m = SetPoolAddressRequest{} }
 func (m *SetPoolAddressRequest) String() string { return proto.CompactTextString(m) }
 func (*SetPoolAddressRequest) ProtoMessage()    {}
 func (*SetPoolAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{128}
+	return fileDescriptor_api_8495174e6b66f558, []int{128}
 }
 func (m *SetPoolAddressRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetPoolAddressRequest.Unmarshal(m, b)
@@ -7513,7 +7740,7 @@ func (m *SetPoolAddressResponse) Reset()         { *m = SetPoolAddressResponse{}
 func (m *SetPoolAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*SetPoolAddressResponse) ProtoMessage()    {}
 func (*SetPoolAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{129}
+	return fileDescriptor_api_8495174e6b66f558, []int{129}
 }
 func (m *SetPoolAddressResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetPoolAddressResponse.Unmarshal(m, b)
@@ -7544,7 +7771,7 @@ func (m *SetPoolFeesRequest) Reset()         { *m = SetPoolFeesRequest{} }
 func (m *SetPoolFeesRequest) String() string { return proto.CompactTextString(m) }
 func (*SetPoolFeesRequest) ProtoMessage()    {}
 func (*SetPoolFeesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{130}
+	return fileDescriptor_api_8495174e6b66f558, []int{130}
 }
 func (m *SetPoolFeesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetPoolFeesRequest.Unmarshal(m, b)
@@ -7581,7 +7808,7 @@ func (m *SetPoolFeesResponse) Reset()         { *m = SetPoolFeesResponse{} }
 func (m *SetPoolFeesResponse) String() string { return proto.CompactTextString(m) }
 func (*SetPoolFeesResponse) ProtoMessage()    {}
 func (*SetPoolFeesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{131}
+	return fileDescriptor_api_8495174e6b66f558, []int{131}
 }
 func (m *SetPoolFeesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetPoolFeesResponse.Unmarshal(m, b)
@@ -7612,7 +7839,7 @@ func (m *SetMaxPerBlockRequest) Reset()         { *m = SetMaxPerBlockRequest{} }
 func (m *SetMaxPerBlockRequest) String() string { return proto.CompactTextString(m) }
 func (*SetMaxPerBlockRequest) ProtoMessage()    {}
 func (*SetMaxPerBlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{132}
+	return fileDescriptor_api_8495174e6b66f558, []int{132}
 }
 func (m *SetMaxPerBlockRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetMaxPerBlockRequest.Unmarshal(m, b)
@@ -7649,7 +7876,7 @@ func (m *SetMaxPerBlockResponse) Reset()         { *m = SetMaxPerBlockResponse{}
 func (m *SetMaxPerBlockResponse) String() string { return proto.CompactTextString(m) }
 func (*SetMaxPerBlockResponse) ProtoMessage()    {}
 func (*SetMaxPerBlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{133}
+	return fileDescriptor_api_8495174e6b66f558, []int{133}
 }
 func (m *SetMaxPerBlockResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetMaxPerBlockResponse.Unmarshal(m, b)
@@ -7679,7 +7906,7 @@ func (m *AgendasRequest) Reset()         { *m = AgendasRequest{} }
 func (m *AgendasRequest) String() string { return proto.CompactTextString(m) }
 func (*AgendasRequest) ProtoMessage()    {}
 func (*AgendasRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{134}
+	return fileDescriptor_api_8495174e6b66f558, []int{134}
 }
 func (m *AgendasRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AgendasRequest.Unmarshal(m, b)
@@ -7711,7 +7938,7 @@ func (m *AgendasResponse) Reset()         { *m = AgendasResponse{} }
 func (m *AgendasResponse) String() string { return proto.CompactTextString(m) }
 func (*AgendasResponse) ProtoMessage()    {}
 func (*AgendasResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{135}
+	return fileDescriptor_api_8495174e6b66f558, []int{135}
 }
 func (m *AgendasResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AgendasResponse.Unmarshal(m, b)
@@ -7761,7 +7988,7 @@ func (m *AgendasResponse_Agenda) Reset()         { *m = AgendasResponse_Agenda{}
 func (m *AgendasResponse_Agenda) String() string { return proto.CompactTextString(m) }
 func (*AgendasResponse_Agenda) ProtoMessage()    {}
 func (*AgendasResponse_Agenda) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{135, 0}
+	return fileDescriptor_api_8495174e6b66f558, []int{135, 0}
 }
 func (m *AgendasResponse_Agenda) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AgendasResponse_Agenda.Unmarshal(m, b)
@@ -7838,7 +8065,7 @@ func (m *AgendasResponse_Choice) Reset()         { *m = AgendasResponse_Choice{}
 func (m *AgendasResponse_Choice) String() string { return proto.CompactTextString(m) }
 func (*AgendasResponse_Choice) ProtoMessage()    {}
 func (*AgendasResponse_Choice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{135, 1}
+	return fileDescriptor_api_8495174e6b66f558, []int{135, 1}
 }
 func (m *AgendasResponse_Choice) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AgendasResponse_Choice.Unmarshal(m, b)
@@ -7903,7 +8130,7 @@ func (m *VoteChoicesRequest) Reset()         { *m = VoteChoicesRequest{} }
 func (m *VoteChoicesRequest) String() string { return proto.CompactTextString(m) }
 func (*VoteChoicesRequest) ProtoMessage()    {}
 func (*VoteChoicesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{136}
+	return fileDescriptor_api_8495174e6b66f558, []int{136}
 }
 func (m *VoteChoicesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VoteChoicesRequest.Unmarshal(m, b)
@@ -7936,7 +8163,7 @@ func (m *VoteChoicesResponse) Reset()         { *m = VoteChoicesResponse{} }
 func (m *VoteChoicesResponse) String() string { return proto.CompactTextString(m) }
 func (*VoteChoicesResponse) ProtoMessage()    {}
 func (*VoteChoicesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{137}
+	return fileDescriptor_api_8495174e6b66f558, []int{137}
 }
 func (m *VoteChoicesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VoteChoicesResponse.Unmarshal(m, b)
@@ -7991,7 +8218,7 @@ func (m *VoteChoicesResponse_Choice) Reset()         { *m = VoteChoicesResponse_
 func (m *VoteChoicesResponse_Choice) String() string { return proto.CompactTextString(m) }
 func (*VoteChoicesResponse_Choice) ProtoMessage()    {}
 func (*VoteChoicesResponse_Choice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{137, 0}
+	return fileDescriptor_api_8495174e6b66f558, []int{137, 0}
 }
 func (m *VoteChoicesResponse_Choice) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VoteChoicesResponse_Choice.Unmarshal(m, b)
@@ -8050,7 +8277,7 @@ func (m *SetVoteChoicesRequest) Reset()         { *m = SetVoteChoicesRequest{} }
 func (m *SetVoteChoicesRequest) String() string { return proto.CompactTextString(m) }
 func (*SetVoteChoicesRequest) ProtoMessage()    {}
 func (*SetVoteChoicesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{138}
+	return fileDescriptor_api_8495174e6b66f558, []int{138}
 }
 func (m *SetVoteChoicesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetVoteChoicesRequest.Unmarshal(m, b)
@@ -8089,7 +8316,7 @@ func (m *SetVoteChoicesRequest_Choice) Reset()         { *m = SetVoteChoicesRequ
 func (m *SetVoteChoicesRequest_Choice) String() string { return proto.CompactTextString(m) }
 func (*SetVoteChoicesRequest_Choice) ProtoMessage()    {}
 func (*SetVoteChoicesRequest_Choice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{138, 0}
+	return fileDescriptor_api_8495174e6b66f558, []int{138, 0}
 }
 func (m *SetVoteChoicesRequest_Choice) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetVoteChoicesRequest_Choice.Unmarshal(m, b)
@@ -8134,7 +8361,7 @@ func (m *SetVoteChoicesResponse) Reset()         { *m = SetVoteChoicesResponse{}
 func (m *SetVoteChoicesResponse) String() string { return proto.CompactTextString(m) }
 func (*SetVoteChoicesResponse) ProtoMessage()    {}
 func (*SetVoteChoicesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{139}
+	return fileDescriptor_api_8495174e6b66f558, []int{139}
 }
 func (m *SetVoteChoicesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetVoteChoicesResponse.Unmarshal(m, b)
@@ -8174,7 +8401,7 @@ func (m *VerifyMessageRequest) Reset()         { *m = VerifyMessageRequest{} }
 func (m *VerifyMessageRequest) String() string { return proto.CompactTextString(m) }
 func (*VerifyMessageRequest) ProtoMessage()    {}
 func (*VerifyMessageRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{140}
+	return fileDescriptor_api_8495174e6b66f558, []int{140}
 }
 func (m *VerifyMessageRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VerifyMessageRequest.Unmarshal(m, b)
@@ -8226,7 +8453,7 @@ func (m *VerifyMessageResponse) Reset()         { *m = VerifyMessageResponse{} }
 func (m *VerifyMessageResponse) String() string { return proto.CompactTextString(m) }
 func (*VerifyMessageResponse) ProtoMessage()    {}
 func (*VerifyMessageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{141}
+	return fileDescriptor_api_8495174e6b66f558, []int{141}
 }
 func (m *VerifyMessageResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VerifyMessageResponse.Unmarshal(m, b)
@@ -8270,7 +8497,7 @@ func (m *DecodedTransaction) Reset()         { *m = DecodedTransaction{} }
 func (m *DecodedTransaction) String() string { return proto.CompactTextString(m) }
 func (*DecodedTransaction) ProtoMessage()    {}
 func (*DecodedTransaction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{142}
+	return fileDescriptor_api_8495174e6b66f558, []int{142}
 }
 func (m *DecodedTransaction) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DecodedTransaction.Unmarshal(m, b)
@@ -8358,7 +8585,7 @@ func (m *DecodedTransaction_Input) Reset()         { *m = DecodedTransaction_Inp
 func (m *DecodedTransaction_Input) String() string { return proto.CompactTextString(m) }
 func (*DecodedTransaction_Input) ProtoMessage()    {}
 func (*DecodedTransaction_Input) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{142, 0}
+	return fileDescriptor_api_8495174e6b66f558, []int{142, 0}
 }
 func (m *DecodedTransaction_Input) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DecodedTransaction_Input.Unmarshal(m, b)
@@ -8460,7 +8687,7 @@ func (m *DecodedTransaction_Output) Reset()         { *m = DecodedTransaction_Ou
 func (m *DecodedTransaction_Output) String() string { return proto.CompactTextString(m) }
 func (*DecodedTransaction_Output) ProtoMessage()    {}
 func (*DecodedTransaction_Output) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{142, 1}
+	return fileDescriptor_api_8495174e6b66f558, []int{142, 1}
 }
 func (m *DecodedTransaction_Output) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DecodedTransaction_Output.Unmarshal(m, b)
@@ -8554,7 +8781,7 @@ func (m *DecodeRawTransactionRequest) Reset()         { *m = DecodeRawTransactio
 func (m *DecodeRawTransactionRequest) String() string { return proto.CompactTextString(m) }
 func (*DecodeRawTransactionRequest) ProtoMessage()    {}
 func (*DecodeRawTransactionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{143}
+	return fileDescriptor_api_8495174e6b66f558, []int{143}
 }
 func (m *DecodeRawTransactionRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DecodeRawTransactionRequest.Unmarshal(m, b)
@@ -8592,7 +8819,7 @@ func (m *DecodeRawTransactionResponse) Reset()         { *m = DecodeRawTransacti
 func (m *DecodeRawTransactionResponse) String() string { return proto.CompactTextString(m) }
 func (*DecodeRawTransactionResponse) ProtoMessage()    {}
 func (*DecodeRawTransactionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{144}
+	return fileDescriptor_api_8495174e6b66f558, []int{144}
 }
 func (m *DecodeRawTransactionResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DecodeRawTransactionResponse.Unmarshal(m, b)
@@ -8630,7 +8857,7 @@ func (m *ValidateAddressRequest) Reset()         { *m = ValidateAddressRequest{}
 func (m *ValidateAddressRequest) String() string { return proto.CompactTextString(m) }
 func (*ValidateAddressRequest) ProtoMessage()    {}
 func (*ValidateAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{145}
+	return fileDescriptor_api_8495174e6b66f558, []int{145}
 }
 func (m *ValidateAddressRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ValidateAddressRequest.Unmarshal(m, b)
@@ -8679,7 +8906,7 @@ func (m *ValidateAddressResponse) Reset()         { *m = ValidateAddressResponse
 func (m *ValidateAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*ValidateAddressResponse) ProtoMessage()    {}
 func (*ValidateAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{146}
+	return fileDescriptor_api_8495174e6b66f558, []int{146}
 }
 func (m *ValidateAddressResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ValidateAddressResponse.Unmarshal(m, b)
@@ -8794,7 +9021,7 @@ func (m *CommittedTicketsRequest) Reset()         { *m = CommittedTicketsRequest
 func (m *CommittedTicketsRequest) String() string { return proto.CompactTextString(m) }
 func (*CommittedTicketsRequest) ProtoMessage()    {}
 func (*CommittedTicketsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{147}
+	return fileDescriptor_api_8495174e6b66f558, []int{147}
 }
 func (m *CommittedTicketsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CommittedTicketsRequest.Unmarshal(m, b)
@@ -8832,7 +9059,7 @@ func (m *GetAccountExtendedPubKeyRequest) Reset()         { *m = GetAccountExten
 func (m *GetAccountExtendedPubKeyRequest) String() string { return proto.CompactTextString(m) }
 func (*GetAccountExtendedPubKeyRequest) ProtoMessage()    {}
 func (*GetAccountExtendedPubKeyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{148}
+	return fileDescriptor_api_8495174e6b66f558, []int{148}
 }
 func (m *GetAccountExtendedPubKeyRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetAccountExtendedPubKeyRequest.Unmarshal(m, b)
@@ -8870,7 +9097,7 @@ func (m *GetAccountExtendedPubKeyResponse) Reset()         { *m = GetAccountExte
 func (m *GetAccountExtendedPubKeyResponse) String() string { return proto.CompactTextString(m) }
 func (*GetAccountExtendedPubKeyResponse) ProtoMessage()    {}
 func (*GetAccountExtendedPubKeyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{149}
+	return fileDescriptor_api_8495174e6b66f558, []int{149}
 }
 func (m *GetAccountExtendedPubKeyResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetAccountExtendedPubKeyResponse.Unmarshal(m, b)
@@ -8908,7 +9135,7 @@ func (m *CommittedTicketsResponse) Reset()         { *m = CommittedTicketsRespon
 func (m *CommittedTicketsResponse) String() string { return proto.CompactTextString(m) }
 func (*CommittedTicketsResponse) ProtoMessage()    {}
 func (*CommittedTicketsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{150}
+	return fileDescriptor_api_8495174e6b66f558, []int{150}
 }
 func (m *CommittedTicketsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CommittedTicketsResponse.Unmarshal(m, b)
@@ -8949,7 +9176,7 @@ func (m *CommittedTicketsResponse_TicketAddress) Reset() {
 func (m *CommittedTicketsResponse_TicketAddress) String() string { return proto.CompactTextString(m) }
 func (*CommittedTicketsResponse_TicketAddress) ProtoMessage()    {}
 func (*CommittedTicketsResponse_TicketAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{150, 0}
+	return fileDescriptor_api_8495174e6b66f558, []int{150, 0}
 }
 func (m *CommittedTicketsResponse_TicketAddress) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CommittedTicketsResponse_TicketAddress.Unmarshal(m, b)
@@ -8993,7 +9220,7 @@ func (m *BestBlockRequest) Reset()         { *m = BestBlockRequest{} }
 func (m *BestBlockRequest) String() string { return proto.CompactTextString(m) }
 func (*BestBlockRequest) ProtoMessage()    {}
 func (*BestBlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{151}
+	return fileDescriptor_api_8495174e6b66f558, []int{151}
 }
 func (m *BestBlockRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BestBlockRequest.Unmarshal(m, b)
@@ -9025,7 +9252,7 @@ func (m *BestBlockResponse) Reset()         { *m = BestBlockResponse{} }
 func (m *BestBlockResponse) String() string { return proto.CompactTextString(m) }
 func (*BestBlockResponse) ProtoMessage()    {}
 func (*BestBlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{152}
+	return fileDescriptor_api_8495174e6b66f558, []int{152}
 }
 func (m *BestBlockResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BestBlockResponse.Unmarshal(m, b)
@@ -9073,7 +9300,7 @@ func (m *SweepAccountRequest) Reset()         { *m = SweepAccountRequest{} }
 func (m *SweepAccountRequest) String() string { return proto.CompactTextString(m) }
 func (*SweepAccountRequest) ProtoMessage()    {}
 func (*SweepAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{153}
+	return fileDescriptor_api_8495174e6b66f558, []int{153}
 }
 func (m *SweepAccountRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SweepAccountRequest.Unmarshal(m, b)
@@ -9135,7 +9362,7 @@ func (m *SweepAccountResponse) Reset()         { *m = SweepAccountResponse{} }
 func (m *SweepAccountResponse) String() string { return proto.CompactTextString(m) }
 func (*SweepAccountResponse) ProtoMessage()    {}
 func (*SweepAccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{154}
+	return fileDescriptor_api_8495174e6b66f558, []int{154}
 }
 func (m *SweepAccountResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SweepAccountResponse.Unmarshal(m, b)
@@ -9183,127 +9410,2593 @@ func (m *SweepAccountResponse) GetEstimatedSignedSize() uint32 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*VersionRequest)(nil), "walletrpc.VersionRequest")
-	proto.RegisterType((*VersionResponse)(nil), "walletrpc.VersionResponse")
-	proto.RegisterType((*TransactionDetails)(nil), "walletrpc.TransactionDetails")
-	proto.RegisterType((*TransactionDetails_Input)(nil), "walletrpc.TransactionDetails.Input")
-	proto.RegisterType((*TransactionDetails_Output)(nil), "walletrpc.TransactionDetails.Output")
-	proto.RegisterType((*BlockDetails)(nil), "walletrpc.BlockDetails")
-	proto.RegisterType((*AccountBalance)(nil), "walletrpc.AccountBalance")
-	proto.RegisterType((*PingRequest)(nil), "walletrpc.PingRequest")
-	proto.RegisterType((*PingResponse)(nil), "walletrpc.PingResponse")
-	proto.RegisterType((*NetworkRequest)(nil), "walletrpc.NetworkRequest")
-	proto.RegisterType((*NetworkResponse)(nil), "walletrpc.NetworkResponse")
-	proto.RegisterType((*AccountNumberRequest)(nil), "walletrpc.AccountNumberRequest")
-	proto.RegisterType((*AccountNumberResponse)(nil), "walletrpc.AccountNumberResponse")
-	proto.RegisterType((*AccountsRequest)(nil), "walletrpc.AccountsRequest")
-	proto.RegisterType((*AccountsResponse)(nil), "walletrpc.AccountsResponse")
-	proto.RegisterType((*AccountsResponse_Account)(nil), "walletrpc.AccountsResponse.Account")
-	proto.RegisterType((*RenameAccountRequest)(nil), "walletrpc.RenameAccountRequest")
-	proto.RegisterType((*RenameAccountResponse)(nil), "walletrpc.RenameAccountResponse")
-	proto.RegisterType((*RescanRequest)(nil), "walletrpc.RescanRequest")
-	proto.RegisterType((*RescanResponse)(nil), "walletrpc.RescanResponse")
-	proto.RegisterType((*NextAccountRequest)(nil), "walletrpc.NextAccountRequest")
-	proto.RegisterType((*NextAccountResponse)(nil), "walletrpc.NextAccountResponse")
-	proto.RegisterType((*NextAddressRequest)(nil), "walletrpc.NextAddressRequest")
-	proto.RegisterType((*NextAddressResponse)(nil), "walletrpc.NextAddressResponse")
-	proto.RegisterType((*ImportPrivateKeyRequest)(nil), "walletrpc.ImportPrivateKeyRequest")
-	proto.RegisterType((*ImportPrivateKeyResponse)(nil), "walletrpc.ImportPrivateKeyResponse")
-	proto.RegisterType((*ImportScriptRequest)(nil), "walletrpc.ImportScriptRequest")
-	proto.RegisterType((*ImportScriptResponse)(nil), "walletrpc.ImportScriptResponse")
-	proto.RegisterType((*BalanceRequest)(nil), "walletrpc.BalanceRequest")
-	proto.RegisterType((*BalanceResponse)(nil), "walletrpc.BalanceResponse")
-	proto.RegisterType((*GetTransactionRequest)(nil), "walletrpc.GetTransactionRequest")
-	proto.RegisterType((*GetTransactionResponse)(nil), "walletrpc.GetTransactionResponse")
-	proto.RegisterType((*GetTransactionsRequest)(nil), "walletrpc.GetTransactionsRequest")
-	proto.RegisterType((*GetTransactionsResponse)(nil), "walletrpc.GetTransactionsResponse")
-	proto.RegisterType((*GetTicketRequest)(nil), "walletrpc.GetTicketRequest")
-	proto.RegisterType((*GetTicketsRequest)(nil), "walletrpc.GetTicketsRequest")
-	proto.RegisterType((*GetTicketsResponse)(nil), "walletrpc.GetTicketsResponse")
-	proto.RegisterType((*GetTicketsResponse_TicketDetails)(nil), "walletrpc.GetTicketsResponse.TicketDetails")
-	proto.RegisterType((*GetTicketsResponse_BlockDetails)(nil), "walletrpc.GetTicketsResponse.BlockDetails")
-	proto.RegisterType((*TicketPriceRequest)(nil), "walletrpc.TicketPriceRequest")
-	proto.RegisterType((*TicketPriceResponse)(nil), "walletrpc.TicketPriceResponse")
-	proto.RegisterType((*StakeInfoRequest)(nil), "walletrpc.StakeInfoRequest")
-	proto.RegisterType((*StakeInfoResponse)(nil), "walletrpc.StakeInfoResponse")
-	proto.RegisterType((*BlockInfoRequest)(nil), "walletrpc.BlockInfoRequest")
-	proto.RegisterType((*BlockInfoResponse)(nil), "walletrpc.BlockInfoResponse")
-	proto.RegisterType((*ChangePassphraseRequest)(nil), "walletrpc.ChangePassphraseRequest")
-	proto.RegisterType((*ChangePassphraseResponse)(nil), "walletrpc.ChangePassphraseResponse")
-	proto.RegisterType((*FundTransactionRequest)(nil), "walletrpc.FundTransactionRequest")
-	proto.RegisterType((*FundTransactionResponse)(nil), "walletrpc.FundTransactionResponse")
-	proto.RegisterType((*FundTransactionResponse_PreviousOutput)(nil), "walletrpc.FundTransactionResponse.PreviousOutput")
-	proto.RegisterType((*UnspentOutputsRequest)(nil), "walletrpc.UnspentOutputsRequest")
-	proto.RegisterType((*UnspentOutputResponse)(nil), "walletrpc.UnspentOutputResponse")
-	proto.RegisterType((*ConstructTransactionRequest)(nil), "walletrpc.ConstructTransactionRequest")
-	proto.RegisterType((*ConstructTransactionRequest_OutputDestination)(nil), "walletrpc.ConstructTransactionRequest.OutputDestination")
-	proto.RegisterType((*ConstructTransactionRequest_Output)(nil), "walletrpc.ConstructTransactionRequest.Output")
-	proto.RegisterType((*ConstructTransactionResponse)(nil), "walletrpc.ConstructTransactionResponse")
-	proto.RegisterType((*SignTransactionRequest)(nil), "walletrpc.SignTransactionRequest")
-	proto.RegisterType((*SignTransactionRequest_AdditionalScript)(nil), "walletrpc.SignTransactionRequest.AdditionalScript")
-	proto.RegisterType((*SignTransactionResponse)(nil), "walletrpc.SignTransactionResponse")
-	proto.RegisterType((*SignTransactionsRequest)(nil), "walletrpc.SignTransactionsRequest")
-	proto.RegisterType((*SignTransactionsRequest_AdditionalScript)(nil), "walletrpc.SignTransactionsRequest.AdditionalScript")
-	proto.RegisterType((*SignTransactionsRequest_UnsignedTransaction)(nil), "walletrpc.SignTransactionsRequest.UnsignedTransaction")
-	proto.RegisterType((*SignTransactionsResponse)(nil), "walletrpc.SignTransactionsResponse")
-	proto.RegisterType((*SignTransactionsResponse_SignedTransaction)(nil), "walletrpc.SignTransactionsResponse.SignedTransaction")
-	proto.RegisterType((*CreateSignatureRequest)(nil), "walletrpc.CreateSignatureRequest")
-	proto.RegisterType((*CreateSignatureResponse)(nil), "walletrpc.CreateSignatureResponse")
-	proto.RegisterType((*PublishTransactionRequest)(nil), "walletrpc.PublishTransactionRequest")
-	proto.RegisterType((*PublishTransactionResponse)(nil), "walletrpc.PublishTransactionResponse")
-	proto.RegisterType((*PublishUnminedTransactionsRequest)(nil), "walletrpc.PublishUnminedTransactionsRequest")
-	proto.RegisterType((*PublishUnminedTransactionsResponse)(nil), "walletrpc.PublishUnminedTransactionsResponse")
-	proto.RegisterType((*PurchaseTicketsRequest)(nil), "walletrpc.PurchaseTicketsRequest")
-	proto.RegisterType((*PurchaseTicketsResponse)(nil), "walletrpc.PurchaseTicketsResponse")
-	proto.RegisterType((*RevokeTicketsRequest)(nil), "walletrpc.RevokeTicketsRequest")
-	proto.RegisterType((*RevokeTicketsResponse)(nil), "walletrpc.RevokeTicketsResponse")
-	proto.RegisterType((*LoadActiveDataFiltersRequest)(nil), "walletrpc.LoadActiveDataFiltersRequest")
-	proto.RegisterType((*LoadActiveDataFiltersResponse)(nil), "walletrpc.LoadActiveDataFiltersResponse")
-	proto.RegisterType((*SignMessageRequest)(nil), "walletrpc.SignMessageRequest")
-	proto.RegisterType((*SignMessageResponse)(nil), "walletrpc.SignMessageResponse")
-	proto.RegisterType((*SignMessagesRequest)(nil), "walletrpc.SignMessagesRequest")
-	proto.RegisterType((*SignMessagesRequest_Message)(nil), "walletrpc.SignMessagesRequest.Message")
-	proto.RegisterType((*SignMessagesResponse)(nil), "walletrpc.SignMessagesResponse")
-	proto.RegisterType((*SignMessagesResponse_SignReply)(nil), "walletrpc.SignMessagesResponse.SignReply")
-	proto.RegisterType((*TransactionNotificationsRequest)(nil), "walletrpc.TransactionNotificationsRequest")
-	proto.RegisterType((*TransactionNotificationsResponse)(nil), "walletrpc.TransactionNotificationsResponse")
-	proto.RegisterType((*AccountNotificationsRequest)(nil), "walletrpc.AccountNotificationsRequest")
-	proto.RegisterType((*AccountNotificationsResponse)(nil), "walletrpc.AccountNotificationsResponse")
-	proto.RegisterType((*ConfirmationNotificationsRequest)(nil), "walletrpc.ConfirmationNotificationsRequest")
-	proto.RegisterType((*ConfirmationNotificationsResponse)(nil), "walletrpc.ConfirmationNotificationsResponse")
-	proto.RegisterType((*ConfirmationNotificationsResponse_TransactionConfirmations)(nil), "walletrpc.ConfirmationNotificationsResponse.TransactionConfirmations")
-	proto.RegisterType((*CreateWalletRequest)(nil), "walletrpc.CreateWalletRequest")
-	proto.RegisterType((*CreateWalletResponse)(nil), "walletrpc.CreateWalletResponse")
-	proto.RegisterType((*CreateWatchingOnlyWalletRequest)(nil), "walletrpc.CreateWatchingOnlyWalletRequest")
-	proto.RegisterType((*CreateWatchingOnlyWalletResponse)(nil), "walletrpc.CreateWatchingOnlyWalletResponse")
-	proto.RegisterType((*OpenWalletRequest)(nil), "walletrpc.OpenWalletRequest")
-	proto.RegisterType((*OpenWalletResponse)(nil), "walletrpc.OpenWalletResponse")
-	proto.RegisterType((*CloseWalletRequest)(nil), "walletrpc.CloseWalletRequest")
-	proto.RegisterType((*CloseWalletResponse)(nil), "walletrpc.CloseWalletResponse")
-	proto.RegisterType((*WalletExistsRequest)(nil), "walletrpc.WalletExistsRequest")
-	proto.RegisterType((*WalletExistsResponse)(nil), "walletrpc.WalletExistsResponse")
-	proto.RegisterType((*StartConsensusRpcRequest)(nil), "walletrpc.StartConsensusRpcRequest")
-	proto.RegisterType((*StartConsensusRpcResponse)(nil), "walletrpc.StartConsensusRpcResponse")
-	proto.RegisterType((*DiscoverAddressesRequest)(nil), "walletrpc.DiscoverAddressesRequest")
-	proto.RegisterType((*DiscoverAddressesResponse)(nil), "walletrpc.DiscoverAddressesResponse")
-	proto.RegisterType((*FetchMissingCFiltersRequest)(nil), "walletrpc.FetchMissingCFiltersRequest")
-	proto.RegisterType((*FetchMissingCFiltersResponse)(nil), "walletrpc.FetchMissingCFiltersResponse")
-	proto.RegisterType((*SubscribeToBlockNotificationsRequest)(nil), "walletrpc.SubscribeToBlockNotificationsRequest")
-	proto.RegisterType((*SubscribeToBlockNotificationsResponse)(nil), "walletrpc.SubscribeToBlockNotificationsResponse")
-	proto.RegisterType((*FetchHeadersRequest)(nil), "walletrpc.FetchHeadersRequest")
-	proto.RegisterType((*FetchHeadersResponse)(nil), "walletrpc.FetchHeadersResponse")
-	proto.RegisterType((*FetchHeadersNotification)(nil), "walletrpc.FetchHeadersNotification")
-	proto.RegisterType((*FetchMissingCFiltersNotification)(nil), "walletrpc.FetchMissingCFiltersNotification")
-	proto.RegisterType((*RescanProgressNotification)(nil), "walletrpc.RescanProgressNotification")
-	proto.RegisterType((*PeerNotification)(nil), "walletrpc.PeerNotification")
-	proto.RegisterType((*RpcSyncRequest)(nil), "walletrpc.RpcSyncRequest")
-	proto.RegisterType((*RpcSyncResponse)(nil), "walletrpc.RpcSyncResponse")
-	proto.RegisterType((*SpvSyncRequest)(nil), "walletrpc.SpvSyncRequest")
-	proto.RegisterType((*SpvSyncResponse)(nil), "walletrpc.SpvSyncResponse")
-	proto.RegisterType((*RescanPointRequest)(nil), "walletrpc.RescanPointRequest")
-	proto.RegisterType((*RescanPointResponse)(nil), "walletrpc.RescanPointResponse")
-	proto.RegisterType((*GenerateRandomSeedRequest)(nil), "walletrpc.GenerateRandomSeedRequest")
+type StakePoolFeeReportRequest struct {
+	BeginHeight          int32    `protobuf:"varint,1,opt,name=begin_height,json=beginHeight,proto3" json:"begin_height,omitempty"`
+	EndHeight            int32    `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	Users                []string `protobuf:"bytes,3,rep,name=users,proto3" json:"users,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StakePoolFeeReportRequest) Reset()         { *m = StakePoolFeeReportRequest{} }
+func (m *StakePoolFeeReportRequest) String() string { return proto.CompactTextString(m) }
+func (*StakePoolFeeReportRequest) ProtoMessage()    {}
+func (*StakePoolFeeReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{155}
+}
+func (m *StakePoolFeeReportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StakePoolFeeReportRequest.Unmarshal(m, b)
+}
+func (m *StakePoolFeeReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StakePoolFeeReportRequest.Marshal(b, m, deterministic)
+}
+func (dst *StakePoolFeeReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakePoolFeeReportRequest.Merge(dst, src)
+}
+func (m *StakePoolFeeReportRequest) XXX_Size() int {
+	return xxx_messageInfo_StakePoolFeeReportRequest.Size(m)
+}
+func (m *StakePoolFeeReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakePoolFeeReportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakePoolFeeReportRequest proto.InternalMessageInfo
+
+func (m *StakePoolFeeReportRequest) GetBeginHeight() int32 {
+	if m != nil {
+		return m.BeginHeight
+	}
+	return 0
+}
+
+func (m *StakePoolFeeReportRequest) GetEndHeight() int32 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *StakePoolFeeReportRequest) GetUsers() []string {
+	if m != nil {
+		return m.Users
+	}
+	return nil
+}
+
+type StakePoolFees struct {
+	Tickets              uint32   `protobuf:"varint,1,opt,name=tickets,proto3" json:"tickets,omitempty"`
+	Voted                uint32   `protobuf:"varint,2,opt,name=voted,proto3" json:"voted,omitempty"`
+	Revoked              uint32   `protobuf:"varint,3,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	Live                 uint32   `protobuf:"varint,4,opt,name=live,proto3" json:"live,omitempty"`
+	Expired              uint32   `protobuf:"varint,5,opt,name=expired,proto3" json:"expired,omitempty"`
+	FeesEarned           int64    `protobuf:"varint,6,opt,name=fees_earned,json=feesEarned,proto3" json:"fees_earned,omitempty"`
+	FeesOutstanding      int64    `protobuf:"varint,7,opt,name=fees_outstanding,json=feesOutstanding,proto3" json:"fees_outstanding,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StakePoolFees) Reset()         { *m = StakePoolFees{} }
+func (m *StakePoolFees) String() string { return proto.CompactTextString(m) }
+func (*StakePoolFees) ProtoMessage()    {}
+func (*StakePoolFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{156}
+}
+func (m *StakePoolFees) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StakePoolFees.Unmarshal(m, b)
+}
+func (m *StakePoolFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StakePoolFees.Marshal(b, m, deterministic)
+}
+func (dst *StakePoolFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakePoolFees.Merge(dst, src)
+}
+func (m *StakePoolFees) XXX_Size() int {
+	return xxx_messageInfo_StakePoolFees.Size(m)
+}
+func (m *StakePoolFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakePoolFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakePoolFees proto.InternalMessageInfo
+
+func (m *StakePoolFees) GetTickets() uint32 {
+	if m != nil {
+		return m.Tickets
+	}
+	return 0
+}
+
+func (m *StakePoolFees) GetVoted() uint32 {
+	if m != nil {
+		return m.Voted
+	}
+	return 0
+}
+
+func (m *StakePoolFees) GetRevoked() uint32 {
+	if m != nil {
+		return m.Revoked
+	}
+	return 0
+}
+
+func (m *StakePoolFees) GetLive() uint32 {
+	if m != nil {
+		return m.Live
+	}
+	return 0
+}
+
+func (m *StakePoolFees) GetExpired() uint32 {
+	if m != nil {
+		return m.Expired
+	}
+	return 0
+}
+
+func (m *StakePoolFees) GetFeesEarned() int64 {
+	if m != nil {
+		return m.FeesEarned
+	}
+	return 0
+}
+
+func (m *StakePoolFees) GetFeesOutstanding() int64 {
+	if m != nil {
+		return m.FeesOutstanding
+	}
+	return 0
+}
+
+type StakePoolFeeReportResponse struct {
+	UserFees             *StakePoolFeeReportResponse_UserFees `protobuf:"bytes,1,opt,name=user_fees,json=userFees,proto3" json:"user_fees,omitempty"`
+	Totals               *StakePoolFeeReportResponse_Totals   `protobuf:"bytes,2,opt,name=totals,proto3" json:"totals,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                             `json:"-"`
+	XXX_unrecognized     []byte                               `json:"-"`
+	XXX_sizecache        int32                                `json:"-"`
+}
+
+func (m *StakePoolFeeReportResponse) Reset()         { *m = StakePoolFeeReportResponse{} }
+func (m *StakePoolFeeReportResponse) String() string { return proto.CompactTextString(m) }
+func (*StakePoolFeeReportResponse) ProtoMessage()    {}
+func (*StakePoolFeeReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{157}
+}
+func (m *StakePoolFeeReportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StakePoolFeeReportResponse.Unmarshal(m, b)
+}
+func (m *StakePoolFeeReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StakePoolFeeReportResponse.Marshal(b, m, deterministic)
+}
+func (dst *StakePoolFeeReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakePoolFeeReportResponse.Merge(dst, src)
+}
+func (m *StakePoolFeeReportResponse) XXX_Size() int {
+	return xxx_messageInfo_StakePoolFeeReportResponse.Size(m)
+}
+func (m *StakePoolFeeReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakePoolFeeReportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakePoolFeeReportResponse proto.InternalMessageInfo
+
+func (m *StakePoolFeeReportResponse) GetUserFees() *StakePoolFeeReportResponse_UserFees {
+	if m != nil {
+		return m.UserFees
+	}
+	return nil
+}
+
+func (m *StakePoolFeeReportResponse) GetTotals() *StakePoolFeeReportResponse_Totals {
+	if m != nil {
+		return m.Totals
+	}
+	return nil
+}
+
+type StakePoolFeeReportResponse_UserFees struct {
+	User                 string         `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Fees                 *StakePoolFees `protobuf:"bytes,2,opt,name=fees,proto3" json:"fees,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *StakePoolFeeReportResponse_UserFees) Reset()         { *m = StakePoolFeeReportResponse_UserFees{} }
+func (m *StakePoolFeeReportResponse_UserFees) String() string { return proto.CompactTextString(m) }
+func (*StakePoolFeeReportResponse_UserFees) ProtoMessage()    {}
+func (*StakePoolFeeReportResponse_UserFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{157, 0}
+}
+func (m *StakePoolFeeReportResponse_UserFees) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StakePoolFeeReportResponse_UserFees.Unmarshal(m, b)
+}
+func (m *StakePoolFeeReportResponse_UserFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StakePoolFeeReportResponse_UserFees.Marshal(b, m, deterministic)
+}
+func (dst *StakePoolFeeReportResponse_UserFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakePoolFeeReportResponse_UserFees.Merge(dst, src)
+}
+func (m *StakePoolFeeReportResponse_UserFees) XXX_Size() int {
+	return xxx_messageInfo_StakePoolFeeReportResponse_UserFees.Size(m)
+}
+func (m *StakePoolFeeReportResponse_UserFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakePoolFeeReportResponse_UserFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakePoolFeeReportResponse_UserFees proto.InternalMessageInfo
+
+func (m *StakePoolFeeReportResponse_UserFees) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *StakePoolFeeReportResponse_UserFees) GetFees() *StakePoolFees {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
+type StakePoolFeeReportResponse_Totals struct {
+	Users                uint32         `protobuf:"varint,1,opt,name=users,proto3" json:"users,omitempty"`
+	BeginHeight          int32          `protobuf:"varint,2,opt,name=begin_height,json=beginHeight,proto3" json:"begin_height,omitempty"`
+	EndHeight            int32          `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	Fees                 *StakePoolFees `protobuf:"bytes,4,opt,name=fees,proto3" json:"fees,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *StakePoolFeeReportResponse_Totals) Reset()         { *m = StakePoolFeeReportResponse_Totals{} }
+func (m *StakePoolFeeReportResponse_Totals) String() string { return proto.CompactTextString(m) }
+func (*StakePoolFeeReportResponse_Totals) ProtoMessage()    {}
+func (*StakePoolFeeReportResponse_Totals) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{157, 1}
+}
+func (m *StakePoolFeeReportResponse_Totals) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StakePoolFeeReportResponse_Totals.Unmarshal(m, b)
+}
+func (m *StakePoolFeeReportResponse_Totals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StakePoolFeeReportResponse_Totals.Marshal(b, m, deterministic)
+}
+func (dst *StakePoolFeeReportResponse_Totals) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakePoolFeeReportResponse_Totals.Merge(dst, src)
+}
+func (m *StakePoolFeeReportResponse_Totals) XXX_Size() int {
+	return xxx_messageInfo_StakePoolFeeReportResponse_Totals.Size(m)
+}
+func (m *StakePoolFeeReportResponse_Totals) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakePoolFeeReportResponse_Totals.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakePoolFeeReportResponse_Totals proto.InternalMessageInfo
+
+func (m *StakePoolFeeReportResponse_Totals) GetUsers() uint32 {
+	if m != nil {
+		return m.Users
+	}
+	return 0
+}
+
+func (m *StakePoolFeeReportResponse_Totals) GetBeginHeight() int32 {
+	if m != nil {
+		return m.BeginHeight
+	}
+	return 0
+}
+
+func (m *StakePoolFeeReportResponse_Totals) GetEndHeight() int32 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *StakePoolFeeReportResponse_Totals) GetFees() *StakePoolFees {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
+type RevocationNotificationsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevocationNotificationsRequest) Reset()         { *m = RevocationNotificationsRequest{} }
+func (m *RevocationNotificationsRequest) String() string { return proto.CompactTextString(m) }
+func (*RevocationNotificationsRequest) ProtoMessage()    {}
+func (*RevocationNotificationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{158}
+}
+func (m *RevocationNotificationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevocationNotificationsRequest.Unmarshal(m, b)
+}
+func (m *RevocationNotificationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevocationNotificationsRequest.Marshal(b, m, deterministic)
+}
+func (dst *RevocationNotificationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevocationNotificationsRequest.Merge(dst, src)
+}
+func (m *RevocationNotificationsRequest) XXX_Size() int {
+	return xxx_messageInfo_RevocationNotificationsRequest.Size(m)
+}
+func (m *RevocationNotificationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevocationNotificationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevocationNotificationsRequest proto.InternalMessageInfo
+
+type RevocationNotificationsResponse struct {
+	Height               int32                                     `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Results              []*RevocationNotificationsResponse_Result `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
+	XXX_unrecognized     []byte                                    `json:"-"`
+	XXX_sizecache        int32                                     `json:"-"`
+}
+
+func (m *RevocationNotificationsResponse) Reset()         { *m = RevocationNotificationsResponse{} }
+func (m *RevocationNotificationsResponse) String() string { return proto.CompactTextString(m) }
+func (*RevocationNotificationsResponse) ProtoMessage()    {}
+func (*RevocationNotificationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{159}
+}
+func (m *RevocationNotificationsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevocationNotificationsResponse.Unmarshal(m, b)
+}
+func (m *RevocationNotificationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevocationNotificationsResponse.Marshal(b, m, deterministic)
+}
+func (dst *RevocationNotificationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevocationNotificationsResponse.Merge(dst, src)
+}
+func (m *RevocationNotificationsResponse) XXX_Size() int {
+	return xxx_messageInfo_RevocationNotificationsResponse.Size(m)
+}
+func (m *RevocationNotificationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevocationNotificationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevocationNotificationsResponse proto.InternalMessageInfo
+
+func (m *RevocationNotificationsResponse) GetHeight() int32 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *RevocationNotificationsResponse) GetResults() []*RevocationNotificationsResponse_Result {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type RevocationNotificationsResponse_Result struct {
+	TicketHash           []byte                                        `protobuf:"bytes,1,opt,name=ticket_hash,json=ticketHash,proto3" json:"ticket_hash,omitempty"`
+	Reason               RevocationNotificationsResponse_Result_Reason `protobuf:"varint,2,opt,name=reason,proto3,enum=walletrpc.RevocationNotificationsResponse_Result_Reason" json:"reason,omitempty"`
+	RevocationHash       []byte                                        `protobuf:"bytes,3,opt,name=revocation_hash,json=revocationHash,proto3" json:"revocation_hash,omitempty"`
+	Fee                  int64                                         `protobuf:"varint,4,opt,name=fee,proto3" json:"fee,omitempty"`
+	Error                string                                        `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
+	XXX_unrecognized     []byte                                        `json:"-"`
+	XXX_sizecache        int32                                         `json:"-"`
+}
+
+func (m *RevocationNotificationsResponse_Result) Reset() {
+	*m = RevocationNotificationsResponse_Result{}
+}
+func (m *RevocationNotificationsResponse_Result) String() string { return proto.CompactTextString(m) }
+func (*RevocationNotificationsResponse_Result) ProtoMessage()    {}
+func (*RevocationNotificationsResponse_Result) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{159, 0}
+}
+func (m *RevocationNotificationsResponse_Result) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevocationNotificationsResponse_Result.Unmarshal(m, b)
+}
+func (m *RevocationNotificationsResponse_Result) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevocationNotificationsResponse_Result.Marshal(b, m, deterministic)
+}
+func (dst *RevocationNotificationsResponse_Result) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevocationNotificationsResponse_Result.Merge(dst, src)
+}
+func (m *RevocationNotificationsResponse_Result) XXX_Size() int {
+	return xxx_messageInfo_RevocationNotificationsResponse_Result.Size(m)
+}
+func (m *RevocationNotificationsResponse_Result) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevocationNotificationsResponse_Result.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevocationNotificationsResponse_Result proto.InternalMessageInfo
+
+func (m *RevocationNotificationsResponse_Result) GetTicketHash() []byte {
+	if m != nil {
+		return m.TicketHash
+	}
+	return nil
+}
+
+func (m *RevocationNotificationsResponse_Result) GetReason() RevocationNotificationsResponse_Result_Reason {
+	if m != nil {
+		return m.Reason
+	}
+	return RevocationNotificationsResponse_Result_MISSED
+}
+
+func (m *RevocationNotificationsResponse_Result) GetRevocationHash() []byte {
+	if m != nil {
+		return m.RevocationHash
+	}
+	return nil
+}
+
+func (m *RevocationNotificationsResponse_Result) GetFee() int64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+func (m *RevocationNotificationsResponse_Result) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type TicketPriceForecastRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TicketPriceForecastRequest) Reset()         { *m = TicketPriceForecastRequest{} }
+func (m *TicketPriceForecastRequest) String() string { return proto.CompactTextString(m) }
+func (*TicketPriceForecastRequest) ProtoMessage()    {}
+func (*TicketPriceForecastRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{160}
+}
+func (m *TicketPriceForecastRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TicketPriceForecastRequest.Unmarshal(m, b)
+}
+func (m *TicketPriceForecastRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TicketPriceForecastRequest.Marshal(b, m, deterministic)
+}
+func (dst *TicketPriceForecastRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TicketPriceForecastRequest.Merge(dst, src)
+}
+func (m *TicketPriceForecastRequest) XXX_Size() int {
+	return xxx_messageInfo_TicketPriceForecastRequest.Size(m)
+}
+func (m *TicketPriceForecastRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TicketPriceForecastRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TicketPriceForecastRequest proto.InternalMessageInfo
+
+type TicketPriceForecastResponse struct {
+	Height               int32                               `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	TicketPrice          int64                               `protobuf:"varint,2,opt,name=ticket_price,json=ticketPrice,proto3" json:"ticket_price,omitempty"`
+	NextWindow           *TicketPriceForecastResponse_Window `protobuf:"bytes,3,opt,name=next_window,json=nextWindow,proto3" json:"next_window,omitempty"`
+	FollowingWindow      *TicketPriceForecastResponse_Window `protobuf:"bytes,4,opt,name=following_window,json=followingWindow,proto3" json:"following_window,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
+	XXX_unrecognized     []byte                              `json:"-"`
+	XXX_sizecache        int32                               `json:"-"`
+}
+
+func (m *TicketPriceForecastResponse) Reset()         { *m = TicketPriceForecastResponse{} }
+func (m *TicketPriceForecastResponse) String() string { return proto.CompactTextString(m) }
+func (*TicketPriceForecastResponse) ProtoMessage()    {}
+func (*TicketPriceForecastResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{161}
+}
+func (m *TicketPriceForecastResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TicketPriceForecastResponse.Unmarshal(m, b)
+}
+func (m *TicketPriceForecastResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TicketPriceForecastResponse.Marshal(b, m, deterministic)
+}
+func (dst *TicketPriceForecastResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TicketPriceForecastResponse.Merge(dst, src)
+}
+func (m *TicketPriceForecastResponse) XXX_Size() int {
+	return xxx_messageInfo_TicketPriceForecastResponse.Size(m)
+}
+func (m *TicketPriceForecastResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TicketPriceForecastResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TicketPriceForecastResponse proto.InternalMessageInfo
+
+func (m *TicketPriceForecastResponse) GetHeight() int32 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *TicketPriceForecastResponse) GetTicketPrice() int64 {
+	if m != nil {
+		return m.TicketPrice
+	}
+	return 0
+}
+
+func (m *TicketPriceForecastResponse) GetNextWindow() *TicketPriceForecastResponse_Window {
+	if m != nil {
+		return m.NextWindow
+	}
+	return nil
+}
+
+func (m *TicketPriceForecastResponse) GetFollowingWindow() *TicketPriceForecastResponse_Window {
+	if m != nil {
+		return m.FollowingWindow
+	}
+	return nil
+}
+
+type TicketPriceForecastResponse_Window struct {
+	Height               int32    `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	MinTicketPrice       int64    `protobuf:"varint,2,opt,name=min_ticket_price,json=minTicketPrice,proto3" json:"min_ticket_price,omitempty"`
+	ExpectedTicketPrice  int64    `protobuf:"varint,3,opt,name=expected_ticket_price,json=expectedTicketPrice,proto3" json:"expected_ticket_price,omitempty"`
+	MaxTicketPrice       int64    `protobuf:"varint,4,opt,name=max_ticket_price,json=maxTicketPrice,proto3" json:"max_ticket_price,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TicketPriceForecastResponse_Window) Reset()         { *m = TicketPriceForecastResponse_Window{} }
+func (m *TicketPriceForecastResponse_Window) String() string { return proto.CompactTextString(m) }
+func (*TicketPriceForecastResponse_Window) ProtoMessage()    {}
+func (*TicketPriceForecastResponse_Window) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{161, 0}
+}
+func (m *TicketPriceForecastResponse_Window) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TicketPriceForecastResponse_Window.Unmarshal(m, b)
+}
+func (m *TicketPriceForecastResponse_Window) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TicketPriceForecastResponse_Window.Marshal(b, m, deterministic)
+}
+func (dst *TicketPriceForecastResponse_Window) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TicketPriceForecastResponse_Window.Merge(dst, src)
+}
+func (m *TicketPriceForecastResponse_Window) XXX_Size() int {
+	return xxx_messageInfo_TicketPriceForecastResponse_Window.Size(m)
+}
+func (m *TicketPriceForecastResponse_Window) XXX_DiscardUnknown() {
+	xxx_messageInfo_TicketPriceForecastResponse_Window.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TicketPriceForecastResponse_Window proto.InternalMessageInfo
+
+func (m *TicketPriceForecastResponse_Window) GetHeight() int32 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *TicketPriceForecastResponse_Window) GetMinTicketPrice() int64 {
+	if m != nil {
+		return m.MinTicketPrice
+	}
+	return 0
+}
+
+func (m *TicketPriceForecastResponse_Window) GetExpectedTicketPrice() int64 {
+	if m != nil {
+		return m.ExpectedTicketPrice
+	}
+	return 0
+}
+
+func (m *TicketPriceForecastResponse_Window) GetMaxTicketPrice() int64 {
+	if m != nil {
+		return m.MaxTicketPrice
+	}
+	return 0
+}
+
+type VotingTicket struct {
+	Ticket               []byte   `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	Branch               uint32   `protobuf:"varint,2,opt,name=branch,proto3" json:"branch,omitempty"`
+	Child                uint32   `protobuf:"varint,3,opt,name=child,proto3" json:"child,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VotingTicket) Reset()         { *m = VotingTicket{} }
+func (m *VotingTicket) String() string { return proto.CompactTextString(m) }
+func (*VotingTicket) ProtoMessage()    {}
+func (*VotingTicket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{162}
+}
+func (m *VotingTicket) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VotingTicket.Unmarshal(m, b)
+}
+func (m *VotingTicket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VotingTicket.Marshal(b, m, deterministic)
+}
+func (dst *VotingTicket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VotingTicket.Merge(dst, src)
+}
+func (m *VotingTicket) XXX_Size() int {
+	return xxx_messageInfo_VotingTicket.Size(m)
+}
+func (m *VotingTicket) XXX_DiscardUnknown() {
+	xxx_messageInfo_VotingTicket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VotingTicket proto.InternalMessageInfo
+
+func (m *VotingTicket) GetTicket() []byte {
+	if m != nil {
+		return m.Ticket
+	}
+	return nil
+}
+
+func (m *VotingTicket) GetBranch() uint32 {
+	if m != nil {
+		return m.Branch
+	}
+	return 0
+}
+
+func (m *VotingTicket) GetChild() uint32 {
+	if m != nil {
+		return m.Child
+	}
+	return 0
+}
+
+type ExportVotingAccountRequest struct {
+	Passphrase           []byte   `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	Account              uint32   `protobuf:"varint,2,opt,name=account,proto3" json:"account,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportVotingAccountRequest) Reset()         { *m = ExportVotingAccountRequest{} }
+func (m *ExportVotingAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportVotingAccountRequest) ProtoMessage()    {}
+func (*ExportVotingAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{163}
+}
+func (m *ExportVotingAccountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportVotingAccountRequest.Unmarshal(m, b)
+}
+func (m *ExportVotingAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportVotingAccountRequest.Marshal(b, m, deterministic)
+}
+func (dst *ExportVotingAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportVotingAccountRequest.Merge(dst, src)
+}
+func (m *ExportVotingAccountRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportVotingAccountRequest.Size(m)
+}
+func (m *ExportVotingAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportVotingAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportVotingAccountRequest proto.InternalMessageInfo
+
+func (m *ExportVotingAccountRequest) GetPassphrase() []byte {
+	if m != nil {
+		return m.Passphrase
+	}
+	return nil
+}
+
+func (m *ExportVotingAccountRequest) GetAccount() uint32 {
+	if m != nil {
+		return m.Account
+	}
+	return 0
+}
+
+type ExportVotingAccountResponse struct {
+	AccountXpriv         string   `protobuf:"bytes,1,opt,name=account_xpriv,json=accountXpriv,proto3" json:"account_xpriv,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportVotingAccountResponse) Reset()         { *m = ExportVotingAccountResponse{} }
+func (m *ExportVotingAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportVotingAccountResponse) ProtoMessage()    {}
+func (*ExportVotingAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{164}
+}
+func (m *ExportVotingAccountResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportVotingAccountResponse.Unmarshal(m, b)
+}
+func (m *ExportVotingAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportVotingAccountResponse.Marshal(b, m, deterministic)
+}
+func (dst *ExportVotingAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportVotingAccountResponse.Merge(dst, src)
+}
+func (m *ExportVotingAccountResponse) XXX_Size() int {
+	return xxx_messageInfo_ExportVotingAccountResponse.Size(m)
+}
+func (m *ExportVotingAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportVotingAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportVotingAccountResponse proto.InternalMessageInfo
+
+func (m *ExportVotingAccountResponse) GetAccountXpriv() string {
+	if m != nil {
+		return m.AccountXpriv
+	}
+	return ""
+}
+
+type ImportVotingAccountRequest struct {
+	Passphrase           []byte   `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	AccountXpriv         string   `protobuf:"bytes,3,opt,name=account_xpriv,json=accountXpriv,proto3" json:"account_xpriv,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportVotingAccountRequest) Reset()         { *m = ImportVotingAccountRequest{} }
+func (m *ImportVotingAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportVotingAccountRequest) ProtoMessage()    {}
+func (*ImportVotingAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{165}
+}
+func (m *ImportVotingAccountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportVotingAccountRequest.Unmarshal(m, b)
+}
+func (m *ImportVotingAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportVotingAccountRequest.Marshal(b, m, deterministic)
+}
+func (dst *ImportVotingAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportVotingAccountRequest.Merge(dst, src)
+}
+func (m *ImportVotingAccountRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportVotingAccountRequest.Size(m)
+}
+func (m *ImportVotingAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportVotingAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportVotingAccountRequest proto.InternalMessageInfo
+
+func (m *ImportVotingAccountRequest) GetPassphrase() []byte {
+	if m != nil {
+		return m.Passphrase
+	}
+	return nil
+}
+
+func (m *ImportVotingAccountRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ImportVotingAccountRequest) GetAccountXpriv() string {
+	if m != nil {
+		return m.AccountXpriv
+	}
+	return ""
+}
+
+type ImportVotingAccountResponse struct {
+	Account              uint32   `protobuf:"varint,1,opt,name=account,proto3" json:"account,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportVotingAccountResponse) Reset()         { *m = ImportVotingAccountResponse{} }
+func (m *ImportVotingAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*ImportVotingAccountResponse) ProtoMessage()    {}
+func (*ImportVotingAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{166}
+}
+func (m *ImportVotingAccountResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportVotingAccountResponse.Unmarshal(m, b)
+}
+func (m *ImportVotingAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportVotingAccountResponse.Marshal(b, m, deterministic)
+}
+func (dst *ImportVotingAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportVotingAccountResponse.Merge(dst, src)
+}
+func (m *ImportVotingAccountResponse) XXX_Size() int {
+	return xxx_messageInfo_ImportVotingAccountResponse.Size(m)
+}
+func (m *ImportVotingAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportVotingAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportVotingAccountResponse proto.InternalMessageInfo
+
+func (m *ImportVotingAccountResponse) GetAccount() uint32 {
+	if m != nil {
+		return m.Account
+	}
+	return 0
+}
+
+type ExportAccountKeyRequest struct {
+	Passphrase           []byte   `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	Account              uint32   `protobuf:"varint,2,opt,name=account,proto3" json:"account,omitempty"`
+	AccountPassphrase    []byte   `protobuf:"bytes,3,opt,name=account_passphrase,json=accountPassphrase,proto3" json:"account_passphrase,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportAccountKeyRequest) Reset()         { *m = ExportAccountKeyRequest{} }
+func (m *ExportAccountKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportAccountKeyRequest) ProtoMessage()    {}
+func (*ExportAccountKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{167}
+}
+func (m *ExportAccountKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportAccountKeyRequest.Unmarshal(m, b)
+}
+func (m *ExportAccountKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportAccountKeyRequest.Marshal(b, m, deterministic)
+}
+func (dst *ExportAccountKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportAccountKeyRequest.Merge(dst, src)
+}
+func (m *ExportAccountKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportAccountKeyRequest.Size(m)
+}
+func (m *ExportAccountKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportAccountKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportAccountKeyRequest proto.InternalMessageInfo
+
+func (m *ExportAccountKeyRequest) GetPassphrase() []byte {
+	if m != nil {
+		return m.Passphrase
+	}
+	return nil
+}
+
+func (m *ExportAccountKeyRequest) GetAccount() uint32 {
+	if m != nil {
+		return m.Account
+	}
+	return 0
+}
+
+func (m *ExportAccountKeyRequest) GetAccountPassphrase() []byte {
+	if m != nil {
+		return m.AccountPassphrase
+	}
+	return nil
+}
+
+type ExportAccountKeyResponse struct {
+	AccountXpriv         string   `protobuf:"bytes,1,opt,name=account_xpriv,json=accountXpriv,proto3" json:"account_xpriv,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportAccountKeyResponse) Reset()         { *m = ExportAccountKeyResponse{} }
+func (m *ExportAccountKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportAccountKeyResponse) ProtoMessage()    {}
+func (*ExportAccountKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{168}
+}
+func (m *ExportAccountKeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportAccountKeyResponse.Unmarshal(m, b)
+}
+func (m *ExportAccountKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportAccountKeyResponse.Marshal(b, m, deterministic)
+}
+func (dst *ExportAccountKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportAccountKeyResponse.Merge(dst, src)
+}
+func (m *ExportAccountKeyResponse) XXX_Size() int {
+	return xxx_messageInfo_ExportAccountKeyResponse.Size(m)
+}
+func (m *ExportAccountKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportAccountKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportAccountKeyResponse proto.InternalMessageInfo
+
+func (m *ExportAccountKeyResponse) GetAccountXpriv() string {
+	if m != nil {
+		return m.AccountXpriv
+	}
+	return ""
+}
+
+type ImportAccountKeyRequest struct {
+	Passphrase           []byte   `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	AccountXpriv         string   `protobuf:"bytes,3,opt,name=account_xpriv,json=accountXpriv,proto3" json:"account_xpriv,omitempty"`
+	AccountPassphrase    []byte   `protobuf:"bytes,4,opt,name=account_passphrase,json=accountPassphrase,proto3" json:"account_passphrase,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportAccountKeyRequest) Reset()         { *m = ImportAccountKeyRequest{} }
+func (m *ImportAccountKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportAccountKeyRequest) ProtoMessage()    {}
+func (*ImportAccountKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{169}
+}
+func (m *ImportAccountKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportAccountKeyRequest.Unmarshal(m, b)
+}
+func (m *ImportAccountKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportAccountKeyRequest.Marshal(b, m, deterministic)
+}
+func (dst *ImportAccountKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportAccountKeyRequest.Merge(dst, src)
+}
+func (m *ImportAccountKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportAccountKeyRequest.Size(m)
+}
+func (m *ImportAccountKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportAccountKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportAccountKeyRequest proto.InternalMessageInfo
+
+func (m *ImportAccountKeyRequest) GetPassphrase() []byte {
+	if m != nil {
+		return m.Passphrase
+	}
+	return nil
+}
+
+func (m *ImportAccountKeyRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ImportAccountKeyRequest) GetAccountXpriv() string {
+	if m != nil {
+		return m.AccountXpriv
+	}
+	return ""
+}
+
+func (m *ImportAccountKeyRequest) GetAccountPassphrase() []byte {
+	if m != nil {
+		return m.AccountPassphrase
+	}
+	return nil
+}
+
+type ImportAccountKeyResponse struct {
+	Account              uint32   `protobuf:"varint,1,opt,name=account,proto3" json:"account,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportAccountKeyResponse) Reset()         { *m = ImportAccountKeyResponse{} }
+func (m *ImportAccountKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*ImportAccountKeyResponse) ProtoMessage()    {}
+func (*ImportAccountKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{170}
+}
+func (m *ImportAccountKeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportAccountKeyResponse.Unmarshal(m, b)
+}
+func (m *ImportAccountKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportAccountKeyResponse.Marshal(b, m, deterministic)
+}
+func (dst *ImportAccountKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportAccountKeyResponse.Merge(dst, src)
+}
+func (m *ImportAccountKeyResponse) XXX_Size() int {
+	return xxx_messageInfo_ImportAccountKeyResponse.Size(m)
+}
+func (m *ImportAccountKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportAccountKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportAccountKeyResponse proto.InternalMessageInfo
+
+func (m *ImportAccountKeyResponse) GetAccount() uint32 {
+	if m != nil {
+		return m.Account
+	}
+	return 0
+}
+
+type VotingAccountTicketsRequest struct {
+	Account              uint32   `protobuf:"varint,1,opt,name=account,proto3" json:"account,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VotingAccountTicketsRequest) Reset()         { *m = VotingAccountTicketsRequest{} }
+func (m *VotingAccountTicketsRequest) String() string { return proto.CompactTextString(m) }
+func (*VotingAccountTicketsRequest) ProtoMessage()    {}
+func (*VotingAccountTicketsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{171}
+}
+func (m *VotingAccountTicketsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VotingAccountTicketsRequest.Unmarshal(m, b)
+}
+func (m *VotingAccountTicketsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VotingAccountTicketsRequest.Marshal(b, m, deterministic)
+}
+func (dst *VotingAccountTicketsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VotingAccountTicketsRequest.Merge(dst, src)
+}
+func (m *VotingAccountTicketsRequest) XXX_Size() int {
+	return xxx_messageInfo_VotingAccountTicketsRequest.Size(m)
+}
+func (m *VotingAccountTicketsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VotingAccountTicketsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VotingAccountTicketsRequest proto.InternalMessageInfo
+
+func (m *VotingAccountTicketsRequest) GetAccount() uint32 {
+	if m != nil {
+		return m.Account
+	}
+	return 0
+}
+
+type VotingAccountTicketsResponse struct {
+	Tickets              []*VotingTicket `protobuf:"bytes,1,rep,name=tickets,proto3" json:"tickets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *VotingAccountTicketsResponse) Reset()         { *m = VotingAccountTicketsResponse{} }
+func (m *VotingAccountTicketsResponse) String() string { return proto.CompactTextString(m) }
+func (*VotingAccountTicketsResponse) ProtoMessage()    {}
+func (*VotingAccountTicketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{172}
+}
+func (m *VotingAccountTicketsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VotingAccountTicketsResponse.Unmarshal(m, b)
+}
+func (m *VotingAccountTicketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VotingAccountTicketsResponse.Marshal(b, m, deterministic)
+}
+func (dst *VotingAccountTicketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VotingAccountTicketsResponse.Merge(dst, src)
+}
+func (m *VotingAccountTicketsResponse) XXX_Size() int {
+	return xxx_messageInfo_VotingAccountTicketsResponse.Size(m)
+}
+func (m *VotingAccountTicketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VotingAccountTicketsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VotingAccountTicketsResponse proto.InternalMessageInfo
+
+func (m *VotingAccountTicketsResponse) GetTickets() []*VotingTicket {
+	if m != nil {
+		return m.Tickets
+	}
+	return nil
+}
+
+type AddVotingTicketsRequest struct {
+	Account              uint32          `protobuf:"varint,1,opt,name=account,proto3" json:"account,omitempty"`
+	Tickets              []*VotingTicket `protobuf:"bytes,2,rep,name=tickets,proto3" json:"tickets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *AddVotingTicketsRequest) Reset()         { *m = AddVotingTicketsRequest{} }
+func (m *AddVotingTicketsRequest) String() string { return proto.CompactTextString(m) }
+func (*AddVotingTicketsRequest) ProtoMessage()    {}
+func (*AddVotingTicketsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{173}
+}
+func (m *AddVotingTicketsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddVotingTicketsRequest.Unmarshal(m, b)
+}
+func (m *AddVotingTicketsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddVotingTicketsRequest.Marshal(b, m, deterministic)
+}
+func (dst *AddVotingTicketsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddVotingTicketsRequest.Merge(dst, src)
+}
+func (m *AddVotingTicketsRequest) XXX_Size() int {
+	return xxx_messageInfo_AddVotingTicketsRequest.Size(m)
+}
+func (m *AddVotingTicketsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddVotingTicketsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddVotingTicketsRequest proto.InternalMessageInfo
+
+func (m *AddVotingTicketsRequest) GetAccount() uint32 {
+	if m != nil {
+		return m.Account
+	}
+	return 0
+}
+
+func (m *AddVotingTicketsRequest) GetTickets() []*VotingTicket {
+	if m != nil {
+		return m.Tickets
+	}
+	return nil
+}
+
+type AddVotingTicketsResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddVotingTicketsResponse) Reset()         { *m = AddVotingTicketsResponse{} }
+func (m *AddVotingTicketsResponse) String() string { return proto.CompactTextString(m) }
+func (*AddVotingTicketsResponse) ProtoMessage()    {}
+func (*AddVotingTicketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{174}
+}
+func (m *AddVotingTicketsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddVotingTicketsResponse.Unmarshal(m, b)
+}
+func (m *AddVotingTicketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddVotingTicketsResponse.Marshal(b, m, deterministic)
+}
+func (dst *AddVotingTicketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddVotingTicketsResponse.Merge(dst, src)
+}
+func (m *AddVotingTicketsResponse) XXX_Size() int {
+	return xxx_messageInfo_AddVotingTicketsResponse.Size(m)
+}
+func (m *AddVotingTicketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddVotingTicketsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddVotingTicketsResponse proto.InternalMessageInfo
+
+type AuditLogRequest struct {
+	StartSeq             uint64   `protobuf:"varint,1,opt,name=start_seq,json=startSeq,proto3" json:"start_seq,omitempty"`
+	Count                uint32   `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuditLogRequest) Reset()         { *m = AuditLogRequest{} }
+func (m *AuditLogRequest) String() string { return proto.CompactTextString(m) }
+func (*AuditLogRequest) ProtoMessage()    {}
+func (*AuditLogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{175}
+}
+func (m *AuditLogRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditLogRequest.Unmarshal(m, b)
+}
+func (m *AuditLogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditLogRequest.Marshal(b, m, deterministic)
+}
+func (dst *AuditLogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditLogRequest.Merge(dst, src)
+}
+func (m *AuditLogRequest) XXX_Size() int {
+	return xxx_messageInfo_AuditLogRequest.Size(m)
+}
+func (m *AuditLogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditLogRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditLogRequest proto.InternalMessageInfo
+
+func (m *AuditLogRequest) GetStartSeq() uint64 {
+	if m != nil {
+		return m.StartSeq
+	}
+	return 0
+}
+
+func (m *AuditLogRequest) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type AuditLogResponse struct {
+	Entries              []*AuditLogResponse_Entry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	HeadSeq              uint64                    `protobuf:"varint,2,opt,name=head_seq,json=headSeq,proto3" json:"head_seq,omitempty"`
+	HeadHash             string                    `protobuf:"bytes,3,opt,name=head_hash,json=headHash,proto3" json:"head_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *AuditLogResponse) Reset()         { *m = AuditLogResponse{} }
+func (m *AuditLogResponse) String() string { return proto.CompactTextString(m) }
+func (*AuditLogResponse) ProtoMessage()    {}
+func (*AuditLogResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{176}
+}
+func (m *AuditLogResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditLogResponse.Unmarshal(m, b)
+}
+func (m *AuditLogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditLogResponse.Marshal(b, m, deterministic)
+}
+func (dst *AuditLogResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditLogResponse.Merge(dst, src)
+}
+func (m *AuditLogResponse) XXX_Size() int {
+	return xxx_messageInfo_AuditLogResponse.Size(m)
+}
+func (m *AuditLogResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditLogResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditLogResponse proto.InternalMessageInfo
+
+func (m *AuditLogResponse) GetEntries() []*AuditLogResponse_Entry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *AuditLogResponse) GetHeadSeq() uint64 {
+	if m != nil {
+		return m.HeadSeq
+	}
+	return 0
+}
+
+func (m *AuditLogResponse) GetHeadHash() string {
+	if m != nil {
+		return m.HeadHash
+	}
+	return ""
+}
+
+type AuditLogResponse_Entry struct {
+	Seq                  uint64            `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	Time                 string            `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	Source               string            `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	Operation            string            `protobuf:"bytes,4,opt,name=operation,proto3" json:"operation,omitempty"`
+	Client               string            `protobuf:"bytes,5,opt,name=client,proto3" json:"client,omitempty"`
+	RemoteAddress        string            `protobuf:"bytes,6,opt,name=remote_address,json=remoteAddress,proto3" json:"remote_address,omitempty"`
+	Details              map[string]string `protobuf:"bytes,7,rep,name=details,proto3" json:"details,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Error                string            `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	PrevHash             string            `protobuf:"bytes,9,opt,name=prev_hash,json=prevHash,proto3" json:"prev_hash,omitempty"`
+	Hash                 string            `protobuf:"bytes,10,opt,name=hash,proto3" json:"hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *AuditLogResponse_Entry) Reset()         { *m = AuditLogResponse_Entry{} }
+func (m *AuditLogResponse_Entry) String() string { return proto.CompactTextString(m) }
+func (*AuditLogResponse_Entry) ProtoMessage()    {}
+func (*AuditLogResponse_Entry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{176, 0}
+}
+func (m *AuditLogResponse_Entry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditLogResponse_Entry.Unmarshal(m, b)
+}
+func (m *AuditLogResponse_Entry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditLogResponse_Entry.Marshal(b, m, deterministic)
+}
+func (dst *AuditLogResponse_Entry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditLogResponse_Entry.Merge(dst, src)
+}
+func (m *AuditLogResponse_Entry) XXX_Size() int {
+	return xxx_messageInfo_AuditLogResponse_Entry.Size(m)
+}
+func (m *AuditLogResponse_Entry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditLogResponse_Entry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditLogResponse_Entry proto.InternalMessageInfo
+
+func (m *AuditLogResponse_Entry) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *AuditLogResponse_Entry) GetTime() string {
+	if m != nil {
+		return m.Time
+	}
+	return ""
+}
+
+func (m *AuditLogResponse_Entry) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *AuditLogResponse_Entry) GetOperation() string {
+	if m != nil {
+		return m.Operation
+	}
+	return ""
+}
+
+func (m *AuditLogResponse_Entry) GetClient() string {
+	if m != nil {
+		return m.Client
+	}
+	return ""
+}
+
+func (m *AuditLogResponse_Entry) GetRemoteAddress() string {
+	if m != nil {
+		return m.RemoteAddress
+	}
+	return ""
+}
+
+func (m *AuditLogResponse_Entry) GetDetails() map[string]string {
+	if m != nil {
+		return m.Details
+	}
+	return nil
+}
+
+func (m *AuditLogResponse_Entry) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *AuditLogResponse_Entry) GetPrevHash() string {
+	if m != nil {
+		return m.PrevHash
+	}
+	return ""
+}
+
+func (m *AuditLogResponse_Entry) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+type PendingTransactionsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PendingTransactionsRequest) Reset()         { *m = PendingTransactionsRequest{} }
+func (m *PendingTransactionsRequest) String() string { return proto.CompactTextString(m) }
+func (*PendingTransactionsRequest) ProtoMessage()    {}
+func (*PendingTransactionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{177}
+}
+func (m *PendingTransactionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingTransactionsRequest.Unmarshal(m, b)
+}
+func (m *PendingTransactionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingTransactionsRequest.Marshal(b, m, deterministic)
+}
+func (dst *PendingTransactionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingTransactionsRequest.Merge(dst, src)
+}
+func (m *PendingTransactionsRequest) XXX_Size() int {
+	return xxx_messageInfo_PendingTransactionsRequest.Size(m)
+}
+func (m *PendingTransactionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingTransactionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingTransactionsRequest proto.InternalMessageInfo
+
+type PendingTransactionsResponse struct {
+	Transactions         []*PendingTransactionsResponse_PendingTransaction `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                          `json:"-"`
+	XXX_unrecognized     []byte                                            `json:"-"`
+	XXX_sizecache        int32                                             `json:"-"`
+}
+
+func (m *PendingTransactionsResponse) Reset()         { *m = PendingTransactionsResponse{} }
+func (m *PendingTransactionsResponse) String() string { return proto.CompactTextString(m) }
+func (*PendingTransactionsResponse) ProtoMessage()    {}
+func (*PendingTransactionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{178}
+}
+func (m *PendingTransactionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingTransactionsResponse.Unmarshal(m, b)
+}
+func (m *PendingTransactionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingTransactionsResponse.Marshal(b, m, deterministic)
+}
+func (dst *PendingTransactionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingTransactionsResponse.Merge(dst, src)
+}
+func (m *PendingTransactionsResponse) XXX_Size() int {
+	return xxx_messageInfo_PendingTransactionsResponse.Size(m)
+}
+func (m *PendingTransactionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingTransactionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingTransactionsResponse proto.InternalMessageInfo
+
+func (m *PendingTransactionsResponse) GetTransactions() []*PendingTransactionsResponse_PendingTransaction {
+	if m != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+type PendingTransactionsResponse_PendingTransaction struct {
+	TransactionHash      []byte   `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	Transaction          []byte   `protobuf:"bytes,2,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	Accounts             []uint32 `protobuf:"varint,3,rep,packed,name=accounts,proto3" json:"accounts,omitempty"`
+	Amount               int64    `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Created              int64    `protobuf:"varint,5,opt,name=created,proto3" json:"created,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PendingTransactionsResponse_PendingTransaction) Reset() {
+	*m = PendingTransactionsResponse_PendingTransaction{}
+}
+func (m *PendingTransactionsResponse_PendingTransaction) String() string {
+	return proto.CompactTextString(m)
+}
+func (*PendingTransactionsResponse_PendingTransaction) ProtoMessage() {}
+func (*PendingTransactionsResponse_PendingTransaction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{178, 0}
+}
+func (m *PendingTransactionsResponse_PendingTransaction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingTransactionsResponse_PendingTransaction.Unmarshal(m, b)
+}
+func (m *PendingTransactionsResponse_PendingTransaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingTransactionsResponse_PendingTransaction.Marshal(b, m, deterministic)
+}
+func (dst *PendingTransactionsResponse_PendingTransaction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingTransactionsResponse_PendingTransaction.Merge(dst, src)
+}
+func (m *PendingTransactionsResponse_PendingTransaction) XXX_Size() int {
+	return xxx_messageInfo_PendingTransactionsResponse_PendingTransaction.Size(m)
+}
+func (m *PendingTransactionsResponse_PendingTransaction) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingTransactionsResponse_PendingTransaction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingTransactionsResponse_PendingTransaction proto.InternalMessageInfo
+
+func (m *PendingTransactionsResponse_PendingTransaction) GetTransactionHash() []byte {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return nil
+}
+
+func (m *PendingTransactionsResponse_PendingTransaction) GetTransaction() []byte {
+	if m != nil {
+		return m.Transaction
+	}
+	return nil
+}
+
+func (m *PendingTransactionsResponse_PendingTransaction) GetAccounts() []uint32 {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+func (m *PendingTransactionsResponse_PendingTransaction) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *PendingTransactionsResponse_PendingTransaction) GetCreated() int64 {
+	if m != nil {
+		return m.Created
+	}
+	return 0
+}
+
+type ApprovePendingTransactionRequest struct {
+	TransactionHash      []byte   `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	ApprovalPassphrase   []byte   `protobuf:"bytes,2,opt,name=approval_passphrase,json=approvalPassphrase,proto3" json:"approval_passphrase,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApprovePendingTransactionRequest) Reset()         { *m = ApprovePendingTransactionRequest{} }
+func (m *ApprovePendingTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*ApprovePendingTransactionRequest) ProtoMessage()    {}
+func (*ApprovePendingTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{179}
+}
+func (m *ApprovePendingTransactionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApprovePendingTransactionRequest.Unmarshal(m, b)
+}
+func (m *ApprovePendingTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApprovePendingTransactionRequest.Marshal(b, m, deterministic)
+}
+func (dst *ApprovePendingTransactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApprovePendingTransactionRequest.Merge(dst, src)
+}
+func (m *ApprovePendingTransactionRequest) XXX_Size() int {
+	return xxx_messageInfo_ApprovePendingTransactionRequest.Size(m)
+}
+func (m *ApprovePendingTransactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApprovePendingTransactionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApprovePendingTransactionRequest proto.InternalMessageInfo
+
+func (m *ApprovePendingTransactionRequest) GetTransactionHash() []byte {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return nil
+}
+
+func (m *ApprovePendingTransactionRequest) GetApprovalPassphrase() []byte {
+	if m != nil {
+		return m.ApprovalPassphrase
+	}
+	return nil
+}
+
+type ApprovePendingTransactionResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApprovePendingTransactionResponse) Reset()         { *m = ApprovePendingTransactionResponse{} }
+func (m *ApprovePendingTransactionResponse) String() string { return proto.CompactTextString(m) }
+func (*ApprovePendingTransactionResponse) ProtoMessage()    {}
+func (*ApprovePendingTransactionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{180}
+}
+func (m *ApprovePendingTransactionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApprovePendingTransactionResponse.Unmarshal(m, b)
+}
+func (m *ApprovePendingTransactionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApprovePendingTransactionResponse.Marshal(b, m, deterministic)
+}
+func (dst *ApprovePendingTransactionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApprovePendingTransactionResponse.Merge(dst, src)
+}
+func (m *ApprovePendingTransactionResponse) XXX_Size() int {
+	return xxx_messageInfo_ApprovePendingTransactionResponse.Size(m)
+}
+func (m *ApprovePendingTransactionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApprovePendingTransactionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApprovePendingTransactionResponse proto.InternalMessageInfo
+
+type RejectPendingTransactionRequest struct {
+	TransactionHash      []byte   `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RejectPendingTransactionRequest) Reset()         { *m = RejectPendingTransactionRequest{} }
+func (m *RejectPendingTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*RejectPendingTransactionRequest) ProtoMessage()    {}
+func (*RejectPendingTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{181}
+}
+func (m *RejectPendingTransactionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RejectPendingTransactionRequest.Unmarshal(m, b)
+}
+func (m *RejectPendingTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RejectPendingTransactionRequest.Marshal(b, m, deterministic)
+}
+func (dst *RejectPendingTransactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RejectPendingTransactionRequest.Merge(dst, src)
+}
+func (m *RejectPendingTransactionRequest) XXX_Size() int {
+	return xxx_messageInfo_RejectPendingTransactionRequest.Size(m)
+}
+func (m *RejectPendingTransactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RejectPendingTransactionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RejectPendingTransactionRequest proto.InternalMessageInfo
+
+func (m *RejectPendingTransactionRequest) GetTransactionHash() []byte {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return nil
+}
+
+type RejectPendingTransactionResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RejectPendingTransactionResponse) Reset()         { *m = RejectPendingTransactionResponse{} }
+func (m *RejectPendingTransactionResponse) String() string { return proto.CompactTextString(m) }
+func (*RejectPendingTransactionResponse) ProtoMessage()    {}
+func (*RejectPendingTransactionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{182}
+}
+func (m *RejectPendingTransactionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RejectPendingTransactionResponse.Unmarshal(m, b)
+}
+func (m *RejectPendingTransactionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RejectPendingTransactionResponse.Marshal(b, m, deterministic)
+}
+func (dst *RejectPendingTransactionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RejectPendingTransactionResponse.Merge(dst, src)
+}
+func (m *RejectPendingTransactionResponse) XXX_Size() int {
+	return xxx_messageInfo_RejectPendingTransactionResponse.Size(m)
+}
+func (m *RejectPendingTransactionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RejectPendingTransactionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RejectPendingTransactionResponse proto.InternalMessageInfo
+
+type UnlockWalletRequest struct {
+	Passphrase           []byte            `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	Timeout              uint32            `protobuf:"varint,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	Accounts             []uint32          `protobuf:"varint,3,rep,packed,name=accounts,proto3" json:"accounts,omitempty"`
+	Uses                 []string          `protobuf:"bytes,4,rep,name=uses,proto3" json:"uses,omitempty"`
+	Signatures           uint32            `protobuf:"varint,5,opt,name=signatures,proto3" json:"signatures,omitempty"`
+	AccountPassphrases   map[uint32][]byte `protobuf:"bytes,6,rep,name=account_passphrases,json=accountPassphrases,proto3" json:"account_passphrases,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *UnlockWalletRequest) Reset()         { *m = UnlockWalletRequest{} }
+func (m *UnlockWalletRequest) String() string { return proto.CompactTextString(m) }
+func (*UnlockWalletRequest) ProtoMessage()    {}
+func (*UnlockWalletRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{183}
+}
+func (m *UnlockWalletRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnlockWalletRequest.Unmarshal(m, b)
+}
+func (m *UnlockWalletRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnlockWalletRequest.Marshal(b, m, deterministic)
+}
+func (dst *UnlockWalletRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlockWalletRequest.Merge(dst, src)
+}
+func (m *UnlockWalletRequest) XXX_Size() int {
+	return xxx_messageInfo_UnlockWalletRequest.Size(m)
+}
+func (m *UnlockWalletRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlockWalletRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnlockWalletRequest proto.InternalMessageInfo
+
+func (m *UnlockWalletRequest) GetPassphrase() []byte {
+	if m != nil {
+		return m.Passphrase
+	}
+	return nil
+}
+
+func (m *UnlockWalletRequest) GetTimeout() uint32 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+func (m *UnlockWalletRequest) GetAccounts() []uint32 {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+func (m *UnlockWalletRequest) GetUses() []string {
+	if m != nil {
+		return m.Uses
+	}
+	return nil
+}
+
+func (m *UnlockWalletRequest) GetSignatures() uint32 {
+	if m != nil {
+		return m.Signatures
+	}
+	return 0
+}
+
+func (m *UnlockWalletRequest) GetAccountPassphrases() map[uint32][]byte {
+	if m != nil {
+		return m.AccountPassphrases
+	}
+	return nil
+}
+
+type UnlockWalletResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnlockWalletResponse) Reset()         { *m = UnlockWalletResponse{} }
+func (m *UnlockWalletResponse) String() string { return proto.CompactTextString(m) }
+func (*UnlockWalletResponse) ProtoMessage()    {}
+func (*UnlockWalletResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{184}
+}
+func (m *UnlockWalletResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnlockWalletResponse.Unmarshal(m, b)
+}
+func (m *UnlockWalletResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnlockWalletResponse.Marshal(b, m, deterministic)
+}
+func (dst *UnlockWalletResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlockWalletResponse.Merge(dst, src)
+}
+func (m *UnlockWalletResponse) XXX_Size() int {
+	return xxx_messageInfo_UnlockWalletResponse.Size(m)
+}
+func (m *UnlockWalletResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlockWalletResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnlockWalletResponse proto.InternalMessageInfo
+
+type LockWalletRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LockWalletRequest) Reset()         { *m = LockWalletRequest{} }
+func (m *LockWalletRequest) String() string { return proto.CompactTextString(m) }
+func (*LockWalletRequest) ProtoMessage()    {}
+func (*LockWalletRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{185}
+}
+func (m *LockWalletRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LockWalletRequest.Unmarshal(m, b)
+}
+func (m *LockWalletRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LockWalletRequest.Marshal(b, m, deterministic)
+}
+func (dst *LockWalletRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockWalletRequest.Merge(dst, src)
+}
+func (m *LockWalletRequest) XXX_Size() int {
+	return xxx_messageInfo_LockWalletRequest.Size(m)
+}
+func (m *LockWalletRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockWalletRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockWalletRequest proto.InternalMessageInfo
+
+type LockWalletResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LockWalletResponse) Reset()         { *m = LockWalletResponse{} }
+func (m *LockWalletResponse) String() string { return proto.CompactTextString(m) }
+func (*LockWalletResponse) ProtoMessage()    {}
+func (*LockWalletResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{186}
+}
+func (m *LockWalletResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LockWalletResponse.Unmarshal(m, b)
+}
+func (m *LockWalletResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LockWalletResponse.Marshal(b, m, deterministic)
+}
+func (dst *LockWalletResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockWalletResponse.Merge(dst, src)
+}
+func (m *LockWalletResponse) XXX_Size() int {
+	return xxx_messageInfo_LockWalletResponse.Size(m)
+}
+func (m *LockWalletResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockWalletResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockWalletResponse proto.InternalMessageInfo
+
+type LockAccountRequest struct {
+	Account              uint32   `protobuf:"varint,1,opt,name=account,proto3" json:"account,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LockAccountRequest) Reset()         { *m = LockAccountRequest{} }
+func (m *LockAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*LockAccountRequest) ProtoMessage()    {}
+func (*LockAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{187}
+}
+func (m *LockAccountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LockAccountRequest.Unmarshal(m, b)
+}
+func (m *LockAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LockAccountRequest.Marshal(b, m, deterministic)
+}
+func (dst *LockAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockAccountRequest.Merge(dst, src)
+}
+func (m *LockAccountRequest) XXX_Size() int {
+	return xxx_messageInfo_LockAccountRequest.Size(m)
+}
+func (m *LockAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockAccountRequest proto.InternalMessageInfo
+
+func (m *LockAccountRequest) GetAccount() uint32 {
+	if m != nil {
+		return m.Account
+	}
+	return 0
+}
+
+type LockAccountResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LockAccountResponse) Reset()         { *m = LockAccountResponse{} }
+func (m *LockAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*LockAccountResponse) ProtoMessage()    {}
+func (*LockAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{188}
+}
+func (m *LockAccountResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LockAccountResponse.Unmarshal(m, b)
+}
+func (m *LockAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LockAccountResponse.Marshal(b, m, deterministic)
+}
+func (dst *LockAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockAccountResponse.Merge(dst, src)
+}
+func (m *LockAccountResponse) XXX_Size() int {
+	return xxx_messageInfo_LockAccountResponse.Size(m)
+}
+func (m *LockAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockAccountResponse proto.InternalMessageInfo
+
+type GenerateSeedSharesRequest struct {
+	Seed                 []byte   `protobuf:"bytes,1,opt,name=seed,proto3" json:"seed,omitempty"`
+	Threshold            uint32   `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Count                uint32   `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GenerateSeedSharesRequest) Reset()         { *m = GenerateSeedSharesRequest{} }
+func (m *GenerateSeedSharesRequest) String() string { return proto.CompactTextString(m) }
+func (*GenerateSeedSharesRequest) ProtoMessage()    {}
+func (*GenerateSeedSharesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{189}
+}
+func (m *GenerateSeedSharesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GenerateSeedSharesRequest.Unmarshal(m, b)
+}
+func (m *GenerateSeedSharesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GenerateSeedSharesRequest.Marshal(b, m, deterministic)
+}
+func (dst *GenerateSeedSharesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenerateSeedSharesRequest.Merge(dst, src)
+}
+func (m *GenerateSeedSharesRequest) XXX_Size() int {
+	return xxx_messageInfo_GenerateSeedSharesRequest.Size(m)
+}
+func (m *GenerateSeedSharesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenerateSeedSharesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenerateSeedSharesRequest proto.InternalMessageInfo
+
+func (m *GenerateSeedSharesRequest) GetSeed() []byte {
+	if m != nil {
+		return m.Seed
+	}
+	return nil
+}
+
+func (m *GenerateSeedSharesRequest) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *GenerateSeedSharesRequest) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type GenerateSeedSharesResponse struct {
+	ShareMnemonics       []string `protobuf:"bytes,1,rep,name=share_mnemonics,json=shareMnemonics,proto3" json:"share_mnemonics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GenerateSeedSharesResponse) Reset()         { *m = GenerateSeedSharesResponse{} }
+func (m *GenerateSeedSharesResponse) String() string { return proto.CompactTextString(m) }
+func (*GenerateSeedSharesResponse) ProtoMessage()    {}
+func (*GenerateSeedSharesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{190}
+}
+func (m *GenerateSeedSharesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GenerateSeedSharesResponse.Unmarshal(m, b)
+}
+func (m *GenerateSeedSharesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GenerateSeedSharesResponse.Marshal(b, m, deterministic)
+}
+func (dst *GenerateSeedSharesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenerateSeedSharesResponse.Merge(dst, src)
+}
+func (m *GenerateSeedSharesResponse) XXX_Size() int {
+	return xxx_messageInfo_GenerateSeedSharesResponse.Size(m)
+}
+func (m *GenerateSeedSharesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenerateSeedSharesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenerateSeedSharesResponse proto.InternalMessageInfo
+
+func (m *GenerateSeedSharesResponse) GetShareMnemonics() []string {
+	if m != nil {
+		return m.ShareMnemonics
+	}
+	return nil
+}
+
+type CombineSeedSharesRequest struct {
+	ShareMnemonics       []string `protobuf:"bytes,1,rep,name=share_mnemonics,json=shareMnemonics,proto3" json:"share_mnemonics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CombineSeedSharesRequest) Reset()         { *m = CombineSeedSharesRequest{} }
+func (m *CombineSeedSharesRequest) String() string { return proto.CompactTextString(m) }
+func (*CombineSeedSharesRequest) ProtoMessage()    {}
+func (*CombineSeedSharesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{191}
+}
+func (m *CombineSeedSharesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CombineSeedSharesRequest.Unmarshal(m, b)
+}
+func (m *CombineSeedSharesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CombineSeedSharesRequest.Marshal(b, m, deterministic)
+}
+func (dst *CombineSeedSharesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CombineSeedSharesRequest.Merge(dst, src)
+}
+func (m *CombineSeedSharesRequest) XXX_Size() int {
+	return xxx_messageInfo_CombineSeedSharesRequest.Size(m)
+}
+func (m *CombineSeedSharesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CombineSeedSharesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CombineSeedSharesRequest proto.InternalMessageInfo
+
+func (m *CombineSeedSharesRequest) GetShareMnemonics() []string {
+	if m != nil {
+		return m.ShareMnemonics
+	}
+	return nil
+}
+
+type CombineSeedSharesResponse struct {
+	DecodedSeed          []byte   `protobuf:"bytes,1,opt,name=decoded_seed,json=decodedSeed,proto3" json:"decoded_seed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CombineSeedSharesResponse) Reset()         { *m = CombineSeedSharesResponse{} }
+func (m *CombineSeedSharesResponse) String() string { return proto.CompactTextString(m) }
+func (*CombineSeedSharesResponse) ProtoMessage()    {}
+func (*CombineSeedSharesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{192}
+}
+func (m *CombineSeedSharesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CombineSeedSharesResponse.Unmarshal(m, b)
+}
+func (m *CombineSeedSharesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CombineSeedSharesResponse.Marshal(b, m, deterministic)
+}
+func (dst *CombineSeedSharesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CombineSeedSharesResponse.Merge(dst, src)
+}
+func (m *CombineSeedSharesResponse) XXX_Size() int {
+	return xxx_messageInfo_CombineSeedSharesResponse.Size(m)
+}
+func (m *CombineSeedSharesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CombineSeedSharesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CombineSeedSharesResponse proto.InternalMessageInfo
+
+func (m *CombineSeedSharesResponse) GetDecodedSeed() []byte {
+	if m != nil {
+		return m.DecodedSeed
+	}
+	return nil
+}
+
+type VerifySeedRequest struct {
+	Seed                 []byte   `protobuf:"bytes,1,opt,name=seed,proto3" json:"seed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifySeedRequest) Reset()         { *m = VerifySeedRequest{} }
+func (m *VerifySeedRequest) String() string { return proto.CompactTextString(m) }
+func (*VerifySeedRequest) ProtoMessage()    {}
+func (*VerifySeedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{193}
+}
+func (m *VerifySeedRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifySeedRequest.Unmarshal(m, b)
+}
+func (m *VerifySeedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifySeedRequest.Marshal(b, m, deterministic)
+}
+func (dst *VerifySeedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifySeedRequest.Merge(dst, src)
+}
+func (m *VerifySeedRequest) XXX_Size() int {
+	return xxx_messageInfo_VerifySeedRequest.Size(m)
+}
+func (m *VerifySeedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifySeedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifySeedRequest proto.InternalMessageInfo
+
+func (m *VerifySeedRequest) GetSeed() []byte {
+	if m != nil {
+		return m.Seed
+	}
+	return nil
+}
+
+type VerifySeedResponse struct {
+	Matches              bool     `protobuf:"varint,1,opt,name=matches,proto3" json:"matches,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifySeedResponse) Reset()         { *m = VerifySeedResponse{} }
+func (m *VerifySeedResponse) String() string { return proto.CompactTextString(m) }
+func (*VerifySeedResponse) ProtoMessage()    {}
+func (*VerifySeedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{194}
+}
+func (m *VerifySeedResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifySeedResponse.Unmarshal(m, b)
+}
+func (m *VerifySeedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifySeedResponse.Marshal(b, m, deterministic)
+}
+func (dst *VerifySeedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifySeedResponse.Merge(dst, src)
+}
+func (m *VerifySeedResponse) XXX_Size() int {
+	return xxx_messageInfo_VerifySeedResponse.Size(m)
+}
+func (m *VerifySeedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifySeedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifySeedResponse proto.InternalMessageInfo
+
+func (m *VerifySeedResponse) GetMatches() bool {
+	if m != nil {
+		return m.Matches
+	}
+	return false
+}
+
+type ListWalletsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListWalletsRequest) Reset()         { *m = ListWalletsRequest{} }
+func (m *ListWalletsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListWalletsRequest) ProtoMessage()    {}
+func (*ListWalletsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{195}
+}
+func (m *ListWalletsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListWalletsRequest.Unmarshal(m, b)
+}
+func (m *ListWalletsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListWalletsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListWalletsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListWalletsRequest.Merge(dst, src)
+}
+func (m *ListWalletsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListWalletsRequest.Size(m)
+}
+func (m *ListWalletsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListWalletsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListWalletsRequest proto.InternalMessageInfo
+
+type ListWalletsResponse struct {
+	Wallets              []*ListWalletsResponse_Wallet `protobuf:"bytes,1,rep,name=wallets,proto3" json:"wallets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *ListWalletsResponse) Reset()         { *m = ListWalletsResponse{} }
+func (m *ListWalletsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListWalletsResponse) ProtoMessage()    {}
+func (*ListWalletsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{196}
+}
+func (m *ListWalletsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListWalletsResponse.Unmarshal(m, b)
+}
+func (m *ListWalletsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListWalletsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListWalletsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListWalletsResponse.Merge(dst, src)
+}
+func (m *ListWalletsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListWalletsResponse.Size(m)
+}
+func (m *ListWalletsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListWalletsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListWalletsResponse proto.InternalMessageInfo
+
+func (m *ListWalletsResponse) GetWallets() []*ListWalletsResponse_Wallet {
+	if m != nil {
+		return m.Wallets
+	}
+	return nil
+}
+
+type ListWalletsResponse_Wallet struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Loaded               bool     `protobuf:"varint,2,opt,name=loaded,proto3" json:"loaded,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListWalletsResponse_Wallet) Reset()         { *m = ListWalletsResponse_Wallet{} }
+func (m *ListWalletsResponse_Wallet) String() string { return proto.CompactTextString(m) }
+func (*ListWalletsResponse_Wallet) ProtoMessage()    {}
+func (*ListWalletsResponse_Wallet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{196, 0}
+}
+func (m *ListWalletsResponse_Wallet) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListWalletsResponse_Wallet.Unmarshal(m, b)
+}
+func (m *ListWalletsResponse_Wallet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListWalletsResponse_Wallet.Marshal(b, m, deterministic)
+}
+func (dst *ListWalletsResponse_Wallet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListWalletsResponse_Wallet.Merge(dst, src)
+}
+func (m *ListWalletsResponse_Wallet) XXX_Size() int {
+	return xxx_messageInfo_ListWalletsResponse_Wallet.Size(m)
+}
+func (m *ListWalletsResponse_Wallet) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListWalletsResponse_Wallet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListWalletsResponse_Wallet proto.InternalMessageInfo
+
+func (m *ListWalletsResponse_Wallet) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ListWalletsResponse_Wallet) GetLoaded() bool {
+	if m != nil {
+		return m.Loaded
+	}
+	return false
+}
+
+type CompactDatabaseRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CompactDatabaseRequest) Reset()         { *m = CompactDatabaseRequest{} }
+func (m *CompactDatabaseRequest) String() string { return proto.CompactTextString(m) }
+func (*CompactDatabaseRequest) ProtoMessage()    {}
+func (*CompactDatabaseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{197}
+}
+func (m *CompactDatabaseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompactDatabaseRequest.Unmarshal(m, b)
+}
+func (m *CompactDatabaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompactDatabaseRequest.Marshal(b, m, deterministic)
+}
+func (dst *CompactDatabaseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactDatabaseRequest.Merge(dst, src)
+}
+func (m *CompactDatabaseRequest) XXX_Size() int {
+	return xxx_messageInfo_CompactDatabaseRequest.Size(m)
+}
+func (m *CompactDatabaseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactDatabaseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompactDatabaseRequest proto.InternalMessageInfo
+
+type CompactDatabaseResponse struct {
+	SizeBefore           int64    `protobuf:"varint,1,opt,name=size_before,json=sizeBefore,proto3" json:"size_before,omitempty"`
+	SizeAfter            int64    `protobuf:"varint,2,opt,name=size_after,json=sizeAfter,proto3" json:"size_after,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CompactDatabaseResponse) Reset()         { *m = CompactDatabaseResponse{} }
+func (m *CompactDatabaseResponse) String() string { return proto.CompactTextString(m) }
+func (*CompactDatabaseResponse) ProtoMessage()    {}
+func (*CompactDatabaseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{198}
+}
+func (m *CompactDatabaseResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompactDatabaseResponse.Unmarshal(m, b)
+}
+func (m *CompactDatabaseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompactDatabaseResponse.Marshal(b, m, deterministic)
+}
+func (dst *CompactDatabaseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactDatabaseResponse.Merge(dst, src)
+}
+func (m *CompactDatabaseResponse) XXX_Size() int {
+	return xxx_messageInfo_CompactDatabaseResponse.Size(m)
+}
+func (m *CompactDatabaseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactDatabaseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompactDatabaseResponse proto.InternalMessageInfo
+
+func (m *CompactDatabaseResponse) GetSizeBefore() int64 {
+	if m != nil {
+		return m.SizeBefore
+	}
+	return 0
+}
+
+func (m *CompactDatabaseResponse) GetSizeAfter() int64 {
+	if m != nil {
+		return m.SizeAfter
+	}
+	return 0
+}
+
+type DatabaseSizeRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DatabaseSizeRequest) Reset()         { *m = DatabaseSizeRequest{} }
+func (m *DatabaseSizeRequest) String() string { return proto.CompactTextString(m) }
+func (*DatabaseSizeRequest) ProtoMessage()    {}
+func (*DatabaseSizeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{199}
+}
+func (m *DatabaseSizeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DatabaseSizeRequest.Unmarshal(m, b)
+}
+func (m *DatabaseSizeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DatabaseSizeRequest.Marshal(b, m, deterministic)
+}
+func (dst *DatabaseSizeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DatabaseSizeRequest.Merge(dst, src)
+}
+func (m *DatabaseSizeRequest) XXX_Size() int {
+	return xxx_messageInfo_DatabaseSizeRequest.Size(m)
+}
+func (m *DatabaseSizeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DatabaseSizeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DatabaseSizeRequest proto.InternalMessageInfo
+
+type DatabaseSizeResponse struct {
+	FileSize             int64                            `protobuf:"varint,1,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
+	Categories           []*DatabaseSizeResponse_Category `protobuf:"bytes,2,rep,name=categories,proto3" json:"categories,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
+}
+
+func (m *DatabaseSizeResponse) Reset()         { *m = DatabaseSizeResponse{} }
+func (m *DatabaseSizeResponse) String() string { return proto.CompactTextString(m) }
+func (*DatabaseSizeResponse) ProtoMessage()    {}
+func (*DatabaseSizeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{200}
+}
+func (m *DatabaseSizeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DatabaseSizeResponse.Unmarshal(m, b)
+}
+func (m *DatabaseSizeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DatabaseSizeResponse.Marshal(b, m, deterministic)
+}
+func (dst *DatabaseSizeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DatabaseSizeResponse.Merge(dst, src)
+}
+func (m *DatabaseSizeResponse) XXX_Size() int {
+	return xxx_messageInfo_DatabaseSizeResponse.Size(m)
+}
+func (m *DatabaseSizeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DatabaseSizeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DatabaseSizeResponse proto.InternalMessageInfo
+
+func (m *DatabaseSizeResponse) GetFileSize() int64 {
+	if m != nil {
+		return m.FileSize
+	}
+	return 0
+}
+
+func (m *DatabaseSizeResponse) GetCategories() []*DatabaseSizeResponse_Category {
+	if m != nil {
+		return m.Categories
+	}
+	return nil
+}
+
+type DatabaseSizeResponse_Category struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Keys                 int64    `protobuf:"varint,2,opt,name=keys,proto3" json:"keys,omitempty"`
+	Bytes                int64    `protobuf:"varint,3,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DatabaseSizeResponse_Category) Reset()         { *m = DatabaseSizeResponse_Category{} }
+func (m *DatabaseSizeResponse_Category) String() string { return proto.CompactTextString(m) }
+func (*DatabaseSizeResponse_Category) ProtoMessage()    {}
+func (*DatabaseSizeResponse_Category) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_8495174e6b66f558, []int{200, 0}
+}
+func (m *DatabaseSizeResponse_Category) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DatabaseSizeResponse_Category.Unmarshal(m, b)
+}
+func (m *DatabaseSizeResponse_Category) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DatabaseSizeResponse_Category.Marshal(b, m, deterministic)
+}
+func (dst *DatabaseSizeResponse_Category) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DatabaseSizeResponse_Category.Merge(dst, src)
+}
+func (m *DatabaseSizeResponse_Category) XXX_Size() int {
+	return xxx_messageInfo_DatabaseSizeResponse_Category.Size(m)
+}
+func (m *DatabaseSizeResponse_Category) XXX_DiscardUnknown() {
+	xxx_messageInfo_DatabaseSizeResponse_Category.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DatabaseSizeResponse_Category proto.InternalMessageInfo
+
+func (m *DatabaseSizeResponse_Category) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DatabaseSizeResponse_Category) GetKeys() int64 {
+	if m != nil {
+		return m.Keys
+	}
+	return 0
+}
+
+func (m *DatabaseSizeResponse_Category) GetBytes() int64 {
+	if m != nil {
+		return m.Bytes
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*VersionRequest)(nil), "walletrpc.VersionRequest")
+	proto.RegisterType((*VersionResponse)(nil), "walletrpc.VersionResponse")
+	proto.RegisterType((*TransactionDetails)(nil), "walletrpc.TransactionDetails")
+	proto.RegisterType((*TransactionDetails_Input)(nil), "walletrpc.TransactionDetails.Input")
+	proto.RegisterType((*TransactionDetails_Output)(nil), "walletrpc.TransactionDetails.Output")
+	proto.RegisterType((*BlockDetails)(nil), "walletrpc.BlockDetails")
+	proto.RegisterType((*AccountBalance)(nil), "walletrpc.AccountBalance")
+	proto.RegisterType((*PingRequest)(nil), "walletrpc.PingRequest")
+	proto.RegisterType((*PingResponse)(nil), "walletrpc.PingResponse")
+	proto.RegisterType((*NetworkRequest)(nil), "walletrpc.NetworkRequest")
+	proto.RegisterType((*NetworkResponse)(nil), "walletrpc.NetworkResponse")
+	proto.RegisterType((*AccountNumberRequest)(nil), "walletrpc.AccountNumberRequest")
+	proto.RegisterType((*AccountNumberResponse)(nil), "walletrpc.AccountNumberResponse")
+	proto.RegisterType((*AccountsRequest)(nil), "walletrpc.AccountsRequest")
+	proto.RegisterType((*AccountsResponse)(nil), "walletrpc.AccountsResponse")
+	proto.RegisterType((*AccountsResponse_Account)(nil), "walletrpc.AccountsResponse.Account")
+	proto.RegisterType((*RenameAccountRequest)(nil), "walletrpc.RenameAccountRequest")
+	proto.RegisterType((*RenameAccountResponse)(nil), "walletrpc.RenameAccountResponse")
+	proto.RegisterType((*RescanRequest)(nil), "walletrpc.RescanRequest")
+	proto.RegisterType((*RescanResponse)(nil), "walletrpc.RescanResponse")
+	proto.RegisterType((*NextAccountRequest)(nil), "walletrpc.NextAccountRequest")
+	proto.RegisterType((*NextAccountResponse)(nil), "walletrpc.NextAccountResponse")
+	proto.RegisterType((*NextAddressRequest)(nil), "walletrpc.NextAddressRequest")
+	proto.RegisterType((*NextAddressResponse)(nil), "walletrpc.NextAddressResponse")
+	proto.RegisterType((*ImportPrivateKeyRequest)(nil), "walletrpc.ImportPrivateKeyRequest")
+	proto.RegisterType((*ImportPrivateKeyResponse)(nil), "walletrpc.ImportPrivateKeyResponse")
+	proto.RegisterType((*ImportScriptRequest)(nil), "walletrpc.ImportScriptRequest")
+	proto.RegisterType((*ImportScriptResponse)(nil), "walletrpc.ImportScriptResponse")
+	proto.RegisterType((*BalanceRequest)(nil), "walletrpc.BalanceRequest")
+	proto.RegisterType((*BalanceResponse)(nil), "walletrpc.BalanceResponse")
+	proto.RegisterType((*GetTransactionRequest)(nil), "walletrpc.GetTransactionRequest")
+	proto.RegisterType((*GetTransactionResponse)(nil), "walletrpc.GetTransactionResponse")
+	proto.RegisterType((*GetTransactionsRequest)(nil), "walletrpc.GetTransactionsRequest")
+	proto.RegisterType((*GetTransactionsResponse)(nil), "walletrpc.GetTransactionsResponse")
+	proto.RegisterType((*GetTicketRequest)(nil), "walletrpc.GetTicketRequest")
+	proto.RegisterType((*GetTicketsRequest)(nil), "walletrpc.GetTicketsRequest")
+	proto.RegisterType((*GetTicketsResponse)(nil), "walletrpc.GetTicketsResponse")
+	proto.RegisterType((*GetTicketsResponse_TicketDetails)(nil), "walletrpc.GetTicketsResponse.TicketDetails")
+	proto.RegisterType((*GetTicketsResponse_BlockDetails)(nil), "walletrpc.GetTicketsResponse.BlockDetails")
+	proto.RegisterType((*TicketPriceRequest)(nil), "walletrpc.TicketPriceRequest")
+	proto.RegisterType((*TicketPriceResponse)(nil), "walletrpc.TicketPriceResponse")
+	proto.RegisterType((*StakeInfoRequest)(nil), "walletrpc.StakeInfoRequest")
+	proto.RegisterType((*StakeInfoResponse)(nil), "walletrpc.StakeInfoResponse")
+	proto.RegisterType((*BlockInfoRequest)(nil), "walletrpc.BlockInfoRequest")
+	proto.RegisterType((*BlockInfoResponse)(nil), "walletrpc.BlockInfoResponse")
+	proto.RegisterType((*ChangePassphraseRequest)(nil), "walletrpc.ChangePassphraseRequest")
+	proto.RegisterType((*ChangePassphraseResponse)(nil), "walletrpc.ChangePassphraseResponse")
+	proto.RegisterType((*FundTransactionRequest)(nil), "walletrpc.FundTransactionRequest")
+	proto.RegisterType((*FundTransactionResponse)(nil), "walletrpc.FundTransactionResponse")
+	proto.RegisterType((*FundTransactionResponse_PreviousOutput)(nil), "walletrpc.FundTransactionResponse.PreviousOutput")
+	proto.RegisterType((*UnspentOutputsRequest)(nil), "walletrpc.UnspentOutputsRequest")
+	proto.RegisterType((*UnspentOutputResponse)(nil), "walletrpc.UnspentOutputResponse")
+	proto.RegisterType((*ConstructTransactionRequest)(nil), "walletrpc.ConstructTransactionRequest")
+	proto.RegisterType((*ConstructTransactionRequest_OutputDestination)(nil), "walletrpc.ConstructTransactionRequest.OutputDestination")
+	proto.RegisterType((*ConstructTransactionRequest_Output)(nil), "walletrpc.ConstructTransactionRequest.Output")
+	proto.RegisterType((*ConstructTransactionResponse)(nil), "walletrpc.ConstructTransactionResponse")
+	proto.RegisterType((*SignTransactionRequest)(nil), "walletrpc.SignTransactionRequest")
+	proto.RegisterType((*SignTransactionRequest_AdditionalScript)(nil), "walletrpc.SignTransactionRequest.AdditionalScript")
+	proto.RegisterType((*SignTransactionResponse)(nil), "walletrpc.SignTransactionResponse")
+	proto.RegisterType((*SignTransactionsRequest)(nil), "walletrpc.SignTransactionsRequest")
+	proto.RegisterType((*SignTransactionsRequest_AdditionalScript)(nil), "walletrpc.SignTransactionsRequest.AdditionalScript")
+	proto.RegisterType((*SignTransactionsRequest_UnsignedTransaction)(nil), "walletrpc.SignTransactionsRequest.UnsignedTransaction")
+	proto.RegisterType((*SignTransactionsResponse)(nil), "walletrpc.SignTransactionsResponse")
+	proto.RegisterType((*SignTransactionsResponse_SignedTransaction)(nil), "walletrpc.SignTransactionsResponse.SignedTransaction")
+	proto.RegisterType((*CreateSignatureRequest)(nil), "walletrpc.CreateSignatureRequest")
+	proto.RegisterType((*CreateSignatureResponse)(nil), "walletrpc.CreateSignatureResponse")
+	proto.RegisterType((*PublishTransactionRequest)(nil), "walletrpc.PublishTransactionRequest")
+	proto.RegisterType((*PublishTransactionResponse)(nil), "walletrpc.PublishTransactionResponse")
+	proto.RegisterType((*PublishUnminedTransactionsRequest)(nil), "walletrpc.PublishUnminedTransactionsRequest")
+	proto.RegisterType((*PublishUnminedTransactionsResponse)(nil), "walletrpc.PublishUnminedTransactionsResponse")
+	proto.RegisterType((*PurchaseTicketsRequest)(nil), "walletrpc.PurchaseTicketsRequest")
+	proto.RegisterType((*PurchaseTicketsResponse)(nil), "walletrpc.PurchaseTicketsResponse")
+	proto.RegisterType((*RevokeTicketsRequest)(nil), "walletrpc.RevokeTicketsRequest")
+	proto.RegisterType((*RevokeTicketsResponse)(nil), "walletrpc.RevokeTicketsResponse")
+	proto.RegisterType((*LoadActiveDataFiltersRequest)(nil), "walletrpc.LoadActiveDataFiltersRequest")
+	proto.RegisterType((*LoadActiveDataFiltersResponse)(nil), "walletrpc.LoadActiveDataFiltersResponse")
+	proto.RegisterType((*SignMessageRequest)(nil), "walletrpc.SignMessageRequest")
+	proto.RegisterType((*SignMessageResponse)(nil), "walletrpc.SignMessageResponse")
+	proto.RegisterType((*SignMessagesRequest)(nil), "walletrpc.SignMessagesRequest")
+	proto.RegisterType((*SignMessagesRequest_Message)(nil), "walletrpc.SignMessagesRequest.Message")
+	proto.RegisterType((*SignMessagesResponse)(nil), "walletrpc.SignMessagesResponse")
+	proto.RegisterType((*SignMessagesResponse_SignReply)(nil), "walletrpc.SignMessagesResponse.SignReply")
+	proto.RegisterType((*TransactionNotificationsRequest)(nil), "walletrpc.TransactionNotificationsRequest")
+	proto.RegisterType((*TransactionNotificationsResponse)(nil), "walletrpc.TransactionNotificationsResponse")
+	proto.RegisterType((*AccountNotificationsRequest)(nil), "walletrpc.AccountNotificationsRequest")
+	proto.RegisterType((*AccountNotificationsResponse)(nil), "walletrpc.AccountNotificationsResponse")
+	proto.RegisterType((*ConfirmationNotificationsRequest)(nil), "walletrpc.ConfirmationNotificationsRequest")
+	proto.RegisterType((*ConfirmationNotificationsResponse)(nil), "walletrpc.ConfirmationNotificationsResponse")
+	proto.RegisterType((*ConfirmationNotificationsResponse_TransactionConfirmations)(nil), "walletrpc.ConfirmationNotificationsResponse.TransactionConfirmations")
+	proto.RegisterType((*CreateWalletRequest)(nil), "walletrpc.CreateWalletRequest")
+	proto.RegisterType((*CreateWalletResponse)(nil), "walletrpc.CreateWalletResponse")
+	proto.RegisterType((*CreateWatchingOnlyWalletRequest)(nil), "walletrpc.CreateWatchingOnlyWalletRequest")
+	proto.RegisterType((*CreateWatchingOnlyWalletResponse)(nil), "walletrpc.CreateWatchingOnlyWalletResponse")
+	proto.RegisterType((*OpenWalletRequest)(nil), "walletrpc.OpenWalletRequest")
+	proto.RegisterType((*OpenWalletResponse)(nil), "walletrpc.OpenWalletResponse")
+	proto.RegisterType((*CloseWalletRequest)(nil), "walletrpc.CloseWalletRequest")
+	proto.RegisterType((*CloseWalletResponse)(nil), "walletrpc.CloseWalletResponse")
+	proto.RegisterType((*WalletExistsRequest)(nil), "walletrpc.WalletExistsRequest")
+	proto.RegisterType((*WalletExistsResponse)(nil), "walletrpc.WalletExistsResponse")
+	proto.RegisterType((*StartConsensusRpcRequest)(nil), "walletrpc.StartConsensusRpcRequest")
+	proto.RegisterType((*StartConsensusRpcResponse)(nil), "walletrpc.StartConsensusRpcResponse")
+	proto.RegisterType((*DiscoverAddressesRequest)(nil), "walletrpc.DiscoverAddressesRequest")
+	proto.RegisterType((*DiscoverAddressesResponse)(nil), "walletrpc.DiscoverAddressesResponse")
+	proto.RegisterType((*FetchMissingCFiltersRequest)(nil), "walletrpc.FetchMissingCFiltersRequest")
+	proto.RegisterType((*FetchMissingCFiltersResponse)(nil), "walletrpc.FetchMissingCFiltersResponse")
+	proto.RegisterType((*SubscribeToBlockNotificationsRequest)(nil), "walletrpc.SubscribeToBlockNotificationsRequest")
+	proto.RegisterType((*SubscribeToBlockNotificationsResponse)(nil), "walletrpc.SubscribeToBlockNotificationsResponse")
+	proto.RegisterType((*FetchHeadersRequest)(nil), "walletrpc.FetchHeadersRequest")
+	proto.RegisterType((*FetchHeadersResponse)(nil), "walletrpc.FetchHeadersResponse")
+	proto.RegisterType((*FetchHeadersNotification)(nil), "walletrpc.FetchHeadersNotification")
+	proto.RegisterType((*FetchMissingCFiltersNotification)(nil), "walletrpc.FetchMissingCFiltersNotification")
+	proto.RegisterType((*RescanProgressNotification)(nil), "walletrpc.RescanProgressNotification")
+	proto.RegisterType((*PeerNotification)(nil), "walletrpc.PeerNotification")
+	proto.RegisterType((*RpcSyncRequest)(nil), "walletrpc.RpcSyncRequest")
+	proto.RegisterType((*RpcSyncResponse)(nil), "walletrpc.RpcSyncResponse")
+	proto.RegisterType((*SpvSyncRequest)(nil), "walletrpc.SpvSyncRequest")
+	proto.RegisterType((*SpvSyncResponse)(nil), "walletrpc.SpvSyncResponse")
+	proto.RegisterType((*RescanPointRequest)(nil), "walletrpc.RescanPointRequest")
+	proto.RegisterType((*RescanPointResponse)(nil), "walletrpc.RescanPointResponse")
+	proto.RegisterType((*GenerateRandomSeedRequest)(nil), "walletrpc.GenerateRandomSeedRequest")
 	proto.RegisterType((*GenerateRandomSeedResponse)(nil), "walletrpc.GenerateRandomSeedResponse")
 	proto.RegisterType((*DecodeSeedRequest)(nil), "walletrpc.DecodeSeedRequest")
 	proto.RegisterType((*DecodeSeedResponse)(nil), "walletrpc.DecodeSeedResponse")
@@ -9361,17 +12054,76 @@ func init() {
 	proto.RegisterType((*BestBlockResponse)(nil), "walletrpc.BestBlockResponse")
 	proto.RegisterType((*SweepAccountRequest)(nil), "walletrpc.SweepAccountRequest")
 	proto.RegisterType((*SweepAccountResponse)(nil), "walletrpc.SweepAccountResponse")
+	proto.RegisterType((*StakePoolFeeReportRequest)(nil), "walletrpc.StakePoolFeeReportRequest")
+	proto.RegisterType((*StakePoolFees)(nil), "walletrpc.StakePoolFees")
+	proto.RegisterType((*StakePoolFeeReportResponse)(nil), "walletrpc.StakePoolFeeReportResponse")
+	proto.RegisterType((*StakePoolFeeReportResponse_UserFees)(nil), "walletrpc.StakePoolFeeReportResponse.UserFees")
+	proto.RegisterType((*StakePoolFeeReportResponse_Totals)(nil), "walletrpc.StakePoolFeeReportResponse.Totals")
+	proto.RegisterType((*RevocationNotificationsRequest)(nil), "walletrpc.RevocationNotificationsRequest")
+	proto.RegisterType((*RevocationNotificationsResponse)(nil), "walletrpc.RevocationNotificationsResponse")
+	proto.RegisterType((*RevocationNotificationsResponse_Result)(nil), "walletrpc.RevocationNotificationsResponse.Result")
+	proto.RegisterType((*TicketPriceForecastRequest)(nil), "walletrpc.TicketPriceForecastRequest")
+	proto.RegisterType((*TicketPriceForecastResponse)(nil), "walletrpc.TicketPriceForecastResponse")
+	proto.RegisterType((*TicketPriceForecastResponse_Window)(nil), "walletrpc.TicketPriceForecastResponse.Window")
+	proto.RegisterType((*VotingTicket)(nil), "walletrpc.VotingTicket")
+	proto.RegisterType((*ExportVotingAccountRequest)(nil), "walletrpc.ExportVotingAccountRequest")
+	proto.RegisterType((*ExportVotingAccountResponse)(nil), "walletrpc.ExportVotingAccountResponse")
+	proto.RegisterType((*ImportVotingAccountRequest)(nil), "walletrpc.ImportVotingAccountRequest")
+	proto.RegisterType((*ImportVotingAccountResponse)(nil), "walletrpc.ImportVotingAccountResponse")
+	proto.RegisterType((*ExportAccountKeyRequest)(nil), "walletrpc.ExportAccountKeyRequest")
+	proto.RegisterType((*ExportAccountKeyResponse)(nil), "walletrpc.ExportAccountKeyResponse")
+	proto.RegisterType((*ImportAccountKeyRequest)(nil), "walletrpc.ImportAccountKeyRequest")
+	proto.RegisterType((*ImportAccountKeyResponse)(nil), "walletrpc.ImportAccountKeyResponse")
+	proto.RegisterType((*VotingAccountTicketsRequest)(nil), "walletrpc.VotingAccountTicketsRequest")
+	proto.RegisterType((*VotingAccountTicketsResponse)(nil), "walletrpc.VotingAccountTicketsResponse")
+	proto.RegisterType((*AddVotingTicketsRequest)(nil), "walletrpc.AddVotingTicketsRequest")
+	proto.RegisterType((*AddVotingTicketsResponse)(nil), "walletrpc.AddVotingTicketsResponse")
+	proto.RegisterType((*AuditLogRequest)(nil), "walletrpc.AuditLogRequest")
+	proto.RegisterType((*AuditLogResponse)(nil), "walletrpc.AuditLogResponse")
+	proto.RegisterType((*AuditLogResponse_Entry)(nil), "walletrpc.AuditLogResponse.Entry")
+	proto.RegisterMapType((map[string]string)(nil), "walletrpc.AuditLogResponse.Entry.DetailsEntry")
+	proto.RegisterType((*PendingTransactionsRequest)(nil), "walletrpc.PendingTransactionsRequest")
+	proto.RegisterType((*PendingTransactionsResponse)(nil), "walletrpc.PendingTransactionsResponse")
+	proto.RegisterType((*PendingTransactionsResponse_PendingTransaction)(nil), "walletrpc.PendingTransactionsResponse.PendingTransaction")
+	proto.RegisterType((*ApprovePendingTransactionRequest)(nil), "walletrpc.ApprovePendingTransactionRequest")
+	proto.RegisterType((*ApprovePendingTransactionResponse)(nil), "walletrpc.ApprovePendingTransactionResponse")
+	proto.RegisterType((*RejectPendingTransactionRequest)(nil), "walletrpc.RejectPendingTransactionRequest")
+	proto.RegisterType((*RejectPendingTransactionResponse)(nil), "walletrpc.RejectPendingTransactionResponse")
+	proto.RegisterType((*UnlockWalletRequest)(nil), "walletrpc.UnlockWalletRequest")
+	proto.RegisterMapType((map[uint32][]byte)(nil), "walletrpc.UnlockWalletRequest.AccountPassphrasesEntry")
+	proto.RegisterType((*UnlockWalletResponse)(nil), "walletrpc.UnlockWalletResponse")
+	proto.RegisterType((*LockWalletRequest)(nil), "walletrpc.LockWalletRequest")
+	proto.RegisterType((*LockWalletResponse)(nil), "walletrpc.LockWalletResponse")
+	proto.RegisterType((*LockAccountRequest)(nil), "walletrpc.LockAccountRequest")
+	proto.RegisterType((*LockAccountResponse)(nil), "walletrpc.LockAccountResponse")
+	proto.RegisterType((*GenerateSeedSharesRequest)(nil), "walletrpc.GenerateSeedSharesRequest")
+	proto.RegisterType((*GenerateSeedSharesResponse)(nil), "walletrpc.GenerateSeedSharesResponse")
+	proto.RegisterType((*CombineSeedSharesRequest)(nil), "walletrpc.CombineSeedSharesRequest")
+	proto.RegisterType((*CombineSeedSharesResponse)(nil), "walletrpc.CombineSeedSharesResponse")
+	proto.RegisterType((*VerifySeedRequest)(nil), "walletrpc.VerifySeedRequest")
+	proto.RegisterType((*VerifySeedResponse)(nil), "walletrpc.VerifySeedResponse")
+	proto.RegisterType((*ListWalletsRequest)(nil), "walletrpc.ListWalletsRequest")
+	proto.RegisterType((*ListWalletsResponse)(nil), "walletrpc.ListWalletsResponse")
+	proto.RegisterType((*ListWalletsResponse_Wallet)(nil), "walletrpc.ListWalletsResponse.Wallet")
+	proto.RegisterType((*CompactDatabaseRequest)(nil), "walletrpc.CompactDatabaseRequest")
+	proto.RegisterType((*CompactDatabaseResponse)(nil), "walletrpc.CompactDatabaseResponse")
+	proto.RegisterType((*DatabaseSizeRequest)(nil), "walletrpc.DatabaseSizeRequest")
+	proto.RegisterType((*DatabaseSizeResponse)(nil), "walletrpc.DatabaseSizeResponse")
+	proto.RegisterType((*DatabaseSizeResponse_Category)(nil), "walletrpc.DatabaseSizeResponse.Category")
 	proto.RegisterEnum("walletrpc.SyncNotificationType", SyncNotificationType_name, SyncNotificationType_value)
+	proto.RegisterEnum("walletrpc.SeedEncoding", SeedEncoding_name, SeedEncoding_value)
 	proto.RegisterEnum("walletrpc.TransactionDetails_TransactionType", TransactionDetails_TransactionType_name, TransactionDetails_TransactionType_value)
 	proto.RegisterEnum("walletrpc.NextAddressRequest_Kind", NextAddressRequest_Kind_name, NextAddressRequest_Kind_value)
 	proto.RegisterEnum("walletrpc.NextAddressRequest_GapPolicy", NextAddressRequest_GapPolicy_name, NextAddressRequest_GapPolicy_value)
 	proto.RegisterEnum("walletrpc.GetTicketsResponse_TicketDetails_TicketStatus", GetTicketsResponse_TicketDetails_TicketStatus_name, GetTicketsResponse_TicketDetails_TicketStatus_value)
 	proto.RegisterEnum("walletrpc.ChangePassphraseRequest_Key", ChangePassphraseRequest_Key_name, ChangePassphraseRequest_Key_value)
+	proto.RegisterEnum("walletrpc.ChangePassphraseRequest_KDF", ChangePassphraseRequest_KDF_name, ChangePassphraseRequest_KDF_value)
 	proto.RegisterEnum("walletrpc.ConstructTransactionRequest_OutputSelectionAlgorithm", ConstructTransactionRequest_OutputSelectionAlgorithm_name, ConstructTransactionRequest_OutputSelectionAlgorithm_value)
 	proto.RegisterEnum("walletrpc.CreateSignatureRequest_SigHashType", CreateSignatureRequest_SigHashType_name, CreateSignatureRequest_SigHashType_value)
 	proto.RegisterEnum("walletrpc.DecodedTransaction_Input_TreeType", DecodedTransaction_Input_TreeType_name, DecodedTransaction_Input_TreeType_value)
 	proto.RegisterEnum("walletrpc.DecodedTransaction_Output_ScriptClass", DecodedTransaction_Output_ScriptClass_name, DecodedTransaction_Output_ScriptClass_value)
 	proto.RegisterEnum("walletrpc.ValidateAddressResponse_ScriptType", ValidateAddressResponse_ScriptType_name, ValidateAddressResponse_ScriptType_value)
+	proto.RegisterEnum("walletrpc.RevocationNotificationsResponse_Result_Reason", RevocationNotificationsResponse_Result_Reason_name, RevocationNotificationsResponse_Result_Reason_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -9461,7 +12213,9 @@ type WalletServiceClient interface {
 	GetTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (WalletService_GetTransactionsClient, error)
 	GetTicket(ctx context.Context, in *GetTicketRequest, opts ...grpc.CallOption) (*GetTicketsResponse, error)
 	GetTickets(ctx context.Context, in *GetTicketsRequest, opts ...grpc.CallOption) (WalletService_GetTicketsClient, error)
+	VotingAccountTickets(ctx context.Context, in *VotingAccountTicketsRequest, opts ...grpc.CallOption) (*VotingAccountTicketsResponse, error)
 	TicketPrice(ctx context.Context, in *TicketPriceRequest, opts ...grpc.CallOption) (*TicketPriceResponse, error)
+	TicketPriceForecast(ctx context.Context, in *TicketPriceForecastRequest, opts ...grpc.CallOption) (*TicketPriceForecastResponse, error)
 	StakeInfo(ctx context.Context, in *StakeInfoRequest, opts ...grpc.CallOption) (*StakeInfoResponse, error)
 	BlockInfo(ctx context.Context, in *BlockInfoRequest, opts ...grpc.CallOption) (*BlockInfoResponse, error)
 	BestBlock(ctx context.Context, in *BestBlockRequest, opts ...grpc.CallOption) (*BestBlockResponse, error)
@@ -9469,6 +12223,7 @@ type WalletServiceClient interface {
 	TransactionNotifications(ctx context.Context, in *TransactionNotificationsRequest, opts ...grpc.CallOption) (WalletService_TransactionNotificationsClient, error)
 	AccountNotifications(ctx context.Context, in *AccountNotificationsRequest, opts ...grpc.CallOption) (WalletService_AccountNotificationsClient, error)
 	ConfirmationNotifications(ctx context.Context, opts ...grpc.CallOption) (WalletService_ConfirmationNotificationsClient, error)
+	RevocationNotifications(ctx context.Context, in *RevocationNotificationsRequest, opts ...grpc.CallOption) (WalletService_RevocationNotificationsClient, error)
 	// Control
 	ChangePassphrase(ctx context.Context, in *ChangePassphraseRequest, opts ...grpc.CallOption) (*ChangePassphraseResponse, error)
 	RenameAccount(ctx context.Context, in *RenameAccountRequest, opts ...grpc.CallOption) (*RenameAccountResponse, error)
@@ -9477,6 +12232,11 @@ type WalletServiceClient interface {
 	NextAddress(ctx context.Context, in *NextAddressRequest, opts ...grpc.CallOption) (*NextAddressResponse, error)
 	ImportPrivateKey(ctx context.Context, in *ImportPrivateKeyRequest, opts ...grpc.CallOption) (*ImportPrivateKeyResponse, error)
 	ImportScript(ctx context.Context, in *ImportScriptRequest, opts ...grpc.CallOption) (*ImportScriptResponse, error)
+	ExportVotingAccount(ctx context.Context, in *ExportVotingAccountRequest, opts ...grpc.CallOption) (*ExportVotingAccountResponse, error)
+	ImportVotingAccount(ctx context.Context, in *ImportVotingAccountRequest, opts ...grpc.CallOption) (*ImportVotingAccountResponse, error)
+	AddVotingTickets(ctx context.Context, in *AddVotingTicketsRequest, opts ...grpc.CallOption) (*AddVotingTicketsResponse, error)
+	ExportAccountKey(ctx context.Context, in *ExportAccountKeyRequest, opts ...grpc.CallOption) (*ExportAccountKeyResponse, error)
+	ImportAccountKey(ctx context.Context, in *ImportAccountKeyRequest, opts ...grpc.CallOption) (*ImportAccountKeyResponse, error)
 	FundTransaction(ctx context.Context, in *FundTransactionRequest, opts ...grpc.CallOption) (*FundTransactionResponse, error)
 	UnspentOutputs(ctx context.Context, in *UnspentOutputsRequest, opts ...grpc.CallOption) (WalletService_UnspentOutputsClient, error)
 	ConstructTransaction(ctx context.Context, in *ConstructTransactionRequest, opts ...grpc.CallOption) (*ConstructTransactionResponse, error)
@@ -9493,6 +12253,15 @@ type WalletServiceClient interface {
 	ValidateAddress(ctx context.Context, in *ValidateAddressRequest, opts ...grpc.CallOption) (*ValidateAddressResponse, error)
 	CommittedTickets(ctx context.Context, in *CommittedTicketsRequest, opts ...grpc.CallOption) (*CommittedTicketsResponse, error)
 	SweepAccount(ctx context.Context, in *SweepAccountRequest, opts ...grpc.CallOption) (*SweepAccountResponse, error)
+	StakePoolFeeReport(ctx context.Context, in *StakePoolFeeReportRequest, opts ...grpc.CallOption) (WalletService_StakePoolFeeReportClient, error)
+	AuditLog(ctx context.Context, in *AuditLogRequest, opts ...grpc.CallOption) (*AuditLogResponse, error)
+	PendingTransactions(ctx context.Context, in *PendingTransactionsRequest, opts ...grpc.CallOption) (*PendingTransactionsResponse, error)
+	ApprovePendingTransaction(ctx context.Context, in *ApprovePendingTransactionRequest, opts ...grpc.CallOption) (*ApprovePendingTransactionResponse, error)
+	RejectPendingTransaction(ctx context.Context, in *RejectPendingTransactionRequest, opts ...grpc.CallOption) (*RejectPendingTransactionResponse, error)
+	UnlockWallet(ctx context.Context, in *UnlockWalletRequest, opts ...grpc.CallOption) (*UnlockWalletResponse, error)
+	LockWallet(ctx context.Context, in *LockWalletRequest, opts ...grpc.CallOption) (*LockWalletResponse, error)
+	LockAccount(ctx context.Context, in *LockAccountRequest, opts ...grpc.CallOption) (*LockAccountResponse, error)
+	VerifySeed(ctx context.Context, in *VerifySeedRequest, opts ...grpc.CallOption) (*VerifySeedResponse, error)
 }
 
 type walletServiceClient struct {
@@ -9639,6 +12408,15 @@ func (x *walletServiceGetTicketsClient) Recv() (*GetTicketsResponse, error) {
 	return m, nil
 }
 
+func (c *walletServiceClient) VotingAccountTickets(ctx context.Context, in *VotingAccountTicketsRequest, opts ...grpc.CallOption) (*VotingAccountTicketsResponse, error) {
+	out := new(VotingAccountTicketsResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/VotingAccountTickets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *walletServiceClient) TicketPrice(ctx context.Context, in *TicketPriceRequest, opts ...grpc.CallOption) (*TicketPriceResponse, error) {
 	out := new(TicketPriceResponse)
 	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/TicketPrice", in, out, opts...)
@@ -9648,6 +12426,15 @@ func (c *walletServiceClient) TicketPrice(ctx context.Context, in *TicketPriceRe
 	return out, nil
 }
 
+func (c *walletServiceClient) TicketPriceForecast(ctx context.Context, in *TicketPriceForecastRequest, opts ...grpc.CallOption) (*TicketPriceForecastResponse, error) {
+	out := new(TicketPriceForecastResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/TicketPriceForecast", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *walletServiceClient) StakeInfo(ctx context.Context, in *StakeInfoRequest, opts ...grpc.CallOption) (*StakeInfoResponse, error) {
 	out := new(StakeInfoResponse)
 	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/StakeInfo", in, out, opts...)
@@ -9770,6 +12557,38 @@ func (x *walletServiceConfirmationNotificationsClient) Recv() (*ConfirmationNoti
 	return m, nil
 }
 
+func (c *walletServiceClient) RevocationNotifications(ctx context.Context, in *RevocationNotificationsRequest, opts ...grpc.CallOption) (WalletService_RevocationNotificationsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_WalletService_serviceDesc.Streams[5], "/walletrpc.WalletService/RevocationNotifications", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &walletServiceRevocationNotificationsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WalletService_RevocationNotificationsClient interface {
+	Recv() (*RevocationNotificationsResponse, error)
+	grpc.ClientStream
+}
+
+type walletServiceRevocationNotificationsClient struct {
+	grpc.ClientStream
+}
+
+func (x *walletServiceRevocationNotificationsClient) Recv() (*RevocationNotificationsResponse, error) {
+	m := new(RevocationNotificationsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *walletServiceClient) ChangePassphrase(ctx context.Context, in *ChangePassphraseRequest, opts ...grpc.CallOption) (*ChangePassphraseResponse, error) {
 	out := new(ChangePassphraseResponse)
 	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/ChangePassphrase", in, out, opts...)
@@ -9789,7 +12608,7 @@ func (c *walletServiceClient) RenameAccount(ctx context.Context, in *RenameAccou
 }
 
 func (c *walletServiceClient) Rescan(ctx context.Context, in *RescanRequest, opts ...grpc.CallOption) (WalletService_RescanClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_WalletService_serviceDesc.Streams[5], "/walletrpc.WalletService/Rescan", opts...)
+	stream, err := c.cc.NewStream(ctx, &_WalletService_serviceDesc.Streams[6], "/walletrpc.WalletService/Rescan", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -9856,6 +12675,51 @@ func (c *walletServiceClient) ImportScript(ctx context.Context, in *ImportScript
 	return out, nil
 }
 
+func (c *walletServiceClient) ExportVotingAccount(ctx context.Context, in *ExportVotingAccountRequest, opts ...grpc.CallOption) (*ExportVotingAccountResponse, error) {
+	out := new(ExportVotingAccountResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/ExportVotingAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) ImportVotingAccount(ctx context.Context, in *ImportVotingAccountRequest, opts ...grpc.CallOption) (*ImportVotingAccountResponse, error) {
+	out := new(ImportVotingAccountResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/ImportVotingAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) AddVotingTickets(ctx context.Context, in *AddVotingTicketsRequest, opts ...grpc.CallOption) (*AddVotingTicketsResponse, error) {
+	out := new(AddVotingTicketsResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/AddVotingTickets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) ExportAccountKey(ctx context.Context, in *ExportAccountKeyRequest, opts ...grpc.CallOption) (*ExportAccountKeyResponse, error) {
+	out := new(ExportAccountKeyResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/ExportAccountKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) ImportAccountKey(ctx context.Context, in *ImportAccountKeyRequest, opts ...grpc.CallOption) (*ImportAccountKeyResponse, error) {
+	out := new(ImportAccountKeyResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/ImportAccountKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *walletServiceClient) FundTransaction(ctx context.Context, in *FundTransactionRequest, opts ...grpc.CallOption) (*FundTransactionResponse, error) {
 	out := new(FundTransactionResponse)
 	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/FundTransaction", in, out, opts...)
@@ -9866,7 +12730,7 @@ func (c *walletServiceClient) FundTransaction(ctx context.Context, in *FundTrans
 }
 
 func (c *walletServiceClient) UnspentOutputs(ctx context.Context, in *UnspentOutputsRequest, opts ...grpc.CallOption) (WalletService_UnspentOutputsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_WalletService_serviceDesc.Streams[6], "/walletrpc.WalletService/UnspentOutputs", opts...)
+	stream, err := c.cc.NewStream(ctx, &_WalletService_serviceDesc.Streams[7], "/walletrpc.WalletService/UnspentOutputs", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -10005,18 +12869,122 @@ func (c *walletServiceClient) ValidateAddress(ctx context.Context, in *ValidateA
 	return out, nil
 }
 
-func (c *walletServiceClient) CommittedTickets(ctx context.Context, in *CommittedTicketsRequest, opts ...grpc.CallOption) (*CommittedTicketsResponse, error) {
-	out := new(CommittedTicketsResponse)
-	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/CommittedTickets", in, out, opts...)
+func (c *walletServiceClient) CommittedTickets(ctx context.Context, in *CommittedTicketsRequest, opts ...grpc.CallOption) (*CommittedTicketsResponse, error) {
+	out := new(CommittedTicketsResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/CommittedTickets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) SweepAccount(ctx context.Context, in *SweepAccountRequest, opts ...grpc.CallOption) (*SweepAccountResponse, error) {
+	out := new(SweepAccountResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/SweepAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) StakePoolFeeReport(ctx context.Context, in *StakePoolFeeReportRequest, opts ...grpc.CallOption) (WalletService_StakePoolFeeReportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_WalletService_serviceDesc.Streams[8], "/walletrpc.WalletService/StakePoolFeeReport", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &walletServiceStakePoolFeeReportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WalletService_StakePoolFeeReportClient interface {
+	Recv() (*StakePoolFeeReportResponse, error)
+	grpc.ClientStream
+}
+
+type walletServiceStakePoolFeeReportClient struct {
+	grpc.ClientStream
+}
+
+func (x *walletServiceStakePoolFeeReportClient) Recv() (*StakePoolFeeReportResponse, error) {
+	m := new(StakePoolFeeReportResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *walletServiceClient) AuditLog(ctx context.Context, in *AuditLogRequest, opts ...grpc.CallOption) (*AuditLogResponse, error) {
+	out := new(AuditLogResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/AuditLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) PendingTransactions(ctx context.Context, in *PendingTransactionsRequest, opts ...grpc.CallOption) (*PendingTransactionsResponse, error) {
+	out := new(PendingTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/PendingTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) ApprovePendingTransaction(ctx context.Context, in *ApprovePendingTransactionRequest, opts ...grpc.CallOption) (*ApprovePendingTransactionResponse, error) {
+	out := new(ApprovePendingTransactionResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/ApprovePendingTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) RejectPendingTransaction(ctx context.Context, in *RejectPendingTransactionRequest, opts ...grpc.CallOption) (*RejectPendingTransactionResponse, error) {
+	out := new(RejectPendingTransactionResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/RejectPendingTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) UnlockWallet(ctx context.Context, in *UnlockWalletRequest, opts ...grpc.CallOption) (*UnlockWalletResponse, error) {
+	out := new(UnlockWalletResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/UnlockWallet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) LockWallet(ctx context.Context, in *LockWalletRequest, opts ...grpc.CallOption) (*LockWalletResponse, error) {
+	out := new(LockWalletResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/LockWallet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) LockAccount(ctx context.Context, in *LockAccountRequest, opts ...grpc.CallOption) (*LockAccountResponse, error) {
+	out := new(LockAccountResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/LockAccount", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *walletServiceClient) SweepAccount(ctx context.Context, in *SweepAccountRequest, opts ...grpc.CallOption) (*SweepAccountResponse, error) {
-	out := new(SweepAccountResponse)
-	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/SweepAccount", in, out, opts...)
+func (c *walletServiceClient) VerifySeed(ctx context.Context, in *VerifySeedRequest, opts ...grpc.CallOption) (*VerifySeedResponse, error) {
+	out := new(VerifySeedResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/VerifySeed", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -10036,7 +13004,9 @@ type WalletServiceServer interface {
 	GetTransactions(*GetTransactionsRequest, WalletService_GetTransactionsServer) error
 	GetTicket(context.Context, *GetTicketRequest) (*GetTicketsResponse, error)
 	GetTickets(*GetTicketsRequest, WalletService_GetTicketsServer) error
+	VotingAccountTickets(context.Context, *VotingAccountTicketsRequest) (*VotingAccountTicketsResponse, error)
 	TicketPrice(context.Context, *TicketPriceRequest) (*TicketPriceResponse, error)
+	TicketPriceForecast(context.Context, *TicketPriceForecastRequest) (*TicketPriceForecastResponse, error)
 	StakeInfo(context.Context, *StakeInfoRequest) (*StakeInfoResponse, error)
 	BlockInfo(context.Context, *BlockInfoRequest) (*BlockInfoResponse, error)
 	BestBlock(context.Context, *BestBlockRequest) (*BestBlockResponse, error)
@@ -10044,6 +13014,7 @@ type WalletServiceServer interface {
 	TransactionNotifications(*TransactionNotificationsRequest, WalletService_TransactionNotificationsServer) error
 	AccountNotifications(*AccountNotificationsRequest, WalletService_AccountNotificationsServer) error
 	ConfirmationNotifications(WalletService_ConfirmationNotificationsServer) error
+	RevocationNotifications(*RevocationNotificationsRequest, WalletService_RevocationNotificationsServer) error
 	// Control
 	ChangePassphrase(context.Context, *ChangePassphraseRequest) (*ChangePassphraseResponse, error)
 	RenameAccount(context.Context, *RenameAccountRequest) (*RenameAccountResponse, error)
@@ -10052,6 +13023,11 @@ type WalletServiceServer interface {
 	NextAddress(context.Context, *NextAddressRequest) (*NextAddressResponse, error)
 	ImportPrivateKey(context.Context, *ImportPrivateKeyRequest) (*ImportPrivateKeyResponse, error)
 	ImportScript(context.Context, *ImportScriptRequest) (*ImportScriptResponse, error)
+	ExportVotingAccount(context.Context, *ExportVotingAccountRequest) (*ExportVotingAccountResponse, error)
+	ImportVotingAccount(context.Context, *ImportVotingAccountRequest) (*ImportVotingAccountResponse, error)
+	AddVotingTickets(context.Context, *AddVotingTicketsRequest) (*AddVotingTicketsResponse, error)
+	ExportAccountKey(context.Context, *ExportAccountKeyRequest) (*ExportAccountKeyResponse, error)
+	ImportAccountKey(context.Context, *ImportAccountKeyRequest) (*ImportAccountKeyResponse, error)
 	FundTransaction(context.Context, *FundTransactionRequest) (*FundTransactionResponse, error)
 	UnspentOutputs(*UnspentOutputsRequest, WalletService_UnspentOutputsServer) error
 	ConstructTransaction(context.Context, *ConstructTransactionRequest) (*ConstructTransactionResponse, error)
@@ -10068,6 +13044,15 @@ type WalletServiceServer interface {
 	ValidateAddress(context.Context, *ValidateAddressRequest) (*ValidateAddressResponse, error)
 	CommittedTickets(context.Context, *CommittedTicketsRequest) (*CommittedTicketsResponse, error)
 	SweepAccount(context.Context, *SweepAccountRequest) (*SweepAccountResponse, error)
+	StakePoolFeeReport(*StakePoolFeeReportRequest, WalletService_StakePoolFeeReportServer) error
+	AuditLog(context.Context, *AuditLogRequest) (*AuditLogResponse, error)
+	PendingTransactions(context.Context, *PendingTransactionsRequest) (*PendingTransactionsResponse, error)
+	ApprovePendingTransaction(context.Context, *ApprovePendingTransactionRequest) (*ApprovePendingTransactionResponse, error)
+	RejectPendingTransaction(context.Context, *RejectPendingTransactionRequest) (*RejectPendingTransactionResponse, error)
+	UnlockWallet(context.Context, *UnlockWalletRequest) (*UnlockWalletResponse, error)
+	LockWallet(context.Context, *LockWalletRequest) (*LockWalletResponse, error)
+	LockAccount(context.Context, *LockAccountRequest) (*LockAccountResponse, error)
+	VerifySeed(context.Context, *VerifySeedRequest) (*VerifySeedResponse, error)
 }
 
 func RegisterWalletServiceServer(s *grpc.Server, srv WalletServiceServer) {
@@ -10260,6 +13245,24 @@ func (x *walletServiceGetTicketsServer) Send(m *GetTicketsResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _WalletService_VotingAccountTickets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VotingAccountTicketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).VotingAccountTickets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/VotingAccountTickets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).VotingAccountTickets(ctx, req.(*VotingAccountTicketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WalletService_TicketPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TicketPriceRequest)
 	if err := dec(in); err != nil {
@@ -10278,6 +13281,24 @@ func _WalletService_TicketPrice_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WalletService_TicketPriceForecast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TicketPriceForecastRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).TicketPriceForecast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/TicketPriceForecast",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).TicketPriceForecast(ctx, req.(*TicketPriceForecastRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WalletService_StakeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StakeInfoRequest)
 	if err := dec(in); err != nil {
@@ -10400,6 +13421,27 @@ func (x *walletServiceConfirmationNotificationsServer) Recv() (*ConfirmationNoti
 	return m, nil
 }
 
+func _WalletService_RevocationNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RevocationNotificationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WalletServiceServer).RevocationNotifications(m, &walletServiceRevocationNotificationsServer{stream})
+}
+
+type WalletService_RevocationNotificationsServer interface {
+	Send(*RevocationNotificationsResponse) error
+	grpc.ServerStream
+}
+
+type walletServiceRevocationNotificationsServer struct {
+	grpc.ServerStream
+}
+
+func (x *walletServiceRevocationNotificationsServer) Send(m *RevocationNotificationsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _WalletService_ChangePassphrase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ChangePassphraseRequest)
 	if err := dec(in); err != nil {
@@ -10529,6 +13571,96 @@ func _WalletService_ImportScript_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WalletService_ExportVotingAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportVotingAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).ExportVotingAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/ExportVotingAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).ExportVotingAccount(ctx, req.(*ExportVotingAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_ImportVotingAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportVotingAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).ImportVotingAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/ImportVotingAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).ImportVotingAccount(ctx, req.(*ImportVotingAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_AddVotingTickets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddVotingTicketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).AddVotingTickets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/AddVotingTickets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).AddVotingTickets(ctx, req.(*AddVotingTicketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_ExportAccountKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportAccountKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).ExportAccountKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/ExportAccountKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).ExportAccountKey(ctx, req.(*ExportAccountKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_ImportAccountKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportAccountKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).ImportAccountKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/ImportAccountKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).ImportAccountKey(ctx, req.(*ImportAccountKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WalletService_FundTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(FundTransactionRequest)
 	if err := dec(in); err != nil {
@@ -10568,254 +13700,419 @@ func (x *walletServiceUnspentOutputsServer) Send(m *UnspentOutputResponse) error
 	return x.ServerStream.SendMsg(m)
 }
 
-func _WalletService_ConstructTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ConstructTransactionRequest)
+func _WalletService_ConstructTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConstructTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).ConstructTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/ConstructTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).ConstructTransaction(ctx, req.(*ConstructTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_SignTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).SignTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/SignTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).SignTransaction(ctx, req.(*SignTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_SignTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).SignTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/SignTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).SignTransactions(ctx, req.(*SignTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_CreateSignature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSignatureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).CreateSignature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/CreateSignature",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).CreateSignature(ctx, req.(*CreateSignatureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_PublishTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).PublishTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/PublishTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).PublishTransaction(ctx, req.(*PublishTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_PublishUnminedTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishUnminedTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).PublishUnminedTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/PublishUnminedTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).PublishUnminedTransactions(ctx, req.(*PublishUnminedTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_PurchaseTickets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurchaseTicketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).PurchaseTickets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/PurchaseTickets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).PurchaseTickets(ctx, req.(*PurchaseTicketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_RevokeTickets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeTicketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).RevokeTickets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/RevokeTickets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).RevokeTickets(ctx, req.(*RevokeTicketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_LoadActiveDataFilters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoadActiveDataFiltersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).ConstructTransaction(ctx, in)
+		return srv.(WalletServiceServer).LoadActiveDataFilters(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/ConstructTransaction",
+		FullMethod: "/walletrpc.WalletService/LoadActiveDataFilters",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).ConstructTransaction(ctx, req.(*ConstructTransactionRequest))
+		return srv.(WalletServiceServer).LoadActiveDataFilters(ctx, req.(*LoadActiveDataFiltersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_SignTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SignTransactionRequest)
+func _WalletService_SignMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignMessageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).SignTransaction(ctx, in)
+		return srv.(WalletServiceServer).SignMessage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/SignTransaction",
+		FullMethod: "/walletrpc.WalletService/SignMessage",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).SignTransaction(ctx, req.(*SignTransactionRequest))
+		return srv.(WalletServiceServer).SignMessage(ctx, req.(*SignMessageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_SignTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SignTransactionsRequest)
+func _WalletService_SignMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignMessagesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).SignTransactions(ctx, in)
+		return srv.(WalletServiceServer).SignMessages(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/SignTransactions",
+		FullMethod: "/walletrpc.WalletService/SignMessages",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).SignTransactions(ctx, req.(*SignTransactionsRequest))
+		return srv.(WalletServiceServer).SignMessages(ctx, req.(*SignMessagesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_CreateSignature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateSignatureRequest)
+func _WalletService_ValidateAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateAddressRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).CreateSignature(ctx, in)
+		return srv.(WalletServiceServer).ValidateAddress(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/CreateSignature",
+		FullMethod: "/walletrpc.WalletService/ValidateAddress",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).CreateSignature(ctx, req.(*CreateSignatureRequest))
+		return srv.(WalletServiceServer).ValidateAddress(ctx, req.(*ValidateAddressRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_PublishTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PublishTransactionRequest)
+func _WalletService_CommittedTickets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommittedTicketsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).PublishTransaction(ctx, in)
+		return srv.(WalletServiceServer).CommittedTickets(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/PublishTransaction",
+		FullMethod: "/walletrpc.WalletService/CommittedTickets",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).PublishTransaction(ctx, req.(*PublishTransactionRequest))
+		return srv.(WalletServiceServer).CommittedTickets(ctx, req.(*CommittedTicketsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_PublishUnminedTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PublishUnminedTransactionsRequest)
+func _WalletService_SweepAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SweepAccountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).PublishUnminedTransactions(ctx, in)
+		return srv.(WalletServiceServer).SweepAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/PublishUnminedTransactions",
+		FullMethod: "/walletrpc.WalletService/SweepAccount",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).PublishUnminedTransactions(ctx, req.(*PublishUnminedTransactionsRequest))
+		return srv.(WalletServiceServer).SweepAccount(ctx, req.(*SweepAccountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_PurchaseTickets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PurchaseTicketsRequest)
+func _WalletService_StakePoolFeeReport_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StakePoolFeeReportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WalletServiceServer).StakePoolFeeReport(m, &walletServiceStakePoolFeeReportServer{stream})
+}
+
+type WalletService_StakePoolFeeReportServer interface {
+	Send(*StakePoolFeeReportResponse) error
+	grpc.ServerStream
+}
+
+type walletServiceStakePoolFeeReportServer struct {
+	grpc.ServerStream
+}
+
+func (x *walletServiceStakePoolFeeReportServer) Send(m *StakePoolFeeReportResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _WalletService_AuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditLogRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).PurchaseTickets(ctx, in)
+		return srv.(WalletServiceServer).AuditLog(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/PurchaseTickets",
+		FullMethod: "/walletrpc.WalletService/AuditLog",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).PurchaseTickets(ctx, req.(*PurchaseTicketsRequest))
+		return srv.(WalletServiceServer).AuditLog(ctx, req.(*AuditLogRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_RevokeTickets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RevokeTicketsRequest)
+func _WalletService_PendingTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PendingTransactionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).RevokeTickets(ctx, in)
+		return srv.(WalletServiceServer).PendingTransactions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/RevokeTickets",
+		FullMethod: "/walletrpc.WalletService/PendingTransactions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).RevokeTickets(ctx, req.(*RevokeTicketsRequest))
+		return srv.(WalletServiceServer).PendingTransactions(ctx, req.(*PendingTransactionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_LoadActiveDataFilters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoadActiveDataFiltersRequest)
+func _WalletService_ApprovePendingTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApprovePendingTransactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).LoadActiveDataFilters(ctx, in)
+		return srv.(WalletServiceServer).ApprovePendingTransaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/LoadActiveDataFilters",
+		FullMethod: "/walletrpc.WalletService/ApprovePendingTransaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).LoadActiveDataFilters(ctx, req.(*LoadActiveDataFiltersRequest))
+		return srv.(WalletServiceServer).ApprovePendingTransaction(ctx, req.(*ApprovePendingTransactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_SignMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SignMessageRequest)
+func _WalletService_RejectPendingTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectPendingTransactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).SignMessage(ctx, in)
+		return srv.(WalletServiceServer).RejectPendingTransaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/SignMessage",
+		FullMethod: "/walletrpc.WalletService/RejectPendingTransaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).SignMessage(ctx, req.(*SignMessageRequest))
+		return srv.(WalletServiceServer).RejectPendingTransaction(ctx, req.(*RejectPendingTransactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_SignMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SignMessagesRequest)
+func _WalletService_UnlockWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockWalletRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).SignMessages(ctx, in)
+		return srv.(WalletServiceServer).UnlockWallet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/SignMessages",
+		FullMethod: "/walletrpc.WalletService/UnlockWallet",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).SignMessages(ctx, req.(*SignMessagesRequest))
+		return srv.(WalletServiceServer).UnlockWallet(ctx, req.(*UnlockWalletRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_ValidateAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ValidateAddressRequest)
+func _WalletService_LockWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockWalletRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).ValidateAddress(ctx, in)
+		return srv.(WalletServiceServer).LockWallet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/ValidateAddress",
+		FullMethod: "/walletrpc.WalletService/LockWallet",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).ValidateAddress(ctx, req.(*ValidateAddressRequest))
+		return srv.(WalletServiceServer).LockWallet(ctx, req.(*LockWalletRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_CommittedTickets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CommittedTicketsRequest)
+func _WalletService_LockAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockAccountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).CommittedTickets(ctx, in)
+		return srv.(WalletServiceServer).LockAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/CommittedTickets",
+		FullMethod: "/walletrpc.WalletService/LockAccount",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).CommittedTickets(ctx, req.(*CommittedTicketsRequest))
+		return srv.(WalletServiceServer).LockAccount(ctx, req.(*LockAccountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_SweepAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SweepAccountRequest)
+func _WalletService_VerifySeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifySeedRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).SweepAccount(ctx, in)
+		return srv.(WalletServiceServer).VerifySeed(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/SweepAccount",
+		FullMethod: "/walletrpc.WalletService/VerifySeed",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).SweepAccount(ctx, req.(*SweepAccountRequest))
+		return srv.(WalletServiceServer).VerifySeed(ctx, req.(*VerifySeedRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -10856,10 +14153,18 @@ var _WalletService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetTicket",
 			Handler:    _WalletService_GetTicket_Handler,
 		},
+		{
+			MethodName: "VotingAccountTickets",
+			Handler:    _WalletService_VotingAccountTickets_Handler,
+		},
 		{
 			MethodName: "TicketPrice",
 			Handler:    _WalletService_TicketPrice_Handler,
 		},
+		{
+			MethodName: "TicketPriceForecast",
+			Handler:    _WalletService_TicketPriceForecast_Handler,
+		},
 		{
 			MethodName: "StakeInfo",
 			Handler:    _WalletService_StakeInfo_Handler,
@@ -10896,6 +14201,26 @@ var _WalletService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ImportScript",
 			Handler:    _WalletService_ImportScript_Handler,
 		},
+		{
+			MethodName: "ExportVotingAccount",
+			Handler:    _WalletService_ExportVotingAccount_Handler,
+		},
+		{
+			MethodName: "ImportVotingAccount",
+			Handler:    _WalletService_ImportVotingAccount_Handler,
+		},
+		{
+			MethodName: "AddVotingTickets",
+			Handler:    _WalletService_AddVotingTickets_Handler,
+		},
+		{
+			MethodName: "ExportAccountKey",
+			Handler:    _WalletService_ExportAccountKey_Handler,
+		},
+		{
+			MethodName: "ImportAccountKey",
+			Handler:    _WalletService_ImportAccountKey_Handler,
+		},
 		{
 			MethodName: "FundTransaction",
 			Handler:    _WalletService_FundTransaction_Handler,
@@ -10956,6 +14281,38 @@ var _WalletService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SweepAccount",
 			Handler:    _WalletService_SweepAccount_Handler,
 		},
+		{
+			MethodName: "AuditLog",
+			Handler:    _WalletService_AuditLog_Handler,
+		},
+		{
+			MethodName: "PendingTransactions",
+			Handler:    _WalletService_PendingTransactions_Handler,
+		},
+		{
+			MethodName: "ApprovePendingTransaction",
+			Handler:    _WalletService_ApprovePendingTransaction_Handler,
+		},
+		{
+			MethodName: "RejectPendingTransaction",
+			Handler:    _WalletService_RejectPendingTransaction_Handler,
+		},
+		{
+			MethodName: "UnlockWallet",
+			Handler:    _WalletService_UnlockWallet_Handler,
+		},
+		{
+			MethodName: "LockWallet",
+			Handler:    _WalletService_LockWallet_Handler,
+		},
+		{
+			MethodName: "LockAccount",
+			Handler:    _WalletService_LockAccount_Handler,
+		},
+		{
+			MethodName: "VerifySeed",
+			Handler:    _WalletService_VerifySeed_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -10984,6 +14341,11 @@ var _WalletService_serviceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "RevocationNotifications",
+			Handler:       _WalletService_RevocationNotifications_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "Rescan",
 			Handler:       _WalletService_Rescan_Handler,
@@ -10994,6 +14356,11 @@ var _WalletService_serviceDesc = grpc.ServiceDesc{
 			Handler:       _WalletService_UnspentOutputs_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "StakePoolFeeReport",
+			Handler:       _WalletService_StakePoolFeeReport_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "api.proto",
 }
@@ -11015,6 +14382,9 @@ type WalletLoaderServiceClient interface {
 	SpvSync(ctx context.Context, in *SpvSyncRequest, opts ...grpc.CallOption) (WalletLoaderService_SpvSyncClient, error)
 	RpcSync(ctx context.Context, in *RpcSyncRequest, opts ...grpc.CallOption) (WalletLoaderService_RpcSyncClient, error)
 	RescanPoint(ctx context.Context, in *RescanPointRequest, opts ...grpc.CallOption) (*RescanPointResponse, error)
+	ListWallets(ctx context.Context, in *ListWalletsRequest, opts ...grpc.CallOption) (*ListWalletsResponse, error)
+	CompactDatabase(ctx context.Context, in *CompactDatabaseRequest, opts ...grpc.CallOption) (*CompactDatabaseResponse, error)
+	DatabaseSize(ctx context.Context, in *DatabaseSizeRequest, opts ...grpc.CallOption) (*DatabaseSizeResponse, error)
 }
 
 type walletLoaderServiceClient struct {
@@ -11188,6 +14558,33 @@ func (c *walletLoaderServiceClient) RescanPoint(ctx context.Context, in *RescanP
 	return out, nil
 }
 
+func (c *walletLoaderServiceClient) ListWallets(ctx context.Context, in *ListWalletsRequest, opts ...grpc.CallOption) (*ListWalletsResponse, error) {
+	out := new(ListWalletsResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletLoaderService/ListWallets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletLoaderServiceClient) CompactDatabase(ctx context.Context, in *CompactDatabaseRequest, opts ...grpc.CallOption) (*CompactDatabaseResponse, error) {
+	out := new(CompactDatabaseResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletLoaderService/CompactDatabase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletLoaderServiceClient) DatabaseSize(ctx context.Context, in *DatabaseSizeRequest, opts ...grpc.CallOption) (*DatabaseSizeResponse, error) {
+	out := new(DatabaseSizeResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletLoaderService/DatabaseSize", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WalletLoaderServiceServer is the server API for WalletLoaderService service.
 type WalletLoaderServiceServer interface {
 	WalletExists(context.Context, *WalletExistsRequest) (*WalletExistsResponse, error)
@@ -11203,6 +14600,9 @@ type WalletLoaderServiceServer interface {
 	SpvSync(*SpvSyncRequest, WalletLoaderService_SpvSyncServer) error
 	RpcSync(*RpcSyncRequest, WalletLoaderService_RpcSyncServer) error
 	RescanPoint(context.Context, *RescanPointRequest) (*RescanPointResponse, error)
+	ListWallets(context.Context, *ListWalletsRequest) (*ListWalletsResponse, error)
+	CompactDatabase(context.Context, *CompactDatabaseRequest) (*CompactDatabaseResponse, error)
+	DatabaseSize(context.Context, *DatabaseSizeRequest) (*DatabaseSizeResponse, error)
 }
 
 func RegisterWalletLoaderServiceServer(s *grpc.Server, srv WalletLoaderServiceServer) {
@@ -11449,6 +14849,60 @@ func _WalletLoaderService_RescanPoint_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WalletLoaderService_ListWallets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWalletsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletLoaderServiceServer).ListWallets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletLoaderService/ListWallets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletLoaderServiceServer).ListWallets(ctx, req.(*ListWalletsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletLoaderService_CompactDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompactDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletLoaderServiceServer).CompactDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletLoaderService/CompactDatabase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletLoaderServiceServer).CompactDatabase(ctx, req.(*CompactDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletLoaderService_DatabaseSize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DatabaseSizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletLoaderServiceServer).DatabaseSize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletLoaderService/DatabaseSize",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletLoaderServiceServer).DatabaseSize(ctx, req.(*DatabaseSizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _WalletLoaderService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "walletrpc.WalletLoaderService",
 	HandlerType: (*WalletLoaderServiceServer)(nil),
@@ -11497,6 +14951,18 @@ var _WalletLoaderService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RescanPoint",
 			Handler:    _WalletLoaderService_RescanPoint_Handler,
 		},
+		{
+			MethodName: "ListWallets",
+			Handler:    _WalletLoaderService_ListWallets_Handler,
+		},
+		{
+			MethodName: "CompactDatabase",
+			Handler:    _WalletLoaderService_CompactDatabase_Handler,
+		},
+		{
+			MethodName: "DatabaseSize",
+			Handler:    _WalletLoaderService_DatabaseSize_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -12037,6 +15503,8 @@ var _TicketBuyerService_serviceDesc = grpc.ServiceDesc{
 type SeedServiceClient interface {
 	GenerateRandomSeed(ctx context.Context, in *GenerateRandomSeedRequest, opts ...grpc.CallOption) (*GenerateRandomSeedResponse, error)
 	DecodeSeed(ctx context.Context, in *DecodeSeedRequest, opts ...grpc.CallOption) (*DecodeSeedResponse, error)
+	GenerateSeedShares(ctx context.Context, in *GenerateSeedSharesRequest, opts ...grpc.CallOption) (*GenerateSeedSharesResponse, error)
+	CombineSeedShares(ctx context.Context, in *CombineSeedSharesRequest, opts ...grpc.CallOption) (*CombineSeedSharesResponse, error)
 }
 
 type seedServiceClient struct {
@@ -12065,10 +15533,30 @@ func (c *seedServiceClient) DecodeSeed(ctx context.Context, in *DecodeSeedReques
 	return out, nil
 }
 
+func (c *seedServiceClient) GenerateSeedShares(ctx context.Context, in *GenerateSeedSharesRequest, opts ...grpc.CallOption) (*GenerateSeedSharesResponse, error) {
+	out := new(GenerateSeedSharesResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.SeedService/GenerateSeedShares", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seedServiceClient) CombineSeedShares(ctx context.Context, in *CombineSeedSharesRequest, opts ...grpc.CallOption) (*CombineSeedSharesResponse, error) {
+	out := new(CombineSeedSharesResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.SeedService/CombineSeedShares", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SeedServiceServer is the server API for SeedService service.
 type SeedServiceServer interface {
 	GenerateRandomSeed(context.Context, *GenerateRandomSeedRequest) (*GenerateRandomSeedResponse, error)
 	DecodeSeed(context.Context, *DecodeSeedRequest) (*DecodeSeedResponse, error)
+	GenerateSeedShares(context.Context, *GenerateSeedSharesRequest) (*GenerateSeedSharesResponse, error)
+	CombineSeedShares(context.Context, *CombineSeedSharesRequest) (*CombineSeedSharesResponse, error)
 }
 
 func RegisterSeedServiceServer(s *grpc.Server, srv SeedServiceServer) {
@@ -12111,6 +15599,42 @@ func _SeedService_DecodeSeed_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SeedService_GenerateSeedShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateSeedSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeedServiceServer).GenerateSeedShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.SeedService/GenerateSeedShares",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeedServiceServer).GenerateSeedShares(ctx, req.(*GenerateSeedSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SeedService_CombineSeedShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CombineSeedSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeedServiceServer).CombineSeedShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.SeedService/CombineSeedShares",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeedServiceServer).CombineSeedShares(ctx, req.(*CombineSeedSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _SeedService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "walletrpc.SeedService",
 	HandlerType: (*SeedServiceServer)(nil),
@@ -12123,6 +15647,14 @@ var _SeedService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DecodeSeed",
 			Handler:    _SeedService_DecodeSeed_Handler,
 		},
+		{
+			MethodName: "GenerateSeedShares",
+			Handler:    _SeedService_GenerateSeedShares_Handler,
+		},
+		{
+			MethodName: "CombineSeedShares",
+			Handler:    _SeedService_CombineSeedShares_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api.proto",
@@ -12417,533 +15949,657 @@ var _DecodeMessageService_serviceDesc = grpc.ServiceDesc{
 	Metadata: "api.proto",
 }
 
-func init() { proto.RegisterFile("api.proto", fileDescriptor_api_bfc3eaa1a00f06bc) }
-
-var fileDescriptor_api_bfc3eaa1a00f06bc = []byte{
-	// 8391 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x5b, 0x6c, 0x24, 0x59,
-	0x96, 0xd0, 0x84, 0xd3, 0x8f, 0xcc, 0xe3, 0xcc, 0x74, 0xe4, 0xf5, 0x2b, 0x2b, 0x5d, 0xd5, 0x55,
-	0x15, 0xdd, 0xd5, 0x5d, 0xd3, 0x0f, 0x77, 0x8d, 0xbb, 0x67, 0xa6, 0x77, 0xa6, 0xa7, 0xbb, 0xb3,
-	0xec, 0xac, 0xaa, 0x9c, 0xb2, 0xd3, 0xb9, 0x91, 0x59, 0xd5, 0xd5, 0xd3, 0xb0, 0xa1, 0x70, 0xe6,
-	0xb5, 0x1d, 0x5b, 0x99, 0x11, 0x39, 0x11, 0x91, 0x76, 0x79, 0x00, 0x31, 0x1a, 0x04, 0x7f, 0x2b,
-	0x1e, 0x12, 0x1f, 0x3c, 0x76, 0x85, 0x04, 0x02, 0x24, 0xc4, 0x02, 0x02, 0xa1, 0x15, 0x83, 0x10,
-	0x8b, 0xf8, 0x41, 0x2b, 0x84, 0x96, 0x1f, 0x3e, 0xf8, 0x43, 0xe2, 0x0b, 0x89, 0x95, 0xf8, 0xe5,
-	0x03, 0x74, 0x5f, 0x11, 0xf7, 0xc6, 0x23, 0x6d, 0xf7, 0xf4, 0x48, 0xcc, 0x88, 0xfa, 0xa9, 0x8c,
-	0x73, 0xcf, 0x3d, 0xf7, 0x75, 0xee, 0xb9, 0xe7, 0x9e, 0x7b, 0xce, 0x31, 0x94, 0xec, 0x89, 0xb3,
-	0x3d, 0xf1, 0xbd, 0xd0, 0x43, 0xa5, 0x73, 0x7b, 0x34, 0xc2, 0xa1, 0x3f, 0x19, 0x18, 0x3a, 0x54,
-	0x9f, 0x63, 0x3f, 0x70, 0x3c, 0xd7, 0xc4, 0x3f, 0x9e, 0xe2, 0x20, 0x34, 0xfe, 0x50, 0x83, 0x95,
-	0x08, 0x14, 0x4c, 0x3c, 0x37, 0xc0, 0xe8, 0x1e, 0x54, 0xcf, 0x18, 0xc8, 0x0a, 0x42, 0xdf, 0x71,
-	0x4f, 0xea, 0xda, 0x1d, 0xed, 0x7e, 0xc9, 0xac, 0x70, 0x68, 0x8f, 0x02, 0xd1, 0x1a, 0x2c, 0x8c,
-	0xed, 0xdf, 0xf6, 0xfc, 0xfa, 0xdc, 0x1d, 0xed, 0x7e, 0xc5, 0x64, 0x1f, 0x14, 0xea, 0xb8, 0x9e,
-	0x5f, 0x2f, 0x70, 0x28, 0xf9, 0x20, 0xd0, 0x89, 0x1d, 0x0e, 0x4e, 0xeb, 0xf3, 0x0c, 0x4a, 0x3f,
-	0xd0, 0x6b, 0x00, 0x13, 0x1f, 0xfb, 0x78, 0x84, 0xed, 0x00, 0xd7, 0x17, 0x68, 0x23, 0x12, 0x84,
-	0x74, 0xe4, 0x68, 0xea, 0x8c, 0x86, 0xd6, 0x18, 0x87, 0xf6, 0xd0, 0x0e, 0xed, 0xfa, 0x22, 0xeb,
-	0x08, 0x85, 0x1e, 0x70, 0xa0, 0xf1, 0x9f, 0x16, 0x00, 0xf5, 0x7d, 0xdb, 0x0d, 0xec, 0x41, 0xe8,
-	0x78, 0xee, 0x1e, 0x0e, 0x6d, 0x67, 0x14, 0x20, 0x04, 0xf3, 0xa7, 0x76, 0x70, 0x4a, 0x3b, 0x5f,
-	0x36, 0xe9, 0x6f, 0x74, 0x07, 0x96, 0xc3, 0x18, 0x93, 0xf6, 0xbc, 0x6c, 0xca, 0x20, 0xf4, 0x7d,
-	0x58, 0x1c, 0xe2, 0x23, 0x27, 0x0c, 0xea, 0x85, 0x3b, 0x85, 0xfb, 0xcb, 0x3b, 0xaf, 0x6f, 0x47,
-	0xd3, 0xb7, 0x9d, 0x6e, 0x64, 0xbb, 0xed, 0x4e, 0xa6, 0xa1, 0xc9, 0xab, 0xa0, 0x4f, 0x60, 0x69,
-	0xe0, 0xe3, 0x21, 0xa9, 0x3d, 0x4f, 0x6b, 0xbf, 0x31, 0xbb, 0xf6, 0xe1, 0x34, 0x24, 0xd5, 0x45,
-	0x25, 0xa4, 0x43, 0xe1, 0x18, 0xb3, 0x99, 0x28, 0x98, 0xe4, 0x27, 0xba, 0x09, 0xa5, 0xd0, 0x19,
-	0xe3, 0x20, 0xb4, 0xc7, 0x13, 0x3a, 0xfa, 0x82, 0x19, 0x03, 0xd0, 0x0b, 0xd0, 0xa5, 0xbe, 0x5b,
-	0xe1, 0xc5, 0x04, 0xd7, 0x97, 0xee, 0x68, 0xf7, 0xab, 0x3b, 0xef, 0xcd, 0x6e, 0x58, 0x02, 0xf5,
-	0x2f, 0x26, 0xd8, 0x5c, 0x09, 0x55, 0x40, 0xe3, 0xc7, 0xb0, 0x40, 0x87, 0x46, 0x56, 0xce, 0x71,
-	0x87, 0xf8, 0x15, 0x9d, 0xc6, 0x8a, 0xc9, 0x3e, 0xd0, 0x37, 0x41, 0x9f, 0xf8, 0xf8, 0xcc, 0xf1,
-	0xa6, 0x81, 0x65, 0x0f, 0x06, 0xde, 0xd4, 0x0d, 0x39, 0x1b, 0xac, 0x08, 0x78, 0x93, 0x81, 0xd1,
-	0x5b, 0xb0, 0x12, 0xa3, 0x8e, 0x29, 0x66, 0x81, 0x8e, 0xa3, 0x1a, 0x61, 0x52, 0x68, 0xe3, 0x1f,
-	0x69, 0xb0, 0xc8, 0x26, 0x24, 0xa7, 0xd1, 0x3a, 0x2c, 0xa9, 0x6d, 0x89, 0x4f, 0xd4, 0x80, 0xa2,
-	0xe3, 0x86, 0xd8, 0x77, 0xed, 0x11, 0x25, 0x5e, 0x34, 0xa3, 0x6f, 0xb4, 0x01, 0x8b, 0xbc, 0xd9,
-	0x79, 0xda, 0x2c, 0xff, 0xa2, 0xd4, 0x86, 0x43, 0x1f, 0x07, 0x01, 0xe7, 0x3c, 0xf1, 0x89, 0x5e,
-	0x87, 0x8a, 0x47, 0xfb, 0x61, 0x05, 0x03, 0xdf, 0x99, 0x84, 0x74, 0xde, 0xcb, 0x66, 0x99, 0x01,
-	0x7b, 0x14, 0x66, 0x7c, 0x09, 0x2b, 0x89, 0x49, 0x44, 0xcb, 0xb0, 0x64, 0xb6, 0x1e, 0x3f, 0xdb,
-	0x6f, 0x9a, 0xfa, 0x37, 0x50, 0x19, 0x8a, 0xbb, 0x87, 0xed, 0xce, 0xc3, 0x66, 0xaf, 0xa5, 0xcf,
-	0xa3, 0x55, 0x58, 0xe9, 0xb7, 0x77, 0x9f, 0xb6, 0xfa, 0x56, 0xf7, 0x99, 0xb9, 0xfb, 0x84, 0x00,
-	0x35, 0x54, 0x84, 0xf9, 0xe7, 0x87, 0xfd, 0x96, 0x3e, 0x87, 0xaa, 0x00, 0x66, 0xeb, 0xf9, 0xe1,
-	0x6e, 0xb3, 0xdf, 0x3e, 0xec, 0xe8, 0x05, 0xe3, 0xdf, 0x6b, 0x50, 0x7e, 0x38, 0xf2, 0x06, 0x2f,
-	0x67, 0xf1, 0xf2, 0x06, 0x2c, 0x9e, 0x62, 0xe7, 0xe4, 0x94, 0xcd, 0xc6, 0x82, 0xc9, 0xbf, 0x54,
-	0x96, 0x29, 0x24, 0x59, 0xe6, 0x2d, 0x58, 0xb1, 0x27, 0x13, 0xdf, 0x3b, 0xc3, 0x81, 0x35, 0xb1,
-	0x7d, 0xec, 0x86, 0x74, 0xf8, 0x45, 0xb3, 0x2a, 0xc0, 0x5d, 0x0a, 0x45, 0x4d, 0x28, 0x4b, 0x4c,
-	0x21, 0x18, 0xfa, 0xd6, 0x4c, 0xbe, 0x32, 0x95, 0x2a, 0xc6, 0x21, 0x54, 0x39, 0x17, 0x3c, 0xb4,
-	0x47, 0xb6, 0x3b, 0xc0, 0xf2, 0x12, 0x6a, 0xea, 0x12, 0xbe, 0x0e, 0x95, 0xd0, 0x0b, 0xed, 0x91,
-	0x75, 0xc4, 0x50, 0xe9, 0xa0, 0x0a, 0x66, 0x99, 0x02, 0x79, 0x75, 0xa3, 0x02, 0xcb, 0x5d, 0xc7,
-	0x3d, 0x11, 0xc2, 0xab, 0x0a, 0x65, 0xf6, 0xc9, 0x04, 0x17, 0x11, 0x6f, 0x1d, 0x1c, 0x9e, 0x7b,
-	0xfe, 0x4b, 0x81, 0xf1, 0x11, 0xac, 0x44, 0x90, 0x58, 0xba, 0x91, 0xfe, 0x9d, 0x61, 0xcb, 0x65,
-	0x25, 0xbc, 0x27, 0x15, 0x06, 0xe5, 0xe8, 0xc6, 0x6f, 0xc0, 0x1a, 0xef, 0x7b, 0x67, 0x3a, 0x3e,
-	0xc2, 0x3e, 0xa7, 0x88, 0xee, 0x42, 0x99, 0x77, 0xd9, 0x72, 0xed, 0x31, 0xe6, 0xa2, 0x71, 0x99,
-	0xc3, 0x3a, 0xf6, 0x18, 0x1b, 0x9f, 0xc0, 0x7a, 0xa2, 0xaa, 0xdc, 0x34, 0xaf, 0x4b, 0x4b, 0xe2,
-	0xa6, 0x25, 0x74, 0xa3, 0x06, 0x2b, 0xbc, 0x7e, 0x20, 0xc6, 0xf1, 0x07, 0x05, 0xd0, 0x63, 0x18,
-	0x27, 0xf7, 0x29, 0x14, 0x79, 0xc5, 0xa0, 0xae, 0xa5, 0x84, 0x55, 0x12, 0x5d, 0x00, 0xcc, 0xa8,
-	0x12, 0x7a, 0x17, 0xd0, 0x60, 0xea, 0x93, 0xd5, 0xb6, 0x8e, 0x08, 0xb7, 0x59, 0x94, 0xc7, 0x98,
-	0x50, 0xd4, 0x79, 0x09, 0x65, 0xc3, 0x27, 0x84, 0xdf, 0x1e, 0xc0, 0x5a, 0x02, 0x9b, 0x71, 0x5f,
-	0x81, 0x72, 0x1f, 0x52, 0xf0, 0x69, 0x49, 0xe3, 0x67, 0x73, 0xb0, 0x24, 0xc4, 0xc0, 0xd5, 0xc6,
-	0x9e, 0x9a, 0xde, 0xb9, 0xd4, 0xf4, 0xa6, 0x39, 0xa5, 0x90, 0xe6, 0x14, 0x32, 0x34, 0xfc, 0x8a,
-	0x49, 0x00, 0xeb, 0x25, 0xbe, 0xb0, 0x06, 0x91, 0x04, 0xa8, 0x98, 0xba, 0x28, 0x79, 0x8a, 0x2f,
-	0x76, 0x69, 0xe7, 0xde, 0x05, 0x24, 0xe4, 0x85, 0x84, 0xbd, 0xc0, 0xb0, 0x45, 0x89, 0x82, 0x3d,
-	0x9e, 0x78, 0x7e, 0x88, 0x87, 0x12, 0xf6, 0x22, 0xc7, 0xe6, 0x25, 0x02, 0xdb, 0x78, 0x01, 0x6b,
-	0x26, 0x26, 0x63, 0x11, 0xf3, 0xcf, 0x19, 0xe9, 0x8a, 0x13, 0x72, 0x03, 0x8a, 0x2e, 0x3e, 0x97,
-	0x27, 0x63, 0xc9, 0xc5, 0xe7, 0x94, 0xcf, 0x36, 0x61, 0x3d, 0x41, 0x99, 0xef, 0x83, 0xdf, 0x84,
-	0x8a, 0x89, 0x83, 0x81, 0xed, 0x4a, 0x4c, 0x7b, 0x84, 0x4f, 0x1c, 0x57, 0x2c, 0x99, 0x46, 0x97,
-	0x6c, 0x99, 0xc2, 0xd8, 0x5a, 0xa1, 0x5b, 0x00, 0x1c, 0x25, 0xe6, 0x81, 0x12, 0x43, 0xb0, 0x83,
-	0x53, 0xe3, 0x07, 0x50, 0x15, 0x24, 0x39, 0xf7, 0xbd, 0x03, 0x35, 0x9f, 0x42, 0x5c, 0x3c, 0xb4,
-	0xc2, 0x53, 0xdf, 0x9b, 0x9e, 0x9c, 0x72, 0xc2, 0x7a, 0x54, 0xd0, 0x67, 0x70, 0xe3, 0x73, 0x40,
-	0x1d, 0xfc, 0x2a, 0x4c, 0x4c, 0x01, 0x39, 0xff, 0xed, 0x20, 0x98, 0x9c, 0xfa, 0xe4, 0xfc, 0x67,
-	0xb2, 0x4d, 0x82, 0x5c, 0x81, 0x19, 0x8c, 0x8f, 0x61, 0x55, 0x21, 0x7c, 0xbd, 0x9d, 0xf6, 0x1f,
-	0xe7, 0x78, 0xbf, 0x98, 0xe4, 0x17, 0xfd, 0xca, 0x97, 0x52, 0xdf, 0x81, 0xf9, 0x97, 0x8e, 0x3b,
-	0xa4, 0x3d, 0xa9, 0xee, 0x18, 0xd2, 0x76, 0x4b, 0x93, 0xd9, 0x7e, 0xea, 0xb8, 0x43, 0x93, 0xe2,
-	0xa3, 0x47, 0x00, 0x27, 0xf6, 0xc4, 0x9a, 0x78, 0x23, 0x67, 0x70, 0x41, 0x19, 0xb6, 0xba, 0xf3,
-	0xd6, 0xec, 0xda, 0x8f, 0xed, 0x49, 0x97, 0xa2, 0x9b, 0xa5, 0x13, 0xf1, 0xd3, 0xd8, 0x81, 0x79,
-	0x42, 0x15, 0xad, 0x81, 0xfe, 0xb0, 0xdd, 0x7d, 0xf0, 0xe0, 0xc3, 0x0f, 0xad, 0xd6, 0x8b, 0x7e,
-	0xcb, 0xec, 0x34, 0xf7, 0xf5, 0x6f, 0xc8, 0xd0, 0x76, 0x87, 0x43, 0x35, 0xc3, 0x81, 0x52, 0x44,
-	0x0b, 0x35, 0x60, 0xe3, 0x71, 0xb3, 0x6b, 0x75, 0x0f, 0xf7, 0xdb, 0xbb, 0x5f, 0x58, 0xcf, 0x3a,
-	0xbd, 0x6e, 0x6b, 0xb7, 0xfd, 0xa8, 0xdd, 0xda, 0x63, 0xd5, 0xa5, 0xb2, 0x96, 0x69, 0x1e, 0x9a,
-	0xba, 0x86, 0xd6, 0xa1, 0x26, 0x41, 0xdb, 0x8f, 0x3b, 0x87, 0x26, 0x39, 0xb2, 0x56, 0x61, 0x45,
-	0x02, 0x7f, 0x6e, 0x36, 0xbb, 0x7a, 0xc1, 0xe8, 0xf0, 0xd5, 0x10, 0x23, 0xe1, 0xab, 0x21, 0x1d,
-	0xb5, 0x9a, 0x7a, 0xd4, 0xde, 0x02, 0x98, 0x4c, 0x8f, 0x46, 0xce, 0x80, 0x6c, 0x24, 0xbe, 0xbe,
-	0x25, 0x06, 0x79, 0x8a, 0x2f, 0x8c, 0x7f, 0xaa, 0xc1, 0x66, 0x9b, 0x6e, 0xa8, 0xae, 0xef, 0x9c,
-	0xd9, 0x21, 0x7e, 0x8a, 0x2f, 0xae, 0xca, 0x3c, 0xf9, 0xda, 0xc2, 0x9b, 0x44, 0x23, 0xa1, 0xe4,
-	0xe8, 0xf6, 0x3d, 0x77, 0x8e, 0xe9, 0x8a, 0x94, 0xcc, 0xca, 0x24, 0x6a, 0xe5, 0x73, 0xe7, 0x98,
-	0x1c, 0xb0, 0x8c, 0x91, 0xa9, 0xdc, 0x28, 0x9a, 0xfc, 0x0b, 0x6d, 0x41, 0x89, 0xfc, 0x6f, 0x1d,
-	0xfb, 0xde, 0x98, 0x0a, 0x89, 0x05, 0xb3, 0x48, 0x00, 0x8f, 0x7c, 0x6f, 0x6c, 0x34, 0xa0, 0x9e,
-	0xee, 0x31, 0xdf, 0x97, 0xff, 0x4c, 0x83, 0x55, 0x56, 0xc8, 0x94, 0x88, 0xab, 0x0e, 0x65, 0x03,
-	0x16, 0xb9, 0x26, 0xc2, 0xf6, 0x25, 0xff, 0x92, 0x3a, 0x58, 0xc8, 0xef, 0xe0, 0xbc, 0xda, 0x41,
-	0xf4, 0x1e, 0x20, 0x1f, 0xff, 0x78, 0xea, 0xf8, 0xd8, 0xf2, 0xf1, 0x10, 0xe3, 0xb1, 0x7d, 0x34,
-	0xc2, 0x5c, 0x07, 0xa8, 0xf1, 0x12, 0x33, 0x2a, 0x30, 0xbe, 0x80, 0x35, 0xb5, 0xcb, 0x7c, 0x4d,
-	0xef, 0x42, 0x79, 0xb2, 0x13, 0x9c, 0x5a, 0xea, 0xc2, 0x2e, 0x13, 0x18, 0x5f, 0x7e, 0x32, 0x2c,
-	0xa9, 0x85, 0x39, 0xda, 0x82, 0x04, 0x31, 0x5c, 0xa8, 0x72, 0x71, 0x7d, 0x4d, 0x99, 0xf8, 0x6d,
-	0xd8, 0xe0, 0x1d, 0x1d, 0x5a, 0x03, 0xcf, 0x3d, 0x76, 0xfc, 0xb1, 0xcd, 0x94, 0x14, 0xa6, 0x09,
-	0xad, 0x8b, 0xd2, 0x5d, 0xb9, 0xd0, 0xf8, 0xbb, 0x73, 0xb0, 0x12, 0x35, 0xc8, 0x87, 0xb1, 0x06,
-	0x0b, 0xf4, 0xdc, 0xa0, 0x0d, 0x15, 0x4c, 0xf6, 0x41, 0x54, 0xa8, 0x60, 0x82, 0xdd, 0x61, 0xd4,
-	0xf1, 0x82, 0x19, 0x03, 0x88, 0x0a, 0xe5, 0x8c, 0xc7, 0x76, 0x38, 0xa5, 0x53, 0x78, 0x6e, 0xfb,
-	0x43, 0xa1, 0xd1, 0x0a, 0xb0, 0x49, 0xa1, 0xe8, 0x7b, 0x70, 0x23, 0x42, 0x0c, 0x42, 0xfb, 0x25,
-	0xb6, 0x4e, 0xb0, 0x8b, 0x7d, 0xda, 0x1d, 0xae, 0x8d, 0x6e, 0x0a, 0x84, 0x1e, 0x29, 0x7f, 0x1c,
-	0x15, 0xa3, 0xb7, 0xa1, 0x46, 0x4e, 0x52, 0x3c, 0xb4, 0x8e, 0x2e, 0xac, 0xd0, 0x19, 0xbc, 0xc4,
-	0x61, 0xc0, 0x2f, 0x06, 0x2b, 0xac, 0xe0, 0xe1, 0x45, 0x9f, 0x81, 0x89, 0x36, 0x7e, 0xe6, 0x85,
-	0x8e, 0x7b, 0x62, 0xd9, 0xd3, 0xf0, 0xd4, 0xf3, 0x9d, 0xf0, 0x82, 0xdf, 0x15, 0x56, 0x18, 0xbc,
-	0x29, 0xc0, 0xe4, 0x02, 0x34, 0x75, 0xf9, 0x9c, 0xe1, 0x21, 0xbd, 0x2c, 0x14, 0x4c, 0x19, 0x64,
-	0x3c, 0x84, 0xf5, 0xc7, 0x38, 0x94, 0x74, 0x3b, 0xb1, 0x38, 0xdf, 0x54, 0x2f, 0x1b, 0x92, 0x3e,
-	0x2a, 0xdf, 0x1e, 0xe8, 0x69, 0xf1, 0x7b, 0x1a, 0x6c, 0x24, 0x89, 0x44, 0x4a, 0x8b, 0x72, 0x03,
-	0x23, 0x04, 0x2e, 0xd5, 0x2a, 0x95, 0x0b, 0xda, 0x1b, 0x50, 0xc9, 0x5a, 0x73, 0x15, 0x48, 0x8f,
-	0xb3, 0x58, 0xa5, 0x29, 0xf0, 0xe3, 0x4c, 0xe8, 0x32, 0xc6, 0x7f, 0x9e, 0x4b, 0x76, 0x30, 0x12,
-	0xfe, 0xdb, 0xb0, 0x1a, 0x84, 0xb6, 0x4f, 0xa7, 0x53, 0x22, 0xc1, 0x46, 0x5a, 0x13, 0x45, 0xb1,
-	0x5a, 0xb4, 0x03, 0xeb, 0x49, 0xfc, 0x58, 0x2b, 0xaf, 0x99, 0xab, 0x6a, 0x0d, 0x76, 0xd8, 0xbe,
-	0x0d, 0x35, 0xec, 0x0e, 0x13, 0x2d, 0xb0, 0x4e, 0xae, 0xb0, 0x82, 0x98, 0xfe, 0x36, 0xac, 0xaa,
-	0xb8, 0x8c, 0x3a, 0xdb, 0xd6, 0x35, 0x19, 0x9b, 0xd1, 0xfe, 0x04, 0xb6, 0xc6, 0x8e, 0xeb, 0x8c,
-	0xa7, 0x63, 0xcb, 0xc7, 0x03, 0xa2, 0xad, 0x29, 0x6a, 0x3c, 0x93, 0x57, 0x37, 0x38, 0x8a, 0x49,
-	0x31, 0xe4, 0x69, 0x40, 0x1f, 0x41, 0x3d, 0xb4, 0xfd, 0x13, 0xac, 0xd4, 0x93, 0x74, 0x9c, 0x05,
-	0x73, 0x83, 0x95, 0x4b, 0xb5, 0x98, 0xa6, 0xf3, 0xcf, 0x35, 0xd8, 0x4c, 0x4d, 0x2a, 0x5f, 0xf6,
-	0x47, 0x80, 0xc6, 0x0e, 0xd5, 0x14, 0xe4, 0xce, 0xb0, 0xd5, 0xdf, 0x94, 0x56, 0x5f, 0xbe, 0xf5,
-	0x98, 0x35, 0x5a, 0x45, 0xe9, 0x5d, 0x17, 0xd6, 0xa6, 0x6e, 0x06, 0xa5, 0xb9, 0xab, 0xdc, 0x4e,
-	0x56, 0x79, 0x55, 0x99, 0xa2, 0xf1, 0x01, 0xe8, 0xa4, 0xd3, 0x74, 0x2b, 0x09, 0x1e, 0xb8, 0x0d,
-	0xcb, 0x6c, 0xcb, 0xc9, 0x6b, 0x0f, 0x0c, 0x44, 0xf9, 0xe7, 0x2f, 0xcc, 0x41, 0x2d, 0xaa, 0xf5,
-	0x6b, 0xc3, 0x3a, 0xdb, 0xb0, 0x2a, 0x96, 0x9e, 0x8d, 0x3e, 0xd6, 0x83, 0x17, 0xcc, 0x1a, 0x5f,
-	0x75, 0x5a, 0xc2, 0x16, 0xfc, 0x3f, 0xcc, 0x03, 0x92, 0x67, 0x81, 0xaf, 0xf5, 0x2e, 0x2c, 0xb2,
-	0xfa, 0x7c, 0x7d, 0xdf, 0x91, 0x56, 0x25, 0x8d, 0xbe, 0xcd, 0xbe, 0xc5, 0x1a, 0xf1, 0xaa, 0xe8,
-	0x33, 0x58, 0xa0, 0x9d, 0xa6, 0x73, 0xb1, 0xbc, 0xf3, 0xf6, 0x6c, 0x1a, 0x0a, 0xdb, 0xb0, 0x8a,
-	0x8d, 0x3f, 0x9e, 0x83, 0x8a, 0x42, 0x1b, 0x7d, 0x3b, 0xd1, 0xb1, 0x4b, 0xd8, 0x45, 0x74, 0xe5,
-	0xbb, 0xb0, 0x44, 0x85, 0x3f, 0xf6, 0x79, 0x67, 0x2e, 0xa9, 0x27, 0xb0, 0xd1, 0x9f, 0x86, 0x0a,
-	0x9f, 0xc8, 0x20, 0xb4, 0xc3, 0x69, 0xc0, 0x15, 0xbf, 0x8f, 0xae, 0x31, 0x1f, 0xfc, 0xab, 0x47,
-	0xeb, 0x9b, 0xe5, 0x50, 0xfa, 0x32, 0x7e, 0x0c, 0x65, 0xb9, 0x14, 0x2d, 0xc3, 0xd2, 0xb3, 0xce,
-	0xd3, 0xce, 0xe1, 0xe7, 0x1d, 0xfd, 0x1b, 0xec, 0xe3, 0xa0, 0xdd, 0x69, 0xed, 0xe9, 0x1a, 0x2a,
-	0x43, 0xb1, 0x7d, 0x70, 0xd0, 0xec, 0x3f, 0xa3, 0xaa, 0x5b, 0x11, 0xe6, 0xf7, 0xdb, 0xcf, 0x5b,
-	0x7a, 0x01, 0x95, 0x60, 0xe1, 0xf9, 0x61, 0xbf, 0xb5, 0xa7, 0xcf, 0x23, 0x80, 0xc5, 0x83, 0x76,
-	0xaf, 0xd7, 0xda, 0xd3, 0x17, 0x48, 0xdd, 0xd6, 0x8b, 0x6e, 0xdb, 0x6c, 0xed, 0xe9, 0x8b, 0xcc,
-	0xaa, 0xf1, 0xfc, 0xf0, 0x69, 0x6b, 0x4f, 0x5f, 0x6a, 0xbc, 0xf8, 0x65, 0xd9, 0x25, 0x8c, 0x35,
-	0x40, 0x6c, 0x30, 0x5d, 0xdf, 0x89, 0x14, 0x02, 0xa3, 0x0b, 0xab, 0x0a, 0x34, 0x56, 0x3e, 0xf8,
-	0xc4, 0x4e, 0x08, 0x9c, 0x1f, 0xde, 0x7c, 0xcf, 0x52, 0xd4, 0xbc, 0x5e, 0x18, 0x08, 0x74, 0x7a,
-	0xd4, 0xb6, 0xdd, 0x63, 0x4f, 0xb4, 0xf2, 0xc7, 0x73, 0x50, 0x93, 0x80, 0xbc, 0x91, 0x2d, 0x28,
-	0x4d, 0x3c, 0x6f, 0x64, 0x05, 0xce, 0x4f, 0x30, 0xd7, 0x43, 0x8a, 0x04, 0xd0, 0x73, 0x7e, 0x82,
-	0x89, 0x0e, 0x69, 0x8f, 0x46, 0xd6, 0x18, 0x8f, 0x29, 0x4e, 0xe8, 0xbc, 0xe2, 0x5a, 0x66, 0xc5,
-	0x1e, 0x8d, 0x0e, 0x18, 0xb4, 0xef, 0xbc, 0x22, 0x78, 0xde, 0xb9, 0xab, 0xe0, 0x31, 0xc3, 0x68,
-	0xc5, 0x3b, 0x77, 0x25, 0xbc, 0x06, 0x14, 0x85, 0x26, 0xc0, 0x6f, 0xa9, 0xd1, 0x37, 0x99, 0xe4,
-	0x91, 0x73, 0x86, 0xf9, 0x7d, 0x94, 0xfe, 0x26, 0x7a, 0xcb, 0x99, 0x17, 0xe2, 0x21, 0xbf, 0x76,
-	0xb2, 0x0f, 0x32, 0xe8, 0xb1, 0x13, 0x04, 0xfc, 0x60, 0xaf, 0x98, 0xfc, 0x8b, 0xe8, 0xc2, 0x3e,
-	0x3e, 0xf3, 0x5e, 0xe2, 0x61, 0xbd, 0xc8, 0x74, 0x61, 0xfe, 0x49, 0x4a, 0xf0, 0xab, 0x09, 0xd1,
-	0x95, 0xea, 0x25, 0x56, 0xc2, 0x3f, 0xe3, 0x6b, 0x76, 0x30, 0x3d, 0x0a, 0x9c, 0xe1, 0x45, 0x1d,
-	0xa4, 0x6b, 0x76, 0x8f, 0xc1, 0x48, 0xf5, 0xa9, 0x4b, 0xd8, 0x3d, 0xac, 0x2f, 0xb3, 0xea, 0xfc,
-	0xd3, 0xe8, 0x83, 0x4e, 0x39, 0x45, 0x9a, 0xe7, 0xc4, 0xa1, 0xac, 0x25, 0x0e, 0x65, 0x7a, 0x4b,
-	0x4d, 0x4a, 0x41, 0x72, 0x4b, 0x8d, 0x25, 0x94, 0xf1, 0xd7, 0xe6, 0xa0, 0x26, 0x91, 0xe5, 0x2b,
-	0xf5, 0x0b, 0xd3, 0x4d, 0x2b, 0x15, 0x85, 0x2c, 0xa5, 0x42, 0xe1, 0xe0, 0xf9, 0xa4, 0x65, 0x4d,
-	0x6a, 0xc6, 0x26, 0xb2, 0x62, 0x81, 0x19, 0x97, 0x79, 0x33, 0x04, 0x44, 0xee, 0xcc, 0x4c, 0x0f,
-	0x74, 0xdc, 0x33, 0x7b, 0xe4, 0x0c, 0x6d, 0xb1, 0x82, 0x45, 0x53, 0x0f, 0x18, 0x03, 0x46, 0xf0,
-	0x2c, 0x4b, 0xdd, 0x52, 0x96, 0xa5, 0xce, 0xf8, 0x43, 0x0d, 0x36, 0x77, 0x4f, 0x6d, 0xf7, 0x04,
-	0x77, 0xa3, 0x3b, 0x83, 0x98, 0xf2, 0x8f, 0xa0, 0x40, 0x6e, 0x56, 0x1a, 0x15, 0x3c, 0x6f, 0x4a,
-	0x82, 0x27, 0xa7, 0xc2, 0x36, 0xb9, 0xaf, 0x90, 0x2a, 0x44, 0x17, 0xf7, 0x46, 0x43, 0x4b, 0xba,
-	0x98, 0xb0, 0xcb, 0x47, 0xc5, 0x1b, 0x0d, 0xe3, 0x6a, 0x04, 0xcd, 0xc5, 0xe7, 0x32, 0x1a, 0x3b,
-	0x8c, 0x2a, 0x2e, 0x3e, 0x8f, 0xd1, 0x8c, 0xd7, 0xa0, 0xf0, 0x14, 0x5f, 0x10, 0x61, 0xd2, 0x35,
-	0xdb, 0xcf, 0x9b, 0xfd, 0x96, 0xfe, 0x0d, 0x22, 0x72, 0xba, 0xcf, 0x1e, 0xee, 0xb7, 0x77, 0x75,
-	0x8d, 0x5c, 0x9b, 0xd2, 0x3d, 0xe2, 0xd7, 0xa6, 0x9f, 0xce, 0xc1, 0xc6, 0xa3, 0xa9, 0x3b, 0xcc,
-	0xd0, 0x49, 0x67, 0xdb, 0x13, 0xd9, 0x59, 0xc6, 0xad, 0xbf, 0xc2, 0x9e, 0x48, 0x81, 0xcc, 0xe4,
-	0x3c, 0xe3, 0x22, 0x51, 0x98, 0x71, 0x91, 0x40, 0x1f, 0x43, 0xc3, 0x71, 0x07, 0xa3, 0xe9, 0x10,
-	0x5b, 0x91, 0x7e, 0x3f, 0xf0, 0x1c, 0xf7, 0xc8, 0x0e, 0x70, 0xc0, 0x2f, 0x8b, 0x75, 0x8e, 0xd1,
-	0xe6, 0x08, 0xbb, 0xa2, 0x9c, 0x9c, 0xfa, 0xa2, 0xf6, 0x80, 0x0e, 0x59, 0x98, 0x99, 0xd9, 0x1d,
-	0x6c, 0x95, 0x17, 0xb2, 0xe9, 0xe0, 0xd6, 0xe6, 0x7f, 0x59, 0x80, 0xcd, 0xd4, 0x14, 0x70, 0xee,
-	0xff, 0x53, 0xa0, 0x07, 0x78, 0x84, 0x07, 0x21, 0x1e, 0x5a, 0xcc, 0x44, 0x2d, 0xcc, 0x81, 0xdf,
-	0x92, 0xd6, 0x3b, 0xa7, 0xf6, 0x76, 0x97, 0x1b, 0xe1, 0xf9, 0x53, 0xc4, 0x8a, 0x20, 0xc5, 0xbe,
-	0x03, 0x2a, 0x6a, 0xa9, 0x18, 0x50, 0xa6, 0x71, 0x99, 0xc2, 0xf8, 0x2c, 0xde, 0x07, 0x9d, 0x0f,
-	0x64, 0xf2, 0x52, 0x8c, 0x85, 0x31, 0x41, 0x95, 0xc1, 0xbb, 0x2f, 0xd9, 0x30, 0x1a, 0x7f, 0xa2,
-	0x41, 0x55, 0x6d, 0xf0, 0x1a, 0xb7, 0x0a, 0xd2, 0x15, 0x6e, 0x97, 0x67, 0x8f, 0x03, 0x4c, 0xe0,
-	0x2e, 0x33, 0x58, 0x9b, 0x3e, 0x11, 0xc4, 0xc6, 0xfe, 0x82, 0x62, 0xec, 0x27, 0xb2, 0x3c, 0xea,
-	0xdb, 0x3c, 0x25, 0x5f, 0x9c, 0xf0, 0x5e, 0x11, 0xba, 0x44, 0x53, 0x76, 0xce, 0xb0, 0x45, 0x76,
-	0x33, 0xbf, 0x65, 0x2d, 0x73, 0x58, 0xdf, 0x61, 0x36, 0x47, 0x72, 0x99, 0x8e, 0x56, 0x99, 0x6f,
-	0xda, 0x32, 0x01, 0x8a, 0x95, 0x25, 0x72, 0x3a, 0xf4, 0x31, 0x7b, 0x81, 0x59, 0x30, 0xe9, 0x6f,
-	0xe3, 0x8f, 0x34, 0x58, 0x7f, 0xc6, 0x44, 0x22, 0x9f, 0xd1, 0x5f, 0x61, 0xd6, 0x35, 0xfe, 0xfa,
-	0x5c, 0x62, 0x34, 0x11, 0x13, 0xfe, 0x7a, 0x2f, 0x23, 0x39, 0x61, 0x58, 0x17, 0xac, 0x60, 0x3a,
-	0xa6, 0x67, 0x68, 0xc1, 0x2c, 0x31, 0x48, 0x6f, 0x3a, 0x36, 0x7e, 0xba, 0x08, 0x5b, 0xbb, 0x9e,
-	0x1b, 0x84, 0xfe, 0x74, 0x90, 0x75, 0x75, 0xbe, 0x07, 0xd5, 0xc0, 0x9b, 0xfa, 0x03, 0x6c, 0xa9,
-	0x4b, 0x5e, 0x61, 0x50, 0x61, 0x23, 0xff, 0x6a, 0x76, 0x0d, 0x74, 0x13, 0xe0, 0x18, 0x63, 0x6b,
-	0x82, 0x7d, 0xeb, 0xe5, 0x11, 0x5f, 0xfe, 0xe2, 0x31, 0xc6, 0x5d, 0xec, 0x3f, 0x3d, 0x42, 0x7f,
-	0x0e, 0x1a, 0xe2, 0x35, 0x8b, 0x6e, 0x6d, 0xb2, 0x3c, 0xf6, 0xe8, 0xc4, 0xf3, 0x9d, 0xf0, 0x94,
-	0x59, 0x87, 0xaa, 0x3b, 0x9f, 0xca, 0x07, 0x43, 0xfe, 0x38, 0xf8, 0x7b, 0x65, 0x4f, 0xd0, 0x69,
-	0x0a, 0x32, 0x66, 0xdd, 0xcb, 0x29, 0x41, 0x5f, 0x02, 0x72, 0xc9, 0xfd, 0x91, 0x09, 0x08, 0x21,
-	0x9f, 0x16, 0xa8, 0x7c, 0x7a, 0xef, 0x5a, 0xcd, 0x9a, 0xba, 0xeb, 0xb9, 0x4c, 0x2a, 0x0a, 0xe1,
-	0x74, 0x02, 0x88, 0x13, 0x1e, 0xe2, 0x20, 0x74, 0x5c, 0x66, 0x59, 0x59, 0xa4, 0x4a, 0xfa, 0x47,
-	0xd7, 0x22, 0xbe, 0x17, 0xd7, 0x37, 0x6b, 0x8c, 0xa6, 0x04, 0x6a, 0x8c, 0xa0, 0x96, 0xc2, 0x9b,
-	0x61, 0xd6, 0xcc, 0x33, 0xd8, 0x11, 0x3e, 0xa0, 0xbf, 0x2c, 0xfe, 0x94, 0x2e, 0x94, 0x41, 0x06,
-	0xe5, 0x0f, 0xf1, 0x8d, 0x3f, 0x1b, 0x3d, 0x84, 0xfe, 0x08, 0x96, 0xe5, 0x91, 0x69, 0xbf, 0xe0,
-	0xc8, 0x64, 0x62, 0xd2, 0x26, 0x9b, 0x93, 0x37, 0x99, 0xf1, 0x21, 0xd4, 0xf3, 0xd6, 0x19, 0xad,
-	0xc0, 0xb2, 0x6a, 0x33, 0x5e, 0x82, 0x42, 0x73, 0x7f, 0x5f, 0xd7, 0x8c, 0xbf, 0x31, 0x07, 0x37,
-	0xb3, 0x3b, 0xc3, 0x25, 0xc4, 0xb7, 0xc8, 0xcd, 0x3d, 0x70, 0x4e, 0x12, 0x57, 0x77, 0x2e, 0x25,
-	0x56, 0x45, 0x99, 0x54, 0x15, 0x7d, 0x0a, 0x37, 0xd9, 0xd9, 0x13, 0x3d, 0x20, 0x73, 0x4e, 0x56,
-	0xfa, 0x7d, 0x83, 0xe2, 0xa8, 0xc7, 0x0a, 0x17, 0x92, 0xe4, 0x42, 0x4b, 0x09, 0xa8, 0xf5, 0x98,
-	0x50, 0xa9, 0xd1, 0x22, 0x05, 0x7f, 0x07, 0xd6, 0xc9, 0x04, 0x8d, 0x89, 0xfe, 0x65, 0xf1, 0xbe,
-	0x52, 0xf5, 0x9f, 0xa9, 0xe4, 0xab, 0x51, 0x61, 0x8f, 0x96, 0xd1, 0x9b, 0xc0, 0x5d, 0x28, 0x73,
-	0x1e, 0x64, 0xe2, 0x8c, 0xdd, 0x96, 0x97, 0x19, 0x8c, 0x8a, 0x33, 0xe3, 0x7f, 0xcf, 0xc1, 0x06,
-	0xa9, 0x91, 0x21, 0x19, 0x2e, 0x33, 0xfd, 0x7e, 0x1b, 0x36, 0x02, 0xec, 0x3b, 0xf6, 0xc8, 0xf9,
-	0x49, 0x62, 0xde, 0x18, 0x67, 0xad, 0xc7, 0xa5, 0xf2, 0xcc, 0xd9, 0x80, 0xec, 0xe1, 0xd0, 0x21,
-	0xbf, 0x89, 0x06, 0x4f, 0xb9, 0x4b, 0x3c, 0xe1, 0xee, 0x48, 0xec, 0x93, 0xdd, 0xab, 0xed, 0x66,
-	0x54, 0x97, 0x5b, 0x7d, 0x6b, 0x76, 0x02, 0x12, 0x34, 0xfe, 0xaa, 0x06, 0x7a, 0x12, 0xef, 0x6b,
-	0x3e, 0x06, 0x84, 0x24, 0x2e, 0x48, 0x92, 0x78, 0xd6, 0x11, 0xf0, 0xc3, 0xf9, 0x62, 0x41, 0x9f,
-	0x37, 0x2b, 0x8e, 0x1b, 0x91, 0xc5, 0xe4, 0x9a, 0xbc, 0x99, 0x1a, 0x26, 0xe7, 0xc9, 0x3b, 0x69,
-	0x63, 0x64, 0xc2, 0x1d, 0xe4, 0x43, 0xd8, 0x88, 0xb8, 0x56, 0x21, 0x4b, 0x2d, 0x4e, 0x15, 0x33,
-	0xe2, 0x69, 0xea, 0x2d, 0xd1, 0xe6, 0x4d, 0xfe, 0xd7, 0x42, 0xaa, 0xcd, 0xe0, 0xaa, 0x2b, 0xfe,
-	0xa3, 0xc4, 0xbb, 0x3b, 0xb3, 0x6c, 0x7d, 0x27, 0x7f, 0xd1, 0xa2, 0x17, 0xa3, 0x67, 0xe9, 0x2d,
-	0xa4, 0x3e, 0xc8, 0xa3, 0xa3, 0x4c, 0xb6, 0x60, 0x8e, 0x2e, 0x1f, 0x5c, 0xa1, 0x85, 0x5f, 0x51,
-	0xbe, 0x68, 0xec, 0xc3, 0x6a, 0xc6, 0xe4, 0xcc, 0xd8, 0x5c, 0xda, 0x8c, 0xcd, 0x65, 0xfc, 0x37,
-	0x0d, 0xea, 0xe9, 0x19, 0xe2, 0x2c, 0xf5, 0x45, 0x62, 0xf9, 0x98, 0x26, 0xfe, 0xed, 0x99, 0x93,
-	0xcb, 0x55, 0xf1, 0xde, 0xec, 0xd5, 0x6b, 0xbc, 0x84, 0x5a, 0x0a, 0xe5, 0x97, 0xc6, 0xc2, 0xff,
-	0xb8, 0x00, 0x1b, 0xbb, 0x3e, 0xb6, 0x43, 0x4c, 0xda, 0xe4, 0xaf, 0x1a, 0x57, 0x7f, 0x79, 0xe3,
-	0xe7, 0xe2, 0x9c, 0x7a, 0x2e, 0xe6, 0x4f, 0x78, 0x61, 0x96, 0x34, 0xbb, 0x0d, 0xcb, 0x52, 0xc7,
-	0xb9, 0x30, 0x06, 0x27, 0xea, 0x2e, 0xfa, 0x21, 0x94, 0x08, 0x4b, 0x31, 0x07, 0xa8, 0x85, 0x94,
-	0x03, 0x54, 0xf6, 0x38, 0xc8, 0x7c, 0x13, 0x8e, 0xa3, 0x0e, 0x50, 0xc5, 0x53, 0xfe, 0x0b, 0xbd,
-	0x0b, 0x28, 0x3a, 0x6e, 0x62, 0x8e, 0x62, 0x2e, 0x40, 0x91, 0xd3, 0x93, 0xb8, 0xd1, 0x18, 0x7f,
-	0x49, 0x83, 0x65, 0x89, 0x0e, 0x39, 0x20, 0x7b, 0xed, 0xc7, 0x4f, 0x9a, 0xbd, 0x27, 0xd6, 0xe1,
-	0x3e, 0x39, 0x20, 0x25, 0x00, 0x3d, 0x28, 0x91, 0x0e, 0x65, 0x01, 0xe8, 0x1c, 0x76, 0x5a, 0xfa,
-	0x1c, 0x42, 0x50, 0x15, 0x90, 0x5e, 0xbb, 0xf3, 0x78, 0xbf, 0xa5, 0x17, 0xd0, 0x1a, 0xe8, 0x52,
-	0xb5, 0xe7, 0xcd, 0xfd, 0x67, 0x2d, 0x7d, 0x1e, 0xdd, 0x80, 0xb5, 0x08, 0xda, 0xf9, 0xe2, 0xb0,
-	0xd3, 0xda, 0x6d, 0x76, 0xba, 0xcd, 0x2f, 0xf4, 0x9f, 0x6a, 0xc6, 0x73, 0xd8, 0x4c, 0x0d, 0x93,
-	0xb3, 0xe4, 0x4d, 0x28, 0x05, 0x02, 0x28, 0xac, 0x23, 0x11, 0x20, 0xe3, 0x09, 0xb6, 0x2c, 0x3f,
-	0xc1, 0xfe, 0x10, 0x6e, 0x74, 0xc9, 0x47, 0x70, 0x9a, 0x71, 0x7a, 0xbd, 0x07, 0x28, 0xf7, 0x44,
-	0xaf, 0xa5, 0xf6, 0x9b, 0xf1, 0x18, 0x1a, 0x59, 0xb4, 0xae, 0x7d, 0x85, 0x30, 0x5e, 0x87, 0xbb,
-	0x9c, 0xd0, 0xb3, 0xb4, 0x45, 0x5f, 0x58, 0xf5, 0xde, 0x00, 0x63, 0x16, 0x92, 0x30, 0x2e, 0x14,
-	0x60, 0xa3, 0x3b, 0xf5, 0x07, 0xa7, 0x76, 0x80, 0x13, 0xe6, 0xfc, 0xaf, 0xfe, 0xc2, 0x7c, 0x1b,
-	0x96, 0xa9, 0x0d, 0xd8, 0x1a, 0x39, 0x63, 0x47, 0xe8, 0x1b, 0x40, 0x41, 0xfb, 0x04, 0x32, 0x43,
-	0xd3, 0x67, 0xcc, 0x9d, 0xa3, 0xe9, 0xdf, 0x83, 0x2a, 0xb7, 0x7b, 0xaa, 0xae, 0x6b, 0xdc, 0xcc,
-	0x2c, 0x1e, 0x5e, 0x6f, 0xc3, 0xb2, 0x3b, 0x1d, 0x47, 0xaf, 0x86, 0xcc, 0x44, 0x08, 0xee, 0x74,
-	0x2c, 0x1e, 0x0c, 0xef, 0x42, 0x99, 0x9a, 0x23, 0x05, 0x95, 0x25, 0xfe, 0x78, 0xeb, 0x79, 0x23,
-	0x41, 0x43, 0x58, 0x3f, 0x8f, 0x31, 0x0e, 0xe8, 0x85, 0x47, 0x63, 0xd6, 0xcf, 0x47, 0x18, 0x53,
-	0xfd, 0x96, 0x9a, 0x09, 0x2f, 0xb8, 0xd1, 0x90, 0x7f, 0xa1, 0x75, 0x58, 0x0c, 0x5f, 0x91, 0x2a,
-	0xdc, 0x58, 0xb8, 0x10, 0xbe, 0x7a, 0xc4, 0x6e, 0x4f, 0xbc, 0xdb, 0xa4, 0x68, 0x59, 0x18, 0xce,
-	0x08, 0xe4, 0x11, 0xc6, 0xc6, 0x27, 0xb0, 0x99, 0x5a, 0x01, 0xce, 0x13, 0xaf, 0x47, 0x16, 0x74,
-	0xc2, 0x0e, 0x98, 0x89, 0xd3, 0xb2, 0xb0, 0x83, 0x3f, 0xa1, 0x30, 0xe3, 0x3b, 0xb0, 0x66, 0x52,
-	0x73, 0xe6, 0xf5, 0xd6, 0x8f, 0xf9, 0xcf, 0x28, 0xf5, 0x38, 0x4f, 0xbc, 0x06, 0x37, 0xf7, 0x3d,
-	0x7b, 0xd8, 0xa4, 0x0e, 0x61, 0x7b, 0x76, 0x68, 0x3f, 0x72, 0x46, 0x21, 0xf6, 0x23, 0xce, 0xba,
-	0x0d, 0xb7, 0x72, 0xca, 0x39, 0x81, 0x53, 0x40, 0x64, 0x1b, 0x1e, 0xe0, 0x20, 0xb0, 0x4f, 0xb0,
-	0x7c, 0xe3, 0xcf, 0xbe, 0x2f, 0xd4, 0x61, 0x69, 0xcc, 0x70, 0x85, 0xc4, 0xe4, 0x9f, 0x89, 0x31,
-	0x14, 0x52, 0x63, 0xf8, 0x00, 0x56, 0x95, 0x96, 0xae, 0xb2, 0xe5, 0x8d, 0x3f, 0xd0, 0x94, 0x5a,
-	0x57, 0x66, 0xf8, 0x87, 0x50, 0xe4, 0xfd, 0x12, 0x6a, 0xc9, 0x9b, 0x89, 0x73, 0x2d, 0x41, 0x71,
-	0x5b, 0xf4, 0x2b, 0xaa, 0xd7, 0xf8, 0x01, 0x2c, 0x71, 0xe0, 0x57, 0x99, 0x0f, 0xe3, 0x6f, 0x6b,
-	0xb0, 0xa6, 0x36, 0x14, 0x3d, 0x3a, 0x2d, 0xf9, 0x78, 0x32, 0x72, 0xb0, 0x38, 0x72, 0xbf, 0x99,
-	0xdb, 0x35, 0xe9, 0xb8, 0x35, 0xf1, 0x64, 0x74, 0x61, 0x8a, 0x9a, 0x8d, 0x4f, 0xa1, 0x14, 0x41,
-	0x2f, 0x11, 0x9b, 0x6b, 0xb0, 0x80, 0x7d, 0x9f, 0x7b, 0x3f, 0x97, 0x4c, 0xf6, 0x61, 0xdc, 0x85,
-	0xdb, 0x92, 0x94, 0xe9, 0x78, 0xa1, 0x73, 0xec, 0x0c, 0x6c, 0x45, 0x2c, 0xfd, 0xee, 0x1c, 0xdc,
-	0xc9, 0xc7, 0xe1, 0xa3, 0xf9, 0x0c, 0x56, 0xec, 0x30, 0xb4, 0x07, 0xa7, 0x78, 0xc8, 0xde, 0xee,
-	0xc4, 0xa8, 0x72, 0xdf, 0x4a, 0xab, 0x02, 0x9f, 0x42, 0x03, 0xf4, 0x16, 0xac, 0x0c, 0xb1, 0x4a,
-	0x61, 0x8e, 0xee, 0x9d, 0xaa, 0x00, 0x73, 0xc4, 0xbc, 0x17, 0xd5, 0xc2, 0x57, 0x7d, 0x51, 0x45,
-	0x1f, 0x43, 0x23, 0x83, 0xa2, 0xd8, 0xc1, 0xf3, 0xb4, 0x17, 0xf5, 0x74, 0x45, 0xbe, 0x9b, 0x6f,
-	0xc1, 0x96, 0xf0, 0x9e, 0xcc, 0x9a, 0xbe, 0xff, 0xa5, 0xc1, 0xcd, 0xec, 0xf2, 0x6b, 0xb9, 0x7e,
-	0x5d, 0xc5, 0xd1, 0x30, 0xdb, 0x87, 0xb0, 0x70, 0x2d, 0x1f, 0xc2, 0xf9, 0x6b, 0xf9, 0x10, 0x2e,
-	0xe4, 0xf8, 0x10, 0xfe, 0x16, 0xdc, 0x91, 0x0f, 0x82, 0xac, 0x89, 0x21, 0x02, 0x3b, 0x7c, 0xa5,
-	0x8a, 0xc9, 0x62, 0xf8, 0x8a, 0x4d, 0x2a, 0x91, 0xc0, 0x41, 0xe8, 0x4d, 0x2c, 0xfb, 0x38, 0xe4,
-	0xaf, 0x98, 0x0b, 0x66, 0x89, 0x40, 0x9a, 0x04, 0x60, 0xfc, 0xfe, 0x1c, 0xdc, 0x9d, 0xd1, 0x00,
-	0x9f, 0xd9, 0x97, 0xc9, 0x47, 0x12, 0xc6, 0x92, 0x2d, 0xd5, 0x1c, 0x31, 0x9b, 0xc8, 0xb6, 0xe2,
-	0x35, 0x20, 0x11, 0x4b, 0xbc, 0xb5, 0x34, 0xfe, 0x96, 0x06, 0xf5, 0x3c, 0x5c, 0xb4, 0x09, 0x4b,
-	0x7c, 0xac, 0x7c, 0x63, 0x2e, 0xb2, 0x91, 0x7e, 0x2d, 0xce, 0x21, 0xa9, 0xf7, 0xa2, 0xf9, 0xf4,
-	0x3b, 0xd4, 0x5f, 0xd4, 0x60, 0x95, 0xa9, 0x5b, 0x9f, 0xd3, 0xb1, 0x8b, 0x45, 0x78, 0x07, 0x6a,
-	0x5c, 0x99, 0x4a, 0x09, 0x52, 0x9d, 0x15, 0x48, 0x4f, 0x27, 0xef, 0x11, 0x4d, 0x93, 0xf9, 0xa1,
-	0xa5, 0x5e, 0x59, 0x6a, 0xbc, 0x44, 0x42, 0x47, 0x30, 0x1f, 0x60, 0x3c, 0xe4, 0xfd, 0xa5, 0xbf,
-	0x8d, 0x0d, 0x58, 0x53, 0xbb, 0xc1, 0x0f, 0xa0, 0x57, 0x70, 0x5b, 0xc0, 0xc3, 0xc1, 0xa9, 0xe3,
-	0x9e, 0x1c, 0xba, 0xa3, 0x0b, 0xb5, 0xab, 0xf7, 0x81, 0xf2, 0xb0, 0x3b, 0xc4, 0x43, 0x6b, 0x32,
-	0x3d, 0xb2, 0xc4, 0x33, 0x51, 0xc9, 0xac, 0x0a, 0x78, 0x77, 0x7a, 0xf4, 0x14, 0x5f, 0x64, 0x0f,
-	0x6a, 0x2e, 0x7b, 0x50, 0x86, 0x01, 0x77, 0xf2, 0x5b, 0xe6, 0xbd, 0xfb, 0x0c, 0x6a, 0x87, 0x13,
-	0xec, 0x7e, 0xf5, 0xa9, 0x33, 0x7e, 0x03, 0x90, 0x4c, 0x21, 0xd6, 0x16, 0xce, 0x79, 0xab, 0x96,
-	0xe7, 0x8e, 0xd8, 0x78, 0x8a, 0x66, 0xf9, 0x5c, 0xea, 0x8a, 0xb1, 0x06, 0x68, 0x77, 0xe4, 0x05,
-	0xea, 0xc2, 0x19, 0xeb, 0xb0, 0xaa, 0x40, 0x79, 0x4f, 0xd7, 0x61, 0x95, 0x41, 0x5a, 0xaf, 0x9c,
-	0x20, 0x76, 0xc7, 0xde, 0x86, 0x35, 0x15, 0xcc, 0x3b, 0x40, 0xf5, 0x22, 0x02, 0xe1, 0x2d, 0xf3,
-	0x2f, 0xe3, 0x77, 0xc9, 0x8d, 0x31, 0xb4, 0xfd, 0x70, 0x97, 0xa0, 0xb9, 0xc1, 0x34, 0x30, 0x27,
-	0x03, 0x31, 0xf0, 0xb7, 0x60, 0x85, 0x7b, 0xa2, 0x27, 0x9c, 0xe9, 0xaa, 0x1c, 0x2c, 0x54, 0xb2,
-	0x06, 0x14, 0xa7, 0x01, 0x11, 0x23, 0x91, 0xb8, 0x8a, 0xbe, 0x49, 0x19, 0x99, 0xb6, 0x73, 0xcf,
-	0x17, 0x0c, 0x12, 0x7d, 0x93, 0x2b, 0xe2, 0x00, 0xfb, 0x7c, 0x33, 0x62, 0x7e, 0x39, 0x96, 0x41,
-	0xc6, 0x16, 0xdc, 0xc8, 0xe8, 0x1e, 0x9f, 0x83, 0xbf, 0xaf, 0x41, 0x7d, 0xcf, 0x09, 0x06, 0xde,
-	0x19, 0xf6, 0x79, 0x57, 0x62, 0x95, 0xe1, 0x1d, 0xa8, 0x0d, 0x79, 0x99, 0x25, 0x39, 0xa3, 0xd3,
-	0x17, 0x4d, 0x51, 0x20, 0x3c, 0xd1, 0xaf, 0xcb, 0xf0, 0x39, 0xee, 0x34, 0x85, 0x1c, 0x77, 0x1a,
-	0x32, 0x8a, 0x8c, 0x7e, 0xf2, 0x51, 0xdc, 0x82, 0xad, 0x47, 0x38, 0x1c, 0x9c, 0x1e, 0x38, 0x41,
-	0xe0, 0xb8, 0x27, 0xbb, 0x09, 0x95, 0xee, 0x35, 0xb8, 0x99, 0x5d, 0xcc, 0xab, 0xbf, 0x09, 0x6f,
-	0xf4, 0xa6, 0x47, 0xe4, 0x32, 0x78, 0x84, 0xfb, 0x1e, 0x6d, 0x33, 0xf3, 0x78, 0x7a, 0x0b, 0xee,
-	0x5d, 0x82, 0x17, 0x73, 0x16, 0x6d, 0x90, 0xbd, 0x0c, 0x47, 0xf5, 0xff, 0xe1, 0x1c, 0xac, 0xa9,
-	0x70, 0xce, 0x5a, 0x3b, 0xb0, 0x7e, 0x4c, 0xe0, 0x78, 0xc8, 0xdf, 0x97, 0x03, 0x4b, 0x7e, 0x49,
-	0x58, 0xe5, 0x85, 0xbc, 0x1a, 0x3b, 0x64, 0xde, 0x87, 0xb5, 0x63, 0xc7, 0x0f, 0x42, 0xcb, 0xc5,
-	0xe7, 0x69, 0x0f, 0xff, 0x1a, 0x2d, 0xeb, 0xe0, 0xf3, 0xd8, 0x61, 0xe8, 0x03, 0xd8, 0x48, 0x55,
-	0x90, 0x9d, 0xfc, 0x57, 0xd5, 0x2a, 0xec, 0xed, 0xfc, 0x23, 0xb8, 0x31, 0xb6, 0x1d, 0x6a, 0xe2,
-	0x77, 0x5c, 0x2b, 0x74, 0x26, 0x72, 0x53, 0x8c, 0xd9, 0xd6, 0x09, 0xc2, 0x2e, 0x29, 0xef, 0x3b,
-	0x93, 0xb8, 0xb9, 0x8f, 0x61, 0x2b, 0xbb, 0x26, 0x6b, 0x93, 0x59, 0x52, 0x37, 0xd3, 0x75, 0x99,
-	0x0c, 0x7e, 0x05, 0x75, 0x79, 0xa6, 0xe4, 0x69, 0x9e, 0x3d, 0x5b, 0x0b, 0xd9, 0xb3, 0x75, 0x1f,
-	0xf4, 0x91, 0x1d, 0x84, 0xbc, 0x02, 0x7b, 0x43, 0x62, 0x16, 0xe6, 0x2a, 0x81, 0x33, 0xdc, 0xbe,
-	0x33, 0xc6, 0xc6, 0xdf, 0xd3, 0xe0, 0x4e, 0x16, 0xb7, 0x28, 0x5d, 0x68, 0xc2, 0x2d, 0xd1, 0x85,
-	0xc1, 0x31, 0x2b, 0xb7, 0x28, 0xcf, 0xaa, 0x4e, 0xf8, 0x0d, 0x8e, 0xb4, 0xcb, 0x71, 0xe8, 0x3e,
-	0xe4, 0x33, 0xfb, 0x03, 0xd8, 0x4a, 0x91, 0x20, 0xb7, 0x4a, 0xc5, 0x8f, 0xa1, 0x9e, 0x20, 0xd0,
-	0x72, 0x87, 0x7c, 0x82, 0xda, 0xd0, 0x60, 0x3e, 0xfb, 0x5d, 0xdf, 0x3b, 0x21, 0xdb, 0x41, 0xe9,
-	0xdf, 0xb5, 0xfc, 0xf7, 0x9f, 0x82, 0xde, 0xc5, 0xd8, 0x57, 0x08, 0xdc, 0x02, 0x98, 0x60, 0xec,
-	0x2b, 0x13, 0x5b, 0x22, 0x90, 0xdd, 0x64, 0x7c, 0x95, 0x6a, 0x05, 0x32, 0xfe, 0x44, 0x83, 0xaa,
-	0x39, 0x19, 0xf4, 0x2e, 0xdc, 0xff, 0x87, 0x64, 0x60, 0xb6, 0x24, 0x5b, 0xb8, 0x96, 0x24, 0x5b,
-	0xcc, 0x91, 0x64, 0xc6, 0xbf, 0x2e, 0xc0, 0x4a, 0x34, 0xe2, 0xf8, 0xac, 0x08, 0x2e, 0xdc, 0x01,
-	0x1e, 0x8a, 0xb3, 0x82, 0x7d, 0xa1, 0x7d, 0xa8, 0xb9, 0xd2, 0x34, 0x33, 0x9b, 0x16, 0x8b, 0x37,
-	0xb8, 0x2d, 0x5f, 0x69, 0x2e, 0xdc, 0x81, 0xbc, 0x1c, 0xd4, 0x8a, 0xa5, 0xbb, 0x09, 0x08, 0x7a,
-	0x02, 0x15, 0xca, 0x1f, 0x62, 0x1b, 0xd0, 0x89, 0x51, 0x03, 0x85, 0xf2, 0x36, 0x91, 0x59, 0x3e,
-	0x96, 0x4a, 0x90, 0x0d, 0x1b, 0x8c, 0xd2, 0x98, 0x31, 0x7d, 0xc4, 0x92, 0x74, 0x32, 0x55, 0x2f,
-	0xbf, 0xcb, 0x36, 0x87, 0xb9, 0x76, 0x2c, 0x63, 0x70, 0x42, 0xa8, 0x03, 0x2b, 0x8c, 0xf3, 0xac,
-	0x09, 0xe7, 0x58, 0xba, 0x00, 0xcb, 0x3b, 0xf7, 0x24, 0xda, 0xf9, 0x2c, 0x6d, 0x56, 0x7d, 0xa5,
-	0x0c, 0x3d, 0x02, 0x9d, 0x72, 0xa8, 0xe3, 0x1e, 0x7b, 0x5c, 0xf9, 0xe3, 0x8f, 0x83, 0x5b, 0x12,
-	0xc1, 0x24, 0x63, 0x9b, 0x2b, 0xa4, 0x52, 0x3b, 0xae, 0x63, 0xfc, 0x8e, 0x06, 0xd5, 0xde, 0xe4,
-	0x4c, 0x66, 0xd8, 0x5f, 0xe6, 0xb9, 0x47, 0xad, 0x47, 0x67, 0xd6, 0xc0, 0x73, 0x5d, 0x3c, 0x08,
-	0xe9, 0x45, 0xac, 0x64, 0x42, 0x30, 0x39, 0xdb, 0x65, 0x10, 0xca, 0x4e, 0x51, 0x7f, 0xfe, 0x3f,
-	0x3b, 0xfd, 0xaa, 0xb1, 0xd3, 0x1a, 0x20, 0xde, 0xaa, 0xe7, 0x44, 0xc1, 0x50, 0x46, 0x13, 0x56,
-	0x15, 0x28, 0x5f, 0xd7, 0xb7, 0x85, 0x98, 0xb6, 0x26, 0x04, 0xae, 0x98, 0x45, 0xfd, 0x18, 0x9f,
-	0x2a, 0x40, 0x1f, 0xc3, 0x0d, 0x1e, 0x41, 0x80, 0x4d, 0xdb, 0x1d, 0x7a, 0xe3, 0x1e, 0xc6, 0x43,
-	0xc9, 0xa7, 0x99, 0x5c, 0x19, 0xac, 0x11, 0x76, 0x4f, 0xc2, 0x53, 0xae, 0x36, 0x00, 0x01, 0xed,
-	0x53, 0x88, 0xf1, 0x67, 0xa0, 0x91, 0x55, 0x3b, 0xf6, 0xb1, 0xa3, 0xd5, 0x8f, 0x2e, 0x42, 0x1c,
-	0x44, 0xe6, 0x10, 0x8c, 0x87, 0x0f, 0x09, 0x00, 0xdd, 0x80, 0x22, 0x2d, 0x3e, 0xe5, 0x2f, 0x36,
-	0x25, 0x73, 0x89, 0x7c, 0x3f, 0xc1, 0xaf, 0x88, 0x56, 0x4e, 0x8b, 0xc6, 0x2e, 0x1e, 0x7b, 0xae,
-	0x33, 0xe0, 0xc1, 0x36, 0x65, 0x02, 0x3c, 0xe0, 0x30, 0x63, 0x07, 0x6a, 0x7b, 0x78, 0xe0, 0x0d,
-	0xb1, 0xdc, 0xe5, 0x5b, 0x00, 0x44, 0xb8, 0xb3, 0x57, 0x0b, 0x7e, 0x20, 0x94, 0x08, 0x84, 0xbe,
-	0x54, 0x18, 0xdf, 0x05, 0x24, 0xd7, 0x89, 0x7d, 0x43, 0x87, 0x14, 0x3a, 0xb4, 0xe8, 0x75, 0x89,
-	0xbf, 0x88, 0x70, 0x18, 0x41, 0x35, 0xfe, 0xf2, 0x1c, 0xac, 0x9b, 0x53, 0x97, 0x99, 0xfd, 0x1e,
-	0x4e, 0x2f, 0xe2, 0xe8, 0xce, 0xaf, 0x6e, 0xf2, 0xbd, 0x07, 0x55, 0x11, 0x83, 0x31, 0x90, 0x0d,
-	0x05, 0x15, 0x1e, 0x81, 0xc1, 0xd1, 0xb6, 0x61, 0x95, 0x87, 0x2d, 0x5a, 0xa1, 0x67, 0x11, 0xd5,
-	0x26, 0xb4, 0x1d, 0x11, 0x0c, 0x52, 0xe3, 0x45, 0x7d, 0xef, 0x80, 0x17, 0xc8, 0x64, 0x55, 0x8b,
-	0x2f, 0x27, 0xcb, 0x8f, 0xc5, 0xa4, 0x41, 0x77, 0xf1, 0x12, 0x83, 0xee, 0x92, 0x6a, 0xd0, 0x35,
-	0xea, 0xb0, 0x91, 0x9c, 0x10, 0xae, 0xa7, 0xfe, 0x4e, 0x01, 0xd6, 0xa9, 0x4e, 0xd2, 0x9c, 0x86,
-	0xde, 0xd7, 0x34, 0x57, 0x39, 0x93, 0x50, 0xc8, 0x9b, 0x84, 0xd7, 0xa1, 0x3a, 0xb6, 0xa9, 0x5d,
-	0x59, 0x38, 0xb9, 0xb0, 0xf9, 0x5a, 0x1e, 0xdb, 0xaf, 0x1e, 0x09, 0x3f, 0x97, 0x77, 0x01, 0x11,
-	0x24, 0xea, 0x10, 0x6c, 0xf9, 0x78, 0x64, 0x87, 0xc2, 0x67, 0x56, 0x33, 0xf5, 0xb1, 0xfd, 0x8a,
-	0x7b, 0x10, 0x33, 0xb8, 0x8a, 0x6d, 0x1f, 0x05, 0xde, 0x68, 0x1a, 0x62, 0x1e, 0x34, 0x13, 0x61,
-	0x37, 0x39, 0x3c, 0x63, 0x15, 0x96, 0xae, 0xb2, 0x0a, 0xc5, 0x4b, 0x56, 0xa1, 0x94, 0x30, 0xab,
-	0x1b, 0x50, 0xa1, 0x9d, 0xc2, 0x3e, 0x53, 0x84, 0xb9, 0x15, 0x9d, 0x0c, 0xb3, 0x8b, 0x7d, 0xaa,
-	0xfb, 0x92, 0x95, 0x4a, 0x2e, 0x07, 0x5f, 0xa9, 0x0d, 0x58, 0xeb, 0x85, 0xde, 0x24, 0xb9, 0x4e,
-	0xc6, 0x26, 0x59, 0x40, 0x05, 0xce, 0x2b, 0x34, 0xa0, 0x2e, 0xad, 0x38, 0xb5, 0xb0, 0x44, 0xa1,
-	0xd5, 0x7f, 0x65, 0x11, 0x6e, 0x64, 0x14, 0x4a, 0x01, 0x7d, 0xd9, 0xbe, 0x6b, 0x6f, 0x40, 0xd5,
-	0x3e, 0x3b, 0xe1, 0xf3, 0x3a, 0xf6, 0x86, 0x42, 0x4b, 0x2b, 0xdb, 0x67, 0x27, 0x74, 0x4e, 0x0f,
-	0xbc, 0x21, 0xbd, 0xd9, 0x45, 0x58, 0xcf, 0x3f, 0x6f, 0x76, 0xad, 0x21, 0x1e, 0x85, 0xb6, 0x60,
-	0x00, 0x81, 0x4a, 0x4a, 0xf6, 0x48, 0xc1, 0xb5, 0x77, 0x8d, 0x01, 0x15, 0x66, 0xf3, 0x24, 0xe8,
-	0xf6, 0xd9, 0x89, 0xf0, 0x05, 0x63, 0xc0, 0xbe, 0xd7, 0x3c, 0x3b, 0x41, 0xdf, 0x82, 0xf5, 0xa1,
-	0xe7, 0x86, 0xd6, 0xb9, 0xed, 0x84, 0xd6, 0xb1, 0xe7, 0x2b, 0xcf, 0x25, 0x45, 0x13, 0x91, 0xc2,
-	0xcf, 0x6d, 0x27, 0x7c, 0xe4, 0xf9, 0xd2, 0xb3, 0x09, 0x7b, 0xe8, 0xe0, 0xfd, 0xe5, 0xd1, 0x53,
-	0x0c, 0xc6, 0x7a, 0x7a, 0x8b, 0xf9, 0x62, 0x31, 0xbf, 0x2e, 0xce, 0x00, 0xa5, 0x63, 0x8c, 0x7b,
-	0x14, 0x40, 0xd8, 0x8e, 0x14, 0x73, 0xf7, 0xbe, 0x60, 0x60, 0x8f, 0x1c, 0xf7, 0x84, 0xf3, 0x81,
-	0x7e, 0x8c, 0x71, 0x9f, 0x16, 0xf4, 0x18, 0x1c, 0x6d, 0xc2, 0xd2, 0xd8, 0x71, 0xa5, 0xf7, 0x94,
-	0xc5, 0xb1, 0xe3, 0x3e, 0xc2, 0x98, 0x16, 0xb0, 0x0d, 0x51, 0x2f, 0xf3, 0x02, 0xba, 0x13, 0xd2,
-	0x1c, 0x54, 0x49, 0x71, 0x50, 0x0e, 0xeb, 0x57, 0x73, 0x58, 0x3f, 0x7b, 0x5b, 0xad, 0xe4, 0x6c,
-	0xab, 0x37, 0xd8, 0x4e, 0x75, 0x22, 0x8f, 0xf7, 0x7a, 0x8d, 0xf9, 0x2e, 0x8e, 0xed, 0x57, 0x6d,
-	0xe1, 0xef, 0x9e, 0xda, 0x27, 0xe8, 0x92, 0x7d, 0xb2, 0x9a, 0xd8, 0x27, 0xdf, 0x81, 0xcd, 0x60,
-	0xe2, 0x63, 0x7b, 0x28, 0xe2, 0x54, 0x26, 0xfc, 0xf9, 0x28, 0xa8, 0xaf, 0xd1, 0xc5, 0x5b, 0x67,
-	0xc5, 0x3c, 0x74, 0x40, 0x14, 0x66, 0x6c, 0xe3, 0xf5, 0xac, 0x6d, 0x1c, 0xbf, 0x62, 0x6d, 0x48,
-	0xaf, 0x58, 0xc6, 0x7b, 0x50, 0xeb, 0xe1, 0x64, 0x08, 0x73, 0xee, 0x4e, 0x20, 0xa7, 0xbc, 0x8c,
-	0xce, 0xf7, 0xdc, 0x01, 0x6c, 0xf5, 0xb0, 0x48, 0x87, 0x10, 0x73, 0xac, 0x14, 0x41, 0x94, 0xc5,
-	0xe8, 0x5a, 0x0e, 0xa3, 0x1b, 0xaf, 0xc1, 0xcd, 0x6c, 0x72, 0xbc, 0xb9, 0xef, 0x82, 0xde, 0xc3,
-	0xe1, 0x01, 0x65, 0x0e, 0xd1, 0x46, 0x5a, 0x9a, 0x6a, 0x29, 0x69, 0x6a, 0xac, 0xd2, 0xc1, 0x8a,
-	0x8a, 0x9c, 0xda, 0x0f, 0xa1, 0xc1, 0x80, 0xca, 0xa2, 0x0b, 0xba, 0xd9, 0x9c, 0xa2, 0x65, 0x73,
-	0x8a, 0x71, 0x8b, 0x4e, 0x44, 0x9a, 0x56, 0x66, 0x53, 0x82, 0x1b, 0x33, 0x9b, 0x8a, 0x58, 0x58,
-	0xcb, 0x66, 0xe1, 0x44, 0x53, 0x31, 0xad, 0xc8, 0x1a, 0xb9, 0xd9, 0xc3, 0xe1, 0x73, 0x99, 0x05,
-	0x24, 0xbf, 0xcd, 0x04, 0xc3, 0x68, 0x19, 0x0c, 0x43, 0x04, 0x69, 0x9a, 0x02, 0xa7, 0xfe, 0x3d,
-	0x58, 0xef, 0xe1, 0xb0, 0x1b, 0xb3, 0xb6, 0x14, 0x93, 0xaf, 0x6c, 0x02, 0x2d, 0xb5, 0x09, 0xa8,
-	0xac, 0x4f, 0xd4, 0xe5, 0x54, 0xbf, 0x45, 0x99, 0xab, 0xcb, 0x37, 0x84, 0xf4, 0x04, 0x10, 0x6f,
-	0x1a, 0x2d, 0x71, 0xc4, 0xaf, 0xc3, 0xaa, 0x52, 0x85, 0x53, 0xfa, 0x3e, 0xed, 0xdf, 0x41, 0x2c,
-	0x1f, 0x04, 0xb1, 0x94, 0x28, 0xd1, 0xb2, 0x0f, 0xa3, 0x44, 0xe5, 0x38, 0x15, 0x47, 0xf3, 0x04,
-	0xbb, 0x43, 0x3b, 0xb2, 0x6c, 0xfd, 0xbc, 0x00, 0x2b, 0x11, 0x28, 0x3e, 0x47, 0x84, 0x23, 0x24,
-	0xdf, 0x3d, 0xfc, 0x13, 0x7d, 0x1f, 0x96, 0x6c, 0x86, 0xcc, 0x5f, 0x1a, 0xef, 0xca, 0xa9, 0x2d,
-	0x54, 0x32, 0xfc, 0xdb, 0x14, 0x35, 0x1a, 0x7f, 0xa4, 0xc1, 0x22, 0x83, 0xa1, 0x2a, 0xcc, 0x39,
-	0x43, 0x3e, 0xb7, 0x73, 0x0e, 0xb5, 0x03, 0x0c, 0x31, 0xf3, 0xe9, 0x10, 0x4e, 0x74, 0x25, 0x53,
-	0x06, 0x21, 0x04, 0xf3, 0x63, 0x3b, 0x78, 0xc9, 0xd5, 0x37, 0xfa, 0x9b, 0xf4, 0x66, 0x70, 0xea,
-	0x39, 0x03, 0x2c, 0x7c, 0xe8, 0x66, 0xf5, 0x66, 0x97, 0x62, 0x9a, 0xa2, 0x06, 0x7b, 0x7b, 0xb1,
-	0xfd, 0x50, 0xf6, 0x4a, 0x2e, 0x51, 0x08, 0xf5, 0x49, 0xbe, 0x0d, 0xec, 0x00, 0xe1, 0x5e, 0xcb,
-	0x4c, 0x05, 0x01, 0x06, 0x22, 0x08, 0x8d, 0x9f, 0x69, 0xb0, 0xc8, 0x68, 0x7e, 0xb5, 0xd1, 0xf0,
-	0x64, 0x46, 0x74, 0x34, 0x34, 0x4b, 0xd1, 0x2d, 0x00, 0x27, 0x20, 0xdb, 0x26, 0x3a, 0x44, 0x8b,
-	0x66, 0xc9, 0x09, 0x9a, 0x0c, 0x80, 0x56, 0x61, 0xc1, 0x09, 0x2c, 0xd7, 0xe3, 0xc6, 0x8f, 0x79,
-	0x27, 0xe8, 0x78, 0x44, 0x9a, 0x3d, 0xf7, 0x42, 0xcc, 0xfa, 0x11, 0xad, 0xe9, 0x3f, 0x99, 0x83,
-	0x55, 0x05, 0x7c, 0xe9, 0xba, 0x7e, 0x1a, 0xcf, 0x24, 0x5b, 0x57, 0xf9, 0x2e, 0x96, 0x41, 0x2a,
-	0x35, 0x9b, 0x0d, 0x28, 0x9e, 0x79, 0x21, 0x96, 0x06, 0x15, 0x7d, 0x37, 0xfe, 0x4e, 0x3c, 0x53,
-	0x5b, 0x50, 0x62, 0xdc, 0x60, 0x45, 0x13, 0x56, 0x64, 0x80, 0xf6, 0x90, 0xdc, 0xc7, 0x79, 0x61,
-	0x7a, 0xf6, 0x6a, 0xac, 0x64, 0x4f, 0x9a, 0xc3, 0x2d, 0x28, 0xb1, 0xd6, 0x09, 0x2d, 0x76, 0x79,
-	0x29, 0x32, 0x00, 0xa3, 0xc5, 0x0b, 0x65, 0x5a, 0xf3, 0x8c, 0x16, 0x2b, 0x91, 0x68, 0x19, 0xbf,
-	0xa7, 0xd1, 0xfd, 0x96, 0x9e, 0x4b, 0xd4, 0x8c, 0x67, 0x86, 0xbd, 0xab, 0xc9, 0xf9, 0x21, 0x32,
-	0xab, 0x24, 0xe7, 0xa6, 0xf1, 0xf0, 0x6a, 0xc3, 0x57, 0xc6, 0x33, 0xa7, 0x8e, 0xc7, 0xf8, 0x90,
-	0x6e, 0xe9, 0xac, 0x45, 0x95, 0x67, 0x5e, 0x53, 0x67, 0xde, 0x38, 0x85, 0xb5, 0xe7, 0xd8, 0x77,
-	0x8e, 0x2f, 0xbe, 0x06, 0x97, 0x07, 0xe5, 0xdd, 0xbd, 0x90, 0xf4, 0x5d, 0x78, 0x0f, 0xd6, 0x13,
-	0x2d, 0xc5, 0x91, 0xfc, 0x34, 0x76, 0x8a, 0xdb, 0x3f, 0xd8, 0x87, 0xf1, 0xd3, 0x65, 0x71, 0x49,
-	0x54, 0x3c, 0xca, 0xae, 0xe1, 0x8f, 0x28, 0xf1, 0x32, 0x33, 0xb8, 0x46, 0xbc, 0xbc, 0x05, 0x25,
-	0x6a, 0xae, 0xa6, 0xfb, 0x96, 0xf3, 0x22, 0x01, 0xd0, 0x6d, 0x1d, 0xbb, 0xc8, 0xcc, 0x2b, 0x2e,
-	0x32, 0x59, 0x29, 0xbb, 0x16, 0xbe, 0x8e, 0x94, 0x5d, 0xe8, 0xfb, 0xb0, 0x48, 0x2f, 0xca, 0x44,
-	0x83, 0x4d, 0x26, 0x03, 0x4a, 0x4f, 0x81, 0xc8, 0x5c, 0xc6, 0xaa, 0xa0, 0x4f, 0x60, 0x49, 0xf8,
-	0xe6, 0x2f, 0xa5, 0x32, 0x97, 0x65, 0xd4, 0x16, 0x99, 0xcb, 0x78, 0xa5, 0xc6, 0x7f, 0x29, 0x88,
-	0x84, 0x61, 0xdf, 0x83, 0x1b, 0x91, 0xff, 0x5c, 0xce, 0x1c, 0x6f, 0x0a, 0x84, 0xc4, 0xeb, 0x3f,
-	0xfa, 0x18, 0x1a, 0x99, 0x75, 0x65, 0x4f, 0xd0, 0x7a, 0x46, 0x65, 0xe6, 0x05, 0xf8, 0x99, 0xe4,
-	0x16, 0x5a, 0xdd, 0x79, 0xf7, 0x0a, 0xc3, 0xdf, 0xee, 0xfb, 0x18, 0xd3, 0xd9, 0x64, 0x4e, 0xa4,
-	0x0d, 0x28, 0x06, 0x84, 0x73, 0xdd, 0x41, 0x14, 0x85, 0x29, 0xbe, 0xe9, 0x96, 0x62, 0x21, 0x20,
-	0x8e, 0xcb, 0xa5, 0x78, 0x91, 0x01, 0xda, 0x6e, 0xea, 0xc9, 0x98, 0xb9, 0x5c, 0x29, 0x21, 0x86,
-	0xb7, 0x81, 0x7d, 0xf2, 0xc1, 0xb0, 0x00, 0x4d, 0xf6, 0x0e, 0xdd, 0x16, 0x39, 0xd5, 0x22, 0x36,
-	0x17, 0x6e, 0x87, 0x45, 0xc6, 0x93, 0x11, 0x9c, 0xbb, 0xd3, 0x3e, 0x80, 0xb5, 0x24, 0xaa, 0x65,
-	0x07, 0x63, 0x7a, 0x91, 0x28, 0x99, 0x28, 0x81, 0xde, 0x0c, 0xc6, 0xc6, 0x47, 0x50, 0x14, 0x63,
-	0x55, 0xf3, 0x94, 0xad, 0xc5, 0x41, 0xc3, 0xff, 0x47, 0xfc, 0xd3, 0x50, 0x09, 0x16, 0x7a, 0xfd,
-	0xe6, 0xd3, 0x96, 0xae, 0x35, 0xfe, 0xcd, 0xbc, 0x9c, 0x96, 0xed, 0xcc, 0x1e, 0x4d, 0x85, 0xa6,
-	0xc5, 0x3e, 0xe2, 0x64, 0x6d, 0x73, 0x89, 0x64, 0x6d, 0x72, 0x8c, 0x83, 0xb4, 0x6d, 0xe2, 0xe0,
-	0x88, 0x79, 0x25, 0x38, 0x82, 0x9c, 0x93, 0xf1, 0x50, 0x98, 0x99, 0xa3, 0x14, 0x88, 0x11, 0xa0,
-	0xf7, 0x61, 0x35, 0x72, 0x99, 0x8b, 0x06, 0x18, 0xf0, 0x94, 0x04, 0x22, 0xa5, 0xc9, 0x30, 0xf2,
-	0x7e, 0x0c, 0x50, 0x0f, 0xca, 0x9c, 0xde, 0x60, 0x64, 0xf3, 0x2b, 0x7b, 0x75, 0xe7, 0xc1, 0x55,
-	0xf8, 0x7a, 0x9b, 0x4d, 0xdc, 0x2e, 0xa9, 0x67, 0x2e, 0x07, 0xf1, 0x07, 0x11, 0x4e, 0xb6, 0x78,
-	0x5b, 0xac, 0x17, 0xa9, 0x65, 0x36, 0x06, 0xa0, 0x77, 0xa0, 0x36, 0xf0, 0xc6, 0x63, 0x27, 0x1c,
-	0x63, 0x37, 0x8a, 0x36, 0x28, 0x31, 0xb5, 0x34, 0x2e, 0x60, 0xc1, 0x06, 0xc6, 0x7f, 0xd7, 0x60,
-	0x59, 0x6a, 0x07, 0xe9, 0x50, 0xee, 0x1c, 0x76, 0xac, 0x5e, 0xbf, 0xd9, 0xd9, 0x6b, 0x9a, 0x7b,
-	0x2c, 0x86, 0xbb, 0xfb, 0xec, 0xa1, 0xf5, 0xb4, 0xf5, 0x05, 0xf3, 0x1b, 0xe5, 0x1f, 0xd6, 0x93,
-	0x66, 0xef, 0x89, 0x3e, 0x47, 0x5d, 0x4b, 0x77, 0xcd, 0x76, 0xb7, 0xcf, 0x00, 0x05, 0x54, 0x81,
-	0xd2, 0xc1, 0xb3, 0xfd, 0x7e, 0xdb, 0xea, 0xb5, 0x1f, 0xeb, 0xf3, 0xe4, 0xb3, 0xf3, 0x6c, 0x7f,
-	0xdf, 0xda, 0x6b, 0xf6, 0x9b, 0xfa, 0x02, 0x75, 0x29, 0x25, 0x6b, 0x6a, 0xf5, 0x9e, 0x3d, 0x3c,
-	0x68, 0xf7, 0x7a, 0xed, 0xc3, 0x8e, 0xbe, 0x48, 0x90, 0x18, 0xf4, 0x71, 0xab, 0xa3, 0x2f, 0xc5,
-	0x48, 0x52, 0x3e, 0xba, 0xa2, 0x52, 0xd5, 0xda, 0x7d, 0xd2, 0xec, 0x3c, 0x6e, 0xe9, 0x25, 0xd2,
-	0xbe, 0xe8, 0x51, 0x73, 0xbf, 0xaf, 0x03, 0x41, 0x93, 0xbb, 0x48, 0xa1, 0xcb, 0x46, 0x1f, 0xb6,
-	0xd8, 0x44, 0x9b, 0xf6, 0x79, 0x86, 0x0f, 0xe9, 0x57, 0x74, 0xc2, 0xb6, 0xe0, 0x66, 0x36, 0xd5,
-	0xab, 0xe6, 0x19, 0x49, 0x2f, 0xbe, 0xe2, 0x36, 0x6d, 0xec, 0xc0, 0xc6, 0x73, 0x1e, 0x8b, 0x9b,
-	0x91, 0x1e, 0x2a, 0xf3, 0x50, 0x33, 0x7e, 0xbe, 0x00, 0x9b, 0xa9, 0x4a, 0xbc, 0x43, 0x37, 0xa0,
-	0xe8, 0x04, 0x96, 0x7c, 0x44, 0x2d, 0x39, 0x01, 0x45, 0x26, 0xd7, 0x79, 0x27, 0xb0, 0xc6, 0x8e,
-	0x2b, 0xb2, 0xe4, 0x2c, 0x3a, 0xc1, 0x81, 0xe3, 0x66, 0xf9, 0x32, 0x15, 0xb2, 0x7c, 0x99, 0xee,
-	0x40, 0x99, 0x7b, 0x70, 0xd0, 0xdb, 0x04, 0xd7, 0x3e, 0x60, 0x42, 0xdd, 0x37, 0x48, 0x3f, 0x48,
-	0x0b, 0xc2, 0xc7, 0x83, 0x85, 0x25, 0x2f, 0xb2, 0x42, 0x22, 0xd5, 0x9c, 0x40, 0x76, 0x72, 0x2e,
-	0x9a, 0x45, 0x27, 0xe0, 0x62, 0xe6, 0x4d, 0x58, 0x89, 0x3c, 0xa0, 0x29, 0x65, 0x76, 0x38, 0x94,
-	0xcc, 0x8a, 0xf0, 0xac, 0x27, 0xc4, 0x03, 0xd4, 0x01, 0xbe, 0x47, 0xd8, 0x71, 0x56, 0x4c, 0x1d,
-	0x67, 0x39, 0x73, 0xc2, 0xb7, 0x19, 0x15, 0xc0, 0x7c, 0xef, 0x53, 0x01, 0xf5, 0x0e, 0xa0, 0x89,
-	0x7d, 0x41, 0x6d, 0x37, 0xc3, 0xa1, 0x2f, 0x7a, 0x57, 0x62, 0xb2, 0x70, 0x62, 0x5f, 0xf4, 0x3d,
-	0x42, 0x88, 0x77, 0xf2, 0x75, 0xa8, 0x04, 0xce, 0x49, 0x60, 0x09, 0x09, 0x40, 0x4d, 0x25, 0x15,
-	0xb3, 0x4c, 0x80, 0x26, 0x87, 0x51, 0x0f, 0xf2, 0xc0, 0x8a, 0x72, 0x44, 0x2e, 0xb3, 0x5c, 0x44,
-	0x4e, 0xd0, 0x16, 0x59, 0x22, 0x23, 0x21, 0x56, 0x96, 0x84, 0x98, 0xf1, 0x3f, 0x34, 0x80, 0xb8,
-	0x8f, 0xa8, 0x06, 0x95, 0x8e, 0xe7, 0xf6, 0x42, 0xdb, 0x1d, 0xda, 0xfe, 0xb0, 0x7f, 0xc1, 0xd2,
-	0x3c, 0x32, 0x2f, 0x99, 0xfe, 0x05, 0xdf, 0xa3, 0xf4, 0x8b, 0xf9, 0x83, 0xeb, 0x73, 0xd4, 0xdb,
-	0x9b, 0x12, 0xe0, 0x90, 0x02, 0xaa, 0x02, 0x1c, 0x4c, 0x47, 0xa1, 0xd3, 0x73, 0x4e, 0xfa, 0x17,
-	0xfa, 0x3c, 0xf9, 0xee, 0x4c, 0x47, 0xa3, 0x3d, 0x3b, 0xb4, 0xfb, 0x17, 0xfa, 0x02, 0x5a, 0xe7,
-	0xb9, 0x08, 0x7a, 0xd3, 0x23, 0xfa, 0x34, 0x42, 0x4e, 0x77, 0x7d, 0x91, 0xa0, 0x89, 0x14, 0x41,
-	0xfd, 0x0b, 0x7d, 0x29, 0x42, 0x33, 0xf1, 0x99, 0x27, 0x1e, 0x68, 0xf8, 0x4e, 0xe5, 0xb5, 0x59,
-	0xa8, 0x5c, 0xff, 0x82, 0xef, 0xd4, 0xe9, 0xd1, 0x4b, 0x7c, 0xd1, 0x1c, 0x85, 0xfd, 0x0b, 0x1d,
-	0xd0, 0x2a, 0xac, 0x30, 0x00, 0xe9, 0x16, 0x03, 0x2e, 0x1b, 0x1f, 0xc0, 0xe6, 0x2e, 0x15, 0x52,
-	0x21, 0x1e, 0x26, 0x5c, 0x69, 0xeb, 0xb0, 0x24, 0xcc, 0x63, 0xcc, 0xb5, 0x4c, 0x7c, 0x1a, 0x4f,
-	0xe0, 0xf6, 0xe3, 0xc8, 0xcc, 0xd1, 0x52, 0x1c, 0x87, 0xae, 0x97, 0xd5, 0xc9, 0xe8, 0xc1, 0x9d,
-	0x7c, 0x4a, 0x7c, 0x13, 0xbd, 0x0f, 0x6b, 0xf6, 0x60, 0x60, 0xe5, 0x38, 0x2e, 0xd5, 0xec, 0xc1,
-	0x40, 0xad, 0x68, 0xfc, 0x2b, 0x0d, 0xea, 0xe9, 0x41, 0x71, 0x6a, 0x5f, 0xc2, 0x8a, 0xe2, 0x38,
-	0x8d, 0xb3, 0x02, 0xa7, 0xf3, 0x6a, 0xf3, 0xcc, 0x1c, 0x82, 0xa5, 0x93, 0x94, 0x1a, 0x4d, 0x91,
-	0x7d, 0xa4, 0x19, 0x07, 0x05, 0x4a, 0xd9, 0x47, 0xca, 0x51, 0x7a, 0x91, 0xfc, 0x87, 0x72, 0x04,
-	0xfa, 0x43, 0x1c, 0x84, 0xf2, 0xb5, 0xdc, 0xf8, 0x14, 0x6a, 0x12, 0x2c, 0x7e, 0xfc, 0x93, 0x9c,
-	0x0a, 0x2a, 0x51, 0xca, 0x0d, 0x91, 0x9e, 0x63, 0x2e, 0x4e, 0xcf, 0x61, 0xfc, 0x3b, 0x0d, 0x56,
-	0x7b, 0xe7, 0x18, 0x4f, 0xd2, 0xf9, 0x08, 0x33, 0x62, 0x54, 0x4b, 0xc9, 0x18, 0xd5, 0xf7, 0x61,
-	0x55, 0x0a, 0x22, 0xb4, 0xd4, 0x9e, 0x23, 0xa9, 0xa8, 0x19, 0xc7, 0x7c, 0xcc, 0x08, 0x54, 0xae,
-	0x5c, 0x2d, 0xa8, 0x75, 0x9e, 0x19, 0x33, 0x44, 0x50, 0xab, 0xf1, 0x3f, 0x35, 0x58, 0x53, 0x07,
-	0xf1, 0xeb, 0x1d, 0x65, 0xf8, 0xf6, 0x3f, 0x28, 0xc0, 0x5a, 0xd6, 0x1b, 0x2d, 0x02, 0x58, 0xec,
-	0x7d, 0xd1, 0xd9, 0xa5, 0xc1, 0x98, 0x65, 0x28, 0x3e, 0xeb, 0xf0, 0x2f, 0x0d, 0x21, 0xa8, 0x76,
-	0x5b, 0x2d, 0xd3, 0xda, 0x3d, 0xec, 0x74, 0x5a, 0xbb, 0xfd, 0xd6, 0x9e, 0x3e, 0x47, 0xa4, 0x06,
-	0x85, 0xed, 0xb5, 0x7b, 0x31, 0xb8, 0x80, 0xde, 0x80, 0x3b, 0x8f, 0x5a, 0xfd, 0xdd, 0x27, 0xad,
-	0x3d, 0x8b, 0x6a, 0x06, 0x9d, 0xc7, 0xd6, 0xee, 0xa3, 0xf6, 0x7e, 0xbf, 0x65, 0xf6, 0x88, 0x3e,
-	0x62, 0xb2, 0x14, 0x31, 0xf7, 0xe0, 0x6e, 0x2e, 0x56, 0xd7, 0x3c, 0x7c, 0x6c, 0xb6, 0x7a, 0x3d,
-	0x7d, 0x61, 0x26, 0xda, 0xa3, 0x76, 0xa7, 0xdd, 0x7b, 0x42, 0xf3, 0xca, 0x6c, 0xc1, 0xa6, 0x40,
-	0x7b, 0xd2, 0x6a, 0xee, 0xc9, 0x4d, 0x2d, 0xa1, 0x9b, 0x50, 0x4f, 0x16, 0x46, 0x2d, 0x14, 0xb3,
-	0x4a, 0x23, 0xc2, 0x25, 0xf4, 0x1a, 0x34, 0xe8, 0xf0, 0x9e, 0xb7, 0x4c, 0xab, 0xb9, 0xb7, 0x47,
-	0xea, 0xb4, 0x62, 0xda, 0x80, 0x6e, 0xc3, 0x56, 0x46, 0x79, 0x44, 0x60, 0x99, 0x4c, 0x9c, 0xd9,
-	0xea, 0xed, 0x36, 0x3b, 0x51, 0xa5, 0x32, 0x11, 0x98, 0x1c, 0x16, 0xf5, 0xa3, 0x22, 0x01, 0xa3,
-	0xda, 0xd5, 0x1d, 0x33, 0xca, 0xb1, 0xdd, 0xc3, 0xfe, 0x19, 0xb9, 0x8c, 0x7f, 0x06, 0x4b, 0x1c,
-	0x82, 0x6e, 0xc8, 0x87, 0xa2, 0x92, 0x89, 0xbb, 0xd1, 0xc8, 0x2a, 0x62, 0x5c, 0xbd, 0xf3, 0xfb,
-	0x5b, 0x50, 0x61, 0x0e, 0x87, 0x82, 0xe6, 0x77, 0x61, 0xbe, 0xeb, 0xb8, 0x27, 0x68, 0x43, 0x7e,
-	0x79, 0x8e, 0x53, 0xe3, 0x36, 0x36, 0x53, 0xf0, 0xc8, 0xb3, 0x7c, 0x89, 0xa7, 0xb8, 0x55, 0x3a,
-	0xa3, 0xe6, 0xcd, 0x55, 0x3a, 0x93, 0x4c, 0xa0, 0x6b, 0x42, 0x45, 0x49, 0x6f, 0x8b, 0x6e, 0xa7,
-	0xb3, 0xce, 0x2a, 0x39, 0x73, 0x1b, 0x77, 0xf2, 0x11, 0x22, 0xef, 0xfd, 0x62, 0xe4, 0x2d, 0xd1,
-	0xc8, 0x4c, 0x62, 0xcb, 0x28, 0x6d, 0xcd, 0x48, 0x70, 0x4b, 0x86, 0x26, 0xd2, 0xbf, 0xca, 0x43,
-	0x53, 0x73, 0x0c, 0x2a, 0x43, 0x4b, 0x66, 0x03, 0x0c, 0xa0, 0x9e, 0x77, 0x04, 0xa1, 0x44, 0x06,
-	0xaa, 0x59, 0x27, 0x5e, 0xe3, 0x9d, 0x2b, 0xe1, 0xf2, 0x46, 0x9f, 0x41, 0x55, 0xcd, 0x9a, 0x86,
-	0xee, 0x24, 0x12, 0x44, 0xa5, 0x94, 0xe6, 0xc6, 0xdd, 0x19, 0x18, 0x9c, 0xec, 0x8f, 0x60, 0x25,
-	0x91, 0x8c, 0x0d, 0xe5, 0xd7, 0x8a, 0x26, 0xd8, 0x98, 0x85, 0xc2, 0x28, 0x3f, 0xd0, 0xd0, 0x63,
-	0x28, 0x45, 0x89, 0xab, 0xd0, 0x56, 0x56, 0x3a, 0x2b, 0x41, 0xef, 0xd6, 0xcc, 0x5c, 0x57, 0xe8,
-	0x29, 0x40, 0x0c, 0x45, 0x37, 0x73, 0x90, 0xaf, 0x42, 0xea, 0x81, 0x86, 0xf6, 0x61, 0x59, 0x4a,
-	0x16, 0x85, 0x94, 0xd0, 0x85, 0x54, 0x6a, 0xa9, 0xc6, 0x6b, 0x79, 0xc5, 0x51, 0xc6, 0xba, 0x52,
-	0x94, 0x13, 0x4a, 0x19, 0x63, 0x32, 0x7d, 0x54, 0xe3, 0x66, 0x76, 0x61, 0x4c, 0x27, 0xca, 0x58,
-	0xa4, 0xd0, 0x49, 0xa6, 0x47, 0x52, 0xe8, 0xa4, 0x93, 0x1c, 0x11, 0x3a, 0xe2, 0xe0, 0x57, 0xe9,
-	0x24, 0x54, 0x04, 0x95, 0x4e, 0x4a, 0x57, 0x98, 0x2a, 0x7e, 0xf5, 0x8a, 0x73, 0xaa, 0xc2, 0xe3,
-	0x97, 0xc4, 0xb1, 0x28, 0x3c, 0x7e, 0x59, 0x3c, 0xcb, 0x03, 0x0d, 0x39, 0x71, 0x3e, 0x6d, 0xa5,
-	0xc9, 0x37, 0x33, 0x64, 0x43, 0x56, 0x73, 0x6f, 0x5d, 0x8a, 0x17, 0x35, 0xf5, 0x13, 0xb8, 0x91,
-	0x1b, 0x87, 0x80, 0xde, 0xb9, 0x5a, 0xb4, 0x02, 0x6b, 0xf4, 0xdd, 0xeb, 0x84, 0x36, 0xdc, 0xd7,
-	0x1e, 0x68, 0xe8, 0x4b, 0xd0, 0x93, 0x79, 0x8c, 0x90, 0x71, 0x79, 0xda, 0xa5, 0xc6, 0xeb, 0x33,
-	0x71, 0x62, 0xc9, 0xab, 0x24, 0x7c, 0x56, 0x24, 0x6f, 0x56, 0x92, 0x69, 0x45, 0xf2, 0x66, 0xe6,
-	0x8a, 0x46, 0x9f, 0xc2, 0x22, 0x73, 0x3b, 0x42, 0xf5, 0x94, 0x57, 0x94, 0xa0, 0x72, 0x23, 0xa3,
-	0x44, 0xde, 0x75, 0x52, 0x06, 0x66, 0x65, 0xd7, 0xa5, 0x53, 0x3e, 0x2b, 0xbb, 0x2e, 0x2b, 0x71,
-	0xb3, 0xa0, 0x26, 0xf2, 0x03, 0xcf, 0xcc, 0x91, 0x9c, 0xa6, 0x96, 0xb8, 0x73, 0x7f, 0x09, 0x7a,
-	0x32, 0x19, 0xaf, 0xb2, 0x1a, 0x39, 0xb9, 0x85, 0x95, 0xd5, 0xc8, 0xcb, 0xe6, 0x8b, 0x0e, 0xa1,
-	0x2c, 0x67, 0xc6, 0x45, 0xaf, 0xa5, 0x2a, 0x29, 0x59, 0x7e, 0x1b, 0xb7, 0x73, 0xcb, 0x39, 0xc1,
-	0x17, 0xb0, 0x92, 0xc8, 0xd2, 0xa4, 0x48, 0xec, 0xec, 0x14, 0x58, 0x8a, 0xc4, 0xce, 0x4b, 0x11,
-	0xf5, 0x1c, 0xaa, 0x6a, 0x12, 0x22, 0xe5, 0x88, 0xc9, 0xcc, 0x4f, 0xd4, 0xc8, 0xc5, 0x90, 0xd6,
-	0xfe, 0x04, 0xd6, 0xb2, 0x72, 0x7e, 0x28, 0x9b, 0x7a, 0x46, 0x86, 0x12, 0x65, 0x53, 0xcf, 0x4c,
-	0x1e, 0xf2, 0x02, 0x56, 0x12, 0x61, 0xf3, 0xca, 0xd4, 0x64, 0xa7, 0xb1, 0x50, 0xa6, 0x26, 0x2f,
-	0x05, 0xc4, 0x97, 0xa0, 0x27, 0x03, 0xf2, 0x91, 0x71, 0x79, 0x2a, 0x04, 0x85, 0x45, 0x72, 0x93,
-	0x01, 0xbc, 0x80, 0x95, 0x44, 0x50, 0xb6, 0xd2, 0xed, 0xec, 0xb8, 0x74, 0xa5, 0xdb, 0x79, 0x31,
-	0xdd, 0x36, 0xa0, 0x74, 0x28, 0x35, 0x92, 0x8d, 0xf6, 0xb9, 0x51, 0xdb, 0x8d, 0x7b, 0x97, 0x60,
-	0xf1, 0x26, 0x2e, 0xa2, 0x68, 0xed, 0x8c, 0xf8, 0x69, 0xf4, 0x6e, 0x9a, 0x48, 0x7e, 0x2c, 0x76,
-	0xe3, 0xbd, 0x2b, 0x62, 0xc7, 0xf3, 0x96, 0x88, 0x08, 0x56, 0xe6, 0x2d, 0x3b, 0x5e, 0x5b, 0x99,
-	0xb7, 0xbc, 0x80, 0x62, 0x2a, 0x42, 0xa5, 0x98, 0xdf, 0x84, 0x08, 0x4d, 0x47, 0x11, 0x27, 0x44,
-	0x68, 0x46, 0xb8, 0x30, 0xfa, 0x6d, 0x58, 0xcf, 0x0c, 0x07, 0x46, 0x32, 0x7b, 0xcf, 0x0a, 0x28,
-	0x6e, 0xdc, 0xbf, 0x1c, 0x31, 0x96, 0x8f, 0x52, 0x30, 0xab, 0x22, 0x1f, 0xd3, 0x11, 0xc7, 0x8a,
-	0x7c, 0xcc, 0x0a, 0x13, 0x3e, 0x84, 0xb2, 0x1c, 0x1a, 0x8b, 0x5e, 0x9b, 0x1d, 0xce, 0xab, 0x88,
-	0xb0, 0xcc, 0x28, 0xdc, 0x17, 0xb0, 0x92, 0xb0, 0xf5, 0x29, 0x0b, 0x97, 0x6d, 0x50, 0x55, 0x16,
-	0x2e, 0xcf, 0x7c, 0x4a, 0x0e, 0xd6, 0x84, 0x25, 0x46, 0x3d, 0x58, 0xb3, 0x2d, 0x57, 0xea, 0xc1,
-	0x9a, 0x67, 0x08, 0x22, 0xf3, 0x20, 0x59, 0x13, 0xd4, 0x79, 0x48, 0xdb, 0x4a, 0xd4, 0x79, 0xc8,
-	0x30, 0x43, 0xec, 0xfc, 0xcd, 0x92, 0x08, 0x1c, 0x23, 0xcb, 0x89, 0x7d, 0x71, 0x6d, 0x3b, 0x84,
-	0xb2, 0x1c, 0x38, 0xa6, 0x34, 0x94, 0x11, 0x68, 0xa6, 0x34, 0x94, 0x19, 0x71, 0x76, 0x08, 0x65,
-	0x39, 0x00, 0x50, 0x21, 0x98, 0x11, 0xa0, 0xa8, 0x10, 0xcc, 0x8a, 0x1c, 0x24, 0x57, 0xa0, 0xbc,
-	0xf8, 0x3d, 0x45, 0x3d, 0xbc, 0x24, 0xbc, 0x50, 0x51, 0x0f, 0x2f, 0x0b, 0x08, 0x44, 0x6d, 0x80,
-	0x38, 0x9c, 0x4f, 0xb9, 0x06, 0xa4, 0xe2, 0x04, 0x95, 0x6b, 0x40, 0x46, 0x0c, 0xe0, 0x3e, 0x2c,
-	0x4b, 0x81, 0x7c, 0xca, 0x06, 0x49, 0x87, 0xfd, 0x29, 0x1b, 0x24, 0x23, 0xfe, 0x0f, 0xfd, 0x16,
-	0xb5, 0xb2, 0xaa, 0x81, 0x71, 0xe8, 0x75, 0x55, 0xdf, 0xcf, 0x8c, 0xea, 0x6b, 0xbc, 0x31, 0x1b,
-	0x29, 0xa6, 0x9f, 0x0a, 0x59, 0x53, 0xe8, 0xe7, 0x05, 0xde, 0x29, 0xf4, 0x73, 0xa3, 0xde, 0xd0,
-	0xcf, 0x34, 0xb8, 0x35, 0x33, 0x1e, 0x0d, 0xbd, 0x2f, 0xf7, 0xf3, 0x0a, 0x11, 0x6e, 0x8d, 0x07,
-	0x57, 0xaf, 0x10, 0xf3, 0xa8, 0x1c, 0x14, 0xa0, 0xf0, 0x68, 0x46, 0x0c, 0x9c, 0xc2, 0xa3, 0x99,
-	0xb1, 0x70, 0x27, 0x3c, 0x46, 0x2e, 0x11, 0x12, 0xa0, 0xa8, 0x1d, 0x33, 0x82, 0xfd, 0x14, 0xb5,
-	0x63, 0x56, 0xd4, 0x1f, 0x7a, 0x08, 0x4b, 0x3c, 0xce, 0x42, 0xb1, 0x28, 0xa8, 0xb1, 0x20, 0x8a,
-	0x45, 0x21, 0x11, 0x96, 0xf1, 0x40, 0x23, 0x34, 0x78, 0xe8, 0x8f, 0x42, 0x43, 0x0d, 0x80, 0x52,
-	0x68, 0x24, 0x22, 0x85, 0x98, 0x8e, 0x2d, 0xc5, 0x06, 0x28, 0x4c, 0x9d, 0x8e, 0x24, 0x50, 0x98,
-	0x3a, 0x23, 0xa4, 0x60, 0xc7, 0x83, 0x35, 0xc9, 0xb5, 0xf7, 0xf9, 0x8e, 0x10, 0x4e, 0x9f, 0x43,
-	0x55, 0x75, 0x02, 0x57, 0xb4, 0xc4, 0x4c, 0x87, 0x79, 0xc5, 0x10, 0x91, 0xed, 0x41, 0xfe, 0x40,
-	0xdb, 0xf9, 0xb7, 0x45, 0x91, 0xdc, 0x99, 0x96, 0x88, 0xf6, 0x9e, 0x41, 0x55, 0x75, 0x65, 0x56,
-	0xda, 0xcb, 0x74, 0x3a, 0x57, 0xda, 0xcb, 0xf6, 0x83, 0x26, 0x47, 0xbc, 0xe2, 0xef, 0xac, 0x1c,
-	0xf1, 0x59, 0x1e, 0xd2, 0x8d, 0x3b, 0xf9, 0x08, 0xf1, 0x3e, 0x4d, 0x79, 0x43, 0x2b, 0xfb, 0x34,
-	0xcf, 0x91, 0x5a, 0xd9, 0xa7, 0xf9, 0x0e, 0xd5, 0x6d, 0x80, 0xd8, 0x59, 0x54, 0x11, 0x80, 0x29,
-	0x97, 0x53, 0x45, 0x00, 0xa6, 0x3d, 0x4c, 0xc9, 0xe6, 0xc8, 0x72, 0x09, 0x55, 0x36, 0xc7, 0x0c,
-	0x17, 0xd4, 0xc6, 0x5b, 0x97, 0xe2, 0x49, 0x06, 0x12, 0xe1, 0x22, 0xaa, 0x1a, 0x48, 0x12, 0x1e,
-	0xa7, 0x8d, 0x9b, 0xd9, 0x85, 0x9c, 0xce, 0x90, 0x3a, 0x26, 0x26, 0x3d, 0x41, 0xd1, 0xbd, 0x54,
-	0xa5, 0x2c, 0xaf, 0xd3, 0xc6, 0x9b, 0x97, 0xa1, 0x65, 0xb6, 0x12, 0x7b, 0xf6, 0x67, 0x57, 0x4f,
-	0x38, 0x9c, 0xe6, 0xb5, 0x92, 0xf4, 0x25, 0xa5, 0xb7, 0x89, 0x84, 0x27, 0xa8, 0x7a, 0x9b, 0xc8,
-	0x76, 0x34, 0x55, 0x6f, 0x13, 0x39, 0xae, 0xa4, 0x74, 0xbf, 0x28, 0xee, 0xa0, 0xea, 0x7e, 0xc9,
-	0xf2, 0x32, 0x55, 0xf7, 0x4b, 0xa6, 0x2f, 0x29, 0x55, 0x29, 0x63, 0xc7, 0x50, 0x74, 0x2b, 0x5d,
-	0x43, 0xf2, 0x31, 0x55, 0x55, 0xca, 0xb4, 0x3f, 0x29, 0xef, 0xa4, 0xe4, 0x12, 0x9a, 0xec, 0x64,
-	0xda, 0xd5, 0x34, 0xd9, 0xc9, 0x0c, 0x7f, 0xd2, 0x9d, 0x9f, 0x6b, 0xa4, 0x97, 0x78, 0x28, 0x64,
-	0x87, 0x0d, 0x28, 0x1d, 0xac, 0xa4, 0xdc, 0x7f, 0x72, 0x23, 0xa1, 0x94, 0xfb, 0xcf, 0x8c, 0x88,
-	0xa7, 0x36, 0x40, 0x1c, 0x5e, 0xa4, 0xec, 0xc9, 0x54, 0xa4, 0x52, 0xe3, 0x56, 0x4e, 0x29, 0xef,
-	0xfd, 0x6f, 0x42, 0x85, 0x79, 0x89, 0x4a, 0x4f, 0x02, 0xdc, 0x6d, 0x54, 0x39, 0x14, 0x54, 0x97,
-	0x59, 0xe5, 0x50, 0x48, 0x78, 0x99, 0xee, 0xfc, 0x0b, 0x0d, 0x2a, 0x8c, 0x4d, 0x04, 0xcd, 0x7d,
-	0x58, 0x96, 0xdc, 0xf6, 0x94, 0x75, 0x4c, 0xfb, 0x0e, 0x2a, 0xeb, 0x98, 0xe5, 0xed, 0xc7, 0xd6,
-	0x51, 0x26, 0x78, 0xe7, 0x32, 0x7f, 0xc4, 0xe4, 0x3a, 0x66, 0x90, 0xdd, 0x99, 0x40, 0x83, 0x5f,
-	0x1a, 0xa8, 0x17, 0x1f, 0xd7, 0x15, 0xc4, 0x10, 0x4c, 0xa8, 0x28, 0xce, 0x7d, 0x8a, 0xe8, 0xce,
-	0x72, 0x30, 0x54, 0x44, 0x77, 0xa6, 0x5f, 0xe0, 0xce, 0x9f, 0x87, 0x35, 0xb6, 0x22, 0xbc, 0x40,
-	0xb4, 0x75, 0x22, 0xe0, 0xaa, 0x03, 0x89, 0x22, 0x27, 0x67, 0xf8, 0xad, 0x28, 0x72, 0x72, 0x96,
-	0x27, 0xca, 0xd1, 0x22, 0xfd, 0x33, 0xac, 0x1f, 0xfc, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x60,
-	0xf1, 0xc6, 0x06, 0x93, 0x75, 0x00, 0x00,
+func init() { proto.RegisterFile("api.proto", fileDescriptor_api_8495174e6b66f558) }
+
+var fileDescriptor_api_8495174e6b66f558 = []byte{
+	// 10370 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x5d, 0x6c, 0x24, 0xc9,
+	0x91, 0x18, 0xac, 0xfe, 0x21, 0xd9, 0x1d, 0xfc, 0x6b, 0x16, 0xff, 0x7a, 0x8a, 0x33, 0x43, 0x4e,
+	0xcd, 0xee, 0xcc, 0x68, 0x67, 0x97, 0xbb, 0xe2, 0xae, 0xb4, 0x2b, 0xe9, 0xa4, 0x55, 0x0f, 0xd9,
+	0x33, 0xd3, 0x1a, 0x4e, 0x93, 0xaa, 0xe6, 0xcc, 0xee, 0x4a, 0xdf, 0x5d, 0xa1, 0xd8, 0x9d, 0x24,
+	0x4b, 0xd3, 0x5d, 0xd5, 0x5b, 0x55, 0x3d, 0x43, 0xee, 0xe7, 0x1f, 0x41, 0x06, 0xee, 0xc9, 0x77,
+	0xb6, 0xcf, 0xf0, 0x83, 0xe1, 0x3b, 0xc3, 0x86, 0xff, 0x0e, 0x30, 0xee, 0xce, 0x86, 0x0d, 0xe3,
+	0xe0, 0x33, 0x8c, 0xb3, 0x61, 0xd8, 0x30, 0x0e, 0xc6, 0xe1, 0xec, 0x07, 0x3f, 0xf8, 0xcd, 0x80,
+	0x9f, 0x0c, 0xf8, 0x00, 0x3f, 0x18, 0x36, 0x0c, 0xd8, 0x46, 0x66, 0x46, 0x56, 0x65, 0xd6, 0x4f,
+	0xb3, 0xb9, 0x5a, 0x01, 0x96, 0x60, 0xbe, 0xb0, 0x33, 0x32, 0x32, 0xf2, 0x2f, 0x32, 0x32, 0x33,
+	0x22, 0x32, 0x0a, 0xaa, 0xf6, 0xd0, 0xd9, 0x1e, 0xfa, 0x5e, 0xe8, 0x69, 0xd5, 0x57, 0x76, 0xbf,
+	0x4f, 0x42, 0x7f, 0xd8, 0x35, 0x6a, 0xb0, 0xf0, 0x9c, 0xf8, 0x81, 0xe3, 0xb9, 0x26, 0xf9, 0x74,
+	0x44, 0x82, 0xd0, 0xf8, 0x67, 0x05, 0x58, 0x8c, 0x40, 0xc1, 0xd0, 0x73, 0x03, 0xa2, 0xbd, 0x0e,
+	0x0b, 0x2f, 0x39, 0xc8, 0x0a, 0x42, 0xdf, 0x71, 0x4f, 0xeb, 0x85, 0xad, 0xc2, 0xbd, 0xaa, 0x39,
+	0x8f, 0xd0, 0x0e, 0x03, 0x6a, 0x2b, 0x30, 0x35, 0xb0, 0x7f, 0xe8, 0xf9, 0xf5, 0xe2, 0x56, 0xe1,
+	0xde, 0xbc, 0xc9, 0x13, 0x0c, 0xea, 0xb8, 0x9e, 0x5f, 0x2f, 0x21, 0xd4, 0x71, 0x39, 0x74, 0x68,
+	0x87, 0xdd, 0xb3, 0x7a, 0x99, 0x43, 0x59, 0x42, 0xbb, 0x09, 0x30, 0xf4, 0x89, 0x4f, 0xfa, 0xc4,
+	0x0e, 0x48, 0x7d, 0x8a, 0x55, 0x22, 0x41, 0x68, 0x43, 0x8e, 0x47, 0x4e, 0xbf, 0x67, 0x0d, 0x48,
+	0x68, 0xf7, 0xec, 0xd0, 0xae, 0x4f, 0xf3, 0x86, 0x30, 0xe8, 0x53, 0x04, 0x1a, 0x7f, 0x38, 0x05,
+	0xda, 0x91, 0x6f, 0xbb, 0x81, 0xdd, 0x0d, 0x1d, 0xcf, 0xdd, 0x23, 0xa1, 0xed, 0xf4, 0x03, 0x4d,
+	0x83, 0xf2, 0x99, 0x1d, 0x9c, 0xb1, 0xc6, 0xcf, 0x99, 0xec, 0xb7, 0xb6, 0x05, 0xb3, 0x61, 0x8c,
+	0xc9, 0x5a, 0x3e, 0x67, 0xca, 0x20, 0xed, 0x9b, 0x30, 0xdd, 0x23, 0xc7, 0x4e, 0x18, 0xd4, 0x4b,
+	0x5b, 0xa5, 0x7b, 0xb3, 0x3b, 0xb7, 0xb7, 0xa3, 0xe1, 0xdb, 0x4e, 0x57, 0xb2, 0xdd, 0x72, 0x87,
+	0xa3, 0xd0, 0xc4, 0x22, 0xda, 0xb7, 0x61, 0xa6, 0xeb, 0x93, 0x1e, 0x2d, 0x5d, 0x66, 0xa5, 0x5f,
+	0x1b, 0x5f, 0xfa, 0x60, 0x14, 0xd2, 0xe2, 0xa2, 0x90, 0x56, 0x83, 0xd2, 0x09, 0xe1, 0x23, 0x51,
+	0x32, 0xe9, 0x4f, 0xed, 0x3a, 0x54, 0x43, 0x67, 0x40, 0x82, 0xd0, 0x1e, 0x0c, 0x59, 0xef, 0x4b,
+	0x66, 0x0c, 0xd0, 0x3e, 0x86, 0x9a, 0xd4, 0x76, 0x2b, 0xbc, 0x18, 0x92, 0xfa, 0xcc, 0x56, 0xe1,
+	0xde, 0xc2, 0xce, 0x5b, 0xe3, 0x2b, 0x96, 0x40, 0x47, 0x17, 0x43, 0x62, 0x2e, 0x86, 0x2a, 0x40,
+	0xff, 0x14, 0xa6, 0x58, 0xd7, 0xe8, 0xcc, 0x39, 0x6e, 0x8f, 0x9c, 0xb3, 0x61, 0x9c, 0x37, 0x79,
+	0x42, 0xfb, 0x32, 0xd4, 0x86, 0x3e, 0x79, 0xe9, 0x78, 0xa3, 0xc0, 0xb2, 0xbb, 0x5d, 0x6f, 0xe4,
+	0x86, 0xc8, 0x06, 0x8b, 0x02, 0xde, 0xe0, 0x60, 0xed, 0x2e, 0x2c, 0xc6, 0xa8, 0x03, 0x86, 0x59,
+	0x62, 0xfd, 0x58, 0x88, 0x30, 0x19, 0x54, 0xff, 0xbb, 0x05, 0x98, 0xe6, 0x03, 0x92, 0x53, 0x69,
+	0x1d, 0x66, 0xd4, 0xba, 0x44, 0x52, 0xd3, 0xa1, 0xe2, 0xb8, 0x21, 0xf1, 0x5d, 0xbb, 0xcf, 0x88,
+	0x57, 0xcc, 0x28, 0xad, 0xad, 0xc1, 0x34, 0x56, 0x5b, 0x66, 0xd5, 0x62, 0x8a, 0x51, 0xeb, 0xf5,
+	0x7c, 0x12, 0x04, 0xc8, 0x79, 0x22, 0xa9, 0xdd, 0x86, 0x79, 0x8f, 0xb5, 0xc3, 0x0a, 0xba, 0xbe,
+	0x33, 0x0c, 0xd9, 0xb8, 0xcf, 0x99, 0x73, 0x1c, 0xd8, 0x61, 0x30, 0xe3, 0x07, 0xb0, 0x98, 0x18,
+	0x44, 0x6d, 0x16, 0x66, 0xcc, 0xe6, 0xa3, 0x67, 0xfb, 0x0d, 0xb3, 0xf6, 0x25, 0x6d, 0x0e, 0x2a,
+	0xbb, 0x07, 0xad, 0xf6, 0x83, 0x46, 0xa7, 0x59, 0x2b, 0x6b, 0xcb, 0xb0, 0x78, 0xd4, 0xda, 0x7d,
+	0xd2, 0x3c, 0xb2, 0x0e, 0x9f, 0x99, 0xbb, 0x8f, 0x29, 0xb0, 0xa0, 0x55, 0xa0, 0xfc, 0xfc, 0xe0,
+	0xa8, 0x59, 0x2b, 0x6a, 0x0b, 0x00, 0x66, 0xf3, 0xf9, 0xc1, 0x6e, 0xe3, 0xa8, 0x75, 0xd0, 0xae,
+	0x95, 0x8c, 0x7f, 0x51, 0x80, 0xb9, 0x07, 0x7d, 0xaf, 0xfb, 0x62, 0x1c, 0x2f, 0xaf, 0xc1, 0xf4,
+	0x19, 0x71, 0x4e, 0xcf, 0xf8, 0x68, 0x4c, 0x99, 0x98, 0x52, 0x59, 0xa6, 0x94, 0x64, 0x99, 0xbb,
+	0xb0, 0x68, 0x0f, 0x87, 0xbe, 0xf7, 0x92, 0x04, 0xd6, 0xd0, 0xf6, 0x89, 0x1b, 0xb2, 0xee, 0x57,
+	0xcc, 0x05, 0x01, 0x3e, 0x64, 0x50, 0xad, 0x01, 0x73, 0x12, 0x53, 0x08, 0x86, 0xbe, 0x31, 0x96,
+	0xaf, 0x4c, 0xa5, 0x88, 0x71, 0x00, 0x0b, 0xc8, 0x05, 0x0f, 0xec, 0xbe, 0xed, 0x76, 0x89, 0x3c,
+	0x85, 0x05, 0x75, 0x0a, 0x6f, 0xc3, 0x7c, 0xe8, 0x85, 0x76, 0xdf, 0x3a, 0xe6, 0xa8, 0xac, 0x53,
+	0x25, 0x73, 0x8e, 0x01, 0xb1, 0xb8, 0x31, 0x0f, 0xb3, 0x87, 0x8e, 0x7b, 0x2a, 0x84, 0xd7, 0x02,
+	0xcc, 0xf1, 0x24, 0x17, 0x5c, 0x54, 0xbc, 0xb5, 0x49, 0xf8, 0xca, 0xf3, 0x5f, 0x08, 0x8c, 0x0f,
+	0x60, 0x31, 0x82, 0xc4, 0xd2, 0x8d, 0xb6, 0xef, 0x25, 0xb1, 0x5c, 0x9e, 0x83, 0x2d, 0x99, 0xe7,
+	0x50, 0x44, 0x37, 0xbe, 0x0e, 0x2b, 0xd8, 0xf6, 0xf6, 0x68, 0x70, 0x4c, 0x7c, 0xa4, 0xa8, 0xdd,
+	0x82, 0x39, 0x6c, 0xb2, 0xe5, 0xda, 0x03, 0x82, 0xa2, 0x71, 0x16, 0x61, 0x6d, 0x7b, 0x40, 0x8c,
+	0x6f, 0xc3, 0x6a, 0xa2, 0xa8, 0x5c, 0x35, 0x96, 0x65, 0x39, 0x71, 0xd5, 0x12, 0xba, 0xb1, 0x04,
+	0x8b, 0x58, 0x3e, 0x10, 0xfd, 0xf8, 0xdd, 0x12, 0xd4, 0x62, 0x18, 0x92, 0xfb, 0x10, 0x2a, 0x58,
+	0x30, 0xa8, 0x17, 0x52, 0xc2, 0x2a, 0x89, 0x2e, 0x00, 0x66, 0x54, 0x48, 0x7b, 0x13, 0xb4, 0xee,
+	0xc8, 0xa7, 0xb3, 0x6d, 0x1d, 0x53, 0x6e, 0xb3, 0x18, 0x8f, 0x71, 0xa1, 0x58, 0xc3, 0x1c, 0xc6,
+	0x86, 0x8f, 0x29, 0xbf, 0xbd, 0x03, 0x2b, 0x09, 0x6c, 0xce, 0x7d, 0x25, 0xc6, 0x7d, 0x9a, 0x82,
+	0xcf, 0x72, 0xf4, 0x1f, 0x17, 0x61, 0x46, 0x88, 0x81, 0xc9, 0xfa, 0x9e, 0x1a, 0xde, 0x62, 0x6a,
+	0x78, 0xd3, 0x9c, 0x52, 0x4a, 0x73, 0x0a, 0xed, 0x1a, 0x39, 0xe7, 0x12, 0xc0, 0x7a, 0x41, 0x2e,
+	0xac, 0x6e, 0x24, 0x01, 0xe6, 0xcd, 0x9a, 0xc8, 0x79, 0x42, 0x2e, 0x76, 0x59, 0xe3, 0xde, 0x04,
+	0xcd, 0x71, 0x53, 0xd8, 0x53, 0x1c, 0xdb, 0x71, 0x33, 0xb0, 0x07, 0x43, 0xcf, 0x0f, 0x49, 0x4f,
+	0xc2, 0x9e, 0x46, 0x6c, 0xcc, 0x11, 0xd8, 0xc6, 0xc7, 0xb0, 0x62, 0x12, 0xda, 0x17, 0x31, 0xfe,
+	0xc8, 0x48, 0x13, 0x0e, 0xc8, 0x35, 0xa8, 0xb8, 0xe4, 0x95, 0x3c, 0x18, 0x33, 0x2e, 0x79, 0xc5,
+	0xf8, 0x6c, 0x1d, 0x56, 0x13, 0x94, 0x71, 0x1d, 0x7c, 0x0f, 0xe6, 0x4d, 0x12, 0x74, 0x6d, 0x57,
+	0x62, 0xda, 0x63, 0x72, 0xea, 0xb8, 0x62, 0xca, 0x0a, 0x6c, 0xca, 0x66, 0x19, 0x8c, 0xcf, 0x95,
+	0x76, 0x03, 0x00, 0x51, 0x62, 0x1e, 0xa8, 0x72, 0x04, 0x3b, 0x38, 0x33, 0xbe, 0x05, 0x0b, 0x82,
+	0x24, 0x72, 0xdf, 0x7d, 0x58, 0xf2, 0x19, 0xc4, 0x25, 0x3d, 0x2b, 0x3c, 0xf3, 0xbd, 0xd1, 0xe9,
+	0x19, 0x12, 0xae, 0x45, 0x19, 0x47, 0x1c, 0x6e, 0xfc, 0xb7, 0x02, 0x68, 0x6d, 0x72, 0x1e, 0x26,
+	0xc6, 0x80, 0x1e, 0x00, 0xec, 0x20, 0x18, 0x9e, 0xf9, 0xf4, 0x00, 0xc0, 0x85, 0x9b, 0x04, 0x99,
+	0x84, 0x1b, 0xde, 0x02, 0x4d, 0xa0, 0x48, 0xa4, 0x4a, 0x8c, 0xd4, 0x12, 0xe6, 0x1c, 0xc6, 0x14,
+	0xe9, 0xd9, 0xc6, 0x0b, 0x1d, 0xf7, 0x34, 0xda, 0xb6, 0xca, 0x4c, 0xfa, 0xcd, 0x73, 0xa8, 0xe0,
+	0xd6, 0x47, 0xb0, 0x65, 0x77, 0x5f, 0xb8, 0xde, 0xab, 0x3e, 0xe9, 0x9d, 0x12, 0xcb, 0xf5, 0x42,
+	0x2b, 0x20, 0xa4, 0x67, 0xf9, 0xa4, 0xeb, 0xbd, 0x24, 0xbe, 0x7d, 0xdc, 0x27, 0x28, 0x36, 0x6f,
+	0x48, 0x78, 0x6d, 0x2f, 0xec, 0x10, 0xd2, 0x33, 0x63, 0x24, 0xe3, 0x17, 0x60, 0x59, 0xe9, 0xf7,
+	0xd5, 0x24, 0xc1, 0xbf, 0x29, 0xe2, 0xb0, 0xf1, 0x9d, 0x49, 0x0c, 0x5b, 0xbe, 0x14, 0xfd, 0x1a,
+	0x94, 0x5f, 0x38, 0x6e, 0x8f, 0x0d, 0xd4, 0xc2, 0x8e, 0x21, 0x89, 0x83, 0x34, 0x99, 0xed, 0x27,
+	0x8e, 0xdb, 0x33, 0x19, 0xbe, 0xf6, 0x10, 0xe0, 0xd4, 0x1e, 0x5a, 0x43, 0xaf, 0xef, 0x74, 0x2f,
+	0xd8, 0xe8, 0x2d, 0xec, 0xdc, 0x1d, 0x5f, 0xfa, 0x91, 0x3d, 0x3c, 0x64, 0xe8, 0x66, 0xf5, 0x54,
+	0xfc, 0x34, 0x76, 0xa0, 0x4c, 0xa9, 0x6a, 0x2b, 0x50, 0x7b, 0xd0, 0x3a, 0x7c, 0xe7, 0x9d, 0xf7,
+	0xde, 0xb3, 0x9a, 0x1f, 0x1f, 0x35, 0xcd, 0x76, 0x63, 0xbf, 0xf6, 0x25, 0x19, 0xda, 0x6a, 0x23,
+	0xb4, 0x60, 0x38, 0x50, 0x8d, 0x68, 0x69, 0x3a, 0xac, 0x3d, 0x6a, 0x1c, 0x5a, 0x87, 0x07, 0xfb,
+	0xad, 0xdd, 0x4f, 0xac, 0x67, 0xed, 0xce, 0x61, 0x73, 0xb7, 0xf5, 0xb0, 0xd5, 0xdc, 0xe3, 0xc5,
+	0xa5, 0xbc, 0xa6, 0x69, 0x1e, 0x98, 0xb5, 0x82, 0xb6, 0x0a, 0x4b, 0x12, 0xb4, 0xf5, 0xa8, 0x7d,
+	0x60, 0xd2, 0x2d, 0x75, 0x19, 0x16, 0x25, 0xf0, 0x47, 0x66, 0xe3, 0xb0, 0x56, 0x32, 0xda, 0xb0,
+	0xac, 0xf4, 0x04, 0x67, 0x43, 0x3a, 0x0a, 0x14, 0xd4, 0xa3, 0xc0, 0x0d, 0x80, 0xe1, 0xe8, 0xb8,
+	0xef, 0x74, 0xe9, 0x42, 0x47, 0xf6, 0xab, 0x72, 0xc8, 0x13, 0x72, 0x61, 0xfc, 0xbd, 0x02, 0xac,
+	0xb7, 0xd8, 0x82, 0x3f, 0xf4, 0x9d, 0x97, 0x76, 0x48, 0x9e, 0x90, 0x8b, 0x49, 0x79, 0x3b, 0xff,
+	0x34, 0x73, 0x87, 0x9e, 0x98, 0x18, 0x39, 0x26, 0x5e, 0x5e, 0x39, 0x27, 0x6c, 0x46, 0xaa, 0xe6,
+	0xfc, 0x30, 0xaa, 0xe5, 0x23, 0xe7, 0x84, 0x1e, 0x00, 0xf8, 0x42, 0x43, 0x1e, 0xc6, 0x94, 0xb6,
+	0x01, 0x55, 0xfa, 0xdf, 0x3a, 0xf1, 0xbd, 0x01, 0xe3, 0xd2, 0x29, 0xb3, 0x42, 0x01, 0x0f, 0x7d,
+	0x6f, 0x60, 0xe8, 0x50, 0x4f, 0xb7, 0x18, 0xe5, 0xc6, 0xdf, 0x2f, 0xc0, 0x32, 0xcf, 0xe4, 0x87,
+	0x9c, 0x49, 0xbb, 0xb2, 0x06, 0xd3, 0x78, 0x52, 0xe2, 0x72, 0x03, 0x53, 0x52, 0x03, 0x4b, 0xf9,
+	0x0d, 0x2c, 0xab, 0x0d, 0xa4, 0x0b, 0xda, 0x27, 0x9f, 0x8e, 0x1c, 0x9f, 0x58, 0x3e, 0xe9, 0x11,
+	0x32, 0x90, 0x16, 0xdb, 0x12, 0xe6, 0x98, 0x51, 0x86, 0xf1, 0x09, 0xac, 0xa8, 0x4d, 0xc6, 0x39,
+	0xbd, 0x05, 0x73, 0xc3, 0x9d, 0xe0, 0xcc, 0x52, 0x27, 0x76, 0x96, 0xc2, 0x70, 0xfa, 0x69, 0xb7,
+	0xa4, 0x1a, 0x8a, 0xac, 0x06, 0x09, 0x62, 0xb8, 0xb0, 0x80, 0xdb, 0xc9, 0x15, 0x65, 0xf6, 0x57,
+	0x61, 0x0d, 0x1b, 0xda, 0xb3, 0xba, 0x9e, 0x7b, 0xe2, 0xf8, 0x03, 0x9b, 0x1f, 0xa2, 0xf8, 0x49,
+	0x6d, 0x55, 0xe4, 0xee, 0xca, 0x99, 0xc6, 0xdf, 0x28, 0xc2, 0x62, 0x54, 0x21, 0x76, 0x63, 0x05,
+	0xa6, 0xd8, 0xbe, 0xc6, 0x2a, 0x2a, 0x99, 0x3c, 0x41, 0x8f, 0x78, 0xc1, 0x90, 0xb8, 0xbd, 0xa8,
+	0xe1, 0x25, 0x33, 0x06, 0xd0, 0x23, 0x9e, 0x33, 0x18, 0xd8, 0xe1, 0x88, 0x0d, 0xe1, 0x2b, 0xdb,
+	0xef, 0x89, 0x13, 0xb7, 0x00, 0x9b, 0x0c, 0xaa, 0x7d, 0x03, 0xae, 0x45, 0x88, 0x41, 0x68, 0xbf,
+	0x20, 0xd6, 0x29, 0x71, 0x89, 0xcf, 0x9a, 0x83, 0xa7, 0xe5, 0x75, 0x81, 0xd0, 0xa1, 0xf9, 0x8f,
+	0xa2, 0x6c, 0xed, 0x0d, 0x58, 0xa2, 0x3b, 0x3d, 0xe9, 0x59, 0xc7, 0x17, 0x56, 0xe8, 0x74, 0x5f,
+	0x90, 0x30, 0xc0, 0x8b, 0xcb, 0x22, 0xcf, 0x78, 0x70, 0x71, 0xc4, 0xc1, 0xf4, 0xb6, 0x20, 0x84,
+	0xee, 0x28, 0x3c, 0xf3, 0x7c, 0x27, 0xbc, 0xc0, 0xbb, 0xcc, 0x22, 0x8a, 0x5d, 0x01, 0xa6, 0x17,
+	0xb4, 0x91, 0x8b, 0x63, 0x46, 0x7a, 0xec, 0x32, 0x53, 0x32, 0x65, 0x90, 0xf1, 0x00, 0x56, 0x1f,
+	0x91, 0x50, 0x3a, 0x7b, 0x8a, 0xc9, 0xf9, 0xb2, 0x7a, 0x19, 0x92, 0xce, 0xcb, 0xf2, 0xed, 0x86,
+	0xed, 0x66, 0x7f, 0xb5, 0x00, 0x6b, 0x49, 0x22, 0xd1, 0xa1, 0x4a, 0xb9, 0x21, 0x52, 0x02, 0x97,
+	0x9e, 0x7a, 0xe5, 0x12, 0xda, 0x6b, 0x30, 0x9f, 0x35, 0xe7, 0x2a, 0x90, 0x6d, 0xb7, 0xf1, 0x91,
+	0xab, 0x84, 0xdb, 0xad, 0x38, 0x6b, 0x19, 0xff, 0xb6, 0x98, 0x6c, 0x60, 0x24, 0xfc, 0xb7, 0x61,
+	0x39, 0x08, 0x6d, 0x9f, 0x0d, 0xa7, 0x44, 0x82, 0xf7, 0x74, 0x49, 0x64, 0xc5, 0xc7, 0xb6, 0x1d,
+	0x58, 0x4d, 0xe2, 0xc7, 0xb7, 0x86, 0x25, 0x73, 0x59, 0x2d, 0xc1, 0xb2, 0xe8, 0xe4, 0x12, 0xb7,
+	0x97, 0xa8, 0x81, 0x37, 0x72, 0x91, 0x67, 0xc4, 0xf4, 0xb7, 0x61, 0x59, 0xc5, 0xe5, 0xd4, 0xf9,
+	0xb2, 0x5e, 0x92, 0xb1, 0x39, 0xed, 0x6f, 0xc3, 0xc6, 0xc0, 0x71, 0x9d, 0xc1, 0x68, 0x40, 0x77,
+	0x53, 0x7a, 0x9a, 0x54, 0xae, 0x19, 0x5c, 0x5e, 0x5d, 0x43, 0x14, 0x93, 0x61, 0xc8, 0xc3, 0xa0,
+	0x7d, 0x00, 0xf5, 0xd0, 0xf6, 0x4f, 0x89, 0x52, 0x4e, 0x3a, 0x83, 0x4d, 0x99, 0x6b, 0x3c, 0x5f,
+	0x2a, 0xc5, 0x4f, 0x62, 0xff, 0xa0, 0x00, 0xeb, 0xa9, 0x41, 0xc5, 0x69, 0x7f, 0x08, 0xda, 0xc0,
+	0x61, 0x27, 0x19, 0xb9, 0x31, 0x7c, 0xf6, 0xd7, 0xa5, 0xd9, 0x97, 0x6f, 0x65, 0xe6, 0x12, 0x2b,
+	0xa2, 0xb4, 0xee, 0x10, 0x56, 0x46, 0x6e, 0x06, 0xa5, 0xe2, 0x24, 0xb7, 0xa7, 0x65, 0x2c, 0x2a,
+	0x53, 0x34, 0xde, 0x85, 0x1a, 0x6d, 0x34, 0x5b, 0x4a, 0x82, 0x07, 0x36, 0x61, 0x96, 0x2f, 0x39,
+	0x79, 0xee, 0x81, 0x83, 0x18, 0xff, 0xfc, 0x99, 0x22, 0x2c, 0x45, 0xa5, 0x7e, 0x6e, 0x58, 0x67,
+	0x1b, 0x96, 0xc5, 0xd4, 0xf3, 0xde, 0xc7, 0xe7, 0xf4, 0x29, 0x73, 0x09, 0x67, 0x9d, 0xe5, 0xf0,
+	0x09, 0xff, 0xd7, 0x65, 0xd0, 0xe4, 0x51, 0xc0, 0xb9, 0xde, 0x85, 0x69, 0x5e, 0x1e, 0xe7, 0xf7,
+	0xbe, 0x34, 0x2b, 0x69, 0xf4, 0x6d, 0x9e, 0x16, 0x73, 0x84, 0x45, 0xb5, 0xef, 0xc0, 0x14, 0x6b,
+	0x34, 0x1b, 0x8b, 0xd9, 0x9d, 0x37, 0xc6, 0xd3, 0x50, 0xd8, 0x86, 0x17, 0xd4, 0xff, 0xa8, 0x08,
+	0xf3, 0x0a, 0x6d, 0xed, 0xab, 0x89, 0x86, 0x5d, 0xc2, 0x2e, 0xa2, 0x29, 0xef, 0xc3, 0x0c, 0x13,
+	0xfe, 0xc4, 0xaf, 0x17, 0x27, 0x29, 0x27, 0xb0, 0xb5, 0x5f, 0x84, 0x79, 0x1c, 0xc8, 0x20, 0xb4,
+	0xc3, 0x51, 0x80, 0x07, 0xbf, 0x0f, 0xae, 0x30, 0x1e, 0x98, 0xea, 0xb0, 0xf2, 0xe6, 0x5c, 0x28,
+	0xa5, 0x8c, 0x4f, 0x61, 0x4e, 0xce, 0xa5, 0xfa, 0x91, 0x67, 0xed, 0x27, 0xed, 0x83, 0x8f, 0xda,
+	0xb5, 0x2f, 0xf1, 0xc4, 0xd3, 0x56, 0xbb, 0xb9, 0x57, 0x2b, 0x50, 0x65, 0x49, 0xeb, 0xe9, 0xd3,
+	0xc6, 0xd1, 0x33, 0x76, 0x74, 0xab, 0x40, 0x79, 0xbf, 0xf5, 0xbc, 0x59, 0x2b, 0x69, 0x55, 0x98,
+	0xa2, 0x1a, 0x92, 0xbd, 0x5a, 0x59, 0x03, 0x98, 0x7e, 0xda, 0xea, 0x74, 0x9a, 0x7b, 0xb5, 0x29,
+	0x5a, 0xb6, 0xf9, 0xf1, 0x61, 0xcb, 0x6c, 0xee, 0xd5, 0xa6, 0xb9, 0xd6, 0xe5, 0xf9, 0xc1, 0x93,
+	0xe6, 0x5e, 0x6d, 0x46, 0xff, 0xf8, 0xa7, 0xa5, 0x37, 0x31, 0x56, 0x40, 0xe3, 0x9d, 0x39, 0xf4,
+	0x9d, 0xe8, 0x40, 0x60, 0x1c, 0xc2, 0xb2, 0x02, 0x8d, 0x0f, 0x1f, 0x38, 0xb0, 0x43, 0x0a, 0xc7,
+	0xcd, 0x7b, 0x36, 0x8c, 0x51, 0xf3, 0x5a, 0x61, 0x68, 0x50, 0x63, 0x5b, 0x6d, 0xcb, 0x3d, 0xf1,
+	0x44, 0x2d, 0x7f, 0x54, 0x84, 0x25, 0x09, 0x88, 0x95, 0x6c, 0x40, 0x75, 0xe8, 0x79, 0x7d, 0x2b,
+	0x70, 0x3e, 0x23, 0x78, 0x0e, 0xa9, 0x50, 0x40, 0xc7, 0xf9, 0x8c, 0xd0, 0x33, 0xa4, 0xdd, 0xef,
+	0x5b, 0x03, 0x32, 0x60, 0x38, 0xa1, 0x73, 0x8e, 0xa7, 0xcc, 0x79, 0xbb, 0xdf, 0x7f, 0xca, 0xa1,
+	0x47, 0xce, 0x39, 0xc5, 0xf3, 0x5e, 0xb9, 0x0a, 0x1e, 0x57, 0xdc, 0xce, 0x7b, 0xaf, 0x5c, 0x09,
+	0x8f, 0x6a, 0xd8, 0xf0, 0x24, 0x80, 0xb7, 0xe8, 0x28, 0x4d, 0x07, 0xb9, 0xef, 0xbc, 0x24, 0x78,
+	0x5f, 0x66, 0xbf, 0xe9, 0xb9, 0xe5, 0xa5, 0x17, 0x92, 0x1e, 0x5e, 0x8b, 0x79, 0x82, 0x76, 0x7a,
+	0xe0, 0x04, 0x01, 0x6e, 0xec, 0xf3, 0x26, 0xa6, 0xe8, 0x59, 0xd8, 0x27, 0x2f, 0xbd, 0x17, 0xa4,
+	0x57, 0xaf, 0xf0, 0xb3, 0x30, 0x26, 0x69, 0x0e, 0x39, 0x1f, 0xd2, 0xb3, 0x52, 0xbd, 0xca, 0x73,
+	0x30, 0x19, 0xab, 0x01, 0x82, 0xd1, 0x71, 0xe0, 0xf4, 0x2e, 0xea, 0x20, 0xa9, 0x01, 0x3a, 0x1c,
+	0x46, 0x8b, 0x8f, 0x5c, 0xca, 0xee, 0x61, 0x7d, 0x96, 0x17, 0xc7, 0xa4, 0x71, 0x04, 0x35, 0xc6,
+	0x29, 0xd2, 0x38, 0x27, 0x36, 0xe5, 0x42, 0x62, 0x53, 0x66, 0xb7, 0xe8, 0xa4, 0x14, 0xa4, 0xb7,
+	0xe8, 0x58, 0x42, 0x19, 0xbf, 0x56, 0x84, 0x25, 0x89, 0x2c, 0xce, 0xd4, 0x4f, 0x4c, 0x37, 0x7d,
+	0xa8, 0x28, 0x65, 0x1d, 0x2a, 0x14, 0x0e, 0x2e, 0x27, 0x35, 0x7f, 0x52, 0x35, 0x36, 0x95, 0x15,
+	0x53, 0x5c, 0xf9, 0x8d, 0xd5, 0x50, 0x10, 0xbd, 0xd3, 0xf3, 0x73, 0xa0, 0xe3, 0xbe, 0xb4, 0xfb,
+	0x4e, 0xcf, 0x16, 0x33, 0x58, 0x31, 0x6b, 0x01, 0x67, 0xc0, 0x08, 0x9e, 0xa5, 0x49, 0x9c, 0xc9,
+	0xd2, 0x24, 0x1a, 0x7f, 0xae, 0x04, 0xeb, 0xbb, 0x67, 0xb6, 0x7b, 0x4a, 0xe2, 0x8b, 0xb8, 0x18,
+	0xf2, 0x0f, 0xa0, 0x44, 0x6f, 0x56, 0x05, 0x26, 0x78, 0xee, 0x48, 0x82, 0x27, 0xa7, 0xc0, 0x36,
+	0xbd, 0xaf, 0xd0, 0x22, 0xf4, 0x2c, 0xee, 0xf5, 0x7b, 0xf2, 0xa5, 0x9f, 0x5f, 0x3e, 0xe6, 0xbd,
+	0x7e, 0x4f, 0xbd, 0xf0, 0x53, 0xfd, 0x49, 0x4a, 0x37, 0x30, 0xef, 0x92, 0x57, 0x87, 0x99, 0xb7,
+	0xb1, 0xb2, 0x7a, 0x1b, 0xa3, 0x2d, 0xec, 0x9d, 0xd4, 0xa7, 0x26, 0x6f, 0xe1, 0xde, 0x43, 0x93,
+	0x16, 0xd1, 0x96, 0x61, 0xea, 0x45, 0xef, 0xc4, 0x72, 0x71, 0x0d, 0x94, 0x5f, 0xf4, 0x4e, 0xda,
+	0x02, 0xe8, 0xd7, 0x67, 0x22, 0xa0, 0x29, 0x80, 0xc3, 0x7a, 0x25, 0x02, 0x1e, 0x1a, 0xf7, 0xa1,
+	0xf4, 0x84, 0x5c, 0x50, 0xf9, 0x76, 0x68, 0xb6, 0x9e, 0x37, 0x8e, 0x9a, 0xb5, 0x2f, 0x51, 0x29,
+	0x78, 0xf8, 0xec, 0xc1, 0x7e, 0x6b, 0xb7, 0x56, 0xa0, 0x19, 0x8d, 0xdd, 0xdd, 0x83, 0x67, 0xed,
+	0xa3, 0x5a, 0xd1, 0x78, 0x13, 0x4a, 0x4f, 0xf6, 0x1e, 0x52, 0xd8, 0x5e, 0xf3, 0x61, 0xe3, 0xd9,
+	0xfe, 0x11, 0x47, 0xee, 0xec, 0x9a, 0x9f, 0x1c, 0x1e, 0x71, 0x09, 0xdb, 0x30, 0x1f, 0x1d, 0xb4,
+	0x77, 0x5a, 0x7b, 0xb5, 0x22, 0xbd, 0x04, 0xa6, 0x5b, 0x8f, 0x97, 0xc0, 0x1f, 0x15, 0x61, 0xed,
+	0xe1, 0xc8, 0xed, 0x65, 0x9c, 0xb0, 0xc7, 0x6b, 0x6f, 0xf9, 0xce, 0x8c, 0xba, 0x76, 0xa1, 0xbd,
+	0x65, 0x40, 0xae, 0xe0, 0x1f, 0x73, 0x2d, 0x2a, 0x8d, 0xb9, 0x16, 0x69, 0xbf, 0x00, 0xba, 0xe3,
+	0x76, 0xfb, 0xa3, 0x1e, 0xb1, 0xa2, 0xdb, 0x4a, 0xd7, 0x73, 0xdc, 0x63, 0x3b, 0x20, 0x01, 0x5e,
+	0x7d, 0xeb, 0x88, 0xd1, 0x42, 0x84, 0x5d, 0x91, 0x4f, 0xcf, 0x30, 0xa2, 0x74, 0x97, 0x75, 0x59,
+	0x28, 0xf5, 0xf9, 0x8d, 0x72, 0x19, 0x33, 0xf9, 0x70, 0xa0, 0x6e, 0xff, 0x1f, 0x95, 0x60, 0x3d,
+	0x35, 0x04, 0xb8, 0x96, 0xff, 0x3f, 0xa8, 0x05, 0xa4, 0x4f, 0xba, 0x54, 0xf9, 0xc7, 0x0d, 0x02,
+	0x42, 0xf9, 0xfa, 0x15, 0x89, 0x37, 0x72, 0x4a, 0x6f, 0x1f, 0xa2, 0xc9, 0x03, 0x0d, 0x3f, 0x8b,
+	0x82, 0x14, 0x4f, 0x07, 0x6c, 0xe3, 0x60, 0x42, 0x4d, 0x19, 0xc6, 0x59, 0x06, 0xc3, 0x51, 0xbc,
+	0x07, 0x35, 0xec, 0xc8, 0xf0, 0x85, 0xe8, 0x0b, 0x67, 0xe9, 0x05, 0x0e, 0x3f, 0x7c, 0xc1, 0xbb,
+	0xa1, 0xff, 0x71, 0x01, 0x16, 0xd4, 0x0a, 0xaf, 0x70, 0x47, 0xa2, 0x4d, 0x41, 0x2b, 0x08, 0x37,
+	0xc5, 0xf0, 0xed, 0x63, 0x96, 0xc3, 0x5a, 0x14, 0x24, 0x99, 0x56, 0x4a, 0x8a, 0x69, 0x85, 0xee,
+	0x4c, 0x51, 0xdb, 0xca, 0x8c, 0x7c, 0x65, 0x88, 0xad, 0xa2, 0x74, 0xe9, 0xb9, 0x9f, 0x2a, 0xe0,
+	0xa9, 0x6c, 0xc2, 0x3b, 0xe3, 0x2c, 0xc2, 0x8e, 0x1c, 0xae, 0xe1, 0xa5, 0xaa, 0x81, 0x68, 0x96,
+	0x51, 0x04, 0xcd, 0x51, 0xa0, 0x98, 0x59, 0xba, 0xeb, 0x84, 0x3e, 0xe1, 0xf6, 0xae, 0x29, 0x93,
+	0xfd, 0x36, 0xfe, 0xa0, 0x00, 0xab, 0xcf, 0xb8, 0x80, 0xc7, 0x11, 0xfd, 0x19, 0x66, 0x5d, 0xe3,
+	0x2f, 0x15, 0x13, 0xbd, 0x89, 0x98, 0xf0, 0xe7, 0x7b, 0x1a, 0xe9, 0x7e, 0xc9, 0x9b, 0x60, 0x05,
+	0xa3, 0x01, 0x93, 0x89, 0x25, 0xb3, 0xca, 0x21, 0x9d, 0xd1, 0xc0, 0xf8, 0xd1, 0x34, 0x6c, 0xec,
+	0x7a, 0x6e, 0x10, 0xfa, 0xa3, 0x6e, 0x96, 0x22, 0xe0, 0x75, 0x58, 0x08, 0xbc, 0x91, 0xdf, 0x25,
+	0x96, 0x3a, 0xe5, 0xf3, 0x1c, 0x2a, 0x74, 0xbc, 0x9f, 0x4f, 0x4b, 0xa3, 0x5d, 0x07, 0x38, 0x21,
+	0xc4, 0x1a, 0x12, 0xdf, 0x7a, 0x71, 0x8c, 0xd3, 0x5f, 0x39, 0x21, 0xe4, 0x90, 0xf8, 0x4f, 0x8e,
+	0xb5, 0x3f, 0x09, 0x3a, 0x0e, 0x37, 0x5f, 0xda, 0x74, 0x7a, 0xec, 0xfe, 0x29, 0x55, 0x6e, 0x9c,
+	0x71, 0x5d, 0xd7, 0xc2, 0xce, 0x87, 0xf2, 0x26, 0x92, 0xdf, 0x0f, 0xb4, 0x0e, 0x77, 0x04, 0x9d,
+	0x86, 0x20, 0x63, 0xd6, 0xbd, 0x9c, 0x1c, 0xed, 0x07, 0xa0, 0xb9, 0xf4, 0x36, 0xcc, 0x05, 0x84,
+	0x90, 0x4f, 0x53, 0x4c, 0x3e, 0xbd, 0x75, 0xa5, 0x6a, 0xcd, 0x9a, 0xeb, 0xb9, 0x5c, 0x2a, 0x0a,
+	0xe1, 0x74, 0x0a, 0x1a, 0x12, 0xee, 0x91, 0x20, 0x74, 0x5c, 0xae, 0x27, 0x9a, 0x66, 0x57, 0x8e,
+	0x0f, 0xae, 0x44, 0x7c, 0x2f, 0x2e, 0x6f, 0x2e, 0x71, 0x9a, 0x12, 0x48, 0xef, 0xc3, 0x52, 0x0a,
+	0x6f, 0x8c, 0x92, 0x36, 0x4f, 0xfd, 0x48, 0xf9, 0x80, 0xfd, 0xb2, 0xd0, 0x71, 0x41, 0x1c, 0x6d,
+	0x39, 0x14, 0xdd, 0x1e, 0xf4, 0x3f, 0x11, 0x99, 0x9d, 0xbf, 0x0f, 0xb3, 0x72, 0xcf, 0x0a, 0x3f,
+	0x61, 0xcf, 0x64, 0x62, 0xd2, 0x22, 0x2b, 0xca, 0x8b, 0xcc, 0x78, 0x0f, 0xea, 0x79, 0xf3, 0xac,
+	0x2d, 0xc2, 0xac, 0xaa, 0x01, 0x9f, 0x81, 0x52, 0x63, 0x9f, 0xea, 0xcc, 0xff, 0x72, 0x11, 0xae,
+	0x67, 0x37, 0x06, 0x25, 0xc4, 0x57, 0xa8, 0x1e, 0x22, 0x70, 0x4e, 0x13, 0x8a, 0x08, 0x94, 0x12,
+	0xcb, 0x22, 0x4f, 0x2a, 0xaa, 0x7d, 0x08, 0xd7, 0xf9, 0xde, 0x13, 0x99, 0xeb, 0x91, 0x93, 0x95,
+	0x76, 0x5f, 0x63, 0x38, 0xea, 0xb6, 0x82, 0x42, 0x92, 0x5e, 0xcf, 0x19, 0x01, 0xb5, 0x1c, 0x17,
+	0x2a, 0x4b, 0x2c, 0x4b, 0xc1, 0xdf, 0x81, 0x55, 0x3a, 0x40, 0x03, 0x7a, 0x9a, 0xb4, 0xb0, 0xad,
+	0xec, 0x32, 0xc3, 0x4f, 0x60, 0xcb, 0x51, 0x66, 0x87, 0xe5, 0xb1, 0x7b, 0xcd, 0x2d, 0x98, 0x43,
+	0x1e, 0xe4, 0xe2, 0x8c, 0xdf, 0xfd, 0x67, 0x39, 0x8c, 0x89, 0x33, 0xe3, 0x7f, 0x16, 0x61, 0x8d,
+	0x96, 0xc8, 0x90, 0x0c, 0x97, 0x29, 0xb2, 0xbf, 0x0a, 0x6b, 0x01, 0xf1, 0x1d, 0xbb, 0xef, 0x7c,
+	0x96, 0x18, 0x37, 0xce, 0x59, 0xab, 0x71, 0xae, 0x3c, 0x72, 0x36, 0x68, 0x76, 0xaf, 0xe7, 0xd0,
+	0xdf, 0xf4, 0x3e, 0xc2, 0xb8, 0x4b, 0x18, 0xcc, 0x77, 0x24, 0xf6, 0xc9, 0x6e, 0xd5, 0x76, 0x23,
+	0x2a, 0x8b, 0x3a, 0xec, 0x25, 0x3b, 0x01, 0x09, 0xf4, 0xbf, 0x50, 0x80, 0x5a, 0x12, 0xef, 0x0b,
+	0xde, 0x06, 0x84, 0x24, 0x2e, 0x49, 0x92, 0x78, 0xdc, 0x16, 0xf0, 0xdd, 0x72, 0xa5, 0x54, 0x2b,
+	0x9b, 0xf3, 0x8e, 0x1b, 0x91, 0x25, 0xf4, 0xd2, 0xbf, 0x9e, 0xea, 0x26, 0xf2, 0xe4, 0x56, 0x5a,
+	0xb5, 0x9a, 0x70, 0xbe, 0x79, 0x0f, 0xd6, 0x22, 0xae, 0x55, 0xc8, 0x32, 0xfd, 0xd9, 0xbc, 0x19,
+	0xf1, 0x74, 0xcb, 0x15, 0xcd, 0x26, 0x81, 0xf1, 0x1f, 0x4a, 0xa9, 0x3a, 0x83, 0x49, 0x67, 0xfc,
+	0xfb, 0x09, 0x2f, 0x07, 0xae, 0xa7, 0xfb, 0x5a, 0xfe, 0xa4, 0x09, 0xca, 0xdb, 0xcf, 0xd2, 0x4b,
+	0x48, 0x75, 0x7f, 0xd0, 0x8e, 0x33, 0xd9, 0x82, 0xbb, 0x15, 0xbd, 0x3b, 0x41, 0x0d, 0x3f, 0xa3,
+	0x7c, 0xa1, 0xef, 0xc3, 0x72, 0xc6, 0xe0, 0x8c, 0x59, 0x5c, 0x85, 0x31, 0x8b, 0xcb, 0xf8, 0x8f,
+	0x05, 0xa8, 0xa7, 0x47, 0x08, 0x59, 0xea, 0x93, 0xc4, 0xf4, 0xf1, 0x93, 0xf8, 0x57, 0xc7, 0x0e,
+	0x2e, 0x2f, 0xba, 0xdd, 0x19, 0x3f, 0x7b, 0xfa, 0x0b, 0x58, 0x4a, 0xa1, 0xfc, 0xd4, 0x58, 0xf8,
+	0xb7, 0x4a, 0xb0, 0xb6, 0xeb, 0x13, 0x3b, 0x24, 0xb4, 0x4e, 0xb4, 0xd1, 0x4c, 0x6e, 0x47, 0xc4,
+	0x7d, 0xb1, 0xa8, 0xee, 0x8b, 0xf9, 0x03, 0x5e, 0x1a, 0x27, 0xcd, 0x36, 0x61, 0x56, 0x6a, 0x38,
+	0x0a, 0x63, 0x70, 0xa2, 0xe6, 0x6a, 0xdf, 0x85, 0x2a, 0x65, 0x29, 0xee, 0x6e, 0x36, 0x95, 0x72,
+	0x37, 0xcb, 0xee, 0x07, 0x1d, 0x6f, 0xca, 0x71, 0xcc, 0xdd, 0xac, 0x72, 0x86, 0xbf, 0xa8, 0x2f,
+	0x45, 0xb4, 0xdd, 0xc4, 0x1c, 0xc5, 0x1d, 0xae, 0x22, 0x17, 0x33, 0x71, 0xa3, 0x31, 0x7e, 0xb9,
+	0x00, 0xb3, 0x12, 0x1d, 0xba, 0x41, 0x76, 0x5a, 0x8f, 0x1e, 0x37, 0x3a, 0x8f, 0xad, 0x83, 0x7d,
+	0xba, 0x41, 0x4a, 0x00, 0xb6, 0x51, 0x6a, 0x35, 0x98, 0x13, 0x80, 0xf6, 0x41, 0x9b, 0x6a, 0x17,
+	0x35, 0x58, 0x10, 0x90, 0x4e, 0xab, 0xfd, 0x68, 0x9f, 0xea, 0x19, 0x57, 0xa0, 0x26, 0x15, 0x7b,
+	0xde, 0xd8, 0x7f, 0x46, 0x9d, 0xb6, 0xae, 0xc1, 0x4a, 0x04, 0x6d, 0x7f, 0x72, 0xd0, 0x6e, 0xee,
+	0x36, 0xda, 0x87, 0x8d, 0x4f, 0x6a, 0x3f, 0x2a, 0x18, 0xcf, 0x61, 0x3d, 0xd5, 0x4d, 0x64, 0x49,
+	0x6a, 0x9b, 0x13, 0x40, 0xa1, 0xeb, 0x89, 0x00, 0x19, 0x06, 0xe5, 0x39, 0xd9, 0xa0, 0xfc, 0x5d,
+	0xb8, 0x76, 0x48, 0x13, 0xc1, 0x59, 0xc6, 0xee, 0xf5, 0x16, 0x68, 0xb9, 0x3b, 0xfa, 0x52, 0x6a,
+	0xbd, 0x19, 0x8f, 0x40, 0xcf, 0xa2, 0x75, 0xe5, 0x2b, 0x84, 0x71, 0x1b, 0x6e, 0x21, 0xa1, 0x67,
+	0x69, 0xfb, 0x84, 0xd0, 0x51, 0xbe, 0x06, 0xc6, 0x38, 0x24, 0xa1, 0x5c, 0x28, 0xc1, 0xda, 0xe1,
+	0xc8, 0xef, 0x9e, 0xd9, 0x01, 0x49, 0x18, 0x27, 0x3e, 0xbf, 0xbd, 0x7c, 0x13, 0x66, 0x99, 0x46,
+	0xdb, 0xea, 0x3b, 0x03, 0x47, 0x9c, 0x37, 0x80, 0x81, 0xf6, 0x29, 0x64, 0xcc, 0x49, 0x9f, 0x33,
+	0x77, 0xce, 0x49, 0xff, 0x75, 0x58, 0x40, 0x2d, 0xae, 0xea, 0x28, 0x88, 0x4a, 0x73, 0x61, 0x46,
+	0xde, 0x84, 0x59, 0x77, 0x34, 0x88, 0x6c, 0xa0, 0x5c, 0xd9, 0x03, 0xee, 0x68, 0x80, 0x1d, 0x64,
+	0xa6, 0x68, 0xaa, 0x5c, 0x15, 0x54, 0x66, 0xd0, 0x14, 0xed, 0x79, 0x7d, 0x41, 0x43, 0xe8, 0x72,
+	0x4f, 0x08, 0x09, 0xd8, 0x85, 0xa7, 0xc0, 0x75, 0xb9, 0x0f, 0x09, 0x61, 0xe7, 0x5b, 0xa6, 0xf4,
+	0xbc, 0x40, 0x15, 0x28, 0xa6, 0xb4, 0x55, 0x98, 0x0e, 0xcf, 0x69, 0x11, 0x54, 0x7d, 0x4e, 0x85,
+	0xe7, 0x0f, 0xf9, 0xed, 0x09, 0x9b, 0x4d, 0xb3, 0x66, 0x85, 0x1a, 0x90, 0x42, 0x1e, 0x12, 0xea,
+	0x9d, 0xb6, 0x9e, 0x9a, 0x01, 0xe4, 0x89, 0xdb, 0x91, 0x3d, 0x80, 0xb2, 0x03, 0xe1, 0xe2, 0x74,
+	0x4e, 0x68, 0xf5, 0x1f, 0x33, 0x98, 0xf1, 0x35, 0xea, 0xcf, 0x44, 0x95, 0xb3, 0x57, 0x9b, 0x3f,
+	0xee, 0xad, 0xa4, 0x94, 0x43, 0x9e, 0xb8, 0x09, 0xd7, 0xf7, 0x3d, 0xbb, 0xd7, 0x60, 0xee, 0x77,
+	0x7b, 0x76, 0x68, 0x3f, 0x74, 0xfa, 0x21, 0xf1, 0x23, 0xce, 0xda, 0x84, 0x1b, 0x39, 0xf9, 0x48,
+	0xe0, 0x0c, 0x34, 0xba, 0x0c, 0x9f, 0x92, 0x20, 0xb0, 0x4f, 0x89, 0x7c, 0xe3, 0xcf, 0xbe, 0x2f,
+	0xd4, 0x61, 0x66, 0xc0, 0x71, 0x85, 0xc4, 0xc4, 0x64, 0xa2, 0x0f, 0xa5, 0x54, 0x1f, 0xde, 0x85,
+	0x65, 0xa5, 0xa6, 0x49, 0x96, 0xbc, 0xf1, 0xbb, 0x05, 0xa5, 0xd4, 0xc4, 0x0c, 0xff, 0x00, 0x2a,
+	0xd8, 0x2e, 0x71, 0x2c, 0xb9, 0x93, 0xd8, 0xd7, 0x12, 0x14, 0xb7, 0x45, 0xbb, 0xa2, 0x72, 0xfa,
+	0xb7, 0x60, 0x06, 0x81, 0x9f, 0x67, 0x3c, 0x8c, 0x5f, 0x2f, 0xc0, 0x8a, 0x5a, 0x51, 0x64, 0x42,
+	0x9b, 0xf1, 0xc9, 0xb0, 0xef, 0x10, 0xb1, 0xe5, 0x7e, 0x39, 0xb7, 0x69, 0xd2, 0x76, 0x6b, 0x92,
+	0x61, 0xff, 0xc2, 0x14, 0x25, 0xf5, 0x0f, 0xa1, 0x1a, 0x41, 0x2f, 0x11, 0x9b, 0x2b, 0x30, 0x45,
+	0x7c, 0x1f, 0x7d, 0xcd, 0xab, 0x26, 0x4f, 0x18, 0xb7, 0x60, 0x53, 0x92, 0x32, 0x6d, 0x2f, 0x74,
+	0x4e, 0x9c, 0xae, 0xad, 0x88, 0xa5, 0xdf, 0x28, 0xc2, 0x56, 0x3e, 0x0e, 0xf6, 0xe6, 0x3b, 0xb0,
+	0x68, 0x87, 0xa1, 0xdd, 0x3d, 0xa3, 0xde, 0x0c, 0x54, 0x1d, 0x2e, 0x7a, 0x95, 0x6b, 0xf9, 0x5d,
+	0x10, 0xf8, 0x0c, 0x1a, 0x50, 0x5d, 0x78, 0x8f, 0xa8, 0x14, 0x8a, 0x6c, 0xed, 0x2c, 0xf4, 0x88,
+	0x82, 0x98, 0x67, 0x1f, 0x2e, 0x7d, 0x5e, 0xfb, 0x30, 0xd5, 0x31, 0x65, 0x50, 0x14, 0x2b, 0xb8,
+	0xcc, 0x5a, 0x51, 0x4f, 0x17, 0xc4, 0xd5, 0x7c, 0x03, 0x36, 0x84, 0xaf, 0x6a, 0xd6, 0xf0, 0xfd,
+	0xd7, 0x02, 0x5c, 0xcf, 0xce, 0xbf, 0x92, 0x23, 0xdb, 0x24, 0x8e, 0x7c, 0xd9, 0x1e, 0x9b, 0xa5,
+	0x2b, 0x79, 0x6c, 0x96, 0xaf, 0xe4, 0xb1, 0x39, 0x95, 0xe3, 0xb1, 0xf9, 0x4b, 0xb0, 0x25, 0x6f,
+	0x04, 0x59, 0x03, 0x43, 0x05, 0x76, 0x78, 0xae, 0x8a, 0xc9, 0x4a, 0x78, 0xce, 0x07, 0x95, 0x4a,
+	0xe0, 0x20, 0xf4, 0x86, 0x96, 0x7d, 0x12, 0xa2, 0x4d, 0x76, 0xca, 0xac, 0x52, 0x48, 0x83, 0x02,
+	0x8c, 0xdf, 0x2e, 0xc2, 0xad, 0x31, 0x15, 0xe0, 0xc8, 0xbe, 0x48, 0x9a, 0x7c, 0x38, 0x4b, 0x36,
+	0x55, 0x75, 0xc4, 0x78, 0x22, 0xdb, 0x8a, 0x0f, 0x84, 0x44, 0x2c, 0x61, 0x39, 0xd2, 0xff, 0x4a,
+	0x01, 0xea, 0x79, 0xb8, 0xda, 0x3a, 0xcc, 0x60, 0x5f, 0x71, 0x61, 0x4e, 0xf3, 0x9e, 0x7e, 0x21,
+	0xae, 0x2e, 0x29, 0xeb, 0x57, 0x39, 0x6d, 0x55, 0xfb, 0x5b, 0x45, 0x58, 0xe6, 0xc7, 0xad, 0x8f,
+	0x58, 0xdf, 0xc5, 0x24, 0xdc, 0x87, 0x25, 0x3c, 0x4c, 0xa5, 0x04, 0x69, 0x8d, 0x67, 0x48, 0x16,
+	0x9e, 0xb7, 0xe8, 0x49, 0x93, 0x7b, 0xd5, 0xa5, 0x6c, 0x46, 0x4b, 0x98, 0x23, 0xa1, 0x6b, 0x50,
+	0x0e, 0x08, 0xe9, 0x61, 0x7b, 0xd9, 0x6f, 0xf6, 0x1e, 0xc5, 0x19, 0xbe, 0xfb, 0x75, 0x6b, 0xe0,
+	0x92, 0x81, 0xe7, 0x3a, 0xdd, 0x7a, 0x19, 0xdf, 0xa3, 0x50, 0xe8, 0x53, 0x04, 0xd2, 0xa3, 0x15,
+	0x47, 0x93, 0xea, 0xe1, 0x27, 0x87, 0x45, 0x06, 0x97, 0x6a, 0xb9, 0x0d, 0xf3, 0xc7, 0x8e, 0x1f,
+	0x9e, 0xf5, 0xec, 0x0b, 0xae, 0x46, 0xe5, 0x6e, 0x51, 0x73, 0x02, 0xc8, 0xf4, 0xa8, 0x77, 0x61,
+	0x31, 0x42, 0xc2, 0x41, 0xe2, 0xda, 0xd2, 0x05, 0x01, 0xc6, 0x71, 0x5a, 0x83, 0x15, 0x75, 0x98,
+	0x70, 0x83, 0xfc, 0x97, 0x05, 0xd8, 0x14, 0x19, 0x61, 0xf7, 0xcc, 0x71, 0x4f, 0x0f, 0xdc, 0xfe,
+	0x85, 0x3a, 0x96, 0xf7, 0x80, 0x2d, 0x32, 0xb7, 0x47, 0x7a, 0xd6, 0x70, 0x74, 0x6c, 0x09, 0xab,
+	0x5c, 0xd5, 0x5c, 0x10, 0xf0, 0xc3, 0xd1, 0x31, 0x35, 0x48, 0x65, 0x8e, 0x7a, 0x31, 0x67, 0xd4,
+	0x53, 0x1d, 0x2c, 0x4d, 0xd6, 0xc1, 0x72, 0x66, 0x07, 0x0d, 0xd8, 0xca, 0xef, 0x07, 0x76, 0xf6,
+	0x3b, 0xb0, 0x74, 0x30, 0x24, 0xee, 0xe7, 0xe7, 0x14, 0xe3, 0xeb, 0xa0, 0xc9, 0x14, 0xe2, 0xc3,
+	0xd1, 0x2b, 0xac, 0xd5, 0xf2, 0xdc, 0x3e, 0x1f, 0x9d, 0x8a, 0x39, 0xf7, 0x4a, 0x6a, 0x0a, 0xf5,
+	0x12, 0xd8, 0xed, 0x7b, 0x81, 0xca, 0xa7, 0xc6, 0x2a, 0x2c, 0x2b, 0x50, 0x6c, 0xe9, 0x2a, 0x2c,
+	0x73, 0x48, 0xf3, 0xdc, 0x09, 0x62, 0x5f, 0xff, 0x6d, 0x58, 0x51, 0xc1, 0xd8, 0x00, 0x76, 0x0c,
+	0xa4, 0x10, 0xac, 0x19, 0x53, 0xc6, 0x6f, 0xd0, 0x0b, 0x72, 0x68, 0xfb, 0x21, 0x55, 0x08, 0x12,
+	0x37, 0x18, 0x05, 0xe6, 0xb0, 0x2b, 0x3a, 0x7e, 0x17, 0x16, 0xf1, 0x99, 0x43, 0xc2, 0x13, 0x72,
+	0x01, 0xc1, 0xe2, 0x04, 0xaa, 0x43, 0x65, 0x14, 0x10, 0x5f, 0x92, 0xce, 0x51, 0x9a, 0xe6, 0xd1,
+	0x61, 0x7b, 0xe5, 0xf9, 0x62, 0x3d, 0x44, 0x69, 0x7a, 0x23, 0xee, 0x12, 0x1f, 0x65, 0x0f, 0x41,
+	0x5d, 0x80, 0x0c, 0x32, 0x36, 0xe0, 0x5a, 0x46, 0xf3, 0x70, 0x0c, 0xfe, 0x76, 0x01, 0xea, 0x7b,
+	0x4e, 0xc0, 0x1c, 0xa6, 0xb1, 0x29, 0xf1, 0x09, 0xe9, 0x3e, 0x2c, 0xf5, 0x30, 0xcf, 0x92, 0x5e,
+	0x3a, 0x30, 0x73, 0xb4, 0xc8, 0x10, 0xcf, 0x1c, 0xae, 0xba, 0xbe, 0x73, 0x7c, 0xa1, 0x4a, 0x39,
+	0xbe, 0x50, 0xb4, 0x17, 0x19, 0xed, 0xc4, 0x5e, 0xdc, 0x80, 0x8d, 0x87, 0x24, 0xec, 0x9e, 0x3d,
+	0x75, 0x82, 0xc0, 0x71, 0x4f, 0x77, 0x13, 0x27, 0xd8, 0x9b, 0x70, 0x3d, 0x3b, 0x1b, 0x8b, 0xdf,
+	0x81, 0xd7, 0xa8, 0xc3, 0x42, 0xd7, 0x77, 0x8e, 0xc9, 0x91, 0xc7, 0xea, 0xcc, 0xdc, 0x8d, 0xef,
+	0xc2, 0xeb, 0x97, 0xe0, 0xc5, 0x9c, 0xc5, 0x2a, 0xe4, 0x66, 0xfd, 0xa8, 0xfc, 0x6f, 0x16, 0x61,
+	0x45, 0x85, 0x23, 0x6b, 0xed, 0xc0, 0xea, 0x09, 0x85, 0x93, 0x1e, 0x3a, 0x07, 0x04, 0x96, 0x6c,
+	0x38, 0x59, 0xc6, 0x4c, 0x2c, 0xc6, 0xf7, 0xd4, 0xb7, 0x61, 0xe5, 0xc4, 0xf1, 0x83, 0xd0, 0xa2,
+	0xe6, 0xf5, 0xd4, 0xf3, 0x91, 0x25, 0x96, 0xd7, 0x26, 0xaf, 0xa2, 0x11, 0xd4, 0xde, 0x85, 0xb5,
+	0x54, 0x01, 0xf9, 0x05, 0xc9, 0xb2, 0x5a, 0x84, 0x65, 0x69, 0x1f, 0xc0, 0xb5, 0x81, 0xed, 0x30,
+	0x8b, 0x86, 0xe3, 0x5a, 0xa1, 0x33, 0x94, 0xab, 0xe2, 0xcc, 0xb6, 0x4a, 0x11, 0x76, 0x69, 0xfe,
+	0x91, 0x33, 0x8c, 0xab, 0xfb, 0x05, 0xd8, 0xc8, 0x2e, 0xc9, 0xeb, 0xe4, 0x8a, 0xe3, 0xf5, 0x74,
+	0x59, 0x2e, 0x69, 0xce, 0xa1, 0x2e, 0x8f, 0x94, 0x3c, 0xcc, 0xe3, 0x47, 0x6b, 0x2a, 0x7b, 0xb4,
+	0xee, 0x41, 0xad, 0x6f, 0x07, 0x21, 0x16, 0xe0, 0xa2, 0x90, 0x2b, 0xd4, 0x17, 0x28, 0x9c, 0xe3,
+	0x52, 0x61, 0x68, 0xfc, 0xcd, 0x02, 0x6c, 0x65, 0x71, 0x8b, 0xd2, 0x84, 0x06, 0xdc, 0x10, 0x4d,
+	0xe8, 0x9e, 0xf0, 0x7c, 0x8b, 0xf1, 0xac, 0xfa, 0xc2, 0x43, 0x47, 0xa4, 0x5d, 0xc4, 0x61, 0xeb,
+	0x10, 0x47, 0xf6, 0x5b, 0xb0, 0x91, 0x22, 0x41, 0x2f, 0xd1, 0x8a, 0x13, 0x4a, 0x3d, 0x41, 0xa0,
+	0xe9, 0xf6, 0x70, 0x80, 0x5a, 0xa0, 0xf3, 0x07, 0x21, 0x87, 0xbe, 0x77, 0x4a, 0x97, 0x83, 0xd2,
+	0xbe, 0x2b, 0x3d, 0x0e, 0x79, 0x02, 0xb5, 0x43, 0x42, 0x7c, 0x85, 0x00, 0xd5, 0x93, 0x10, 0xe2,
+	0x2b, 0x03, 0x5b, 0xa5, 0x90, 0xdd, 0xe4, 0xe3, 0x3d, 0x55, 0xe9, 0x65, 0x50, 0xa3, 0xb7, 0x39,
+	0xec, 0x76, 0x2e, 0xdc, 0xff, 0x8b, 0x64, 0x60, 0xb6, 0x24, 0x9b, 0xba, 0x92, 0x24, 0x9b, 0xce,
+	0x91, 0x64, 0xc6, 0x3f, 0x29, 0xc1, 0x62, 0xd4, 0xe3, 0x78, 0xaf, 0x08, 0x2e, 0xdc, 0x2e, 0xe9,
+	0x89, 0xbd, 0x82, 0xa7, 0xb4, 0x7d, 0x58, 0x72, 0xa5, 0x61, 0xe6, 0x2a, 0x3c, 0xfe, 0x58, 0x64,
+	0x53, 0xbe, 0xc1, 0x5d, 0xb8, 0x5d, 0x79, 0x3a, 0x98, 0xd2, 0xae, 0xe6, 0x26, 0x20, 0xda, 0x63,
+	0x98, 0x67, 0xfc, 0x21, 0x96, 0x01, 0x1b, 0x18, 0xf5, 0x15, 0x5a, 0xde, 0x22, 0x32, 0xe7, 0x4e,
+	0xa4, 0x1c, 0xcd, 0x86, 0x35, 0x4e, 0x69, 0xc0, 0x99, 0x3e, 0x62, 0xc9, 0x7a, 0x39, 0xe5, 0xa2,
+	0x79, 0xd9, 0xe2, 0x30, 0x57, 0x4e, 0x64, 0x0c, 0x24, 0xa4, 0xb5, 0x61, 0x91, 0x73, 0x9e, 0x35,
+	0x44, 0x8e, 0x65, 0x13, 0x30, 0xbb, 0xf3, 0xba, 0x44, 0x3b, 0x9f, 0xa5, 0xcd, 0x05, 0x5f, 0xc9,
+	0xd3, 0x1e, 0x42, 0x8d, 0x71, 0xa8, 0xe3, 0x9e, 0x78, 0x78, 0xd6, 0x45, 0x5b, 0xe8, 0x86, 0x44,
+	0x30, 0xc9, 0xd8, 0xe6, 0x22, 0x2d, 0xd4, 0x8a, 0xcb, 0x18, 0xbf, 0x52, 0x80, 0x85, 0xce, 0xf0,
+	0xa5, 0xcc, 0xb0, 0x3f, 0xcd, 0x7d, 0x8f, 0x29, 0xcb, 0x5e, 0x52, 0x35, 0x98, 0x4b, 0xba, 0x21,
+	0xbb, 0x77, 0x56, 0xa9, 0xb2, 0xec, 0xe5, 0x2e, 0x87, 0x30, 0x76, 0x8a, 0xda, 0xf3, 0xff, 0xd8,
+	0xe9, 0x67, 0x8d, 0x9d, 0x56, 0x40, 0xc3, 0x5a, 0x3d, 0x27, 0x7a, 0x68, 0x67, 0x34, 0x60, 0x59,
+	0x81, 0xe2, 0xbc, 0xbe, 0x21, 0xc4, 0xb4, 0x35, 0xa4, 0x70, 0x45, 0x0b, 0xec, 0xc7, 0xf8, 0xec,
+	0x00, 0xf4, 0xeb, 0x05, 0xb8, 0x86, 0xef, 0x3f, 0x88, 0x69, 0xbb, 0x3d, 0x6f, 0xc0, 0xdf, 0xba,
+	0x45, 0x1e, 0xe9, 0xec, 0x81, 0x5c, 0x9f, 0xb8, 0xa7, 0xe1, 0x19, 0x9e, 0x1b, 0x80, 0x82, 0xf6,
+	0x19, 0x44, 0x7b, 0x17, 0x2a, 0xc4, 0xed, 0x7a, 0xd4, 0xa5, 0x1b, 0x39, 0x44, 0x56, 0xae, 0x50,
+	0x52, 0x4d, 0xcc, 0x36, 0x23, 0xc4, 0xcc, 0x9b, 0x54, 0x29, 0xf3, 0x26, 0x65, 0xfc, 0xff, 0xa0,
+	0x67, 0xb5, 0x2e, 0xf6, 0xc0, 0x64, 0xcd, 0x3b, 0xbe, 0x08, 0x49, 0x10, 0xa9, 0x97, 0x08, 0x7d,
+	0xa4, 0x12, 0x92, 0x80, 0x3e, 0xb2, 0x64, 0xd9, 0x67, 0x68, 0x01, 0xab, 0x9a, 0x33, 0x34, 0xfd,
+	0x98, 0x9c, 0xd3, 0x63, 0x3f, 0xcb, 0x8a, 0xae, 0x7c, 0xbc, 0xfe, 0x39, 0x0a, 0x14, 0x37, 0x3e,
+	0xe3, 0xd7, 0x0a, 0xb0, 0xb4, 0x47, 0xba, 0x5e, 0x8f, 0xc8, 0x63, 0x72, 0x03, 0x80, 0x6e, 0x1f,
+	0xdc, 0x0c, 0x84, 0x5b, 0x4e, 0x95, 0x42, 0xf8, 0xcb, 0xfa, 0x9f, 0xf6, 0x88, 0xbc, 0x0f, 0x9a,
+	0xdc, 0xa6, 0xd8, 0x35, 0xb9, 0xc7, 0xa0, 0x3d, 0xf6, 0xa2, 0x51, 0x98, 0xb0, 0x10, 0x46, 0x51,
+	0x8d, 0xdf, 0x2f, 0xc2, 0xaa, 0x39, 0x72, 0xb9, 0x9e, 0xf6, 0xc1, 0xe8, 0x82, 0xf8, 0x93, 0xaa,
+	0x2c, 0xf3, 0x75, 0xf4, 0xe9, 0x77, 0x97, 0xe8, 0x8b, 0xa1, 0xbe, 0xbb, 0xdc, 0x86, 0x65, 0x7c,
+	0xd5, 0x6b, 0x85, 0x9e, 0x45, 0x0f, 0x67, 0xa1, 0xed, 0x88, 0xb7, 0x48, 0x4b, 0x98, 0x75, 0xe4,
+	0x3d, 0xc5, 0x0c, 0x99, 0xac, 0xaa, 0xa2, 0x47, 0xb2, 0x1c, 0x98, 0xd2, 0xc0, 0x4f, 0x5f, 0xa2,
+	0x81, 0x9f, 0x49, 0x68, 0xe0, 0xdf, 0x86, 0x95, 0x57, 0xb6, 0x13, 0x5a, 0x27, 0x9e, 0x6f, 0xf5,
+	0xbd, 0x57, 0xc4, 0x47, 0xbf, 0xee, 0x0a, 0x7f, 0x95, 0x46, 0xf3, 0x1e, 0x7a, 0xfe, 0x3e, 0xcd,
+	0x61, 0xde, 0xdd, 0x46, 0x1d, 0xd6, 0x92, 0x23, 0x88, 0x47, 0xf3, 0x5f, 0x29, 0xc1, 0x2a, 0x3b,
+	0x86, 0x35, 0x46, 0xa1, 0xf7, 0x05, 0x0d, 0x6e, 0xce, 0xa8, 0x95, 0xf2, 0x46, 0xed, 0x36, 0x2c,
+	0x0c, 0xec, 0x73, 0x4b, 0x72, 0x63, 0xe2, 0x03, 0x3c, 0x3b, 0xb0, 0xcf, 0x1f, 0x0a, 0x4f, 0xa6,
+	0x37, 0x41, 0xa3, 0x48, 0xac, 0xa3, 0x96, 0x4f, 0xfa, 0x76, 0x28, 0x7c, 0xbc, 0x0b, 0x66, 0x6d,
+	0x60, 0x9f, 0xa3, 0xc7, 0x3b, 0x87, 0xab, 0xd8, 0xf6, 0x71, 0xe0, 0xf5, 0x47, 0xa1, 0xd0, 0x66,
+	0x44, 0xd8, 0x0d, 0x84, 0x67, 0x4c, 0xdb, 0xcc, 0x24, 0xd3, 0x56, 0xb9, 0x64, 0xda, 0xaa, 0x89,
+	0x69, 0x33, 0x60, 0x9e, 0x35, 0x8a, 0xf8, 0xfc, 0xec, 0x5f, 0x87, 0xa8, 0x9b, 0x87, 0xc4, 0x67,
+	0xc7, 0x7d, 0x3a, 0x53, 0xc9, 0xe9, 0xc0, 0x99, 0x5a, 0x83, 0x95, 0x0e, 0xd5, 0xd9, 0x25, 0xe6,
+	0x89, 0x1a, 0x32, 0x12, 0x70, 0x2c, 0xa0, 0x43, 0x5d, 0x9a, 0x71, 0xa6, 0x43, 0x8b, 0x42, 0x15,
+	0xfc, 0xf9, 0x69, 0xb8, 0x96, 0x91, 0x29, 0x3d, 0x40, 0xcd, 0xf6, 0x4e, 0x7c, 0x0d, 0x16, 0xec,
+	0x97, 0xa7, 0x38, 0xae, 0x03, 0xaf, 0x27, 0x0e, 0xa6, 0x73, 0xf6, 0xcb, 0x53, 0x36, 0xa6, 0x4f,
+	0xbd, 0x1e, 0xbb, 0xcc, 0x46, 0x58, 0xcf, 0x3f, 0x6a, 0x1c, 0x5a, 0x3d, 0xd2, 0x0f, 0x6d, 0xc1,
+	0x00, 0x02, 0x95, 0xe6, 0xec, 0xd1, 0x8c, 0x2b, 0x2f, 0x33, 0x03, 0xe6, 0xd9, 0x00, 0x06, 0x14,
+	0xdd, 0x7e, 0x79, 0x2a, 0xbc, 0xfd, 0x38, 0xf0, 0xc8, 0x6b, 0xbc, 0x3c, 0xd5, 0xbe, 0x02, 0xab,
+	0x3d, 0xcf, 0x0d, 0xad, 0x68, 0xa1, 0xc8, 0x06, 0xb1, 0x8a, 0xa9, 0xd1, 0xcc, 0x8f, 0xf8, 0x42,
+	0x91, 0x0c, 0x63, 0xdc, 0x94, 0x85, 0xed, 0xc5, 0xd7, 0x7e, 0x1c, 0xc6, 0x5b, 0x7a, 0x83, 0x7b,
+	0xdb, 0x71, 0xcf, 0x3d, 0x64, 0x80, 0xea, 0x09, 0x21, 0x1d, 0x06, 0xa0, 0x6c, 0x47, 0xb3, 0xd1,
+	0x81, 0x33, 0xe8, 0xda, 0x7d, 0x2a, 0x4d, 0x39, 0x1f, 0xd4, 0x4e, 0x08, 0x39, 0x62, 0x19, 0x1d,
+	0x0e, 0xa7, 0x8a, 0xcc, 0x81, 0xe3, 0x4a, 0x16, 0xb3, 0xe9, 0x81, 0xe3, 0x52, 0x93, 0x19, 0xcd,
+	0xe0, 0x0b, 0xa2, 0x3e, 0x87, 0x19, 0x6c, 0x25, 0xa4, 0x39, 0x68, 0x3e, 0xc5, 0x41, 0x39, 0xac,
+	0xbf, 0x90, 0xc3, 0xfa, 0xd9, 0xcb, 0x6a, 0x31, 0x67, 0x59, 0xbd, 0xc6, 0x57, 0xaa, 0x13, 0xbd,
+	0xd0, 0xa8, 0x2f, 0x71, 0xfd, 0xd9, 0xc0, 0x3e, 0x6f, 0x89, 0xf7, 0x19, 0xa9, 0x75, 0xa2, 0x5d,
+	0xb2, 0x4e, 0x96, 0x13, 0xeb, 0xe4, 0x6b, 0xb0, 0x1e, 0x0c, 0x7d, 0x62, 0xf7, 0xc4, 0xbb, 0xaa,
+	0x21, 0x1a, 0x08, 0x83, 0xfa, 0x0a, 0x9b, 0xbc, 0x55, 0x9e, 0x8d, 0x4f, 0x5d, 0x44, 0x66, 0xc6,
+	0x32, 0x5e, 0xcd, 0x5a, 0xc6, 0xb1, 0x9d, 0x72, 0x4d, 0xb2, 0x53, 0x1a, 0x6f, 0xc1, 0x52, 0x87,
+	0x24, 0x23, 0x02, 0xe4, 0xae, 0x04, 0x7a, 0xb0, 0x91, 0xd1, 0x71, 0xcd, 0x3d, 0x85, 0x8d, 0x0e,
+	0x09, 0x1f, 0x24, 0x39, 0x56, 0x7a, 0xf1, 0x96, 0xc5, 0xe8, 0x85, 0x1c, 0x46, 0xa7, 0x9a, 0x9a,
+	0x6c, 0x72, 0x58, 0xdd, 0xfb, 0x50, 0xeb, 0x90, 0xf0, 0x29, 0x63, 0x0e, 0x51, 0x47, 0x5a, 0x9a,
+	0x16, 0x52, 0xd2, 0xd4, 0x58, 0x86, 0x25, 0xa9, 0x20, 0x52, 0xfb, 0x2e, 0xe8, 0x1c, 0xa8, 0x4c,
+	0xba, 0xa0, 0x9b, 0xcd, 0x29, 0x85, 0x6c, 0x4e, 0xa1, 0x2a, 0xa8, 0x4c, 0x5a, 0x99, 0x55, 0x09,
+	0x6e, 0xcc, 0xac, 0x2a, 0x62, 0xe1, 0x42, 0x36, 0x0b, 0x27, 0xaa, 0x8a, 0x69, 0x45, 0x0a, 0xd8,
+	0xf5, 0x0e, 0x09, 0x9f, 0xcb, 0x2c, 0x20, 0x79, 0xe6, 0x26, 0x18, 0xa6, 0x90, 0xc1, 0x30, 0x54,
+	0x90, 0xa6, 0x29, 0x20, 0xf5, 0x6f, 0xc0, 0x6a, 0x87, 0x84, 0x87, 0x31, 0x6b, 0x4b, 0x31, 0x2e,
+	0x94, 0x45, 0x50, 0x48, 0x2d, 0x02, 0x26, 0xeb, 0x13, 0x65, 0x91, 0xea, 0x57, 0x40, 0xc3, 0x1c,
+	0xba, 0x20, 0x24, 0x23, 0x4f, 0xbc, 0x68, 0x0a, 0xea, 0xa2, 0xa1, 0x3a, 0x36, 0xa5, 0x08, 0x52,
+	0xfa, 0x26, 0xac, 0xe2, 0xe0, 0xa0, 0x7c, 0x10, 0xc4, 0x52, 0xa2, 0xa4, 0x90, 0xbd, 0x19, 0x25,
+	0x0a, 0xc7, 0xa1, 0x6d, 0x1a, 0xa7, 0xc4, 0xed, 0xd9, 0x91, 0x32, 0xef, 0xf7, 0x4a, 0xb0, 0x18,
+	0x81, 0xe2, 0x7d, 0x44, 0xb8, 0xba, 0xe2, 0xea, 0xc1, 0xa4, 0xf6, 0x4d, 0x98, 0xb1, 0x39, 0x32,
+	0xda, 0x92, 0x6f, 0xc9, 0xa1, 0x62, 0x54, 0x32, 0x98, 0x36, 0x45, 0x09, 0xfd, 0x0f, 0x0a, 0x30,
+	0xcd, 0x61, 0xda, 0x02, 0x14, 0x9d, 0x1e, 0x8e, 0x6d, 0xd1, 0x61, 0xaa, 0x8f, 0x1e, 0xe1, 0x5e,
+	0x3b, 0xc2, 0x4d, 0xb2, 0x6a, 0xca, 0x20, 0x6a, 0x48, 0x19, 0xd8, 0xc1, 0x0b, 0x3c, 0xef, 0xb1,
+	0xdf, 0xb4, 0x35, 0xdd, 0x33, 0xcf, 0xe9, 0x12, 0xe1, 0x25, 0x39, 0xae, 0x35, 0xbb, 0x0c, 0xd3,
+	0x14, 0x25, 0xb8, 0x75, 0x8d, 0xaa, 0xba, 0x24, 0xbf, 0xf3, 0x2a, 0x83, 0x30, 0x63, 0xc2, 0x26,
+	0xf0, 0x0d, 0x84, 0xc8, 0x06, 0x15, 0xe0, 0x20, 0x8a, 0xa0, 0xff, 0xb8, 0x00, 0xd3, 0x9c, 0xe6,
+	0xe7, 0xeb, 0x0d, 0x06, 0x07, 0x63, 0xbd, 0xa1, 0xbf, 0x69, 0x83, 0x9c, 0x80, 0x2e, 0x9b, 0x68,
+	0x13, 0xad, 0x98, 0x55, 0x27, 0x68, 0x70, 0x00, 0x7d, 0xdc, 0xe3, 0x04, 0x96, 0xeb, 0xa1, 0xbe,
+	0xa7, 0xec, 0x04, 0x6d, 0x8f, 0x4a, 0xb3, 0xe7, 0x5e, 0x48, 0x78, 0x3b, 0xa2, 0x39, 0xfd, 0x9d,
+	0x22, 0x2c, 0x2b, 0xe0, 0x4b, 0xe7, 0xf5, 0xc3, 0x78, 0x24, 0xf9, 0xbc, 0xca, 0xd7, 0xcf, 0x0c,
+	0x52, 0xa9, 0xd1, 0xd4, 0xa1, 0x42, 0xdf, 0xe6, 0x49, 0x9d, 0x8a, 0xd2, 0xfa, 0x5f, 0x8b, 0x47,
+	0x6a, 0x03, 0xaa, 0x9c, 0x1b, 0xac, 0x68, 0xc0, 0x2a, 0x1c, 0xd0, 0xea, 0xb1, 0x18, 0x2c, 0x3c,
+	0x33, 0x3d, 0x7a, 0x4b, 0x3c, 0x67, 0x2f, 0xce, 0xa0, 0xb4, 0x78, 0xed, 0x94, 0x16, 0xbf, 0xbc,
+	0x54, 0x38, 0x80, 0xd3, 0xc2, 0x4c, 0x99, 0x16, 0xb7, 0xb3, 0x2d, 0xf1, 0x1c, 0x89, 0x16, 0x7d,
+	0xc9, 0xbf, 0xca, 0x65, 0x45, 0x62, 0x2c, 0xb5, 0x46, 0x3c, 0x32, 0xdc, 0x72, 0x7a, 0x57, 0xb9,
+	0x5d, 0x65, 0x14, 0x49, 0x8e, 0x8d, 0xfe, 0x60, 0xb2, 0xee, 0x2b, 0xfd, 0x29, 0xaa, 0xfd, 0x31,
+	0xde, 0x83, 0xb5, 0x64, 0x65, 0x38, 0xa9, 0xf2, 0xc8, 0x17, 0xd4, 0x91, 0x37, 0xce, 0x60, 0xe5,
+	0x39, 0xf1, 0x9d, 0x93, 0x8b, 0x2f, 0xc0, 0xa9, 0x45, 0xf1, 0xac, 0x28, 0x25, 0xbd, 0x53, 0xde,
+	0x82, 0xd5, 0x44, 0x4d, 0x71, 0xe4, 0x09, 0xf6, 0xd6, 0x0f, 0x55, 0x3e, 0x3c, 0x61, 0xfc, 0x68,
+	0x56, 0xdc, 0x2a, 0x15, 0x9f, 0xc1, 0x2b, 0x78, 0x9c, 0x4a, 0xbc, 0xcc, 0x75, 0xcc, 0x22, 0x49,
+	0xc7, 0x91, 0x69, 0xe8, 0x23, 0x3b, 0xe1, 0xbc, 0x59, 0xa1, 0x00, 0xb6, 0xac, 0x63, 0x27, 0xa8,
+	0xb2, 0xe2, 0x04, 0x95, 0x15, 0x02, 0x6f, 0xea, 0x8b, 0x08, 0x81, 0x47, 0x23, 0x01, 0xb2, 0x9b,
+	0x3b, 0x3d, 0xc1, 0x26, 0x83, 0x6b, 0xa5, 0x87, 0x40, 0x44, 0x02, 0xe4, 0x45, 0x68, 0x24, 0x40,
+	0xf1, 0xfa, 0x62, 0x26, 0x15, 0x09, 0x30, 0xa3, 0xb4, 0x88, 0x04, 0x88, 0x85, 0xf4, 0x7f, 0x5f,
+	0x12, 0x01, 0xf8, 0xbe, 0x01, 0xd7, 0x22, 0x0f, 0xc9, 0x9c, 0x31, 0x5e, 0x17, 0x08, 0x09, 0xff,
+	0x0e, 0xea, 0x1b, 0x92, 0x59, 0x56, 0xf6, 0xf5, 0xad, 0x67, 0x14, 0xe6, 0x7e, 0x9e, 0xdf, 0x91,
+	0x1c, 0x7f, 0x17, 0x76, 0xde, 0x9c, 0xa0, 0xfb, 0xdb, 0x47, 0x3e, 0x21, 0x6c, 0x34, 0x59, 0x49,
+	0xca, 0xe2, 0x01, 0xe5, 0x5c, 0xb7, 0x1b, 0xbd, 0x1a, 0x16, 0x69, 0xb6, 0xa4, 0xf8, 0x23, 0x1f,
+	0xc7, 0x45, 0x29, 0x5e, 0xe1, 0x80, 0x96, 0x9b, 0x72, 0x0a, 0xe0, 0x4e, 0x75, 0xca, 0x93, 0xd8,
+	0x4d, 0xe0, 0x49, 0xec, 0x0c, 0x7f, 0x4e, 0xc9, 0x3d, 0x0d, 0x5a, 0x22, 0x46, 0x61, 0xc4, 0xe6,
+	0xc2, 0xb1, 0xb4, 0xc2, 0x79, 0x32, 0x82, 0xa3, 0xc3, 0xf4, 0x3b, 0xb0, 0x92, 0x44, 0xb5, 0xec,
+	0x60, 0xc0, 0x2e, 0x12, 0x55, 0x53, 0x4b, 0xa0, 0x37, 0x82, 0x81, 0xf1, 0x01, 0x54, 0x44, 0x5f,
+	0xd5, 0xb8, 0x7f, 0x2b, 0xf1, 0x23, 0xf7, 0xff, 0x2d, 0xfe, 0x0a, 0xf4, 0x21, 0x7b, 0xe7, 0xa8,
+	0xf1, 0xa4, 0x59, 0x2b, 0xe8, 0xff, 0xb4, 0x2c, 0x87, 0x39, 0x7c, 0x69, 0xf7, 0x47, 0xe2, 0xa4,
+	0xc5, 0x13, 0x71, 0xf0, 0xc3, 0x62, 0x22, 0xf8, 0xa1, 0xfc, 0x8a, 0x45, 0x5a, 0x36, 0xf1, 0xf3,
+	0x97, 0xb2, 0xf2, 0xfc, 0x85, 0xee, 0x93, 0x71, 0x57, 0xb8, 0x5e, 0xa4, 0x1a, 0x88, 0x1e, 0x68,
+	0x6f, 0xc3, 0x72, 0xe4, 0x14, 0x19, 0x75, 0x30, 0xc0, 0x10, 0x1a, 0x22, 0x04, 0x4f, 0x2f, 0xf2,
+	0x6f, 0x0d, 0xb4, 0x0e, 0xcc, 0x21, 0xbd, 0x6e, 0xdf, 0xc6, 0x2b, 0xfb, 0xc2, 0xce, 0x3b, 0x93,
+	0xf0, 0xf5, 0x36, 0x1f, 0xb8, 0x5d, 0x5a, 0xce, 0x9c, 0x0d, 0xe2, 0x04, 0x15, 0x4e, 0xb6, 0x30,
+	0xa7, 0xd6, 0x2b, 0x4c, 0x19, 0x1d, 0x03, 0xa8, 0x22, 0xbc, 0xeb, 0x0d, 0x06, 0x4e, 0x38, 0x20,
+	0x6e, 0xf4, 0x9e, 0xa4, 0xca, 0x8f, 0xa5, 0x71, 0x06, 0x7f, 0x4e, 0x62, 0xfc, 0x27, 0xea, 0x1c,
+	0x2c, 0x91, 0xae, 0xc1, 0x5c, 0xfb, 0xa0, 0x6d, 0x75, 0x8e, 0x1a, 0xed, 0xbd, 0x86, 0xb9, 0xc7,
+	0x63, 0x0e, 0x1c, 0x3e, 0x7b, 0x60, 0x3d, 0x69, 0x7e, 0xc2, 0x3d, 0x83, 0x31, 0x61, 0x51, 0x17,
+	0xdf, 0x5a, 0x91, 0x39, 0x0f, 0xef, 0x9a, 0xad, 0xc3, 0x23, 0x0e, 0x28, 0x69, 0xf3, 0x50, 0x7d,
+	0xfa, 0x6c, 0xff, 0xa8, 0x65, 0x75, 0x5a, 0x8f, 0x6a, 0x65, 0x9a, 0x6c, 0x3f, 0xdb, 0xdf, 0xb7,
+	0xf6, 0x1a, 0x47, 0x8d, 0xda, 0x14, 0x73, 0x1a, 0xa6, 0x73, 0x6a, 0x75, 0x9e, 0x3d, 0xa0, 0xa1,
+	0x09, 0x68, 0xe8, 0xc6, 0x69, 0x8a, 0xc4, 0xa1, 0x8f, 0x9a, 0xed, 0xda, 0x4c, 0x8c, 0x24, 0xc5,
+	0x77, 0xac, 0x28, 0x45, 0xad, 0xdd, 0xc7, 0x8d, 0xf6, 0xa3, 0x66, 0xad, 0x4a, 0xeb, 0x17, 0x2d,
+	0x6a, 0xec, 0x1f, 0xd5, 0x80, 0xa2, 0xc9, 0x4d, 0x64, 0xd0, 0x59, 0xe3, 0x08, 0x36, 0xf8, 0x40,
+	0x9b, 0xf6, 0xab, 0x0c, 0x2f, 0xe1, 0xcf, 0xe9, 0x66, 0x6f, 0xc1, 0xf5, 0x6c, 0xaa, 0x93, 0xc6,
+	0xc5, 0x49, 0x4f, 0xbe, 0xe2, 0x18, 0x6f, 0xec, 0xc0, 0xda, 0x73, 0x7c, 0x3b, 0x9e, 0x11, 0xce,
+	0x2c, 0x73, 0x53, 0x33, 0x7e, 0x6f, 0x0a, 0xd6, 0x53, 0x85, 0xb0, 0x41, 0xd7, 0xa0, 0xe2, 0x04,
+	0x96, 0xbc, 0x45, 0xcd, 0x38, 0x01, 0x43, 0xa6, 0xd7, 0x79, 0x27, 0xb0, 0xa8, 0xc7, 0x1b, 0x46,
+	0x75, 0x9a, 0x76, 0x82, 0xa7, 0x8e, 0x9b, 0xe5, 0xad, 0x56, 0xca, 0xf2, 0x56, 0xdb, 0x82, 0x39,
+	0x74, 0x81, 0x61, 0xb7, 0x09, 0x3c, 0x7d, 0x50, 0xc7, 0xed, 0x27, 0xe4, 0x82, 0xb6, 0x83, 0xd6,
+	0x80, 0x18, 0xf8, 0x8c, 0x7e, 0x9a, 0x67, 0x52, 0xa9, 0xe6, 0x04, 0xb2, 0x1b, 0x3b, 0x0d, 0x45,
+	0x1a, 0xa0, 0x98, 0xa1, 0x81, 0xbd, 0x5e, 0x44, 0xf2, 0xa5, 0xd7, 0xf3, 0xf9, 0xe6, 0x40, 0x03,
+	0x7b, 0xa1, 0x87, 0x3b, 0x25, 0x4e, 0x6d, 0x0b, 0xb8, 0x46, 0xf8, 0x76, 0x56, 0x49, 0x6d, 0x67,
+	0x39, 0x63, 0x82, 0xcb, 0x8c, 0x09, 0x60, 0x08, 0xa2, 0xdf, 0xda, 0x7d, 0xd0, 0x86, 0xd4, 0xff,
+	0xc6, 0x63, 0x95, 0x8a, 0xd6, 0x55, 0xb9, 0x2c, 0x1c, 0xda, 0x17, 0x47, 0x1e, 0x25, 0x84, 0x8d,
+	0xa4, 0x0a, 0x6f, 0xe7, 0x34, 0xb0, 0x84, 0x04, 0x60, 0xaa, 0x92, 0x79, 0x73, 0x8e, 0x02, 0x4d,
+	0x84, 0xb1, 0x37, 0x02, 0x81, 0x15, 0xc5, 0x5c, 0x9d, 0x65, 0x1d, 0x05, 0x27, 0x68, 0x21, 0x24,
+	0x16, 0x62, 0x73, 0x92, 0x10, 0x33, 0xfe, 0x73, 0x01, 0x20, 0x6e, 0xa3, 0xb6, 0x04, 0xf3, 0x6d,
+	0xcf, 0xed, 0x84, 0xb6, 0xdb, 0xb3, 0xfd, 0xde, 0xd1, 0x05, 0x0f, 0x9b, 0xca, 0xdd, 0x8c, 0x8e,
+	0x2e, 0x70, 0x8d, 0xb2, 0x14, 0xf7, 0xf8, 0xaf, 0x15, 0x29, 0x84, 0x13, 0x40, 0x48, 0x89, 0xc6,
+	0x4e, 0x7d, 0x3a, 0xea, 0x87, 0x4e, 0xc7, 0x39, 0x3d, 0xba, 0xa8, 0x95, 0x69, 0xba, 0x3d, 0xea,
+	0xf7, 0xa9, 0xa3, 0xf0, 0xd1, 0x45, 0x6d, 0x4a, 0x5b, 0xc5, 0xd8, 0x19, 0x9d, 0xd1, 0x31, 0xb3,
+	0x06, 0xd1, 0xdd, 0xbd, 0x36, 0x4d, 0xd1, 0x44, 0x48, 0xab, 0xa3, 0x8b, 0xda, 0x4c, 0x84, 0x46,
+	0x7d, 0x94, 0x85, 0x4d, 0x0a, 0x57, 0x2a, 0x96, 0xe6, 0x8f, 0x21, 0x8f, 0x2e, 0x70, 0xa5, 0x8e,
+	0x8e, 0x5f, 0x90, 0x8b, 0x46, 0x3f, 0x3c, 0xba, 0xa8, 0x01, 0x8d, 0x36, 0xc7, 0x01, 0xb4, 0x59,
+	0x1c, 0x38, 0x6b, 0xbc, 0x0b, 0xeb, 0xbb, 0x4c, 0x48, 0x85, 0xa4, 0x97, 0x70, 0x96, 0xae, 0xc3,
+	0x8c, 0x50, 0x8f, 0x71, 0xe7, 0x41, 0x91, 0x34, 0x1e, 0xc3, 0xe6, 0xa3, 0x48, 0xcd, 0xd1, 0x54,
+	0x3c, 0xaf, 0xae, 0x16, 0x85, 0xcc, 0xe8, 0xc0, 0x56, 0x3e, 0x25, 0x5c, 0x44, 0x6f, 0xc3, 0x8a,
+	0xdd, 0xed, 0x5a, 0x39, 0x9e, 0x5f, 0x34, 0x7e, 0xa2, 0x5a, 0xd0, 0xf8, 0xc7, 0x05, 0xa8, 0xa7,
+	0x3b, 0x85, 0xd4, 0x7e, 0x00, 0x8b, 0x8a, 0x6b, 0x3c, 0xc9, 0x7a, 0x1a, 0x9f, 0x57, 0x7a, 0xfb,
+	0x48, 0x2e, 0x6a, 0x26, 0x29, 0xe9, 0x0d, 0x11, 0x2d, 0xa7, 0x11, 0x3f, 0xfb, 0x94, 0xa2, 0xe5,
+	0xcc, 0x45, 0xe1, 0x70, 0xf2, 0x7d, 0x03, 0x34, 0xa8, 0x3d, 0x20, 0x41, 0x28, 0x5f, 0xcb, 0x8d,
+	0x0f, 0x61, 0x49, 0x82, 0xc5, 0xf6, 0x4e, 0xc9, 0x8f, 0x62, 0x3e, 0x0a, 0x11, 0x23, 0xc2, 0xc9,
+	0x14, 0xe3, 0x70, 0x32, 0xc6, 0x3f, 0xa7, 0xee, 0xdd, 0xaf, 0x08, 0x19, 0xa6, 0xe3, 0x7b, 0x66,
+	0xbc, 0x42, 0xae, 0x26, 0x5f, 0x21, 0xbf, 0x0d, 0xcb, 0xd2, 0x33, 0x51, 0x4b, 0x6d, 0xb9, 0x26,
+	0x65, 0x35, 0xe2, 0x57, 0x3d, 0x63, 0x9e, 0xa2, 0xcf, 0x4f, 0xf6, 0x6c, 0xb9, 0xcc, 0x95, 0x19,
+	0xe2, 0xd9, 0xb2, 0xf1, 0x5f, 0xa8, 0xa3, 0xb7, 0xd2, 0x89, 0x9f, 0xef, 0x77, 0xa4, 0x46, 0xc0,
+	0x9c, 0xd2, 0x5e, 0x10, 0xd4, 0xdf, 0x98, 0x64, 0xe8, 0xf9, 0xe1, 0xd5, 0xc2, 0xa5, 0xa6, 0x9c,
+	0x65, 0xaa, 0x44, 0x78, 0xc7, 0x50, 0xf1, 0x37, 0x0a, 0xb8, 0x09, 0x9b, 0xca, 0x77, 0x9e, 0x30,
+	0xfe, 0x5d, 0x01, 0xe6, 0xe5, 0x5a, 0x03, 0x55, 0x0e, 0xb0, 0x8b, 0x3d, 0x26, 0xe3, 0x00, 0x3a,
+	0x45, 0x39, 0x80, 0x8e, 0x14, 0x28, 0xa7, 0xa4, 0x06, 0xca, 0x11, 0x41, 0x78, 0xca, 0x52, 0x10,
+	0x1e, 0x29, 0x78, 0xce, 0x94, 0x1a, 0x3c, 0x67, 0x13, 0x66, 0xa9, 0x52, 0xcb, 0x22, 0xb6, 0xef,
+	0x62, 0x88, 0x97, 0x92, 0x49, 0x19, 0x24, 0x68, 0x32, 0x08, 0x3d, 0x3c, 0x33, 0x04, 0x6f, 0x14,
+	0x06, 0x54, 0x3e, 0x53, 0xb5, 0x3a, 0x57, 0xcf, 0x2f, 0x52, 0xf8, 0x41, 0x0c, 0x36, 0x7e, 0xb5,
+	0x04, 0x7a, 0xd6, 0x58, 0x22, 0x07, 0x3d, 0x01, 0x66, 0xf3, 0x8c, 0x95, 0x68, 0xb3, 0x3b, 0xdb,
+	0xf2, 0x4d, 0x3c, 0xb7, 0xe4, 0xf6, 0xb3, 0x80, 0xf8, 0x4c, 0xb7, 0x56, 0x19, 0xe1, 0x2f, 0x6d,
+	0x0f, 0xa6, 0xd9, 0xfc, 0x07, 0x18, 0xe9, 0xea, 0xcd, 0xc9, 0x28, 0x1d, 0xb1, 0x32, 0x26, 0x96,
+	0xd5, 0xf7, 0xa1, 0x22, 0x68, 0xd3, 0x71, 0xa3, 0xd4, 0x71, 0x71, 0xb2, 0xdf, 0xda, 0x9b, 0x50,
+	0x66, 0xad, 0xe5, 0x75, 0xd4, 0x73, 0xea, 0x08, 0x4c, 0x86, 0xa5, 0xff, 0x6a, 0x01, 0xa6, 0x79,
+	0x05, 0xf1, 0xb4, 0x63, 0xdc, 0x72, 0x96, 0x48, 0xb1, 0x53, 0xf1, 0x32, 0x76, 0x2a, 0x25, 0xd9,
+	0x49, 0x34, 0xa8, 0x3c, 0x49, 0x83, 0x8c, 0x2d, 0xb8, 0x19, 0xef, 0x62, 0x99, 0x8e, 0x84, 0xff,
+	0xbd, 0x08, 0x9b, 0xb9, 0x28, 0x99, 0x32, 0x30, 0x0e, 0x93, 0xf5, 0x84, 0xb2, 0x60, 0x30, 0xea,
+	0x87, 0x42, 0xe3, 0xf4, 0x15, 0xc5, 0xe1, 0x61, 0x2c, 0xd1, 0x6d, 0x93, 0x95, 0x34, 0x05, 0x05,
+	0x1a, 0xa2, 0x64, 0x9a, 0xc3, 0x2e, 0x8d, 0x69, 0xa7, 0x1d, 0xd2, 0x68, 0xa2, 0x76, 0x80, 0x7a,
+	0x03, 0x35, 0x4c, 0xd9, 0x64, 0xf5, 0x6e, 0x9b, 0xac, 0xbc, 0x89, 0x74, 0xa8, 0x63, 0x98, 0x1f,
+	0x15, 0x94, 0xfd, 0x3f, 0x17, 0x62, 0x30, 0xab, 0x1a, 0xe3, 0xf2, 0x97, 0xe3, 0xb8, 0xfc, 0xd1,
+	0x83, 0x94, 0x29, 0xf5, 0x41, 0xca, 0x34, 0xaf, 0x42, 0x0a, 0x57, 0xf6, 0x25, 0x39, 0x5c, 0x59,
+	0xc1, 0xb8, 0x0e, 0xba, 0x14, 0x31, 0xec, 0xa1, 0xe7, 0x93, 0xae, 0x1d, 0x44, 0x7e, 0x1a, 0xbf,
+	0x55, 0x82, 0x8d, 0xcc, 0xec, 0x4b, 0x26, 0x25, 0x19, 0x70, 0xac, 0x98, 0x0e, 0x38, 0xd6, 0x86,
+	0x59, 0x97, 0x9c, 0x87, 0xd6, 0x2b, 0xc7, 0xed, 0x79, 0xaf, 0xd0, 0xb7, 0x46, 0xd1, 0x91, 0xe4,
+	0xd7, 0xbb, 0xfd, 0x11, 0x2b, 0x64, 0x02, 0xa5, 0xc0, 0x7f, 0x53, 0xc5, 0xcb, 0x89, 0xd7, 0xef,
+	0x7b, 0xaf, 0xa8, 0x3a, 0x1f, 0x89, 0x96, 0x3f, 0x0f, 0xd1, 0xc5, 0x88, 0x0c, 0x07, 0xe8, 0xbf,
+	0x59, 0x80, 0x69, 0xac, 0x24, 0xaf, 0xbf, 0xf7, 0xa0, 0x36, 0x60, 0x5e, 0x9d, 0xa9, 0x3e, 0x2f,
+	0x0c, 0x1c, 0x57, 0xaa, 0x94, 0x6d, 0x0e, 0xe7, 0x43, 0x1e, 0xaf, 0x47, 0x41, 0xe7, 0xdb, 0xc9,
+	0xb2, 0xc8, 0x94, 0xcb, 0x50, 0xea, 0xf6, 0xb9, 0x8a, 0x5e, 0x46, 0xea, 0xf6, 0xb9, 0x84, 0x69,
+	0x1c, 0xc1, 0x1c, 0x37, 0x53, 0x70, 0x60, 0xee, 0x99, 0x64, 0x0d, 0xa6, 0x8f, 0x7d, 0xdb, 0xed,
+	0x9e, 0xa1, 0x38, 0xc7, 0x14, 0x65, 0xa3, 0xee, 0x99, 0xd3, 0x17, 0xd2, 0x9c, 0x27, 0x8c, 0xe7,
+	0xa0, 0x37, 0xcf, 0xa9, 0x00, 0x7b, 0x2e, 0x3b, 0x47, 0xfc, 0xc4, 0x7e, 0x02, 0xc6, 0x03, 0xd8,
+	0xc8, 0xa4, 0x1b, 0x7b, 0xb8, 0x23, 0xa6, 0x75, 0x4e, 0x1d, 0xc7, 0x50, 0x26, 0x8a, 0x97, 0x3b,
+	0x1f, 0x53, 0x98, 0x31, 0x02, 0xbd, 0x35, 0xc8, 0xa0, 0x31, 0x59, 0xdb, 0x34, 0x28, 0x4b, 0xde,
+	0x95, 0xec, 0x77, 0xba, 0xda, 0x52, 0x46, 0xb5, 0xef, 0xc3, 0x46, 0x6b, 0x90, 0xdf, 0xf4, 0x7c,
+	0xb3, 0xe1, 0x8f, 0x0b, 0xb0, 0xce, 0x3b, 0x8d, 0x65, 0xbe, 0x90, 0x10, 0xcd, 0x57, 0x8b, 0x3a,
+	0x6e, 0x7c, 0x08, 0xf5, 0x74, 0x1b, 0xae, 0x32, 0xea, 0x7f, 0x3d, 0x0a, 0x34, 0x7d, 0xf5, 0x5e,
+	0x7c, 0xde, 0x31, 0xcf, 0xe9, 0x64, 0x39, 0xaf, 0x93, 0xef, 0x41, 0x3d, 0xdd, 0xc4, 0x4b, 0xe7,
+	0xe7, 0x7d, 0xd8, 0x50, 0xa6, 0x34, 0x7d, 0x51, 0xca, 0x29, 0xf8, 0x3d, 0xb8, 0x9e, 0x5d, 0x30,
+	0x3a, 0xb9, 0x2a, 0x57, 0x2c, 0xf5, 0x31, 0x9f, 0xbc, 0x68, 0xe3, 0xbb, 0xd7, 0x09, 0xac, 0x37,
+	0x7a, 0x3d, 0x39, 0xef, 0xf2, 0x76, 0xc8, 0xf5, 0x14, 0x27, 0xac, 0x47, 0x87, 0x7a, 0xba, 0x1e,
+	0x34, 0xf4, 0xed, 0xc1, 0x62, 0x63, 0xd4, 0x73, 0xc2, 0x7d, 0xef, 0x54, 0x32, 0x43, 0x72, 0x83,
+	0x57, 0x40, 0x3e, 0x65, 0xb5, 0x97, 0xcd, 0x0a, 0x03, 0x74, 0xc8, 0xa7, 0x4c, 0x82, 0x48, 0x1c,
+	0xca, 0x13, 0xc6, 0xff, 0xa0, 0xdf, 0x8b, 0x88, 0xc8, 0xe0, 0x88, 0x7c, 0x13, 0x66, 0x88, 0x1b,
+	0xfa, 0xf1, 0xa3, 0x4d, 0xc5, 0xea, 0x96, 0xc0, 0xde, 0x6e, 0xba, 0xa1, 0x7f, 0x61, 0x8a, 0x12,
+	0x54, 0xdd, 0x42, 0xdd, 0x32, 0x59, 0x1b, 0x8a, 0xac, 0x0d, 0x33, 0x34, 0x4d, 0x9b, 0xb0, 0x01,
+	0x55, 0x96, 0x15, 0x6d, 0xa0, 0x55, 0x93, 0xe1, 0xd2, 0xad, 0x53, 0xff, 0xe3, 0x22, 0x4c, 0x31,
+	0x52, 0x74, 0x13, 0x8d, 0x3b, 0x40, 0x7f, 0x52, 0xce, 0x8c, 0x1c, 0xe1, 0xab, 0x26, 0xfb, 0xcd,
+	0xb4, 0x99, 0xdc, 0xd9, 0x83, 0x53, 0xc2, 0x14, 0x55, 0x14, 0x7a, 0x43, 0x39, 0x36, 0x75, 0xd5,
+	0x8c, 0x01, 0xb4, 0x54, 0xb7, 0xef, 0x88, 0x8f, 0x99, 0x54, 0x4d, 0x4c, 0xd1, 0x4b, 0x98, 0x4f,
+	0x06, 0x5e, 0x48, 0x12, 0xae, 0x5f, 0xf3, 0x1c, 0x2a, 0xee, 0x54, 0x8f, 0x61, 0xa6, 0xc7, 0xcd,
+	0x02, 0xa8, 0xad, 0xdf, 0xbe, 0x74, 0x64, 0xb6, 0xd1, 0x8e, 0x80, 0xc3, 0x84, 0xc5, 0xe3, 0x73,
+	0x41, 0x45, 0x3a, 0x17, 0x30, 0x43, 0xb2, 0x4f, 0x5e, 0xf2, 0x11, 0xe2, 0x4a, 0xe5, 0x0a, 0x05,
+	0xb0, 0xc3, 0x85, 0xb8, 0x54, 0x02, 0x1f, 0x05, 0xfa, 0x5b, 0xff, 0x06, 0xcc, 0xc9, 0xf4, 0xe9,
+	0xd8, 0xc5, 0xd7, 0x72, 0xfa, 0x33, 0xd6, 0x1d, 0xe3, 0x8b, 0x58, 0x96, 0xf8, 0x46, 0xf1, 0x03,
+	0x76, 0xc2, 0x38, 0xe4, 0xb1, 0x73, 0xb3, 0xde, 0xe9, 0xff, 0x76, 0x11, 0x36, 0x32, 0xb3, 0x91,
+	0x49, 0x7e, 0x31, 0x33, 0xa2, 0xc6, 0xd7, 0x15, 0x1f, 0xd4, 0xdc, 0xd2, 0x19, 0x79, 0x89, 0xa8,
+	0x1a, 0xbf, 0x53, 0x00, 0x2d, 0x8d, 0x74, 0x15, 0xfb, 0xd1, 0xe5, 0x9f, 0x70, 0xd2, 0xa5, 0xef,
+	0xa2, 0x94, 0x58, 0xd0, 0x8d, 0x28, 0x3d, 0xee, 0x6b, 0x40, 0x5d, 0xf6, 0xb2, 0xac, 0x87, 0xb6,
+	0x08, 0x91, 0x34, 0xfe, 0x14, 0x6c, 0x35, 0x78, 0x3c, 0xcb, 0x8c, 0xce, 0x5d, 0x39, 0xf4, 0x38,
+	0xbd, 0xef, 0xf3, 0xf0, 0x98, 0xf4, 0x82, 0x9c, 0xf4, 0xd7, 0xd6, 0x44, 0x96, 0x24, 0x56, 0x6f,
+	0xc3, 0xad, 0x31, 0xf5, 0xa3, 0xd4, 0xd8, 0xa7, 0xe7, 0xf9, 0x1f, 0x92, 0x6e, 0xf8, 0x45, 0xb4,
+	0x91, 0x3e, 0xb3, 0xcb, 0xa7, 0x86, 0x35, 0xfe, 0x61, 0x91, 0x46, 0x79, 0xa1, 0x5a, 0x13, 0xf5,
+	0xa5, 0xdd, 0x04, 0x7b, 0x2a, 0x5d, 0xe7, 0xde, 0x28, 0xda, 0x53, 0x31, 0x39, 0x76, 0xda, 0xf8,
+	0x2d, 0x8d, 0x7b, 0x0b, 0xf0, 0x5b, 0x1a, 0x0b, 0xdf, 0x2f, 0xd9, 0x2d, 0xa6, 0xd0, 0xe3, 0x38,
+	0x82, 0x68, 0xa7, 0xb0, 0x9c, 0xde, 0xbe, 0x84, 0x31, 0x4f, 0x8e, 0xf0, 0x93, 0xd1, 0x0d, 0xf1,
+	0xb1, 0x9c, 0x78, 0x1e, 0x70, 0xa1, 0x6b, 0xa9, 0x7d, 0x2f, 0xd0, 0x9b, 0xb0, 0x9e, 0x83, 0x2e,
+	0xaf, 0xdb, 0xf9, 0x8c, 0x75, 0x3b, 0x27, 0xaf, 0xdb, 0x35, 0x58, 0x51, 0x5b, 0x82, 0x23, 0xbd,
+	0x0c, 0x4b, 0xfb, 0xc9, 0xf6, 0x51, 0xff, 0x81, 0xfd, 0x34, 0xea, 0x36, 0x87, 0x4e, 0xec, 0x53,
+	0xb5, 0x0a, 0xcb, 0x0a, 0x3e, 0x92, 0xe9, 0xc6, 0x9e, 0xde, 0xd4, 0x21, 0xb8, 0x73, 0x66, 0xfb,
+	0xb1, 0x5d, 0x5d, 0x3c, 0x8c, 0x2d, 0x48, 0x0f, 0x63, 0x69, 0xe0, 0xd9, 0x33, 0x9f, 0x04, 0x67,
+	0x5e, 0x5f, 0x28, 0x2c, 0x62, 0x40, 0xbc, 0x45, 0x95, 0xe4, 0x2d, 0xaa, 0x09, 0x7a, 0x56, 0x25,
+	0x28, 0x86, 0xee, 0xc2, 0x62, 0x40, 0x21, 0x91, 0xdf, 0x35, 0x97, 0x44, 0x55, 0x73, 0x81, 0x81,
+	0x85, 0xe7, 0x75, 0x60, 0xec, 0x32, 0x7d, 0xe4, 0xb1, 0xe3, 0x66, 0x34, 0x75, 0x62, 0x22, 0xdf,
+	0x86, 0x6b, 0x19, 0x44, 0x26, 0xf7, 0x98, 0xbe, 0x0b, 0x4b, 0xdc, 0x88, 0x2e, 0xbb, 0x7f, 0x67,
+	0x0c, 0x14, 0x9d, 0x20, 0x19, 0x31, 0x3e, 0x1d, 0x0d, 0xec, 0xb0, 0x7b, 0x46, 0xc4, 0x2b, 0x0f,
+	0x91, 0x64, 0xd3, 0xec, 0x04, 0x21, 0x9f, 0xe6, 0x48, 0x86, 0xff, 0xd9, 0x02, 0x2c, 0x2b, 0xe0,
+	0xc8, 0x46, 0x33, 0xc3, 0xb9, 0x5a, 0x88, 0x6d, 0xd9, 0x19, 0x24, 0xa3, 0xc0, 0x36, 0x4f, 0x9b,
+	0xa2, 0x94, 0xfe, 0x1e, 0x4c, 0x73, 0x50, 0x74, 0x68, 0x2c, 0x48, 0x87, 0xc6, 0x35, 0x98, 0xee,
+	0x7b, 0x76, 0x0f, 0x75, 0x52, 0x15, 0x13, 0x53, 0xd4, 0x6b, 0x69, 0xd7, 0x1b, 0x0c, 0xed, 0x6e,
+	0x48, 0x95, 0xee, 0xc7, 0x71, 0x28, 0x5c, 0xe3, 0x13, 0x58, 0x4f, 0xe5, 0x60, 0x5b, 0xe9, 0x83,
+	0x01, 0xe7, 0x33, 0x62, 0x1d, 0x93, 0x13, 0xcf, 0x17, 0x16, 0x50, 0xa0, 0xa0, 0x07, 0x0c, 0xc2,
+	0xcc, 0x97, 0x14, 0x21, 0x7e, 0x44, 0x4f, 0xdd, 0x7c, 0x9c, 0xcf, 0x08, 0x7f, 0x44, 0xbf, 0x0a,
+	0xcb, 0x82, 0x26, 0x55, 0xe8, 0x89, 0x1a, 0xff, 0x55, 0x01, 0x56, 0x54, 0x78, 0x1c, 0x2d, 0xfb,
+	0xc4, 0xe9, 0x93, 0x38, 0x5a, 0x76, 0xc9, 0xac, 0x50, 0x00, 0x45, 0xd2, 0x1e, 0x03, 0x74, 0xed,
+	0x90, 0xd0, 0xb8, 0x7b, 0x91, 0x23, 0xcd, 0x3d, 0xd9, 0xb6, 0x95, 0x41, 0x71, 0x7b, 0x97, 0x97,
+	0xb8, 0x30, 0xa5, 0xb2, 0xfa, 0x63, 0xa8, 0x08, 0x78, 0xe6, 0x18, 0x6a, 0x50, 0x7e, 0x41, 0x2e,
+	0x02, 0xec, 0x0f, 0xfb, 0x4d, 0xd7, 0x07, 0x7f, 0x97, 0xc0, 0xaf, 0xa4, 0x3c, 0xf1, 0xc6, 0xdf,
+	0x29, 0xc1, 0x4a, 0xd6, 0xc3, 0x19, 0x16, 0xd6, 0xf7, 0x93, 0xf6, 0x2e, 0x53, 0x2d, 0xcc, 0x41,
+	0xe5, 0x59, 0x1b, 0x53, 0x05, 0x1a, 0xda, 0xe8, 0xb0, 0xd9, 0x34, 0xad, 0xdd, 0x83, 0x76, 0xbb,
+	0xb9, 0x4b, 0xe3, 0xa6, 0x17, 0xa9, 0x5d, 0x83, 0xc1, 0xf6, 0x5a, 0x9d, 0x18, 0x5c, 0xd2, 0x5e,
+	0x83, 0xad, 0x87, 0xcd, 0xa3, 0xdd, 0xc7, 0xcd, 0x3d, 0x8b, 0xd9, 0x2e, 0xdb, 0x8f, 0xac, 0xdd,
+	0x87, 0xad, 0xfd, 0xa3, 0xa6, 0xd9, 0xa1, 0x16, 0x53, 0x93, 0x07, 0x5d, 0x7f, 0x1d, 0x6e, 0xe5,
+	0x62, 0x1d, 0x9a, 0x07, 0x8f, 0xcc, 0x66, 0xa7, 0x53, 0x9b, 0x1a, 0x8b, 0xf6, 0xb0, 0xd5, 0x6e,
+	0x75, 0x1e, 0xb3, 0x48, 0xed, 0x1b, 0xb0, 0x2e, 0xd0, 0x1e, 0x37, 0x1b, 0x7b, 0x72, 0x55, 0x33,
+	0xda, 0x75, 0xa8, 0x27, 0x33, 0xa3, 0x1a, 0x2a, 0x59, 0xb9, 0x11, 0xe1, 0xaa, 0x76, 0x13, 0x74,
+	0xd6, 0xbd, 0xe7, 0x4d, 0xd3, 0x6a, 0xec, 0xed, 0xd1, 0x32, 0xcd, 0x98, 0x36, 0x68, 0x9b, 0xb0,
+	0x91, 0x91, 0x1f, 0x11, 0x98, 0xa5, 0x03, 0x67, 0x36, 0x3b, 0xbb, 0x8d, 0x76, 0x54, 0x68, 0x8e,
+	0x9a, 0x74, 0x10, 0x16, 0xb5, 0x63, 0x5e, 0x02, 0x46, 0xa5, 0x17, 0xde, 0xb8, 0x0f, 0x73, 0xf2,
+	0x6b, 0x0d, 0x66, 0xb5, 0x7a, 0x74, 0x68, 0x7d, 0x74, 0x60, 0xee, 0xed, 0xb7, 0x3a, 0x34, 0x12,
+	0x73, 0x15, 0xa6, 0x1e, 0xb4, 0x0e, 0xdf, 0xfd, 0x7a, 0xad, 0xb0, 0x63, 0x46, 0x9f, 0xe0, 0xec,
+	0x10, 0xff, 0x25, 0xd5, 0x36, 0x7c, 0x07, 0x66, 0x10, 0xa2, 0x5d, 0x93, 0xaf, 0x0e, 0xca, 0x87,
+	0x3a, 0x75, 0x3d, 0x2b, 0x8b, 0x73, 0xe2, 0xce, 0x2f, 0xdf, 0x83, 0x79, 0xbe, 0x6e, 0x05, 0xcd,
+	0xf7, 0xa1, 0x4c, 0xbf, 0x8c, 0xa7, 0xad, 0xc9, 0xe7, 0xb6, 0xf8, 0xcb, 0x79, 0xfa, 0x7a, 0x0a,
+	0x1e, 0x85, 0x42, 0x99, 0xc1, 0x2f, 0xe0, 0x29, 0x8d, 0x51, 0x3f, 0xab, 0xa7, 0xeb, 0x59, 0x59,
+	0x48, 0xc1, 0x84, 0x79, 0xe5, 0xeb, 0x77, 0xda, 0x66, 0xfa, 0xa3, 0x74, 0xca, 0x27, 0xf5, 0xf4,
+	0xad, 0x7c, 0x84, 0x28, 0xdc, 0x4c, 0xa5, 0x21, 0x8e, 0x02, 0x7a, 0xe6, 0x37, 0xee, 0x38, 0xa5,
+	0x8d, 0x31, 0xdf, 0xbf, 0xa3, 0x5d, 0x13, 0x5f, 0x87, 0x93, 0xbb, 0xa6, 0x7e, 0xe2, 0x47, 0xd7,
+	0xb3, 0xb2, 0x90, 0x42, 0x00, 0xf5, 0x3c, 0x8b, 0x9a, 0x96, 0xf8, 0x00, 0xc4, 0x38, 0x03, 0x9e,
+	0x7e, 0x7f, 0x22, 0x5c, 0xac, 0xf4, 0x19, 0x2c, 0xa8, 0x1f, 0x2d, 0xd1, 0xb6, 0xd4, 0xe2, 0xe9,
+	0xb3, 0x9e, 0x7e, 0x6b, 0x0c, 0x06, 0x92, 0xfd, 0x3e, 0x2c, 0xaa, 0x39, 0x81, 0x96, 0x5f, 0x2a,
+	0x1a, 0x60, 0x63, 0x1c, 0x0a, 0xa7, 0xfc, 0x4e, 0x41, 0x7b, 0x04, 0xd5, 0xe8, 0xbb, 0x11, 0xda,
+	0x46, 0xd6, 0xd7, 0x24, 0x04, 0xbd, 0x1b, 0x63, 0x3f, 0x35, 0xa1, 0x3d, 0x01, 0x88, 0xa1, 0xda,
+	0xf5, 0x1c, 0xe4, 0x49, 0x48, 0xbd, 0x53, 0xd0, 0x4e, 0x61, 0x25, 0x4b, 0x61, 0xa0, 0xdd, 0x49,
+	0xdd, 0xd7, 0x33, 0x55, 0x11, 0xfa, 0xdd, 0x4b, 0xf1, 0xb0, 0xd5, 0xfb, 0x30, 0x2b, 0x6b, 0x13,
+	0x6f, 0x64, 0xab, 0x43, 0x05, 0xd9, 0x9b, 0x79, 0xd9, 0x48, 0xad, 0x07, 0xcb, 0x19, 0x4a, 0x54,
+	0xed, 0xf5, 0xcb, 0x94, 0xac, 0x9c, 0xfa, 0x9d, 0xc9, 0x74, 0xb1, 0xda, 0x43, 0xa8, 0x46, 0x5f,
+	0x98, 0x50, 0xa6, 0x2c, 0xf9, 0x31, 0x0a, 0xfd, 0x7a, 0x76, 0x66, 0x4c, 0x27, 0xfa, 0xfe, 0x81,
+	0x42, 0x27, 0xf9, 0xb1, 0x05, 0xfd, 0x7a, 0x76, 0xa6, 0x44, 0x47, 0x98, 0x65, 0x55, 0x3a, 0x09,
+	0x03, 0xae, 0x7e, 0x3d, 0x3b, 0x13, 0xe9, 0x8c, 0x94, 0xb8, 0x36, 0x8a, 0x71, 0x40, 0x59, 0xb2,
+	0x97, 0xc4, 0x91, 0xd2, 0xef, 0x4f, 0x84, 0x1b, 0xf1, 0x9a, 0x13, 0x7f, 0x3d, 0x54, 0xa9, 0xf2,
+	0x4e, 0x86, 0xa8, 0xcb, 0xaa, 0xee, 0xee, 0xa5, 0x78, 0x51, 0x55, 0x9f, 0xc1, 0x35, 0xd9, 0xd2,
+	0xab, 0xd6, 0x77, 0x7f, 0xb2, 0x68, 0x41, 0xbc, 0xd2, 0x37, 0xaf, 0x12, 0x5a, 0xe8, 0x5e, 0xe1,
+	0x9d, 0x82, 0xe6, 0xc3, 0x7a, 0x8e, 0xe5, 0x45, 0xfb, 0xf2, 0x24, 0xd6, 0x19, 0x5e, 0xef, 0x1b,
+	0x93, 0x1b, 0x72, 0xde, 0x29, 0x68, 0x3f, 0x80, 0x5a, 0xf2, 0xdb, 0x05, 0x9a, 0x71, 0xf9, 0x67,
+	0x19, 0xf4, 0xdb, 0x63, 0x71, 0xe2, 0xcd, 0x4b, 0xf9, 0xa4, 0xa6, 0xb2, 0x79, 0x65, 0x7d, 0xc6,
+	0x53, 0xdf, 0xca, 0x47, 0x88, 0x4e, 0xe5, 0xd3, 0xfc, 0xed, 0xad, 0x56, 0x4f, 0x3d, 0x0d, 0x16,
+	0x54, 0xae, 0x65, 0xe4, 0x44, 0x3d, 0xde, 0x87, 0x59, 0xe9, 0x1b, 0x92, 0x8a, 0x3c, 0x49, 0x7f,
+	0x53, 0x53, 0xbf, 0x99, 0x97, 0x1d, 0x4b, 0x27, 0xe9, 0x1b, 0x88, 0x69, 0x6a, 0x8a, 0x6f, 0x96,
+	0x7e, 0x33, 0x2f, 0x3b, 0x72, 0xf9, 0xa8, 0x25, 0x3f, 0x27, 0xa8, 0xcc, 0x46, 0xce, 0xd7, 0x11,
+	0xf5, 0xdb, 0x63, 0x71, 0x90, 0xf8, 0x01, 0xcc, 0xc9, 0xdf, 0xf6, 0xd3, 0x6e, 0xa6, 0x0a, 0x29,
+	0xdf, 0x29, 0xd4, 0x37, 0x73, 0xf3, 0x63, 0x59, 0x9a, 0x61, 0x00, 0x51, 0x64, 0x69, 0xbe, 0xe1,
+	0x45, 0xbf, 0x73, 0x19, 0x5a, 0x5c, 0x4b, 0x6b, 0x30, 0xbe, 0x96, 0xd6, 0x60, 0xa2, 0x5a, 0xc6,
+	0x99, 0x3c, 0x7e, 0xc0, 0x02, 0xff, 0x2a, 0x4a, 0x64, 0x65, 0xe4, 0x73, 0x34, 0xd9, 0xfa, 0xed,
+	0xb1, 0x38, 0x31, 0xf1, 0xa4, 0xc1, 0x42, 0x21, 0x9e, 0x63, 0x51, 0xd1, 0x6f, 0x8f, 0xc5, 0x49,
+	0xf2, 0x4c, 0x0e, 0xf1, 0xd6, 0xe0, 0x72, 0xe2, 0xb9, 0x96, 0x86, 0x8f, 0x61, 0x31, 0xf1, 0xf1,
+	0x0d, 0xe5, 0x5c, 0x93, 0xfd, 0x65, 0x13, 0xdd, 0x18, 0x87, 0x82, 0x94, 0x9f, 0xc3, 0x82, 0xfa,
+	0x6d, 0x09, 0xe5, 0x20, 0x96, 0xf9, 0xd9, 0x09, 0x3d, 0x17, 0x43, 0x3d, 0x97, 0x64, 0x85, 0x72,
+	0x57, 0xf6, 0x8a, 0x31, 0x81, 0xe7, 0xf5, 0xbb, 0x97, 0xe2, 0xc5, 0x43, 0x93, 0x88, 0x86, 0xac,
+	0x0c, 0x4d, 0x76, 0x74, 0x72, 0xdd, 0x18, 0x87, 0x12, 0xcf, 0x68, 0x22, 0x4b, 0xe5, 0xc5, 0x9c,
+	0x08, 0xd7, 0xfa, 0xed, 0xb1, 0x38, 0x71, 0xb3, 0x13, 0xb1, 0x76, 0x95, 0x66, 0x67, 0x87, 0x1b,
+	0xd6, 0x8d, 0x71, 0x28, 0x48, 0xd9, 0x06, 0x2d, 0x1d, 0x21, 0x57, 0x93, 0x3d, 0xf5, 0x73, 0x83,
+	0xf1, 0xea, 0xaf, 0x5f, 0x82, 0x85, 0x55, 0x5c, 0x80, 0x9e, 0x1f, 0x16, 0x57, 0x7b, 0x33, 0x4d,
+	0x24, 0x3f, 0xc4, 0xae, 0xfe, 0xd6, 0x84, 0xd8, 0xf1, 0xb8, 0x25, 0x02, 0xbd, 0x2a, 0xe3, 0x96,
+	0x1d, 0x86, 0x57, 0x37, 0xc6, 0xa1, 0xc8, 0xbb, 0xa4, 0x14, 0xca, 0x35, 0xb1, 0x4b, 0xa6, 0x83,
+	0xc3, 0xea, 0x5b, 0xf9, 0x08, 0x48, 0xf3, 0x87, 0xb0, 0x9a, 0x19, 0xe5, 0x55, 0x93, 0xd9, 0x7b,
+	0x5c, 0x9c, 0x58, 0xfd, 0xde, 0xe5, 0x88, 0xf1, 0x16, 0x28, 0xc5, 0x28, 0x55, 0xb6, 0xc0, 0x74,
+	0x20, 0x59, 0xfd, 0x66, 0x5e, 0x76, 0xbc, 0x4b, 0x49, 0xe0, 0x40, 0xbb, 0x39, 0x3e, 0x4a, 0xab,
+	0xbe, 0x99, 0x9b, 0x1f, 0x4f, 0x5c, 0xc2, 0xc1, 0x57, 0x99, 0xb8, 0x6c, 0x2f, 0x6a, 0xdd, 0x18,
+	0x87, 0x12, 0xaf, 0xd3, 0xa4, 0xfb, 0xa5, 0x7a, 0x76, 0xca, 0x76, 0x57, 0xd5, 0x6f, 0x8f, 0xc5,
+	0x91, 0xc6, 0x41, 0x72, 0x21, 0x54, 0xc7, 0x21, 0xed, 0x20, 0xa9, 0x6f, 0xe6, 0xe6, 0x23, 0xc1,
+	0x2e, 0x68, 0x69, 0x9f, 0x2e, 0x65, 0x79, 0xe6, 0xba, 0xf0, 0xe9, 0xaf, 0x5f, 0x82, 0x15, 0x49,
+	0x5f, 0xaa, 0x5a, 0x40, 0x03, 0x9f, 0xaa, 0x5a, 0x50, 0x8d, 0xb0, 0xfa, 0x46, 0x66, 0x5e, 0xbc,
+	0xe3, 0x67, 0x58, 0xc5, 0x94, 0x1d, 0x3f, 0xdf, 0x24, 0xa7, 0xdf, 0xb9, 0x0c, 0x0d, 0x6b, 0x79,
+	0x09, 0xd7, 0x72, 0x2d, 0x41, 0xca, 0x49, 0xff, 0x32, 0x7b, 0x95, 0xfe, 0xe6, 0x64, 0xc8, 0xb1,
+	0xda, 0x23, 0xcf, 0x1c, 0xa4, 0xa9, 0x67, 0xf7, 0xb1, 0x16, 0x28, 0xfd, 0xfe, 0x44, 0xb8, 0x31,
+	0x37, 0xc9, 0xd6, 0x10, 0x85, 0x9b, 0x32, 0x0c, 0x36, 0xfa, 0x66, 0x6e, 0x3e, 0x12, 0x6c, 0x01,
+	0xc4, 0x16, 0x13, 0x45, 0x97, 0x90, 0xb2, 0xae, 0xe8, 0x37, 0x72, 0x72, 0x63, 0xf9, 0x21, 0x99,
+	0x4d, 0xb4, 0x24, 0xf6, 0x98, 0x03, 0x79, 0x86, 0xb5, 0x85, 0x36, 0x2c, 0xb6, 0x09, 0x28, 0x0d,
+	0x4b, 0xd9, 0x14, 0xf4, 0x1b, 0x39, 0xb9, 0xa8, 0x08, 0xfc, 0x8b, 0xb3, 0x22, 0xa4, 0x24, 0x15,
+	0x80, 0xc4, 0x17, 0xea, 0xc0, 0x03, 0x98, 0x93, 0x43, 0x4a, 0x2a, 0x83, 0x99, 0x11, 0x82, 0x52,
+	0xdf, 0xcc, 0xcd, 0x8f, 0x67, 0x47, 0x8e, 0x34, 0xaa, 0x10, 0xcc, 0x88, 0xd4, 0xaa, 0x6f, 0xe6,
+	0xe6, 0xc7, 0x3c, 0x96, 0x17, 0xd9, 0x53, 0xe1, 0xb1, 0x4b, 0xc2, 0x98, 0xea, 0xf7, 0x27, 0xc2,
+	0x8d, 0x47, 0x3e, 0x0e, 0xf4, 0xa9, 0x8c, 0x7c, 0x2a, 0x82, 0xa8, 0x7e, 0x23, 0x27, 0x37, 0x66,
+	0x09, 0x29, 0xc4, 0xa7, 0xc2, 0x12, 0xe9, 0x80, 0xa0, 0xfa, 0xcd, 0xbc, 0x6c, 0xa4, 0xf6, 0x4b,
+	0xb0, 0x94, 0x0a, 0x99, 0xa9, 0xdd, 0x56, 0x45, 0x5a, 0x66, 0xbc, 0x4f, 0xfd, 0xb5, 0xf1, 0x48,
+	0x31, 0xfd, 0x54, 0x30, 0x4b, 0x85, 0x7e, 0x5e, 0x48, 0x4e, 0xfd, 0xb5, 0xf1, 0x48, 0x48, 0xff,
+	0xc7, 0x05, 0xb8, 0x31, 0x36, 0x52, 0xa5, 0xf6, 0xb6, 0xdc, 0xce, 0x09, 0x62, 0x5f, 0xea, 0xef,
+	0x4c, 0x5e, 0x20, 0xe6, 0x51, 0x39, 0x5c, 0x98, 0xc2, 0xa3, 0x19, 0xd1, 0x31, 0xf5, 0xcd, 0xdc,
+	0x7c, 0x24, 0x78, 0x8a, 0xd1, 0x33, 0x13, 0xc1, 0xc2, 0x94, 0x83, 0xfa, 0x98, 0x30, 0xa0, 0xfa,
+	0xdd, 0x4b, 0xf1, 0xb0, 0xa2, 0x07, 0x30, 0x83, 0x11, 0xd8, 0x14, 0x4d, 0xb5, 0x1a, 0x25, 0x4e,
+	0xd7, 0xb3, 0xb2, 0xa2, 0x7d, 0xed, 0x01, 0xcc, 0x60, 0x50, 0x40, 0x85, 0x86, 0x1a, 0x1a, 0x51,
+	0xd7, 0xb3, 0xb2, 0x64, 0xc5, 0x83, 0x14, 0x35, 0x4c, 0x61, 0xea, 0x74, 0x8c, 0x31, 0xfd, 0x66,
+	0x5e, 0xb6, 0x24, 0x35, 0x63, 0x1b, 0xa4, 0x2a, 0x35, 0x53, 0x36, 0x4e, 0xfd, 0x66, 0x5e, 0xb6,
+	0x74, 0x2b, 0x50, 0x4d, 0x8b, 0xea, 0xad, 0x20, 0xd3, 0x20, 0xa9, 0x1b, 0xe3, 0x50, 0x62, 0xbe,
+	0x91, 0xed, 0x7d, 0x0a, 0xdf, 0x64, 0x98, 0x1c, 0xf5, 0xcd, 0xdc, 0x7c, 0x94, 0xca, 0x1e, 0xac,
+	0x48, 0xa1, 0x7f, 0x9e, 0xef, 0x08, 0xa9, 0xfc, 0x11, 0x2c, 0xa8, 0x41, 0xa2, 0x94, 0x0b, 0x65,
+	0x66, 0x04, 0x2e, 0xfd, 0xd6, 0x18, 0x0c, 0x31, 0x6f, 0x3b, 0xbf, 0x5f, 0x01, 0x4d, 0xca, 0x11,
+	0xf5, 0x3d, 0x83, 0x05, 0x35, 0xd4, 0x91, 0x52, 0x5f, 0x66, 0x50, 0x2a, 0xfd, 0xd6, 0x18, 0x8c,
+	0xf8, 0x36, 0xa0, 0xc4, 0x43, 0x52, 0x6e, 0x03, 0x59, 0x11, 0x94, 0xf4, 0xad, 0x7c, 0x84, 0x58,
+	0x40, 0xa5, 0xa2, 0x25, 0x29, 0x02, 0x2a, 0x2f, 0xd0, 0x92, 0xfe, 0xda, 0x78, 0xa4, 0x58, 0xf2,
+	0xc7, 0xc1, 0x64, 0x14, 0xc9, 0x9f, 0x0a, 0x49, 0xa3, 0xdf, 0xc8, 0xc9, 0x8d, 0xa5, 0x42, 0x56,
+	0xc8, 0x18, 0x45, 0x2a, 0x8c, 0x09, 0x51, 0xa3, 0xdf, 0xbd, 0x14, 0x4f, 0x52, 0xd1, 0x8b, 0x10,
+	0x32, 0xaa, 0x8a, 0x3e, 0x11, 0x91, 0x46, 0xbf, 0x9e, 0x9d, 0x19, 0x1f, 0x56, 0x33, 0x22, 0xc5,
+	0x28, 0x87, 0xd5, 0xfc, 0xa8, 0x34, 0xfa, 0x9d, 0xcb, 0xd0, 0x32, 0x6b, 0x89, 0x23, 0x7f, 0x65,
+	0x17, 0x4f, 0x04, 0xa4, 0xd1, 0xef, 0x5c, 0x86, 0x26, 0x29, 0x1e, 0x12, 0x91, 0x62, 0x54, 0xc5,
+	0x43, 0x76, 0x20, 0x1a, 0xfd, 0xf6, 0x58, 0x9c, 0xd8, 0xf2, 0xa6, 0x86, 0x8b, 0x51, 0xd7, 0x4b,
+	0x56, 0x14, 0x1a, 0xfd, 0xd6, 0x18, 0x0c, 0xe9, 0xf6, 0x19, 0x07, 0x8e, 0xd1, 0x6e, 0xa4, 0x4b,
+	0x48, 0x31, 0x68, 0xf4, 0x9b, 0x79, 0xd9, 0x4a, 0x23, 0xa5, 0x90, 0x31, 0xc9, 0x46, 0xa6, 0x43,
+	0xd1, 0xe8, 0xb7, 0xc6, 0x60, 0xa0, 0xcc, 0xfa, 0x5f, 0x45, 0xda, 0x4a, 0xd2, 0x13, 0xb2, 0xc3,
+	0x06, 0x4d, 0x78, 0xeb, 0xc4, 0xe1, 0x15, 0x95, 0xbb, 0x58, 0x6e, 0x6c, 0x48, 0xfd, 0xf5, 0x4b,
+	0xb0, 0xe2, 0x35, 0x19, 0xc7, 0x2b, 0x54, 0xd6, 0x64, 0x2a, 0xb4, 0xa2, 0x7e, 0x23, 0x27, 0x37,
+	0x56, 0xec, 0xa4, 0x7d, 0x8b, 0x32, 0x5b, 0x9b, 0x72, 0x1a, 0xd2, 0x5f, 0xbf, 0x04, 0x2b, 0x96,
+	0x50, 0x29, 0x97, 0x21, 0x2d, 0x71, 0x4f, 0xce, 0xf4, 0x4a, 0xd2, 0x5f, 0x1b, 0x8f, 0x84, 0x13,
+	0xf0, 0x3d, 0x98, 0xe7, 0x81, 0x70, 0x24, 0x37, 0x01, 0x0e, 0x08, 0x94, 0x0d, 0x5d, 0x8d, 0x0a,
+	0xa4, 0xeb, 0x59, 0x59, 0x48, 0xf2, 0x1f, 0x16, 0x60, 0x9e, 0x73, 0xba, 0xa0, 0xb9, 0x0f, 0xb3,
+	0x52, 0x64, 0x12, 0x85, 0x15, 0xd3, 0xe1, 0x51, 0xf4, 0x9b, 0x79, 0xd9, 0x0a, 0x2b, 0xca, 0x04,
+	0xb7, 0x2e, 0x0b, 0xb9, 0xa2, 0xdf, 0x1a, 0x83, 0x81, 0xcd, 0x1e, 0x82, 0x8e, 0x2a, 0x12, 0x76,
+	0xe3, 0xc1, 0x73, 0x9e, 0xe8, 0x82, 0x09, 0xf3, 0x4a, 0xfc, 0x12, 0x65, 0xf7, 0xc9, 0x8a, 0xa1,
+	0xa2, 0x6f, 0xe5, 0x23, 0x60, 0x8d, 0x7f, 0x1a, 0x56, 0x38, 0x53, 0x61, 0x86, 0xa8, 0xeb, 0x14,
+	0x56, 0xb2, 0xde, 0xc8, 0x2b, 0xa2, 0x7e, 0xcc, 0xd3, 0x7c, 0xfd, 0xee, 0xa5, 0x78, 0xbc, 0x01,
+	0xc7, 0xd3, 0x43, 0xdf, 0x0b, 0xbd, 0x77, 0xff, 0xcf, 0x00, 0xd0, 0x9d, 0x90, 0x6c, 0xc6, 0x95,
+	0x00, 0x00,
 }
@@ -6,10 +6,14 @@
 package main
 
 import (
+	"bytes"
 	"context"
 	"crypto/tls"
+	"crypto/x509"
+	"expvar"
 	"io/ioutil"
 	"net"
+	"net/http"
 	"os"
 	"path/filepath"
 	"runtime"
@@ -19,6 +23,7 @@ import (
 	"github.com/fonero-project/fnod/certgen"
 	"github.com/fonero-project/fnowallet/errors"
 	"github.com/fonero-project/fnowallet/loader"
+	"github.com/fonero-project/fnowallet/rpc/gateway"
 	"github.com/fonero-project/fnowallet/rpc/legacyrpc"
 	"github.com/fonero-project/fnowallet/rpc/rpcserver"
 
@@ -106,7 +111,7 @@ func generateRPCKeyPair(writeKey bool) (tls.Certificate, error) {
 	return keyPair, nil
 }
 
-func startRPCServers(walletLoader *loader.Loader) (*grpc.Server, *legacyrpc.Server, error) {
+func startRPCServers(ctx context.Context, walletLoader *loader.Loader) (*grpc.Server, *legacyrpc.Server, error) {
 	var jsonrpcAddrNotifier jsonrpcListenerEventServer
 	var grpcAddrNotifier grpcListenerEventServer
 	if cfg.RPCListenerEvents {
@@ -114,6 +119,12 @@ func startRPCServers(walletLoader *loader.Loader) (*grpc.Server, *legacyrpc.Serv
 		grpcAddrNotifier = newGRPCListenerEventServer(outgoingPipeMessages)
 	}
 
+	if cfg.rateLimiter != nil {
+		expvar.Publish("ratelimit", expvar.Func(func() interface{} {
+			return cfg.rateLimiter.Stats()
+		}))
+	}
+
 	var (
 		server       *grpc.Server
 		legacyServer *legacyrpc.Server
@@ -139,17 +150,25 @@ func startRPCServers(walletLoader *loader.Loader) (*grpc.Server, *legacyrpc.Serv
 			return tls.Listen(net, laddr, tlsConfig)
 		}
 
-		if len(cfg.GRPCListeners) != 0 {
-			listeners := makeListeners(cfg.GRPCListeners, net.Listen)
-			if len(listeners) == 0 {
-				err := errors.New("failed to create listeners for RPC server")
-				return nil, nil, err
+		if len(cfg.GRPCListeners) != 0 || len(cfg.GatewayListeners) != 0 {
+			var listeners []net.Listener
+			if len(cfg.GRPCListeners) != 0 {
+				listeners = makeListeners(cfg.GRPCListeners, net.Listen)
+				if len(listeners) == 0 {
+					err := errors.New("failed to create listeners for RPC server")
+					return nil, nil, err
+				}
 			}
-			creds := credentials.NewServerTLSFromCert(&keyPair)
+			grpcTLSConfig := &tls.Config{
+				Certificates: []tls.Certificate{keyPair},
+				MinVersion:   tls.VersionTLS12,
+			}
+			cfg.grpcAuth.configureTLS(grpcTLSConfig)
+			creds := credentials.NewTLS(grpcTLSConfig)
 			server = grpc.NewServer(
 				grpc.Creds(creds),
-				grpc.StreamInterceptor(interceptStreaming),
-				grpc.UnaryInterceptor(interceptUnary),
+				grpc.StreamInterceptor(interceptStreaming(authorizeGRPC)),
+				grpc.UnaryInterceptor(interceptUnary(authorizeGRPC)),
 			)
 			rpcserver.RegisterServices(server)
 			rpcserver.StartWalletLoaderService(server, walletLoader, activeNet)
@@ -167,11 +186,17 @@ func startRPCServers(walletLoader *loader.Loader) (*grpc.Server, *legacyrpc.Serv
 					log.Tracef("Finished serving gRPC: %v", err)
 				}()
 			}
+			if len(cfg.GatewayListeners) != 0 {
+				err := startGateway(ctx, keyPair)
+				if err != nil {
+					return nil, nil, err
+				}
+			}
 		}
 	}
 
-	if cfg.Username == "" || cfg.Password == "" {
-		log.Info("Legacy RPC server disabled (requires username and password)")
+	if (cfg.Username == "" || cfg.Password == "") && len(cfg.legacyRPCUsers) == 0 {
+		log.Info("Legacy RPC server disabled (requires username and password or rpcauth users)")
 	} else if len(cfg.LegacyRPCListeners) != 0 {
 		listeners := makeListeners(cfg.LegacyRPCListeners, legacyListen)
 		if len(listeners) == 0 {
@@ -181,8 +206,11 @@ func startRPCServers(walletLoader *loader.Loader) (*grpc.Server, *legacyrpc.Serv
 		opts := legacyrpc.Options{
 			Username:            cfg.Username,
 			Password:            cfg.Password,
+			Users:               cfg.legacyRPCUsers,
 			MaxPOSTClients:      cfg.LegacyRPCMaxClients,
 			MaxWebsocketClients: cfg.LegacyRPCMaxWebsockets,
+			RateLimiter:         cfg.rateLimiter,
+			AuditLog:            cfg.auditLog,
 		}
 		legacyServer = legacyrpc.NewServer(&opts, activeNet.Params, walletLoader, &cfg.tbCfg, listeners)
 		for _, lis := range listeners {
@@ -198,6 +226,117 @@ func startRPCServers(walletLoader *loader.Loader) (*grpc.Server, *legacyrpc.Serv
 	return server, legacyServer, nil
 }
 
+// gatewayCredentials authenticates the gateway to the gRPC server with the
+// internal bearer token.
+type gatewayCredentials string
+
+func (c gatewayCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(c)}, nil
+}
+
+func (c gatewayCredentials) RequireTransportSecurity() bool {
+	return true
+}
+
+// startGateway serves the gRPC services to the HTTP/JSON gateway and starts
+// serving the gateway on the configured listeners.  The gateway calls a
+// separate gRPC server on a localhost listener, which only accepts calls
+// authenticated by the internal gateway token, so client certificates remain
+// required by the gRPC listeners.  Gateway clients are authenticated in the
+// same way as gRPC clients, and the gateway is not started unless client
+// certificates or bearer tokens are configured, as the gateway would otherwise
+// allow any client reaching its listeners to call every method.  The gateway
+// is stopped when ctx is cancelled.
+func startGateway(ctx context.Context, keyPair tls.Certificate) error {
+	if cfg.grpcAuth == nil {
+		return errors.New("the gateway requires gRPC client certificates " +
+			"or bearer tokens")
+	}
+	token, err := newGatewayToken()
+	if err != nil {
+		return err
+	}
+	serverTLSConfig := &tls.Config{
+		Certificates: []tls.Certificate{keyPair},
+		MinVersion:   tls.VersionTLS12,
+	}
+	server := grpc.NewServer(
+		grpc.Creds(credentials.NewTLS(serverTLSConfig)),
+		grpc.StreamInterceptor(interceptStreaming(authorizeGatewayCall)),
+		grpc.UnaryInterceptor(interceptUnary(authorizeGatewayCall)),
+	)
+	rpcserver.RegisterServices(server)
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return err
+	}
+	go func() {
+		err := server.Serve(lis)
+		log.Tracef("Finished serving gRPC to the gateway: %v", err)
+	}()
+
+	// The gateway connection is pinned to the server certificate rather
+	// than verifying it by hostname.
+	serverCert := keyPair.Certificate[0]
+	clientTLSConfig := &tls.Config{
+		InsecureSkipVerify: true,
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			if len(rawCerts) == 0 || !bytes.Equal(rawCerts[0], serverCert) {
+				return errors.New("unexpected gRPC server certificate")
+			}
+			return nil
+		},
+	}
+	conn, err := grpc.Dial(lis.Addr().String(),
+		grpc.WithTransportCredentials(credentials.NewTLS(clientTLSConfig)),
+		grpc.WithPerRPCCredentials(gatewayCredentials(token)))
+	if err != nil {
+		server.Stop()
+		return err
+	}
+	gw := gateway.New(conn, &gateway.Options{
+		Authorize:      gatewayAuthorize,
+		AllowedOrigins: cfg.GatewayOrigins,
+	})
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{keyPair},
+		MinVersion:   tls.VersionTLS12,
+	}
+	cfg.grpcAuth.configureTLS(tlsConfig)
+	listeners := makeListeners(cfg.GatewayListeners, func(net string, laddr string) (net.Listener, error) {
+		return tls.Listen(net, laddr, tlsConfig)
+	})
+	if len(listeners) == 0 {
+		conn.Close()
+		server.Stop()
+		return errors.New("failed to create listeners for gateway")
+	}
+	httpServer := &http.Server{Handler: gw}
+	for _, lis := range listeners {
+		lis := lis
+		go func() {
+			log.Infof("Gateway listening on %s", lis.Addr())
+			err := httpServer.Serve(lis)
+			log.Tracef("Finished serving gateway: %v", err)
+		}()
+	}
+	go func() {
+		<-ctx.Done()
+		log.Warn("Stopping gateway...")
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		err := httpServer.Shutdown(shutdownCtx)
+		cancel()
+		if err != nil {
+			httpServer.Close()
+		}
+		conn.Close()
+		server.Stop()
+		log.Info("Gateway shutdown")
+	}()
+	return nil
+}
+
 // serviceName returns the package.service segment from the full gRPC method
 // name `/package.service/method`.
 func serviceName(method string) string {
@@ -207,40 +346,83 @@ func serviceName(method string) string {
 	return method[:strings.IndexRune(method, '/')]
 }
 
-func interceptStreaming(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
-	p, ok := peer.FromContext(ss.Context())
-	if ok {
-		grpcLog.Infof("Streaming method %s invoked by %s", info.FullMethod,
-			p.Addr.String())
-	}
-	err := rpcserver.ServiceReady(serviceName(info.FullMethod))
-	if err != nil {
-		return err
-	}
-	err = handler(srv, ss)
-	if err != nil && ok {
-		grpcLog.Errorf("Streaming method %s invoked by %s errored: %v",
-			info.FullMethod, p.Addr.String(), err)
+// grpcAuthorizeFunc returns nil when the client of a gRPC request may call the
+// method and a gRPC error when it may not.
+type grpcAuthorizeFunc func(ctx context.Context, method string) error
+
+// authorizeGRPC authorizes and rate limits the clients of the gRPC listeners.
+func authorizeGRPC(ctx context.Context, method string) error {
+	err := cfg.grpcAuth.authorize(ctx, method)
+	if err == nil {
+		err = checkGRPCRateLimit(ctx, method)
 	}
 	return err
 }
 
-func interceptUnary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
-	p, ok := peer.FromContext(ctx)
-	if ok {
-		grpcLog.Infof("Unary method %s invoked by %s", info.FullMethod,
-			p.Addr.String())
-	}
-	err = rpcserver.ServiceReady(serviceName(info.FullMethod))
-	if err != nil {
-		return nil, err
+func interceptStreaming(authorize grpcAuthorizeFunc) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		p, ok := peer.FromContext(ss.Context())
+		if ok {
+			grpcLog.Infof("Streaming method %s invoked by %s", info.FullMethod,
+				p.Addr.String())
+		}
+		err := authorize(ss.Context(), info.FullMethod)
+		if err != nil {
+			if ok {
+				grpcLog.Warnf("Streaming method %s denied to %s: %v",
+					info.FullMethod, p.Addr.String(), err)
+			}
+			auditGRPC(ss.Context(), info.FullMethod, err)
+			return err
+		}
+		err = rpcserver.ServiceReady(serviceName(info.FullMethod))
+		if err == nil {
+			err = rpcserver.CheckWalletSelection(ss.Context(), info.FullMethod)
+		}
+		if err != nil {
+			return err
+		}
+		err = handler(srv, ss)
+		if err != nil && ok {
+			grpcLog.Errorf("Streaming method %s invoked by %s errored: %v",
+				info.FullMethod, p.Addr.String(), err)
+		}
+		auditGRPC(ss.Context(), info.FullMethod, err)
+		return err
 	}
-	resp, err = handler(ctx, req)
-	if err != nil && ok {
-		grpcLog.Errorf("Unary method %s invoked by %s errored: %v",
-			info.FullMethod, p.Addr.String(), err)
+}
+
+func interceptUnary(authorize grpcAuthorizeFunc) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		p, ok := peer.FromContext(ctx)
+		if ok {
+			grpcLog.Infof("Unary method %s invoked by %s", info.FullMethod,
+				p.Addr.String())
+		}
+		err = authorize(ctx, info.FullMethod)
+		if err != nil {
+			if ok {
+				grpcLog.Warnf("Unary method %s denied to %s: %v",
+					info.FullMethod, p.Addr.String(), err)
+			}
+			auditGRPC(ctx, info.FullMethod, err)
+			return nil, err
+		}
+		err = rpcserver.ServiceReady(serviceName(info.FullMethod))
+		if err == nil {
+			err = rpcserver.CheckWalletSelection(ctx, info.FullMethod)
+		}
+		if err != nil {
+			return nil, err
+		}
+		resp, err = handler(ctx, req)
+		if err != nil && ok {
+			grpcLog.Errorf("Unary method %s invoked by %s errored: %v",
+				info.FullMethod, p.Addr.String(), err)
+		}
+		auditGRPC(ctx, info.FullMethod, err)
+		return resp, err
 	}
-	return resp, err
 }
 
 type listenFunc func(net string, laddr string) (net.Listener, error)
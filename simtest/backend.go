@@ -0,0 +1,452 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package simtest
+
+import (
+	"context"
+	"sync"
+
+	"github.com/fonero-project/fnod/blockchain/stake"
+	"github.com/fonero-project/fnod/chaincfg/chainhash"
+	"github.com/fonero-project/fnod/fnoutil"
+	"github.com/fonero-project/fnod/gcs"
+	"github.com/fonero-project/fnod/txscript"
+	"github.com/fonero-project/fnod/wire"
+	"github.com/fonero-project/fnowallet/errors"
+	"github.com/fonero-project/fnowallet/wallet"
+	"github.com/jrick/bitset"
+)
+
+// maxHeaders is the maximum number of headers returned by GetHeaders.
+const maxHeaders = 2000
+
+// backend implements the wallet.NetworkBackend interface to sync a single
+// wallet to the harness chain.
+type backend struct {
+	h *Harness
+	w *wallet.Wallet
+
+	filterMu sync.Mutex
+	filter   *wallet.RescanFilter
+
+	// syncMu serializes syncs and protects the following fields.
+	syncMu           sync.Mutex
+	sidechains       wallet.SidechainForest
+	synced           bool
+	discoverAccounts bool
+	loadedFilters    bool
+}
+
+var (
+	_ wallet.NetworkBackend       = (*backend)(nil)
+	_ wallet.MissedTicketsChecker = (*backend)(nil)
+)
+
+func (b *backend) lookupBlock(op errors.Op, hash *chainhash.Hash) (*block, error) {
+	b.h.mu.Lock()
+	blk, ok := b.h.blocks[*hash]
+	b.h.mu.Unlock()
+	if !ok {
+		return nil, errors.E(op, errors.NotExist, errors.Errorf("unknown block %v", hash))
+	}
+	return blk, nil
+}
+
+// GetBlocks implements the GetBlocks method of the wallet.Peer interface.
+func (b *backend) GetBlocks(ctx context.Context, blockHashes []*chainhash.Hash) ([]*wire.MsgBlock, error) {
+	const op errors.Op = "simtest.GetBlocks"
+	blocks := make([]*wire.MsgBlock, len(blockHashes))
+	for i, hash := range blockHashes {
+		blk, err := b.lookupBlock(op, hash)
+		if err != nil {
+			return nil, err
+		}
+		blocks[i] = blk.msg
+	}
+	return blocks, nil
+}
+
+// GetCFilters implements the GetCFilters method of the wallet.Peer interface.
+func (b *backend) GetCFilters(ctx context.Context, blockHashes []*chainhash.Hash) ([]*gcs.Filter, error) {
+	const op errors.Op = "simtest.GetCFilters"
+	filters := make([]*gcs.Filter, len(blockHashes))
+	for i, hash := range blockHashes {
+		blk, err := b.lookupBlock(op, hash)
+		if err != nil {
+			return nil, err
+		}
+		filters[i] = blk.filter
+	}
+	return filters, nil
+}
+
+// GetHeaders implements the GetHeaders method of the wallet.Peer interface.
+// Headers of main chain blocks are returned beginning after the first locator
+// found in the main chain, and ending at hashStop or after maxHeaders headers.
+func (b *backend) GetHeaders(ctx context.Context, blockLocators []*chainhash.Hash, hashStop *chainhash.Hash) ([]*wire.BlockHeader, error) {
+	h := b.h
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	start := int32(1)
+	for _, hash := range blockLocators {
+		blk, ok := h.blocks[*hash]
+		if ok && h.mainChain[blk.height] == blk {
+			start = blk.height + 1
+			break
+		}
+	}
+	var headers []*wire.BlockHeader
+	for i := int(start); i < len(h.mainChain) && len(headers) < maxHeaders; i++ {
+		blk := h.mainChain[i]
+		headers = append(headers, &blk.msg.Header)
+		if hashStop != nil && blk.hash == *hashStop {
+			break
+		}
+	}
+	return headers, nil
+}
+
+// LoadTxFilter implements the LoadTxFilter method of the wallet.NetworkBackend
+// interface.
+func (b *backend) LoadTxFilter(ctx context.Context, reload bool, addrs []fnoutil.Address, outpoints []wire.OutPoint) error {
+	b.filterMu.Lock()
+	if reload || b.filter == nil {
+		b.filter = wallet.NewRescanFilter(nil, nil)
+	}
+	for _, addr := range addrs {
+		b.filter.AddAddress(addr)
+	}
+	for i := range outpoints {
+		b.filter.AddUnspentOutPoint(&outpoints[i])
+	}
+	b.filterMu.Unlock()
+	return nil
+}
+
+// PublishTransactions implements the PublishTransaction method of the
+// wallet.Peer interface.  Transactions are added to the harness mempool.
+func (b *backend) PublishTransactions(ctx context.Context, txs ...*wire.MsgTx) error {
+	const op errors.Op = "simtest.PublishTransactions"
+	err := b.h.acceptTxs(b, txs)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// Rescan implements the Rescan method of the wallet.NetworkBackend interface.
+func (b *backend) Rescan(ctx context.Context, blockHashes []chainhash.Hash, r wallet.RescanSaver) error {
+	const op errors.Op = "simtest.Rescan"
+	for i := range blockHashes {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		blk, err := b.lookupBlock(op, &blockHashes[i])
+		if err != nil {
+			return err
+		}
+		matches := b.rescanBlock(blk.msg)
+		if len(matches) != 0 {
+			err := r.SaveRescanned(&blockHashes[i], matches)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// StakeDifficulty implements the StakeDifficulty method of the
+// wallet.NetworkBackend interface.  The ticket price of every harness block is
+// the minimum stake difficulty.
+func (b *backend) StakeDifficulty(ctx context.Context) (fnoutil.Amount, error) {
+	return fnoutil.Amount(b.h.params.MinimumStakeDiff), nil
+}
+
+// ExistsMissedTickets implements the ExistsMissedTickets method of the
+// wallet.MissedTicketsChecker interface.  Tickets are reported as missed until
+// they are revoked in the main chain.
+func (b *backend) ExistsMissedTickets(ctx context.Context, tickets []*chainhash.Hash) (bitset.Bytes, error) {
+	h := b.h
+	h.mu.Lock()
+	tip := h.mainChain[len(h.mainChain)-1]
+	h.mu.Unlock()
+
+	missed := bitset.NewBytes(len(tickets))
+	for i, ticket := range tickets {
+		if _, ok := tip.state.missed[*ticket]; ok {
+			missed.Set(i)
+		}
+	}
+	return missed, nil
+}
+
+// rescanCheckTransactions appends transactions which match the filter to
+// *matches, and adds the outpoints of new outputs controlled by the wallet to
+// the filter.
+//
+// This method must be called with the filter mutex held.
+func (b *backend) rescanCheckTransactions(matches *[]*wire.MsgTx, txs []*wire.MsgTx, tree int8) {
+	params := b.h.params
+	for i, tx := range txs {
+		// Keep track of whether the transaction has already been added
+		// to the result.  It shouldn't be added twice.
+		added := false
+
+		txty := stake.TxTypeRegular
+		if tree == wire.TxTreeStake {
+			txty = stake.DetermineTxType(tx)
+		}
+
+		// Inputs of coinbases and the stakebase input of votes do not
+		// reference previous outputs.
+		inputs := tx.TxIn
+		switch {
+		case i == 0 && txty == stake.TxTypeRegular:
+			inputs = nil
+		case txty == stake.TxTypeSSGen:
+			inputs = inputs[1:]
+		}
+		for _, input := range inputs {
+			if !b.filter.ExistsUnspentOutPoint(&input.PreviousOutPoint) {
+				continue
+			}
+			if !added {
+				*matches = append(*matches, tx)
+				added = true
+			}
+		}
+
+		for i, output := range tx.TxOut {
+			_, addrs, _, err := txscript.ExtractPkScriptAddrs(
+				output.Version, output.PkScript, params)
+			if err != nil {
+				continue
+			}
+			for _, a := range addrs {
+				if !b.filter.ExistsAddress(a) {
+					continue
+				}
+
+				op := wire.OutPoint{
+					Hash:  tx.TxHash(),
+					Index: uint32(i),
+					Tree:  tree,
+				}
+				b.filter.AddUnspentOutPoint(&op)
+
+				if !added {
+					*matches = append(*matches, tx)
+					added = true
+				}
+			}
+		}
+	}
+}
+
+// rescanBlock returns the transactions of a block which are relevant to the
+// wallet, updating the filter with new outputs controlled by the wallet.
+func (b *backend) rescanBlock(block *wire.MsgBlock) (matches []*wire.MsgTx) {
+	b.filterMu.Lock()
+	if b.filter == nil {
+		b.filter = wallet.NewRescanFilter(nil, nil)
+	}
+	b.rescanCheckTransactions(&matches, block.STransactions, wire.TxTreeStake)
+	b.rescanCheckTransactions(&matches, block.Transactions, wire.TxTreeRegular)
+	b.filterMu.Unlock()
+	return matches
+}
+
+// filterRelevant filters out all transactions considered irrelevant without
+// updating the filter.
+func (b *backend) filterRelevant(txs []*wire.MsgTx) []*wire.MsgTx {
+	b.filterMu.Lock()
+	defer b.filterMu.Unlock()
+	if b.filter == nil {
+		return nil
+	}
+
+	var matches []*wire.MsgTx
+Txs:
+	for _, tx := range txs {
+		for _, in := range tx.TxIn {
+			if b.filter.ExistsUnspentOutPoint(&in.PreviousOutPoint) {
+				matches = append(matches, tx)
+				continue Txs
+			}
+		}
+		for _, out := range tx.TxOut {
+			_, addrs, _, err := txscript.ExtractPkScriptAddrs(out.Version,
+				out.PkScript, b.h.params)
+			if err != nil {
+				continue
+			}
+			for _, a := range addrs {
+				if b.filter.ExistsAddress(a) {
+					matches = append(matches, tx)
+					continue Txs
+				}
+			}
+		}
+	}
+	return matches
+}
+
+// acceptTxs adds relevant mempool transactions to a synced wallet.
+func (b *backend) acceptTxs(txs []*wire.MsgTx) {
+	for _, tx := range b.filterRelevant(txs) {
+		err := b.w.AcceptMempoolTx(tx)
+		if err != nil {
+			log.Warnf("Wallet rejected mempool transaction %v: %v", tx.TxHash(), err)
+		}
+	}
+}
+
+// addBlock adds a generated block to the sidechain forest when it is not in the
+// wallet's main chain.  Blocks of every branch are added as they are generated,
+// as is done when an SPV wallet is sent the headers of blocks which may never
+// become part of the main chain.
+func (b *backend) addBlock(blk *block) error {
+	const op errors.Op = "simtest.addBlock"
+	b.syncMu.Lock()
+	defer b.syncMu.Unlock()
+
+	haveBlock, _, err := b.w.BlockInMainChain(&blk.hash)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	if !haveBlock {
+		n := wallet.NewBlockNode(&blk.msg.Header, &blk.hash, blk.filter)
+		b.sidechains.AddBlockNode(n)
+	}
+	return nil
+}
+
+// sync attaches the harness main chain to the wallet.  The first sync attaches
+// all blocks without transactions, then discovers addresses and rescans the
+// chain, as is done during the startup of an SPV wallet.  Later syncs attach the
+// relevant transactions of new blocks, and create votes and revocations for the
+// new main chain tip.
+func (b *backend) sync() error {
+	const op errors.Op = "simtest.sync"
+	ctx := context.Background()
+	b.syncMu.Lock()
+	defer b.syncMu.Unlock()
+
+	// Find the main chain blocks which the wallet does not have in its
+	// main chain, in increasing height order.
+	b.h.mu.Lock()
+	mainChain := b.h.mainChain
+	b.h.mu.Unlock()
+	var newBlocks []*block
+	for i := len(mainChain) - 1; i > 0; i-- {
+		haveBlock, _, err := b.w.BlockInMainChain(&mainChain[i].hash)
+		if err != nil {
+			return errors.E(op, err)
+		}
+		if haveBlock {
+			break
+		}
+		newBlocks = append(newBlocks, mainChain[i])
+	}
+	for i := len(newBlocks) - 1; i >= 0; i-- {
+		blk := newBlocks[i]
+		n := wallet.NewBlockNode(&blk.msg.Header, &blk.hash, blk.filter)
+		b.sidechains.AddBlockNode(n)
+	}
+
+	bestChain, err := b.w.EvaluateBestChain(&b.sidechains)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	var newlyMissed []*chainhash.Hash
+	if len(bestChain) != 0 {
+		rescanPoint, err := b.w.RescanPoint()
+		if err != nil {
+			return errors.E(op, err)
+		}
+		var relevantTxs map[chainhash.Hash][]*wire.MsgTx
+		if b.synced && rescanPoint == nil {
+			relevantTxs = make(map[chainhash.Hash][]*wire.MsgTx)
+			for _, n := range bestChain {
+				blk, err := b.lookupBlock(op, n.Hash)
+				if err != nil {
+					return err
+				}
+				if matches := b.rescanBlock(blk.msg); len(matches) != 0 {
+					relevantTxs[*n.Hash] = matches
+				}
+				for i := range blk.state.newlyMissed {
+					newlyMissed = append(newlyMissed, &blk.state.newlyMissed[i])
+				}
+			}
+		}
+		prevChain, err := b.w.ChainSwitch(&b.sidechains, bestChain, relevantTxs)
+		if err != nil {
+			return errors.E(op, err)
+		}
+		for _, n := range prevChain {
+			b.sidechains.AddBlockNode(n)
+		}
+	}
+
+	rescanPoint, err := b.w.RescanPoint()
+	if err != nil {
+		return errors.E(op, err)
+	}
+	if rescanPoint != nil {
+		err = b.w.DiscoverActiveAddresses(ctx, b, rescanPoint, b.discoverAccounts)
+		if err != nil {
+			return errors.E(op, err)
+		}
+		b.discoverAccounts = false
+		err = b.w.LoadActiveDataFilters(ctx, b, true)
+		if err != nil {
+			return errors.E(op, err)
+		}
+		b.loadedFilters = true
+		header, err := b.w.BlockHeader(rescanPoint)
+		if err != nil {
+			return errors.E(op, err)
+		}
+		err = b.w.RescanFromHeight(ctx, b, int32(header.Height))
+		if err != nil {
+			return errors.E(op, err)
+		}
+	} else if !b.loadedFilters {
+		err = b.w.LoadActiveDataFilters(ctx, b, true)
+		if err != nil {
+			return errors.E(op, err)
+		}
+		b.loadedFilters = true
+	}
+
+	// Votes and revocations are only created for blocks attached after the
+	// wallet has synced, as earlier tickets may have already been spent.
+	if b.synced && len(bestChain) != 0 {
+		tip := bestChain[len(bestChain)-1]
+		blk, err := b.lookupBlock(op, tip.Hash)
+		if err != nil {
+			return err
+		}
+		winners := make([]*chainhash.Hash, len(blk.state.winners))
+		for i := range blk.state.winners {
+			winners[i] = &blk.state.winners[i]
+		}
+		err = b.w.VoteOnOwnedTickets(winners, tip.Hash, int32(tip.Header.Height))
+		if err != nil {
+			return errors.E(op, err)
+		}
+		if len(newlyMissed) != 0 {
+			err = b.w.RevokeOwnedTickets(newlyMissed)
+			if err != nil {
+				return errors.E(op, err)
+			}
+		}
+	}
+	b.synced = true
+	return nil
+}
@@ -0,0 +1,46 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+/*
+Package simtest provides an in-process simnet harness for end-to-end tests of
+the wallet.  Unlike the rpctest package, it does not require the fnod and
+fnowallet executables, and tests using it run with a plain go test.
+
+A Harness manages a mock chain of simnet blocks, a mempool, and the wallets
+synced to it.  Blocks are generated on demand and include all mempool
+transactions which are valid on the extended chain.  Any block may be extended,
+and the chain with the most blocks is the main chain, so reorganizations are
+created by generating blocks on a parent other than the main chain tip.
+
+The mock chain enforces the rules needed to test sends, tickets, votes and
+revocations: inputs must exist and be unspent, input scripts must execute,
+coinbase and stake outputs must reach maturity before being spent, and votes
+and revocations must spend tickets which were selected to vote on the parent
+block or missed their vote.  Tickets are selected to vote by a PRNG seeded by
+the block height and a lottery seed set with SetLotterySeed, so the selection
+does not depend on the ordering of wallet-created transactions.  Proof of work,
+subsidy amounts, and transaction fees are not validated.  The harness chain parameters are the simnet parameters
+with a stake difficulty window which never ends, so the ticket price of every
+block is the minimum stake difficulty.
+
+Wallets are created with in-memory databases and are kept unlocked.  Each
+wallet is associated with a network backend which serves the harness chain and
+delivers new blocks and relevant mempool transactions to the wallet before the
+harness methods that created them return.  After each new main chain tip is
+attached, wallets with voting enabled vote with any of their tickets selected
+to vote on the tip block, and revoke their tickets which missed a vote.  Every
+generated block is added to the sidechain forest of each wallet, even when it
+does not extend the main chain, so wallets evaluate competing branches as an SPV
+wallet would.
+
+CheckWallet compares the state of a wallet against a reference model computed
+from the harness chain and mempool.  The package tests use it to check wallets
+after each step of randomly generated sequences of blocks, forks, sends, and
+ticket purchases.
+
+Block timestamps begin at the genesis block timestamp and increase by the
+target block time, so wallet birthdays should be specified by height or by the
+timestamp of a generated block.
+*/
+package simtest
@@ -0,0 +1,465 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package simtest
+
+import (
+	"encoding/binary"
+	"math"
+	"sync"
+
+	"github.com/fonero-project/fnod/blockchain"
+	"github.com/fonero-project/fnod/blockchain/stake"
+	"github.com/fonero-project/fnod/chaincfg"
+	"github.com/fonero-project/fnod/chaincfg/chainhash"
+	"github.com/fonero-project/fnod/fnoutil"
+	"github.com/fonero-project/fnod/gcs"
+	"github.com/fonero-project/fnod/gcs/blockcf"
+	"github.com/fonero-project/fnod/txscript"
+	"github.com/fonero-project/fnod/wire"
+	"github.com/fonero-project/fnowallet/errors"
+)
+
+// blockVersion is the header version of generated blocks.
+const blockVersion = 6
+
+// block is a block of the harness chain.
+type block struct {
+	msg    *wire.MsgBlock
+	hash   chainhash.Hash
+	height int32
+	filter *gcs.Filter
+	parent *block
+	state  *state
+}
+
+// Harness manages a mock simnet chain, its mempool, and the wallets synced to
+// it.  Harness methods are safe for concurrent access, but blocks are expected
+// to be generated by a single goroutine.
+type Harness struct {
+	params       *chaincfg.Params
+	subsidyCache *blockchain.SubsidyCache
+
+	mu        sync.Mutex
+	blocks    map[chainhash.Hash]*block
+	mainChain []*block // Indexed by height
+	mempool   []*wire.MsgTx
+	pool      *state // Tip state with mempool transactions applied
+	nonce     uint64
+	wallets   []*Wallet
+
+	// lotterySeed seeds the selection of winning tickets.
+	lotterySeed int64
+}
+
+// New creates a harness with a chain containing only the simnet genesis block.
+func New() (*Harness, error) {
+	const op errors.Op = "simtest.New"
+
+	// Ticket prices never retarget, so the price of every block is the
+	// minimum stake difficulty.
+	params := chaincfg.SimNetParams
+	params.StakeDiffWindowSize = math.MaxInt32
+
+	genesis := params.GenesisBlock
+	filter, err := blockcf.Regular(genesis)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	g := &block{
+		msg:    genesis,
+		hash:   genesis.BlockHash(),
+		filter: filter,
+		state:  newState(),
+	}
+	h := &Harness{
+		params:       &params,
+		subsidyCache: blockchain.NewSubsidyCache(0, &params),
+		blocks:       map[chainhash.Hash]*block{g.hash: g},
+		mainChain:    []*block{g},
+		pool:         g.state.copy(),
+	}
+	return h, nil
+}
+
+// Params returns the chain parameters of the harness chain.  These must be used
+// by any wallet or transaction created for the chain.
+func (h *Harness) Params() *chaincfg.Params {
+	return h.params
+}
+
+// SetLotterySeed sets the seed used to select the tickets which vote on blocks
+// generated after the call.  Winners are selected by the seed and the block
+// height, and not the block hash, so a harness driven by the same sequence of
+// operations always selects the same winners even when the transactions
+// created by its wallets differ.
+func (h *Harness) SetLotterySeed(seed int64) {
+	h.mu.Lock()
+	h.lotterySeed = seed
+	h.mu.Unlock()
+}
+
+// Close stops and closes all wallets synced to the harness.
+func (h *Harness) Close() error {
+	h.mu.Lock()
+	wallets := h.wallets
+	h.wallets = nil
+	h.mu.Unlock()
+
+	var err error
+	for _, w := range wallets {
+		if e := w.close(); e != nil && err == nil {
+			err = e
+		}
+	}
+	return err
+}
+
+// Tip returns the hash and height of the main chain tip block.
+func (h *Harness) Tip() (chainhash.Hash, int32) {
+	h.mu.Lock()
+	tip := h.mainChain[len(h.mainChain)-1]
+	h.mu.Unlock()
+	return tip.hash, tip.height
+}
+
+// BlockHash returns the hash of the main chain block at height.
+func (h *Harness) BlockHash(height int32) (*chainhash.Hash, error) {
+	const op errors.Op = "simtest.BlockHash"
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if height < 0 || int(height) >= len(h.mainChain) {
+		return nil, errors.E(op, errors.NotExist, errors.Errorf("no main chain block at height %d", height))
+	}
+	hash := h.mainChain[height].hash
+	return &hash, nil
+}
+
+// Block returns the block with some hash from any branch of the chain.
+func (h *Harness) Block(hash *chainhash.Hash) (*wire.MsgBlock, error) {
+	const op errors.Op = "simtest.Block"
+	h.mu.Lock()
+	b, ok := h.blocks[*hash]
+	h.mu.Unlock()
+	if !ok {
+		return nil, errors.E(op, errors.NotExist, errors.Errorf("unknown block %v", hash))
+	}
+	return b.msg, nil
+}
+
+// Winners returns the tickets selected to vote on a block.
+func (h *Harness) Winners(hash *chainhash.Hash) ([]chainhash.Hash, error) {
+	const op errors.Op = "simtest.Winners"
+	h.mu.Lock()
+	b, ok := h.blocks[*hash]
+	h.mu.Unlock()
+	if !ok {
+		return nil, errors.E(op, errors.NotExist, errors.Errorf("unknown block %v", hash))
+	}
+	return append([]chainhash.Hash(nil), b.state.winners...), nil
+}
+
+// Mempool returns the transactions in the mempool, in the order they were
+// accepted.
+func (h *Harness) Mempool() []*wire.MsgTx {
+	h.mu.Lock()
+	txs := append([]*wire.MsgTx(nil), h.mempool...)
+	h.mu.Unlock()
+	return txs
+}
+
+// AcceptTx adds a transaction to the mempool if it is valid in a block
+// extending the main chain tip.  Wallets synced to the harness are notified of
+// the transaction if it is relevant to them.
+func (h *Harness) AcceptTx(tx *wire.MsgTx) error {
+	const op errors.Op = "simtest.AcceptTx"
+	err := h.acceptTxs(nil, []*wire.MsgTx{tx})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// acceptTxs adds transactions to the mempool and notifies all wallets other
+// than the publishing wallet of the accepted transactions.  Transactions are
+// added until one is rejected.
+func (h *Harness) acceptTxs(origin *backend, txs []*wire.MsgTx) error {
+	var accepted []*wire.MsgTx
+	var err error
+	h.mu.Lock()
+	for _, tx := range txs {
+		err = h.addToPool(tx)
+		if err != nil {
+			break
+		}
+		accepted = append(accepted, tx)
+	}
+	wallets := h.wallets
+	h.mu.Unlock()
+
+	for _, w := range wallets {
+		if w.backend != origin {
+			w.backend.acceptTxs(accepted)
+		}
+	}
+	return err
+}
+
+// addToPool validates a transaction against the tip state with the current
+// mempool applied and adds it to the mempool.
+//
+// This method must be called with the harness mutex held.
+func (h *Harness) addToPool(tx *wire.MsgTx) error {
+	txHash := tx.TxHash()
+	for _, m := range h.mempool {
+		if m.TxHash() == txHash {
+			return errors.E(errors.Exist, errors.Errorf("transaction %v is already in the mempool", &txHash))
+		}
+	}
+	tip := h.mainChain[len(h.mainChain)-1]
+	err := checkTx(h.params, h.pool, tip, tx, tip.height+1)
+	if err != nil {
+		return err
+	}
+	h.pool.apply(h.params, tx, tip.height+1)
+	h.mempool = append(h.mempool, tx)
+	return nil
+}
+
+// GenerateBlocks generates n blocks extending the main chain tip, with
+// coinbases paying to payTo.  A nil payTo creates anyone-can-spend coinbase
+// outputs.  Each block includes the mempool transactions which are valid in the
+// block.  All wallets are synced to the main chain after each generated block.
+func (h *Harness) GenerateBlocks(n int, payTo fnoutil.Address) ([]*chainhash.Hash, error) {
+	tip, _ := h.Tip()
+	return h.GenerateBlocksOn(&tip, n, payTo)
+}
+
+// GenerateBlocksOn generates n blocks extending the parent block, which may be
+// any block of the chain.  The new blocks become the main chain if the branch
+// ending at the last generated block is longer than the main chain, and
+// transactions of the blocks removed from the main chain are returned to the
+// mempool when they remain valid.  Each generated block is announced to all
+// wallets, including blocks which do not extend the main chain, and the wallets
+// are synced to the main chain after each block.
+func (h *Harness) GenerateBlocksOn(parent *chainhash.Hash, n int, payTo fnoutil.Address) ([]*chainhash.Hash, error) {
+	const op errors.Op = "simtest.GenerateBlocksOn"
+
+	pkScript := []byte{txscript.OP_TRUE}
+	if payTo != nil {
+		var err error
+		pkScript, err = txscript.PayToAddrScript(payTo)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+	}
+
+	h.mu.Lock()
+	p, ok := h.blocks[*parent]
+	h.mu.Unlock()
+	if !ok {
+		return nil, errors.E(op, errors.NotExist, errors.Errorf("unknown block %v", parent))
+	}
+
+	// Wallets are synced after each block so votes for each new tip are
+	// available to the next block.
+	hashes := make([]*chainhash.Hash, 0, n)
+	for i := 0; i < n; i++ {
+		h.mu.Lock()
+		b, err := h.generate(p, pkScript)
+		if err == nil && int(b.height) >= len(h.mainChain) {
+			h.setTip(b)
+		}
+		h.mu.Unlock()
+		if err != nil {
+			return hashes, errors.E(op, err)
+		}
+		hashes = append(hashes, &b.hash)
+		err = h.syncWallets(b)
+		if err != nil {
+			return hashes, errors.E(op, err)
+		}
+		p = b
+	}
+	return hashes, nil
+}
+
+// generate creates a block extending the parent.
+//
+// This method must be called with the harness mutex held.
+func (h *Harness) generate(parent *block, pkScript []byte) (*block, error) {
+	height := parent.height + 1
+	s := parent.state.copy()
+
+	// Stake transactions are included before regular transactions, and may
+	// not spend regular outputs of the same block.
+	var stakeTxs, regularTxs []*wire.MsgTx
+	var voters uint16
+	var freshStake, revocations uint8
+	for _, tx := range h.mempool {
+		txType := stake.DetermineTxType(tx)
+		switch {
+		case txType == stake.TxTypeRegular:
+			continue
+		case txType == stake.TxTypeSStx && freshStake >= h.params.MaxFreshStakePerBlock:
+			continue
+		}
+		if checkTx(h.params, s, parent, tx, height) != nil {
+			continue
+		}
+		s.apply(h.params, tx, height)
+		stakeTxs = append(stakeTxs, tx)
+		switch txType {
+		case stake.TxTypeSStx:
+			freshStake++
+		case stake.TxTypeSSGen:
+			voters++
+		case stake.TxTypeSSRtx:
+			revocations++
+		}
+	}
+	for _, tx := range h.mempool {
+		if stake.DetermineTxType(tx) != stake.TxTypeRegular {
+			continue
+		}
+		if checkTx(h.params, s, parent, tx, height) != nil {
+			continue
+		}
+		s.apply(h.params, tx, height)
+		regularTxs = append(regularTxs, tx)
+	}
+	coinbase := h.coinbase(height, voters, pkScript)
+	s.apply(h.params, coinbase, height)
+	regularTxs = append([]*wire.MsgTx{coinbase}, regularTxs...)
+
+	live := s.updateTickets(h.params, parent.state.winners, height)
+
+	h.nonce++
+	msg := &wire.MsgBlock{
+		Header: wire.BlockHeader{
+			Version:     blockVersion,
+			PrevBlock:   parent.hash,
+			VoteBits:    fnoutil.BlockValid,
+			Voters:      voters,
+			FreshStake:  freshStake,
+			Revocations: revocations,
+			PoolSize:    uint32(len(live)),
+			Bits:        h.params.PowLimitBits,
+			SBits:       h.params.MinimumStakeDiff,
+			Height:      uint32(height),
+			Timestamp:   parent.msg.Header.Timestamp.Add(h.params.TargetTimePerBlock),
+			Nonce:       uint32(h.nonce),
+		},
+		Transactions:  regularTxs,
+		STransactions: stakeTxs,
+	}
+	merkles := blockchain.BuildMsgTxMerkleTreeStore(msg.Transactions)
+	msg.Header.MerkleRoot = *merkles[len(merkles)-1]
+	merkles = blockchain.BuildMsgTxMerkleTreeStore(msg.STransactions)
+	msg.Header.StakeRoot = *merkles[len(merkles)-1]
+	msg.Header.Size = uint32(msg.SerializeSize())
+
+	hash := msg.BlockHash()
+	s.selectWinners(h.params, live, h.lotterySeed, height)
+	filter, err := blockcf.Regular(msg)
+	if err != nil {
+		return nil, err
+	}
+	b := &block{
+		msg:    msg,
+		hash:   hash,
+		height: height,
+		filter: filter,
+		parent: parent,
+		state:  s,
+	}
+	h.blocks[hash] = b
+	return b, nil
+}
+
+// coinbase creates the coinbase transaction of a block at height.  The coinbase
+// commits to the height and a unique nonce, so coinbases of blocks on different
+// branches have different hashes.
+//
+// This method must be called with the harness mutex held.
+func (h *Harness) coinbase(height int32, voters uint16, pkScript []byte) *wire.MsgTx {
+	h.nonce++
+	var extraNonce [12]byte
+	binary.LittleEndian.PutUint32(extraNonce[:4], uint32(height))
+	binary.LittleEndian.PutUint64(extraNonce[4:], h.nonce)
+	nullData, _ := txscript.NewScriptBuilder().AddOp(txscript.OP_RETURN).
+		AddData(extraNonce[:]).Script()
+
+	subsidy := blockchain.CalcBlockWorkSubsidy(h.subsidyCache, int64(height),
+		voters, h.params)
+	tx := wire.NewMsgTx()
+	tx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: *wire.NewOutPoint(&chainhash.Hash{},
+			wire.MaxPrevOutIndex, wire.TxTreeRegular),
+		Sequence:        wire.MaxTxInSequenceNum,
+		ValueIn:         subsidy,
+		BlockHeight:     wire.NullBlockHeight,
+		BlockIndex:      wire.NullBlockIndex,
+		SignatureScript: []byte{txscript.OP_0, txscript.OP_0},
+	})
+	tx.AddTxOut(wire.NewTxOut(0, nullData))
+	tx.AddTxOut(wire.NewTxOut(subsidy, pkScript))
+	return tx
+}
+
+// setTip makes the chain ending at tip the main chain, and rebuilds the mempool
+// from the transactions of removed main chain blocks and the previous mempool.
+//
+// This method must be called with the harness mutex held.
+func (h *Harness) setTip(tip *block) {
+	var attach []*block
+	fork := tip
+	for int(fork.height) >= len(h.mainChain) || h.mainChain[fork.height] != fork {
+		attach = append(attach, fork)
+		fork = fork.parent
+	}
+	detached := h.mainChain[fork.height+1:]
+	mainChain := h.mainChain[: fork.height+1 : fork.height+1]
+	for i := len(attach) - 1; i >= 0; i-- {
+		mainChain = append(mainChain, attach[i])
+	}
+	h.mainChain = mainChain
+
+	var txs []*wire.MsgTx
+	for _, b := range detached {
+		txs = append(txs, b.msg.Transactions[1:]...)
+		for _, tx := range b.msg.STransactions {
+			if !stake.IsSSGen(tx) {
+				txs = append(txs, tx)
+			}
+		}
+	}
+	txs = append(txs, h.mempool...)
+	h.mempool = nil
+	h.pool = tip.state.copy()
+	for _, tx := range txs {
+		// Transactions which are mined or no longer valid are removed.
+		h.addToPool(tx)
+	}
+}
+
+// syncWallets adds a newly generated block to the sidechain forest of all
+// wallets and syncs them to the main chain.
+func (h *Harness) syncWallets(b *block) error {
+	h.mu.Lock()
+	wallets := h.wallets
+	h.mu.Unlock()
+
+	for _, w := range wallets {
+		err := w.backend.addBlock(b)
+		if err != nil {
+			return err
+		}
+		err = w.backend.sync()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,226 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package simtest
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/fonero-project/fnod/chaincfg/chainhash"
+	"github.com/fonero-project/fnod/fnoutil"
+	"github.com/fonero-project/fnod/txscript"
+	"github.com/fonero-project/fnod/wire"
+	"github.com/fonero-project/fnowallet/wallet/udb"
+)
+
+func newHarness(t *testing.T) *Harness {
+	h, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return h
+}
+
+func newWallet(t *testing.T, h *Harness, cfg *WalletConfig) *Wallet {
+	w, err := h.NewWallet(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return w
+}
+
+// fund generates n blocks paying to a new address of w.
+func fund(t *testing.T, h *Harness, w *Wallet, n int) {
+	addr, err := w.NewAddress()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = h.GenerateBlocks(n, addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func balance(t *testing.T, w *Wallet, minConf int32) udb.Balances {
+	t.Helper()
+	bal, err := w.CalculateAccountBalance(0, minConf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return bal
+}
+
+func inMempool(h *Harness, hash *chainhash.Hash) bool {
+	for _, tx := range h.Mempool() {
+		if tx.TxHash() == *hash {
+			return true
+		}
+	}
+	return false
+}
+
+func TestSendAndReorg(t *testing.T) {
+	t.Parallel()
+
+	h := newHarness(t)
+	defer h.Close()
+	sender := newWallet(t, h, nil)
+	receiver := newWallet(t, h, nil)
+	fund(t, h, sender, int(h.Params().CoinbaseMaturity)+4)
+	if bal := balance(t, sender, 1); bal.Spendable == 0 {
+		t.Fatalf("sender has no spendable balance: %+v", bal)
+	}
+
+	addr, err := receiver.NewAddress()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const amount = 10e8
+	txHash, err := sender.SendOutputs([]*wire.TxOut{wire.NewTxOut(amount, pkScript)}, 0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !inMempool(h, txHash) {
+		t.Fatalf("sent transaction %v is not in the mempool", txHash)
+	}
+	if bal := balance(t, receiver, 0); bal.Total != amount {
+		t.Fatalf("receiver has unmined balance %v, expected %v", bal.Total, fnoutil.Amount(amount))
+	}
+
+	_, err = h.GenerateBlocks(1, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inMempool(h, txHash) {
+		t.Fatalf("mined transaction %v remains in the mempool", txHash)
+	}
+	if bal := balance(t, receiver, 1); bal.Spendable != amount {
+		t.Fatalf("receiver has spendable balance %v, expected %v", bal.Spendable, fnoutil.Amount(amount))
+	}
+
+	// Reorganize the block mining the transaction out of the main chain.
+	// The transaction returns to the mempool and is unmined in the
+	// receiving wallet.
+	_, tipHeight := h.Tip()
+	fork, err := h.BlockHash(tipHeight - 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = h.GenerateBlocksOn(fork, 2, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, height := receiver.MainChainTip(); height != tipHeight+1 {
+		t.Fatalf("receiver tip height is %d after reorg, expected %d", height, tipHeight+1)
+	}
+	if !inMempool(h, txHash) {
+		t.Fatalf("transaction %v did not return to the mempool", txHash)
+	}
+	if bal := balance(t, receiver, 1); bal.Spendable != 0 {
+		t.Fatalf("receiver has spendable balance %v after reorg", bal.Spendable)
+	}
+	if bal := balance(t, receiver, 0); bal.Total != amount {
+		t.Fatalf("receiver has unmined balance %v after reorg, expected %v", bal.Total, fnoutil.Amount(amount))
+	}
+
+	// The transaction is mined again in the next block.
+	_, err = h.GenerateBlocks(1, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bal := balance(t, receiver, 1); bal.Spendable != amount {
+		t.Fatalf("receiver has spendable balance %v, expected %v", bal.Spendable, fnoutil.Amount(amount))
+	}
+}
+
+func TestRestore(t *testing.T) {
+	t.Parallel()
+
+	h := newHarness(t)
+	defer h.Close()
+	seed := bytes.Repeat([]byte{0x01}, 32)
+	w := newWallet(t, h, &WalletConfig{Seed: seed})
+	fund(t, h, w, int(h.Params().CoinbaseMaturity)+4)
+	_, birthdayHeight := h.Tip()
+	_, err := h.GenerateBlocks(4, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := balance(t, w, 1)
+	if want.Total == 0 {
+		t.Fatalf("wallet has no balance")
+	}
+
+	// A wallet restored from the seed discovers the used addresses and
+	// rescans the chain for the funding transactions.
+	restored := newWallet(t, h, &WalletConfig{Seed: seed})
+	if bal := balance(t, restored, 1); bal != want {
+		t.Fatalf("restored wallet has balance %+v, expected %+v", bal, want)
+	}
+
+	// Blocks before the birthday of a restored wallet are not searched.
+	restored = newWallet(t, h, &WalletConfig{
+		Seed:     seed,
+		Birthday: &udb.Birthday{Height: birthdayHeight + 1},
+	})
+	if bal := balance(t, restored, 1); bal.Total != 0 {
+		t.Fatalf("restored wallet has balance %v from blocks before its birthday", bal.Total)
+	}
+}
+
+func TestTicketsVote(t *testing.T) {
+	t.Parallel()
+
+	h := newHarness(t)
+	defer h.Close()
+	params := h.Params()
+	w := newWallet(t, h, &WalletConfig{VotingEnabled: true})
+	fund(t, h, w, int(params.StakeEnabledHeight)+int(params.CoinbaseMaturity))
+
+	const numTickets = 5
+	tickets, err := w.PurchaseTickets(0, fnoutil.Amount(params.MinimumStakeDiff), 1,
+		nil, 0, numTickets, nil, 0, 0, w.RelayFee(), w.TicketFeeIncrement())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tickets) != numTickets {
+		t.Fatalf("purchased %d tickets, expected %d", len(tickets), numTickets)
+	}
+
+	// Generate blocks until the block before stake validation height, which
+	// is the first block with tickets selected to vote.  Every live ticket
+	// is selected.
+	_, tipHeight := h.Tip()
+	_, err = h.GenerateBlocks(int(params.StakeValidationHeight)-1-int(tipHeight), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tipHash, _ := h.Tip()
+	winners, err := h.Winners(&tipHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(winners) != numTickets {
+		t.Fatalf("%d tickets selected to vote, expected %d", len(winners), numTickets)
+	}
+
+	// The wallet votes with its winning tickets, and the votes are mined in
+	// the next block.
+	hashes, err := h.GenerateBlocks(1, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := h.Block(hashes[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b.Header.Voters != numTickets {
+		t.Fatalf("block has %d votes, expected %d", b.Header.Voters, numTickets)
+	}
+}
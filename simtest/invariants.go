@@ -0,0 +1,226 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package simtest
+
+import (
+	"github.com/fonero-project/fnod/blockchain/stake"
+	"github.com/fonero-project/fnod/chaincfg"
+	"github.com/fonero-project/fnod/chaincfg/chainhash"
+	"github.com/fonero-project/fnod/fnoutil"
+	"github.com/fonero-project/fnod/txscript"
+	"github.com/fonero-project/fnod/wire"
+	"github.com/fonero-project/fnowallet/errors"
+	"github.com/fonero-project/fnowallet/wallet"
+)
+
+// ownsOutput returns whether an output pays to an address of the wallet.
+// Outputs without value are never recorded as wallet credits.
+func (w *Wallet) ownsOutput(params *chaincfg.Params, version uint16, pkScript []byte, value int64) (bool, error) {
+	if value == 0 || isNullData(pkScript) {
+		return false, nil
+	}
+	_, addrs, _, err := txscript.ExtractPkScriptAddrs(version, pkScript, params)
+	if err != nil {
+		return false, nil
+	}
+	for _, a := range addrs {
+		have, err := w.HaveAddress(a)
+		if err != nil {
+			return false, err
+		}
+		if have {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// CheckWallet checks that a synced wallet is consistent with the harness
+// chain.  The following invariants are checked:
+//
+// The wallet main chain is the harness main chain.
+//
+// No unmined wallet transaction is mined in the main chain, and unmined wallet
+// transactions only spend unspent main chain outputs or outputs of other
+// unmined transactions.
+//
+// Every mempool transaction which spends or creates wallet outputs is an
+// unmined wallet transaction.
+//
+// The unspent outputs and total balance of the wallet are equal to a reference
+// model: the main chain tip's unspent outputs paying to wallet addresses, with
+// the unmined wallet transactions applied.  Credits which were not created by a
+// main chain or unmined transaction, or which remain unspent after being spent
+// in the main chain, are reported as errors.
+//
+// CheckWallet must not be called concurrently with block generation.
+func (h *Harness) CheckWallet(w *Wallet) error {
+	const op errors.Op = "simtest.CheckWallet"
+
+	h.mu.Lock()
+	mainChain := h.mainChain
+	mempool := append([]*wire.MsgTx(nil), h.mempool...)
+	h.mu.Unlock()
+	tip := mainChain[len(mainChain)-1]
+
+	walletTip, walletTipHeight := w.MainChainTip()
+	if walletTip != tip.hash {
+		return errors.E(op, errors.Errorf("wallet tip is %v (height %d), "+
+			"expected %v (height %d)", &walletTip, walletTipHeight,
+			&tip.hash, tip.height))
+	}
+	minedTxs := make(map[chainhash.Hash]*block)
+	for _, b := range mainChain[1:] {
+		haveBlock, _, err := w.BlockInMainChain(&b.hash)
+		if err != nil {
+			return errors.E(op, err)
+		}
+		if !haveBlock {
+			return errors.E(op, errors.Errorf("main chain block %v "+
+				"(height %d) is not in the wallet main chain", &b.hash, b.height))
+		}
+		for _, tx := range b.msg.Transactions {
+			minedTxs[tx.TxHash()] = b
+		}
+		for _, tx := range b.msg.STransactions {
+			minedTxs[tx.TxHash()] = b
+		}
+	}
+
+	// Record the unspent main chain outputs which pay to the wallet.
+	model := make(map[wire.OutPoint]int64)
+	for outPoint, u := range tip.state.utxos {
+		owned, err := w.ownsOutput(h.params, u.version, u.pkScript, u.value)
+		if err != nil {
+			return errors.E(op, err)
+		}
+		if owned {
+			model[outPoint] = u.value
+		}
+	}
+
+	// Apply the unmined transactions.  All outputs are added before any
+	// are spent, so the transactions may be applied in any order.
+	unmined, err := w.UnminedTransactions()
+	if err != nil {
+		return errors.E(op, err)
+	}
+	unminedHashes := make(map[chainhash.Hash]struct{}, len(unmined))
+	unminedOutputs := make(map[wire.OutPoint]struct{})
+	for _, tx := range unmined {
+		txHash := tx.TxHash()
+		if b, ok := minedTxs[txHash]; ok {
+			return errors.E(op, errors.Errorf("unmined transaction %v "+
+				"is mined in main chain block %v (height %d)", &txHash,
+				&b.hash, b.height))
+		}
+		unminedHashes[txHash] = struct{}{}
+		tree := wire.TxTreeRegular
+		if stake.DetermineTxType(tx) != stake.TxTypeRegular {
+			tree = wire.TxTreeStake
+		}
+		for i, out := range tx.TxOut {
+			outPoint := wire.OutPoint{Hash: txHash, Index: uint32(i), Tree: tree}
+			unminedOutputs[outPoint] = struct{}{}
+			owned, err := w.ownsOutput(h.params, out.Version, out.PkScript, out.Value)
+			if err != nil {
+				return errors.E(op, err)
+			}
+			if owned {
+				model[outPoint] = out.Value
+			}
+		}
+	}
+	walletOutputs := make(map[wire.OutPoint]int64, len(model))
+	for outPoint, value := range model {
+		walletOutputs[outPoint] = value
+	}
+	for _, tx := range unmined {
+		txHash := tx.TxHash()
+		inputs := tx.TxIn
+		if stake.IsSSGen(tx) {
+			inputs = inputs[1:]
+		}
+		for _, in := range inputs {
+			prevOut := in.PreviousOutPoint
+			_, unspent := tip.state.utxos[prevOut]
+			_, fromUnmined := unminedOutputs[prevOut]
+			if !unspent && !fromUnmined {
+				return errors.E(op, errors.Errorf("unmined transaction %v "+
+					"spends output %v which is missing or spent in the "+
+					"main chain", &txHash, &prevOut))
+			}
+			delete(model, prevOut)
+		}
+	}
+
+	// Mempool transactions which spend or create wallet outputs must be
+	// recorded by the wallet.
+	for _, tx := range mempool {
+		txHash := tx.TxHash()
+		if _, ok := unminedHashes[txHash]; ok {
+			continue
+		}
+		relevant := false
+		for _, in := range tx.TxIn {
+			if _, ok := walletOutputs[in.PreviousOutPoint]; ok {
+				relevant = true
+				break
+			}
+		}
+		for _, out := range tx.TxOut {
+			if relevant {
+				break
+			}
+			relevant, err = w.ownsOutput(h.params, out.Version, out.PkScript, out.Value)
+			if err != nil {
+				return errors.E(op, err)
+			}
+		}
+		if relevant {
+			return errors.E(op, errors.Errorf("relevant mempool "+
+				"transaction %v is not recorded by the wallet", &txHash))
+		}
+	}
+
+	// Compare the model against the wallet's unspent outputs.
+	outputs, err := w.UnspentOutputs(wallet.OutputSelectionPolicy{})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	credits := make(map[wire.OutPoint]int64, len(outputs))
+	for _, out := range outputs {
+		outPoint := out.OutPoint
+		credits[outPoint] = out.Output.Value
+		value, ok := model[outPoint]
+		if !ok {
+			return errors.E(op, errors.Errorf("wallet credit %v is "+
+				"spent or does not exist", &outPoint))
+		}
+		if value != out.Output.Value {
+			return errors.E(op, errors.Errorf("wallet credit %v has "+
+				"value %v, expected %v", &outPoint,
+				fnoutil.Amount(out.Output.Value), fnoutil.Amount(value)))
+		}
+	}
+	var total int64
+	for outPoint, value := range model {
+		if _, ok := credits[outPoint]; !ok {
+			return errors.E(op, errors.Errorf("unspent output %v is "+
+				"not a wallet credit", &outPoint))
+		}
+		total += value
+	}
+	bal, err := w.CalculateAccountBalance(0, 0)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	if bal.Total != fnoutil.Amount(total) {
+		return errors.E(op, errors.Errorf("wallet balance is %v, expected %v",
+			bal.Total, fnoutil.Amount(total)))
+	}
+
+	return nil
+}
@@ -0,0 +1,262 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package simtest
+
+import (
+	"flag"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/fonero-project/fnod/chaincfg/chainhash"
+	"github.com/fonero-project/fnod/fnoutil"
+	"github.com/fonero-project/fnod/txscript"
+	"github.com/fonero-project/fnod/wire"
+	"github.com/fonero-project/fnowallet/errors"
+)
+
+// maxReorgDepth is the most blocks removed from the main chain by a reorg of
+// the fuzzer.  Reorgs are kept shallower than coinbase maturity, as spends of
+// removed coinbase and stake outputs can not return to the mempool.
+const maxReorgDepth = 4
+
+// reorgFuzzSeed replays a single fuzzer run with the seed logged by a failing
+// run of TestReorgFuzz.
+var reorgFuzzSeed = flag.Int64("reorgfuzz.seed", 0, "run TestReorgFuzz with only this seed")
+
+// reorgFuzzer drives a harness through random sequences of blocks, forks,
+// sends, and ticket purchases chosen by a seeded PRNG, and checks the
+// invariants of every wallet after each step.
+type reorgFuzzer struct {
+	t       *testing.T
+	h       *Harness
+	prng    *rand.Rand
+	wallets []*Wallet
+
+	// sideTips are the tips of generated branches which may be extended
+	// later.
+	sideTips []*chainhash.Hash
+}
+
+func newReorgFuzzer(t *testing.T, seed int64, numWallets int) *reorgFuzzer {
+	f := &reorgFuzzer{
+		t:    t,
+		h:    newHarness(t),
+		prng: rand.New(rand.NewSource(seed)),
+	}
+	f.h.SetLotterySeed(seed)
+	for i := 0; i < numWallets; i++ {
+		walletSeed := make([]byte, 32)
+		f.prng.Read(walletSeed)
+		f.wallets = append(f.wallets, newWallet(t, f.h, &WalletConfig{
+			Seed:          walletSeed,
+			VotingEnabled: true,
+		}))
+	}
+	return f
+}
+
+func (f *reorgFuzzer) logf(format string, args ...interface{}) {
+	_, height := f.h.Tip()
+	f.t.Logf("tip %d: %s", height, fmt.Sprintf(format, args...))
+}
+
+func (f *reorgFuzzer) randomWallet() (int, *Wallet) {
+	i := f.prng.Intn(len(f.wallets))
+	return i, f.wallets[i]
+}
+
+func (f *reorgFuzzer) address(w *Wallet) fnoutil.Address {
+	addr, err := w.NewAddress()
+	if err != nil {
+		f.t.Fatal(err)
+	}
+	return addr
+}
+
+// payTo returns the address of a random wallet, or nil for anyone-can-spend
+// coinbase outputs.
+func (f *reorgFuzzer) payTo() fnoutil.Address {
+	if f.prng.Intn(4) == 0 {
+		return nil
+	}
+	_, w := f.randomWallet()
+	return f.address(w)
+}
+
+// forkDepth returns the number of main chain blocks after the block where the
+// branch ending at hash forks from the main chain.
+func (f *reorgFuzzer) forkDepth(hash *chainhash.Hash) int32 {
+	h := f.h
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	b := h.blocks[*hash]
+	for int(b.height) >= len(h.mainChain) || h.mainChain[b.height] != b {
+		b = b.parent
+	}
+	return int32(len(h.mainChain)-1) - b.height
+}
+
+// extend generates blocks on the main chain tip.
+func (f *reorgFuzzer) extend() {
+	n := 1 + f.prng.Intn(3)
+	f.logf("extend main chain by %d block(s)", n)
+	_, err := f.h.GenerateBlocks(n, f.payTo())
+	if err != nil {
+		f.t.Fatal(err)
+	}
+}
+
+// generateOn generates up to depth+1 blocks on a block which is depth blocks
+// behind the main chain tip.  The branch becomes the main chain only when it
+// grows longer than the main chain.
+func (f *reorgFuzzer) generateOn(parent *chainhash.Hash, depth int32) {
+	n := 1 + f.prng.Intn(int(depth)+1)
+	f.logf("generate %d block(s) on %v (depth %d)", n, parent, depth)
+	hashes, err := f.h.GenerateBlocksOn(parent, n, f.payTo())
+	if err != nil {
+		f.t.Fatal(err)
+	}
+	f.sideTips = append(f.sideTips, hashes[len(hashes)-1])
+}
+
+// fork generates a branch from a recent main chain block.
+func (f *reorgFuzzer) fork() {
+	_, tipHeight := f.h.Tip()
+	depth := 1 + int32(f.prng.Intn(maxReorgDepth))
+	if depth >= tipHeight {
+		return
+	}
+	parent, err := f.h.BlockHash(tipHeight - depth)
+	if err != nil {
+		f.t.Fatal(err)
+	}
+	f.generateOn(parent, depth)
+}
+
+// extendSide extends a previously generated branch, which may reorganize the
+// main chain back to it.  Branches forking too deep to be reorganized to are
+// forgotten.
+func (f *reorgFuzzer) extendSide() {
+	if len(f.sideTips) == 0 {
+		return
+	}
+	i := f.prng.Intn(len(f.sideTips))
+	tip := f.sideTips[i]
+	f.sideTips = append(f.sideTips[:i], f.sideTips[i+1:]...)
+	depth := f.forkDepth(tip)
+	if depth == 0 || depth > maxReorgDepth {
+		return
+	}
+	f.generateOn(tip, depth)
+}
+
+// send pays a random amount from a random wallet to an address of a random
+// wallet.
+func (f *reorgFuzzer) send() {
+	i, from := f.randomWallet()
+	j, to := f.randomWallet()
+	bal := balance(f.t, from, 1)
+	if bal.Spendable < 2e7 {
+		return
+	}
+	amount := 1e6 + f.prng.Int63n(int64(bal.Spendable)/2)
+	pkScript, err := txscript.PayToAddrScript(f.address(to))
+	if err != nil {
+		f.t.Fatal(err)
+	}
+	txHash, err := from.SendOutputs([]*wire.TxOut{wire.NewTxOut(amount, pkScript)}, 0, 1)
+	if errors.Is(errors.InsufficientBalance, err) {
+		return
+	}
+	if err != nil {
+		f.t.Fatalf("wallet %d: %v", i, err)
+	}
+	f.logf("wallet %d sends %v to wallet %d in %v", i, fnoutil.Amount(amount), j, txHash)
+}
+
+// buyTickets purchases tickets with a random wallet once tickets may be mined.
+func (f *reorgFuzzer) buyTickets() {
+	params := f.h.Params()
+	_, tipHeight := f.h.Tip()
+	if int64(tipHeight) < params.StakeEnabledHeight {
+		return
+	}
+	i, w := f.randomWallet()
+	n := 1 + f.prng.Intn(3)
+	tickets, err := w.PurchaseTickets(0, fnoutil.Amount(params.MinimumStakeDiff), 1,
+		nil, 0, n, nil, 0, 0, w.RelayFee(), w.TicketFeeIncrement())
+	if errors.Is(errors.InsufficientBalance, err) {
+		return
+	}
+	if err != nil {
+		f.t.Fatalf("wallet %d: %v", i, err)
+	}
+	f.logf("wallet %d purchases %d ticket(s)", i, len(tickets))
+}
+
+func (f *reorgFuzzer) check() {
+	for i, w := range f.wallets {
+		err := f.h.CheckWallet(w)
+		if err != nil {
+			f.t.Fatalf("wallet %d: %v", i, err)
+		}
+	}
+}
+
+func (f *reorgFuzzer) step() {
+	switch r := f.prng.Intn(10); {
+	case r < 3:
+		f.extend()
+	case r < 5:
+		f.fork()
+	case r < 6:
+		f.extendSide()
+	case r < 8:
+		f.send()
+	default:
+		f.buyTickets()
+	}
+}
+
+func TestReorgFuzz(t *testing.T) {
+	t.Parallel()
+
+	steps := 250
+	if testing.Short() {
+		steps = 50
+	}
+	seeds := []int64{1, 2, 3}
+	if *reorgFuzzSeed != 0 {
+		seeds = []int64{*reorgFuzzSeed}
+	}
+	for _, seed := range seeds {
+		seed := seed
+		t.Run(fmt.Sprintf("seed=%d", seed), func(t *testing.T) {
+			t.Parallel()
+			t.Logf("seed %d (replay with -reorgfuzz.seed=%[1]d)", seed)
+
+			f := newReorgFuzzer(t, seed, 3)
+			defer f.h.Close()
+
+			// Fund the wallets past coinbase maturity before
+			// generating any forks.
+			funding := int(f.h.Params().CoinbaseMaturity) + 2*len(f.wallets)
+			for i := 0; i < funding; i++ {
+				w := f.wallets[i%len(f.wallets)]
+				_, err := f.h.GenerateBlocks(1, f.address(w))
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+			f.check()
+
+			for i := 0; i < steps; i++ {
+				f.step()
+				f.check()
+			}
+		})
+	}
+}
@@ -0,0 +1,291 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package simtest
+
+import (
+	"bytes"
+	"math/rand"
+	"sort"
+
+	"github.com/fonero-project/fnod/blockchain"
+	"github.com/fonero-project/fnod/blockchain/stake"
+	"github.com/fonero-project/fnod/chaincfg"
+	"github.com/fonero-project/fnod/chaincfg/chainhash"
+	"github.com/fonero-project/fnod/mempool"
+	"github.com/fonero-project/fnod/txscript"
+	"github.com/fonero-project/fnod/wire"
+	"github.com/fonero-project/fnowallet/errors"
+)
+
+// utxo is an unspent transaction output.
+type utxo struct {
+	value    int64
+	version  uint16
+	pkScript []byte
+	height   int32
+
+	// maturity is the number of blocks which must be mined on top of the
+	// block containing the output before it may be spent.
+	maturity int32
+
+	// ticket is set for ticket outputs, which may only be spent by votes
+	// and revocations.
+	ticket bool
+}
+
+// state describes the chain after a block is connected.  States are never
+// modified after the block is created, so a block on any branch may be
+// extended.
+type state struct {
+	utxos map[wire.OutPoint]*utxo
+
+	// tickets records the mined height of unspent tickets that have not
+	// missed a vote.
+	tickets map[chainhash.Hash]int32
+
+	// missed records tickets that missed a vote or expired and have not
+	// been revoked.  newlyMissed holds the tickets that were missed by this
+	// block.
+	missed      map[chainhash.Hash]struct{}
+	newlyMissed []chainhash.Hash
+
+	// winners are the tickets selected to vote on this block.
+	winners []chainhash.Hash
+}
+
+func newState() *state {
+	return &state{
+		utxos:   make(map[wire.OutPoint]*utxo),
+		tickets: make(map[chainhash.Hash]int32),
+		missed:  make(map[chainhash.Hash]struct{}),
+	}
+}
+
+// copy returns a copy of the state which may be modified to connect another
+// block.  Winners are not copied.
+func (s *state) copy() *state {
+	c := &state{
+		utxos:   make(map[wire.OutPoint]*utxo, len(s.utxos)),
+		tickets: make(map[chainhash.Hash]int32, len(s.tickets)),
+		missed:  make(map[chainhash.Hash]struct{}, len(s.missed)),
+	}
+	for op, u := range s.utxos {
+		c.utxos[op] = u
+	}
+	for hash, height := range s.tickets {
+		c.tickets[hash] = height
+	}
+	for hash := range s.missed {
+		c.missed[hash] = struct{}{}
+	}
+	return c
+}
+
+func isWinner(winners []chainhash.Hash, ticket *chainhash.Hash) bool {
+	for i := range winners {
+		if winners[i] == *ticket {
+			return true
+		}
+	}
+	return false
+}
+
+func isNullData(pkScript []byte) bool {
+	return len(pkScript) != 0 && pkScript[0] == txscript.OP_RETURN
+}
+
+// checkTx checks whether tx may be included in a block at height which extends
+// the parent block.  s must be the parent's state with the effects of any
+// transactions preceding tx in the new block applied.
+func checkTx(params *chaincfg.Params, s *state, parent *block, tx *wire.MsgTx, height int32) error {
+	txHash := tx.TxHash()
+	op := errors.Opf("simtest.checkTx(%v)", &txHash)
+
+	if blockchain.IsCoinBaseTx(tx) {
+		return errors.E(op, errors.Consensus, "coinbase transactions may only be mined")
+	}
+	if tx.Expiry != wire.NoExpiryValue && uint32(height) >= tx.Expiry {
+		return errors.E(op, errors.Consensus, "transaction has expired")
+	}
+
+	txType := stake.DetermineTxType(tx)
+	switch txType {
+	case stake.TxTypeSStx:
+		if tx.TxOut[0].Value != params.MinimumStakeDiff {
+			return errors.E(op, errors.Consensus, errors.Errorf("ticket price "+
+				"%v does not match the stake difficulty %v",
+				tx.TxOut[0].Value, params.MinimumStakeDiff))
+		}
+	case stake.TxTypeSSGen:
+		votedOn, _ := stake.SSGenBlockVotedOn(tx)
+		if votedOn != parent.hash {
+			return errors.E(op, errors.Consensus, errors.Errorf("vote is for "+
+				"block %v, not the parent block %v", &votedOn, &parent.hash))
+		}
+		ticket := &tx.TxIn[1].PreviousOutPoint.Hash
+		if !isWinner(parent.state.winners, ticket) {
+			return errors.E(op, errors.Consensus, errors.Errorf("ticket %v "+
+				"was not selected to vote on block %v", ticket, &parent.hash))
+		}
+	case stake.TxTypeSSRtx:
+		ticket := &tx.TxIn[0].PreviousOutPoint.Hash
+		if _, ok := s.missed[*ticket]; !ok {
+			return errors.E(op, errors.Consensus, errors.Errorf("ticket %v "+
+				"has not missed a vote", ticket))
+		}
+	}
+
+	var in, out int64
+	prevScripts := make(map[int]*utxo, len(tx.TxIn))
+	for i, txIn := range tx.TxIn {
+		// The stakebase input of a vote does not spend a previous
+		// output.
+		if txType == stake.TxTypeSSGen && i == 0 {
+			continue
+		}
+		prevOut := &txIn.PreviousOutPoint
+		u, ok := s.utxos[*prevOut]
+		if !ok {
+			return errors.E(op, errors.DoubleSpend, errors.Errorf("input %v "+
+				"is missing or spent", prevOut))
+		}
+		if u.ticket && txType != stake.TxTypeSSGen && txType != stake.TxTypeSSRtx {
+			return errors.E(op, errors.Consensus, errors.Errorf("ticket %v "+
+				"may only be spent by a vote or revocation", prevOut))
+		}
+		if height-u.height < u.maturity {
+			return errors.E(op, errors.Consensus, errors.Errorf("input %v "+
+				"is immature", prevOut))
+		}
+		in += u.value
+		prevScripts[i] = u
+	}
+	for _, txOut := range tx.TxOut {
+		out += txOut.Value
+	}
+	if txType != stake.TxTypeSSGen && out > in {
+		return errors.E(op, errors.Consensus, errors.Errorf("outputs "+
+			"(%v) exceed inputs (%v)", out, in))
+	}
+
+	for i, u := range prevScripts {
+		vm, err := txscript.NewEngine(u.pkScript, tx, i,
+			mempool.BaseStandardVerifyFlags, u.version, nil)
+		if err != nil {
+			return errors.E(op, errors.ScriptFailure, err)
+		}
+		err = vm.Execute()
+		if err != nil {
+			return errors.E(op, errors.ScriptFailure, err)
+		}
+	}
+
+	return nil
+}
+
+// apply spends the inputs and adds the outputs of a transaction included in a
+// block at height.
+func (s *state) apply(params *chaincfg.Params, tx *wire.MsgTx, height int32) {
+	txHash := tx.TxHash()
+	txType := stake.DetermineTxType(tx)
+	tree := wire.TxTreeRegular
+	if txType != stake.TxTypeRegular {
+		tree = wire.TxTreeStake
+	}
+
+	switch txType {
+	case stake.TxTypeSSGen:
+		ticket := tx.TxIn[1].PreviousOutPoint.Hash
+		delete(s.utxos, tx.TxIn[1].PreviousOutPoint)
+		delete(s.tickets, ticket)
+	case stake.TxTypeSSRtx:
+		ticket := tx.TxIn[0].PreviousOutPoint.Hash
+		delete(s.utxos, tx.TxIn[0].PreviousOutPoint)
+		delete(s.missed, ticket)
+	default:
+		if !blockchain.IsCoinBaseTx(tx) {
+			for _, txIn := range tx.TxIn {
+				delete(s.utxos, txIn.PreviousOutPoint)
+			}
+		}
+	}
+
+	for i, txOut := range tx.TxOut {
+		if isNullData(txOut.PkScript) {
+			continue
+		}
+		u := &utxo{
+			value:    txOut.Value,
+			version:  txOut.Version,
+			pkScript: txOut.PkScript,
+			height:   height,
+		}
+		switch {
+		case txType == stake.TxTypeSStx && i == 0:
+			u.ticket = true
+			s.tickets[txHash] = height
+		case txType == stake.TxTypeSStx:
+			u.maturity = int32(params.SStxChangeMaturity)
+		case txType != stake.TxTypeRegular, blockchain.IsCoinBaseTx(tx):
+			u.maturity = int32(params.CoinbaseMaturity)
+		}
+		op := wire.OutPoint{Hash: txHash, Index: uint32(i), Tree: tree}
+		s.utxos[op] = u
+	}
+}
+
+// updateTickets records the tickets which missed their vote on the parent
+// block or expired at height, and returns the live tickets which may be
+// selected to vote on the block.
+func (s *state) updateTickets(params *chaincfg.Params, parentWinners []chainhash.Hash, height int32) []chainhash.Hash {
+	miss := func(ticket chainhash.Hash) {
+		delete(s.tickets, ticket)
+		s.missed[ticket] = struct{}{}
+		s.newlyMissed = append(s.newlyMissed, ticket)
+	}
+
+	// Winners of the parent block which did not vote in this block are
+	// still unspent.
+	for _, ticket := range parentWinners {
+		if _, ok := s.tickets[ticket]; ok {
+			miss(ticket)
+		}
+	}
+
+	maturity := int32(params.TicketMaturity)
+	expiry := maturity + int32(params.TicketExpiry)
+	live := make([]chainhash.Hash, 0, len(s.tickets))
+	for ticket, minedHeight := range s.tickets {
+		switch {
+		case height-minedHeight > expiry:
+			miss(ticket)
+		case height-minedHeight > maturity:
+			live = append(live, ticket)
+		}
+	}
+	sort.Slice(live, func(i, j int) bool {
+		return bytes.Compare(live[i][:], live[j][:]) < 0
+	})
+	return live
+}
+
+// selectWinners selects the tickets to vote on the block at height from the
+// sorted live tickets.  Selection uses a PRNG seeded by the harness lottery
+// seed and the height, so the same tickets are always selected for a block
+// regardless of the ordering of its transactions.
+func (s *state) selectWinners(params *chaincfg.Params, live []chainhash.Hash, lotterySeed int64, height int32) {
+	if int64(height) < params.StakeValidationHeight-1 || len(live) == 0 {
+		return
+	}
+	prng := rand.New(rand.NewSource(lotterySeed ^ int64(height)<<32))
+	n := int(params.TicketsPerBlock)
+	if n > len(live) {
+		n = len(live)
+	}
+	s.winners = make([]chainhash.Hash, 0, n)
+	for _, i := range prng.Perm(len(live))[:n] {
+		s.winners = append(s.winners, live[i])
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package simtest
+
+import (
+	"github.com/fonero-project/fnod/fnoutil"
+	"github.com/fonero-project/fnod/hdkeychain"
+	"github.com/fonero-project/fnowallet/errors"
+	"github.com/fonero-project/fnowallet/wallet"
+	_ "github.com/fonero-project/fnowallet/wallet/drivers/memdb" // Register memdb driver
+	"github.com/fonero-project/fnowallet/wallet/txrules"
+	"github.com/fonero-project/fnowallet/wallet/udb"
+)
+
+// DefaultPrivatePassphrase is the private passphrase of wallets created
+// without a configured passphrase.
+const DefaultPrivatePassphrase = "private"
+
+// WalletConfig describes a wallet created by the harness.
+type WalletConfig struct {
+	// Seed is the seed of the wallet.  A random seed is generated when nil.
+	Seed []byte
+
+	// Birthday is recorded as the wallet birthday when non-nil.  Without a
+	// birthday, the wallet searches the entire chain for transactions.
+	Birthday *udb.Birthday
+
+	// PrivatePassphrase is the private passphrase of the wallet, which
+	// defaults to DefaultPrivatePassphrase.
+	PrivatePassphrase []byte
+
+	// VotingEnabled enables voting with tickets selected to vote on the main
+	// chain tip and revoking tickets which missed a vote.
+	VotingEnabled bool
+
+	// GapLimit is the unused address gap limit, which defaults to
+	// wallet.DefaultGapLimit.
+	GapLimit int
+}
+
+// Wallet is a wallet synced to the harness chain.
+type Wallet struct {
+	*wallet.Wallet
+	db      wallet.DB
+	backend *backend
+}
+
+// NewWallet creates an unlocked wallet with an in-memory database and syncs it
+// to the harness chain.  The wallet is notified of all later blocks and
+// relevant mempool transactions until the harness is closed.  Change outputs
+// are not randomized, so the transactions created by the wallet depend only on
+// its seed and the operations performed on it.
+func (h *Harness) NewWallet(cfg *WalletConfig) (*Wallet, error) {
+	const op errors.Op = "simtest.NewWallet"
+
+	if cfg == nil {
+		cfg = new(WalletConfig)
+	}
+	seed := cfg.Seed
+	if seed == nil {
+		var err error
+		seed, err = hdkeychain.GenerateSeed(hdkeychain.RecommendedSeedLen)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+	}
+	privPass := cfg.PrivatePassphrase
+	if privPass == nil {
+		privPass = []byte(DefaultPrivatePassphrase)
+	}
+	gapLimit := cfg.GapLimit
+	if gapLimit == 0 {
+		gapLimit = wallet.DefaultGapLimit
+	}
+
+	db, err := wallet.CreateDB("memdb")
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	pubPass := []byte(wallet.InsecurePubPassphrase)
+	err = wallet.Create(db, pubPass, privPass, seed, cfg.Birthday, h.params)
+	if err != nil {
+		db.Close()
+		return nil, errors.E(op, err)
+	}
+	w, err := wallet.Open(&wallet.Config{
+		DB:            db,
+		PubPassphrase: pubPass,
+		VotingEnabled: cfg.VotingEnabled,
+		GapLimit:      gapLimit,
+		RelayFee:      txrules.DefaultRelayFeePerKb.ToCoin(),
+		Params:        h.params,
+
+		DisableChangeRandomization: true,
+	})
+	if err != nil {
+		db.Close()
+		return nil, errors.E(op, err)
+	}
+	w.Start()
+	sw := &Wallet{
+		Wallet: w,
+		db:     db,
+		backend: &backend{
+			h:                h,
+			w:                w,
+			discoverAccounts: true,
+		},
+	}
+	err = w.Unlock(privPass, nil)
+	if err != nil {
+		sw.close()
+		return nil, errors.E(op, err)
+	}
+	w.SetNetworkBackend(sw.backend)
+
+	h.mu.Lock()
+	h.wallets = append(h.wallets, sw)
+	h.mu.Unlock()
+
+	err = sw.backend.sync()
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return sw, nil
+}
+
+// close stops the wallet and closes its database.
+func (w *Wallet) close() error {
+	w.Stop()
+	w.WaitForShutdown()
+	return w.db.Close()
+}
+
+// NewAddress returns a new external address of the default account.
+func (w *Wallet) NewAddress() (fnoutil.Address, error) {
+	return w.NewExternalAddress(0, wallet.WithGapPolicyWrap())
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/hex"
+	"strconv"
+	"strings"
+
+	"github.com/fonero-project/fnod/chaincfg"
+	"github.com/fonero-project/fnod/fnoutil"
+	"github.com/fonero-project/fnowallet/errors"
+	"github.com/fonero-project/fnowallet/wallet"
+)
+
+// splitAccountOption splits an option in the form account:value at the last
+// colon, as account names may contain colons.
+func splitAccountOption(opt, s string) (account, value string, err error) {
+	colon := strings.LastIndexByte(s, ':')
+	if colon < 1 || colon == len(s)-1 {
+		return "", "", errors.Errorf("%s %q is not in the form account:value", opt, s)
+	}
+	return s[:colon], s[colon+1:], nil
+}
+
+// parseAmount parses a positive coin amount.
+func parseAmount(opt, s string) (fnoutil.Amount, error) {
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || f <= 0 {
+		return 0, errors.Errorf("%s: invalid amount %q", opt, s)
+	}
+	amount, err := fnoutil.NewAmount(f)
+	if err != nil {
+		return 0, errors.Errorf("%s: invalid amount %q: %v", opt, s, err)
+	}
+	return amount, nil
+}
+
+// parseSpendPolicies parses the spend policy options into policies keyed by
+// account name.
+func parseSpendPolicies(limits, destinations, feeRates, approvals []string,
+	params *chaincfg.Params) (map[string]*wallet.SpendPolicy, error) {
+
+	policies := make(map[string]*wallet.SpendPolicy)
+	policy := func(account string) *wallet.SpendPolicy {
+		p, ok := policies[account]
+		if !ok {
+			p = new(wallet.SpendPolicy)
+			policies[account] = p
+		}
+		return p
+	}
+	for _, s := range limits {
+		account, value, err := splitAccountOption("spendlimit", s)
+		if err != nil {
+			return nil, err
+		}
+		policy(account).DailyLimit, err = parseAmount("spendlimit", value)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, s := range destinations {
+		account, value, err := splitAccountOption("spenddestination", s)
+		if err != nil {
+			return nil, err
+		}
+		addr, err := fnoutil.DecodeAddress(value)
+		if err != nil {
+			return nil, errors.Errorf("spenddestination: invalid address %q: %v", value, err)
+		}
+		if !addr.IsForNet(params) {
+			return nil, errors.Errorf("spenddestination: address %v is not "+
+				"intended for use on %s", addr, params.Name)
+		}
+		p := policy(account)
+		p.Destinations = append(p.Destinations, addr)
+	}
+	for _, s := range feeRates {
+		account, value, err := splitAccountOption("spendmaxfeerate", s)
+		if err != nil {
+			return nil, err
+		}
+		policy(account).MaxFeeRate, err = parseAmount("spendmaxfeerate", value)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, s := range approvals {
+		// The form is account:threshold:salt$hash.
+		rest, auth, err := splitAccountOption("spendapproval", s)
+		if err != nil {
+			return nil, err
+		}
+		account, value, err := splitAccountOption("spendapproval", rest)
+		if err != nil {
+			return nil, errors.Errorf("spendapproval %q is not in the form "+
+				"account:threshold:salt$hash", s)
+		}
+		p := policy(account)
+		p.ApprovalThreshold, err = parseAmount("spendapproval", value)
+		if err != nil {
+			return nil, err
+		}
+		dollar := strings.IndexByte(auth, '$')
+		if dollar < 1 {
+			return nil, errors.Errorf("spendapproval %q is not in the form "+
+				"account:threshold:salt$hash", s)
+		}
+		p.ApprovalSalt = auth[:dollar]
+		p.ApprovalHash, err = hex.DecodeString(auth[dollar+1:])
+		if err != nil || len(p.ApprovalHash) != 32 {
+			return nil, errors.Errorf("spendapproval: invalid hash %q", auth[dollar+1:])
+		}
+	}
+	return policies, nil
+}
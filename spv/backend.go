@@ -22,6 +22,7 @@ import (
 )
 
 var _ wallet.NetworkBackend = (*Syncer)(nil)
+var _ wallet.NetworkBackend = (*syncWallet)(nil)
 
 // TODO: When using the Syncer as a NetworkBackend, keep track of in-flight
 // blocks and cfilters.  If one is already incoming, wait on that response.  If
@@ -92,25 +93,31 @@ func (s *Syncer) String() string {
 }
 
 // LoadTxFilter implements the LoadTxFilter method of the wallet.NetworkBackend
-// interface.
+// interface.  The filter of the wallet the Syncer was created for is loaded.
 func (s *Syncer) LoadTxFilter(ctx context.Context, reload bool, addrs []fnoutil.Address, outpoints []wire.OutPoint) error {
-	s.filterMu.Lock()
-	if reload || s.rescanFilter == nil {
-		s.rescanFilter = wallet.NewRescanFilter(nil, nil)
-		s.filterData = nil
+	return s.primary.LoadTxFilter(ctx, reload, addrs, outpoints)
+}
+
+// LoadTxFilter implements the LoadTxFilter method of the wallet.NetworkBackend
+// interface.
+func (sw *syncWallet) LoadTxFilter(ctx context.Context, reload bool, addrs []fnoutil.Address, outpoints []wire.OutPoint) error {
+	sw.filterMu.Lock()
+	if reload || sw.rescanFilter == nil {
+		sw.rescanFilter = wallet.NewRescanFilter(nil, nil)
+		sw.filterData = nil
 	}
 	for _, addr := range addrs {
 		pkScript, err := txscript.PayToAddrScript(addr)
 		if err == nil {
-			s.rescanFilter.AddAddress(addr)
-			s.filterData.AddRegularPkScript(pkScript)
+			sw.rescanFilter.AddAddress(addr)
+			sw.filterData.AddRegularPkScript(pkScript)
 		}
 	}
 	for i := range outpoints {
-		s.rescanFilter.AddUnspentOutPoint(&outpoints[i])
-		s.filterData.AddOutPoint(&outpoints[i])
+		sw.rescanFilter.AddUnspentOutPoint(&outpoints[i])
+		sw.filterData.AddOutPoint(&outpoints[i])
 	}
-	s.filterMu.Unlock()
+	sw.filterMu.Unlock()
 	return nil
 }
 
@@ -134,12 +141,18 @@ func (s *Syncer) PublishTransactions(ctx context.Context, txs ...*wire.MsgTx) er
 }
 
 // Rescan implements the Rescan method of the wallet.NetworkBackend interface.
+// The wallet the Syncer was created for is rescanned.
 func (s *Syncer) Rescan(ctx context.Context, blockHashes []chainhash.Hash, r wallet.RescanSaver) error {
+	return s.primary.Rescan(ctx, blockHashes, r)
+}
+
+// Rescan implements the Rescan method of the wallet.NetworkBackend interface.
+func (sw *syncWallet) Rescan(ctx context.Context, blockHashes []chainhash.Hash, r wallet.RescanSaver) error {
 	const op errors.Op = "spv.Rescan"
 
 	cfilters := make([]*gcs.Filter, 0, len(blockHashes))
 	for i := 0; i < len(blockHashes); i++ {
-		f, err := s.wallet.CFilter(&blockHashes[i])
+		f, err := sw.wallet.CFilter(&blockHashes[i])
 		if err != nil {
 			return err
 		}
@@ -151,9 +164,9 @@ func (s *Syncer) Rescan(ctx context.Context, blockHashes []chainhash.Hash, r wal
 	// Read current filter data.  filterData is reassinged to new data matches
 	// for subsequent filter checks, which improves filter matching performance
 	// by checking for less data.
-	s.filterMu.Lock()
-	filterData := s.filterData
-	s.filterMu.Unlock()
+	sw.filterMu.Lock()
+	filterData := sw.filterData
+	sw.filterMu.Unlock()
 
 	idx := 0
 FilterLoop:
@@ -199,7 +212,7 @@ FilterLoop:
 			for {
 				if rp == nil {
 					var err error
-					rp, err = s.pickRemote(pickAny)
+					rp, err = sw.pickRemote(pickAny)
 					if err != nil {
 						return err
 					}
@@ -250,7 +263,7 @@ FilterLoop:
 				return err
 			}
 
-			matchedTxs, fadded := s.rescanBlock(b)
+			matchedTxs, fadded := sw.rescanBlock(b)
 			if len(matchedTxs) != 0 {
 				err := r.SaveRescanned(&blockHashes[i], matchedTxs)
 				if err != nil {
@@ -15,11 +15,11 @@ import (
 // rescanCheckTransaction is a helper function to rescan both stake and regular
 // transactions in a block.  It appends transations that match the filters to
 // *matches, while updating the filters to add outpoints for new UTXOs
-// controlled by this wallet.  New data added to the Syncer's filters is also
+// controlled by this wallet.  New data added to the wallet's filters is also
 // added to fadded.
 //
 // This function may only be called with the filter mutex held.
-func (s *Syncer) rescanCheckTransactions(matches *[]*wire.MsgTx, fadded *blockcf.Entries, txs []*wire.MsgTx, tree int8) {
+func (sw *syncWallet) rescanCheckTransactions(matches *[]*wire.MsgTx, fadded *blockcf.Entries, txs []*wire.MsgTx, tree int8) {
 	for i, tx := range txs {
 		// Keep track of whether the transaction has already been added
 		// to the result.  It shouldn't be added twice.
@@ -42,7 +42,7 @@ func (s *Syncer) rescanCheckTransactions(matches *[]*wire.MsgTx, fadded *blockcf
 		}
 
 		for _, input := range inputs {
-			if !s.rescanFilter.ExistsUnspentOutPoint(&input.PreviousOutPoint) {
+			if !sw.rescanFilter.ExistsUnspentOutPoint(&input.PreviousOutPoint) {
 				continue
 			}
 			if !added {
@@ -55,12 +55,12 @@ func (s *Syncer) rescanCheckTransactions(matches *[]*wire.MsgTx, fadded *blockcf
 		for i, output := range tx.TxOut {
 			_, addrs, _, err := txscript.ExtractPkScriptAddrs(
 				output.Version, output.PkScript,
-				s.wallet.ChainParams())
+				sw.wallet.ChainParams())
 			if err != nil {
 				continue
 			}
 			for _, a := range addrs {
-				if !s.rescanFilter.ExistsAddress(a) {
+				if !sw.rescanFilter.ExistsAddress(a) {
 					continue
 				}
 
@@ -69,9 +69,9 @@ func (s *Syncer) rescanCheckTransactions(matches *[]*wire.MsgTx, fadded *blockcf
 					Index: uint32(i),
 					Tree:  tree,
 				}
-				if !s.rescanFilter.ExistsUnspentOutPoint(&op) {
-					s.rescanFilter.AddUnspentOutPoint(&op)
-					s.filterData.AddOutPoint(&op)
+				if !sw.rescanFilter.ExistsUnspentOutPoint(&op) {
+					sw.rescanFilter.AddUnspentOutPoint(&op)
+					sw.filterData.AddOutPoint(&op)
 					fadded.AddOutPoint(&op)
 				}
 
@@ -87,37 +87,38 @@ func (s *Syncer) rescanCheckTransactions(matches *[]*wire.MsgTx, fadded *blockcf
 // rescanBlock rescans a block for any relevant transactions for the passed
 // lookup keys.  Returns any discovered transactions and any new data added to
 // the filter.
-func (s *Syncer) rescanBlock(block *wire.MsgBlock) (matches []*wire.MsgTx, fadded blockcf.Entries) {
-	s.filterMu.Lock()
-	s.rescanCheckTransactions(&matches, &fadded, block.STransactions, wire.TxTreeStake)
-	s.rescanCheckTransactions(&matches, &fadded, block.Transactions, wire.TxTreeRegular)
-	s.filterMu.Unlock()
+func (sw *syncWallet) rescanBlock(block *wire.MsgBlock) (matches []*wire.MsgTx, fadded blockcf.Entries) {
+	sw.filterMu.Lock()
+	sw.rescanCheckTransactions(&matches, &fadded, block.STransactions, wire.TxTreeStake)
+	sw.rescanCheckTransactions(&matches, &fadded, block.Transactions, wire.TxTreeRegular)
+	sw.filterMu.Unlock()
 	return matches, fadded
 }
 
-// filterRelevant filters out all transactions considered irrelevant
-// without updating filters.
-func (s *Syncer) filterRelevant(txs []*wire.MsgTx) []*wire.MsgTx {
-	defer s.filterMu.Unlock()
-	s.filterMu.Lock()
+// filterRelevant returns all transactions considered relevant without
+// updating filters.  txs is not modified, as the same transactions are
+// filtered for each synchronized wallet.
+func (sw *syncWallet) filterRelevant(txs []*wire.MsgTx) []*wire.MsgTx {
+	defer sw.filterMu.Unlock()
+	sw.filterMu.Lock()
 
-	matches := txs[:0]
+	var matches []*wire.MsgTx
 Txs:
 	for _, tx := range txs {
 		for _, in := range tx.TxIn {
-			if s.rescanFilter.ExistsUnspentOutPoint(&in.PreviousOutPoint) {
+			if sw.rescanFilter.ExistsUnspentOutPoint(&in.PreviousOutPoint) {
 				matches = append(matches, tx)
 				continue Txs
 			}
 		}
 		for _, out := range tx.TxOut {
 			_, addrs, _, err := txscript.ExtractPkScriptAddrs(out.Version,
-				out.PkScript, s.wallet.ChainParams())
+				out.PkScript, sw.wallet.ChainParams())
 			if err != nil {
 				continue
 			}
 			for _, a := range addrs {
-				if s.rescanFilter.ExistsAddress(a) {
+				if sw.rescanFilter.ExistsAddress(a) {
 					matches = append(matches, tx)
 					continue Txs
 				}
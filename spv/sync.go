@@ -15,6 +15,7 @@ import (
 
 	"github.com/fonero-project/fnod/addrmgr"
 	"github.com/fonero-project/fnod/chaincfg/chainhash"
+	"github.com/fonero-project/fnod/gcs"
 	"github.com/fonero-project/fnod/gcs/blockcf"
 	"github.com/fonero-project/fnod/wire"
 	"github.com/fonero-project/fnowallet/errors"
@@ -32,17 +33,20 @@ const reqSvcs = wire.SFNodeNetwork | wire.SFNodeCF
 
 // Syncer implements wallet synchronization services by over the Fonero wire
 // protocol using Simplified Payment Verification (SPV) with compact filters.
+// The peers of a single Syncer synchronize every wallet added to it.
 type Syncer struct {
 	// atomics
-	atomicCatchUpTryLock uint32 // CAS (entered=1) to perform discovery/rescan
-	atomicWalletSynced   uint32 // CAS (synced=1) when wallet syncing complete
+	atomicWalletSynced uint32 // CAS (synced=1) when syncing of every wallet is complete
 
-	wallet *wallet.Wallet
-	lp     *p2p.LocalPeer
+	lp *p2p.LocalPeer
 
-	// Protected by atomicCatchUpTryLock
-	discoverAccounts bool
-	loadedFilters    bool
+	// primary is the wallet the Syncer was created for.  The Syncer is the
+	// network backend of this wallet, while wallets added with AddWallet use
+	// the backend returned by that method.
+	primary   *syncWallet
+	wallets   []*syncWallet
+	runCtx    context.Context // Set while running
+	walletsMu sync.Mutex
 
 	persistantPeers []string
 
@@ -50,6 +54,31 @@ type Syncer struct {
 	remotes           map[string]*p2p.RemotePeer
 	remotesMu         sync.Mutex
 
+	// seenTxs records hashes of received inventoried transactions.  Once a
+	// transaction is fetched and processed from one peer, the hash is added to
+	// this cache to avoid fetching it again from other peers that announce the
+	// transaction.
+	seenTxs lru.Cache
+
+	// Holds all potential callbacks used to notify clients
+	notifications *Notifications
+}
+
+// syncWallet is a wallet synchronized by a Syncer.  It implements the
+// wallet.NetworkBackend interface for the wallet using the Syncer's peers.
+type syncWallet struct {
+	*Syncer
+
+	// atomics
+	atomicCatchUpTryLock uint32 // CAS (entered=1) to perform discovery/rescan
+	atomicSynced         uint32 // (synced=1) when wallet syncing complete
+
+	wallet *wallet.Wallet
+
+	// Protected by atomicCatchUpTryLock
+	discoverAccounts bool
+	loadedFilters    bool
+
 	// Data filters
 	//
 	// TODO: Replace precise rescan filter with wallet db accesses to avoid
@@ -58,12 +87,6 @@ type Syncer struct {
 	filterData   blockcf.Entries
 	filterMu     sync.Mutex
 
-	// seenTxs records hashes of received inventoried transactions.  Once a
-	// transaction is fetched and processed from one peer, the hash is added to
-	// this cache to avoid fetching it again from other peers that announce the
-	// transaction.
-	seenTxs lru.Cache
-
 	// Sidechain management
 	sidechains  wallet.SidechainForest
 	sidechainMu sync.Mutex
@@ -71,9 +94,6 @@ type Syncer struct {
 	currentLocators   []*chainhash.Hash
 	locatorGeneration uint
 	locatorMu         sync.Mutex
-
-	// Holds all potential callbacks used to notify clients
-	notifications *Notifications
 }
 
 // Notifications struct to contain all of the upcoming callbacks that will
@@ -97,15 +117,77 @@ type Notifications struct {
 
 // NewSyncer creates a Syncer that will sync the wallet using SPV.
 func NewSyncer(w *wallet.Wallet, lp *p2p.LocalPeer) *Syncer {
-	return &Syncer{
-		wallet:            w,
-		discoverAccounts:  !w.Locked(),
+	s := &Syncer{
 		connectingRemotes: make(map[string]struct{}),
 		remotes:           make(map[string]*p2p.RemotePeer),
-		rescanFilter:      wallet.NewRescanFilter(nil, nil),
 		seenTxs:           lru.NewCache(2000),
 		lp:                lp,
 	}
+	s.primary = newSyncWallet(s, w)
+	s.wallets = []*syncWallet{s.primary}
+	return s
+}
+
+func newSyncWallet(s *Syncer, w *wallet.Wallet) *syncWallet {
+	return &syncWallet{
+		Syncer:           s,
+		wallet:           w,
+		discoverAccounts: !w.Locked(),
+		rescanFilter:     wallet.NewRescanFilter(nil, nil),
+	}
+}
+
+// AddWallet synchronizes an additional wallet using the Syncer's peers and
+// returns the network backend of the wallet.  A wallet added while the Syncer
+// is running is synchronized with a connected peer, or otherwise with the next
+// connected peer.
+func (s *Syncer) AddWallet(w *wallet.Wallet) wallet.NetworkBackend {
+	sw := newSyncWallet(s, w)
+	s.walletsMu.Lock()
+	s.wallets = append(s.wallets, sw)
+	ctx := s.runCtx
+	s.walletsMu.Unlock()
+	s.updateSynced()
+
+	if ctx != nil {
+		go func() {
+			rp, err := s.pickRemote(pickAny)
+			if err != nil {
+				return
+			}
+			err = sw.loadLocators()
+			if err == nil {
+				err = sw.startupSync(ctx, rp)
+			}
+			if err != nil && ctx.Err() == nil {
+				log.Warnf("Failed to synchronize added wallet with %v: %v", rp, err)
+			}
+		}()
+	}
+	return sw
+}
+
+// RemoveWallet stops synchronizing a wallet added with AddWallet.
+func (s *Syncer) RemoveWallet(w *wallet.Wallet) {
+	s.walletsMu.Lock()
+	for i, sw := range s.wallets {
+		if sw.wallet == w && sw != s.primary {
+			// Copy the remaining wallets so the slices returned by
+			// syncWallets are never modified.
+			s.wallets = append(s.wallets[:i:i], s.wallets[i+1:]...)
+			break
+		}
+	}
+	s.walletsMu.Unlock()
+	s.updateSynced()
+}
+
+// syncWallets returns every wallet synchronized by the Syncer.
+func (s *Syncer) syncWallets() []*syncWallet {
+	s.walletsMu.Lock()
+	wallets := s.wallets
+	s.walletsMu.Unlock()
+	return wallets
 }
 
 // SetPersistantPeers sets each peer as a persistant peer and disables DNS
@@ -140,6 +222,30 @@ func (s *Syncer) unsynced() {
 	}
 }
 
+// updateSynced records the Syncer as synced when every wallet is synced, and
+// as unsynced otherwise.
+func (s *Syncer) updateSynced() {
+	for _, sw := range s.syncWallets() {
+		if atomic.LoadUint32(&sw.atomicSynced) == 0 {
+			s.unsynced()
+			return
+		}
+	}
+	s.synced()
+}
+
+// synced records the wallet as synced.
+func (sw *syncWallet) synced() {
+	atomic.StoreUint32(&sw.atomicSynced, 1)
+	sw.updateSynced()
+}
+
+// unsynced records the wallet as unsynced.
+func (sw *syncWallet) unsynced() {
+	atomic.StoreUint32(&sw.atomicSynced, 0)
+	sw.updateSynced()
+}
+
 // peerConnected updates the notification for peer count, if set.
 func (s *Syncer) peerConnected(remotesCount int, addr string) {
 	if s.notifications != nil && s.notifications.PeerConnected != nil {
@@ -219,17 +325,17 @@ func (s *Syncer) rescanFinished() {
 	}
 }
 
-// Run synchronizes the wallet, returning when synchronization fails or the
-// context is cancelled.
-func (s *Syncer) Run(ctx context.Context) error {
-	tipHash, tipHeight := s.wallet.MainChainTip()
-	rescanPoint, err := s.wallet.RescanPoint()
+// loadLocators logs the synchronization state of the wallet and loads the
+// block locators of its main chain.
+func (sw *syncWallet) loadLocators() error {
+	tipHash, tipHeight := sw.wallet.MainChainTip()
+	rescanPoint, err := sw.wallet.RescanPoint()
 	if err != nil {
 		return err
 	}
 	log.Infof("Headers synced through block %v height %d", &tipHash, tipHeight)
 	if rescanPoint != nil {
-		h, err := s.wallet.BlockHeader(rescanPoint)
+		h, err := sw.wallet.BlockHeader(rescanPoint)
 		if err != nil {
 			return err
 		}
@@ -240,11 +346,25 @@ func (s *Syncer) Run(ctx context.Context) error {
 		log.Infof("Transactions synced through block %v height %d", &tipHash, tipHeight)
 	}
 
-	locators, err := s.wallet.BlockLocators(nil)
+	locators, err := sw.wallet.BlockLocators(nil)
 	if err != nil {
 		return err
 	}
-	s.currentLocators = locators
+	sw.locatorMu.Lock()
+	sw.currentLocators = locators
+	sw.locatorMu.Unlock()
+	return nil
+}
+
+// Run synchronizes every wallet, returning when synchronization fails or the
+// context is cancelled.
+func (s *Syncer) Run(ctx context.Context) error {
+	for _, sw := range s.syncWallets() {
+		err := sw.loadLocators()
+		if err != nil {
+			return err
+		}
+	}
 
 	s.lp.AddrManager().Start()
 	defer func() {
@@ -261,6 +381,14 @@ func (s *Syncer) Run(ctx context.Context) error {
 
 	// Start background handlers to read received messages from remote peers
 	g, ctx := errgroup.WithContext(ctx)
+	s.walletsMu.Lock()
+	s.runCtx = ctx
+	s.walletsMu.Unlock()
+	defer func() {
+		s.walletsMu.Lock()
+		s.runCtx = nil
+		s.walletsMu.Unlock()
+	}()
 	g.Go(func() error { return s.receiveGetData(ctx) })
 	g.Go(func() error { return s.receiveInv(ctx) })
 	g.Go(func() error { return s.receiveHeadersAnnouncements(ctx) })
@@ -513,8 +641,8 @@ func (s *Syncer) receiveGetData(ctx context.Context) error {
 			if len(txHashes) != 0 {
 				var missing []*wire.InvVect
 				var err error
-				foundTxs, missing, err = s.wallet.GetTransactionsByHashes(txHashes)
-				if err != nil && !errors.Is(errors.NotExist, err) {
+				foundTxs, missing, err = s.getTransactionsByHashes(txHashes)
+				if err != nil {
 					log.Warnf("Failed to look up transactions for getdata reply to peer %v: %v",
 						rp.RemoteAddr(), err)
 					return
@@ -551,6 +679,31 @@ func (s *Syncer) receiveGetData(ctx context.Context) error {
 	}
 }
 
+// getTransactionsByHashes returns the transactions found by any synchronized
+// wallet, and inventory vectors of the transactions not found by any wallet.
+func (s *Syncer) getTransactionsByHashes(txHashes []*chainhash.Hash) ([]*wire.MsgTx, []*wire.InvVect, error) {
+	var found []*wire.MsgTx
+	for _, sw := range s.syncWallets() {
+		txs, missing, err := sw.wallet.GetTransactionsByHashes(txHashes)
+		if err != nil && !errors.Is(errors.NotExist, err) {
+			return nil, nil, err
+		}
+		found = append(found, txs...)
+		if len(missing) == 0 {
+			return found, nil, nil
+		}
+		txHashes = make([]*chainhash.Hash, len(missing))
+		for i := range missing {
+			txHashes[i] = &missing[i].Hash
+		}
+	}
+	notFound := make([]*wire.InvVect, len(txHashes))
+	for i, h := range txHashes {
+		notFound[i] = wire.NewInvVect(wire.InvTypeTx, h)
+	}
+	return found, notFound, nil
+}
+
 // receiveInv receives all inv messages from peers and starts goroutines to
 // handle block and tx announcements.
 func (s *Syncer) receiveInv(ctx context.Context) error {
@@ -629,19 +782,25 @@ func (s *Syncer) handleBlockInvs(ctx context.Context, rp *p2p.RemotePeer, hashes
 
 // handleTxInvs responds to the inv message created by rp by fetching
 // all unseen transactions announced by the peer.  Any transactions
-// that are relevant to the wallet are saved as unconfirmed
-// transactions.  Transaction invs are ignored when a rescan is
-// necessary or ongoing.
+// that are relevant to a wallet are saved as unconfirmed
+// transactions.  Transaction invs are ignored by wallets for which a
+// rescan is necessary or ongoing.
 func (s *Syncer) handleTxInvs(ctx context.Context, rp *p2p.RemotePeer, hashes []*chainhash.Hash) {
 	const opf = "spv.handleTxInvs(%v)"
 
-	rpt, err := s.wallet.RescanPoint()
-	if err != nil {
-		op := errors.Opf(opf, rp.RemoteAddr())
-		log.Warn(errors.E(op, err))
-		return
+	var wallets []*syncWallet
+	for _, sw := range s.syncWallets() {
+		rpt, err := sw.wallet.RescanPoint()
+		if err != nil {
+			op := errors.Opf(opf, rp.RemoteAddr())
+			log.Warn(errors.E(op, err))
+			continue
+		}
+		if rpt == nil {
+			wallets = append(wallets, sw)
+		}
 	}
-	if rpt != nil {
+	if len(wallets) == 0 {
 		return
 	}
 
@@ -685,11 +844,13 @@ func (s *Syncer) handleTxInvs(ctx context.Context, rp *p2p.RemotePeer, hashes []
 	}
 
 	// Save any relevant transaction.
-	for _, tx := range s.filterRelevant(txs) {
-		err := s.wallet.AcceptMempoolTx(tx)
-		if err != nil {
-			op := errors.Opf(opf, rp.RemoteAddr())
-			log.Warn(errors.E(op, err))
+	for _, sw := range wallets {
+		for _, tx := range sw.filterRelevant(txs) {
+			err := sw.wallet.AcceptMempoolTx(tx)
+			if err != nil {
+				op := errors.Opf(opf, rp.RemoteAddr())
+				log.Warn(errors.E(op, err))
+			}
 		}
 	}
 }
@@ -726,14 +887,14 @@ func (s *Syncer) receiveHeadersAnnouncements(ctx context.Context) error {
 // scanChain checks for matching filters of chain and returns a map of
 // relevant wallet transactions keyed by block hash.  bmap is queried
 // for the block first with fallback to querying rp using getdata.
-func (s *Syncer) scanChain(ctx context.Context, rp *p2p.RemotePeer, chain []*wallet.BlockNode,
+func (sw *syncWallet) scanChain(ctx context.Context, rp *p2p.RemotePeer, chain []*wallet.BlockNode,
 	bmap map[chainhash.Hash]*wire.MsgBlock) (map[chainhash.Hash][]*wire.MsgTx, error) {
 
 	found := make(map[chainhash.Hash][]*wire.MsgTx)
 
-	s.filterMu.Lock()
-	filterData := s.filterData
-	s.filterMu.Unlock()
+	sw.filterMu.Lock()
+	filterData := sw.filterData
+	sw.filterMu.Unlock()
 
 	fetched := make([]*wire.MsgBlock, len(chain))
 	if bmap != nil {
@@ -759,7 +920,9 @@ FilterLoop:
 				n := chain[i]
 				f := n.Filter
 				k := blockcf.Key(n.Hash)
-				if f.N() != 0 && f.MatchAny(k, filterData) {
+				// Filters are not fetched for blocks preceding the
+				// wallet birthday.
+				if f != nil && f.N() != 0 && f.MatchAny(k, filterData) {
 					fmatchMu.Lock()
 					fmatches = append(fmatches, n.Hash)
 					fmatchidx = append(fmatchidx, i)
@@ -821,7 +984,7 @@ FilterLoop:
 			if b == nil {
 				continue
 			}
-			matches, fadded := s.rescanBlock(b)
+			matches, fadded := sw.rescanBlock(b)
 			found[*chain[i].Hash] = matches
 			if len(fadded) != 0 {
 				idx = i + 1
@@ -866,15 +1029,32 @@ func (s *Syncer) handleBlockAnnouncements(ctx context.Context, rp *p2p.RemotePee
 		return err
 	}
 
+	// Every wallet connects the blocks to its own main chain.  The first
+	// error is returned after each wallet has processed the blocks.
+	var firstErr error
+	for _, sw := range s.syncWallets() {
+		err := sw.connectBlocks(ctx, rp, headers, blockHashes, filters, bmap)
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// connectBlocks adds announced blocks and their committed filters to the
+// wallet's sidechains and switches the wallet to the best chain.
+func (sw *syncWallet) connectBlocks(ctx context.Context, rp *p2p.RemotePeer, headers []*wire.BlockHeader,
+	blockHashes []*chainhash.Hash, filters []*gcs.Filter, bmap map[chainhash.Hash]*wire.MsgBlock) error {
+
 	newBlocks := make([]*wallet.BlockNode, 0, len(headers))
 	var bestChain []*wallet.BlockNode
 	var matchingTxs map[chainhash.Hash][]*wire.MsgTx
-	err = func() error {
-		defer s.sidechainMu.Unlock()
-		s.sidechainMu.Lock()
+	err := func() error {
+		defer sw.sidechainMu.Unlock()
+		sw.sidechainMu.Lock()
 
 		for i := range headers {
-			haveBlock, _, err := s.wallet.BlockInMainChain(blockHashes[i])
+			haveBlock, _, err := sw.wallet.BlockInMainChain(blockHashes[i])
 			if err != nil {
 				return err
 			}
@@ -882,12 +1062,13 @@ func (s *Syncer) handleBlockAnnouncements(ctx context.Context, rp *p2p.RemotePee
 				continue
 			}
 			n := wallet.NewBlockNode(headers[i], blockHashes[i], filters[i])
-			if s.sidechains.AddBlockNode(n) {
+			if sw.sidechains.AddBlockNode(n) {
 				newBlocks = append(newBlocks, n)
 			}
 		}
 
-		bestChain, err = s.wallet.EvaluateBestChain(&s.sidechains)
+		var err error
+		bestChain, err = sw.wallet.EvaluateBestChain(&sw.sidechains)
 		if err != nil {
 			return err
 		}
@@ -896,23 +1077,23 @@ func (s *Syncer) handleBlockAnnouncements(ctx context.Context, rp *p2p.RemotePee
 			return nil
 		}
 
-		_, err = s.wallet.ValidateHeaderChainDifficulties(bestChain, 0)
+		_, err = sw.wallet.ValidateHeaderChainDifficulties(bestChain, 0)
 		if err != nil {
 			return err
 		}
 
-		rpt, err := s.wallet.RescanPoint()
+		rpt, err := sw.wallet.RescanPoint()
 		if err != nil {
 			return err
 		}
 		if rpt == nil {
-			matchingTxs, err = s.scanChain(ctx, rp, bestChain, bmap)
+			matchingTxs, err = sw.scanChain(ctx, rp, bestChain, bmap)
 			if err != nil {
 				return err
 			}
 		}
 
-		prevChain, err := s.wallet.ChainSwitch(&s.sidechains, bestChain, matchingTxs)
+		prevChain, err := sw.wallet.ChainSwitch(&sw.sidechains, bestChain, matchingTxs)
 		if err != nil {
 			return err
 		}
@@ -920,7 +1101,7 @@ func (s *Syncer) handleBlockAnnouncements(ctx context.Context, rp *p2p.RemotePee
 			log.Infof("Reorganize from %v to %v (total %d block(s) reorged)",
 				prevChain[len(prevChain)-1].Hash, bestChain[len(bestChain)-1].Hash, len(prevChain))
 			for _, n := range prevChain {
-				s.sidechains.AddBlockNode(n)
+				sw.sidechains.AddBlockNode(n)
 			}
 		}
 
@@ -931,10 +1112,10 @@ func (s *Syncer) handleBlockAnnouncements(ctx context.Context, rp *p2p.RemotePee
 	}
 
 	if len(bestChain) != 0 {
-		s.locatorMu.Lock()
-		s.currentLocators = nil
-		s.locatorGeneration++
-		s.locatorMu.Unlock()
+		sw.locatorMu.Lock()
+		sw.currentLocators = nil
+		sw.locatorGeneration++
+		sw.locatorMu.Unlock()
 	}
 
 	// Log connected blocks.
@@ -945,7 +1126,7 @@ func (s *Syncer) handleBlockAnnouncements(ctx context.Context, rp *p2p.RemotePee
 	// Announced blocks not in the main chain are logged as sidechain or orphan
 	// blocks.
 	for _, n := range newBlocks {
-		haveBlock, _, err := s.wallet.BlockInMainChain(n.Hash)
+		haveBlock, _, err := sw.wallet.BlockInMainChain(n.Hash)
 		if err != nil {
 			return err
 		}
@@ -965,23 +1146,23 @@ var hashStop chainhash.Hash
 // getHeaders iteratively fetches headers from rp using the latest locators.
 // Returns when no more headers are available.  A sendheaders message is pushed
 // to the peer when there are no more headers to fetch.
-func (s *Syncer) getHeaders(ctx context.Context, rp *p2p.RemotePeer) error {
+func (sw *syncWallet) getHeaders(ctx context.Context, rp *p2p.RemotePeer) error {
 	var locators []*chainhash.Hash
 	var generation uint
 	var err error
-	s.locatorMu.Lock()
-	locators = s.currentLocators
-	generation = s.locatorGeneration
+	sw.locatorMu.Lock()
+	locators = sw.currentLocators
+	generation = sw.locatorGeneration
 	if locators == nil {
-		locators, err = s.wallet.BlockLocators(nil)
+		locators, err = sw.wallet.BlockLocators(nil)
 		if err != nil {
-			s.locatorMu.Unlock()
+			sw.locatorMu.Unlock()
 			return err
 		}
-		s.currentLocators = locators
-		s.locatorGeneration++
+		sw.currentLocators = locators
+		sw.locatorGeneration++
 	}
-	s.locatorMu.Unlock()
+	sw.locatorMu.Unlock()
 
 	var lastHeight int32
 
@@ -998,7 +1179,7 @@ func (s *Syncer) getHeaders(ctx context.Context, rp *p2p.RemotePeer) error {
 				// Peer may not have provided any headers if our own locators
 				// were up to date.  Compare the best locator hash with the
 				// advertised height.
-				h, err := s.wallet.BlockHeader(locators[0])
+				h, err := sw.wallet.BlockHeader(locators[0])
 				if err == nil && int32(h.Height) < rp.InitialHeight() {
 					return errors.E(errors.Protocol, "peer did not provide "+
 						"headers through advertised height")
@@ -1011,10 +1192,22 @@ func (s *Syncer) getHeaders(ctx context.Context, rp *p2p.RemotePeer) error {
 
 		lastHeight = int32(headers[len(headers)-1].Height)
 
+		// Committed filters are not fetched for deep blocks preceding the
+		// wallet birthday.
+		skip, err := sw.wallet.SkippableCFilters(headers, rp.InitialHeight())
+		if err != nil {
+			return err
+		}
+
 		nodes := make([]*wallet.BlockNode, len(headers))
 		g, ctx := errgroup.WithContext(ctx)
 		for i := range headers {
 			i := i
+			if i < skip {
+				hash := headers[i].BlockHash()
+				nodes[i] = wallet.NewBlockNode(headers[i], &hash, nil)
+				continue
+			}
 			g.Go(func() error {
 				header := headers[i]
 				hash := header.BlockHash()
@@ -1032,58 +1225,58 @@ func (s *Syncer) getHeaders(ctx context.Context, rp *p2p.RemotePeer) error {
 		}
 
 		var added int
-		s.sidechainMu.Lock()
+		sw.sidechainMu.Lock()
 		for _, n := range nodes {
-			haveBlock, _, _ := s.wallet.BlockInMainChain(n.Hash)
+			haveBlock, _, _ := sw.wallet.BlockInMainChain(n.Hash)
 			if haveBlock {
 				continue
 			}
-			if s.sidechains.AddBlockNode(n) {
+			if sw.sidechains.AddBlockNode(n) {
 				added++
 			}
 		}
 		if added == 0 {
-			s.sidechainMu.Unlock()
+			sw.sidechainMu.Unlock()
 
-			s.locatorMu.Lock()
-			if s.locatorGeneration > generation {
-				locators = s.currentLocators
+			sw.locatorMu.Lock()
+			if sw.locatorGeneration > generation {
+				locators = sw.currentLocators
 			} else {
-				locators, err = s.wallet.BlockLocators(nil)
+				locators, err = sw.wallet.BlockLocators(nil)
 				if err != nil {
-					s.locatorMu.Unlock()
+					sw.locatorMu.Unlock()
 					return err
 				}
-				s.currentLocators = locators
-				s.locatorGeneration++
-				generation = s.locatorGeneration
+				sw.currentLocators = locators
+				sw.locatorGeneration++
+				generation = sw.locatorGeneration
 			}
-			s.locatorMu.Unlock()
+			sw.locatorMu.Unlock()
 			continue
 		}
-		s.fetchHeadersProgress(int32(added), headers[len(headers)-1].Timestamp.Unix())
+		sw.fetchHeadersProgress(int32(added), headers[len(headers)-1].Timestamp.Unix())
 		log.Debugf("Fetched %d new header(s) ending at height %d from %v",
 			added, nodes[len(nodes)-1].Header.Height, rp)
 
-		bestChain, err := s.wallet.EvaluateBestChain(&s.sidechains)
+		bestChain, err := sw.wallet.EvaluateBestChain(&sw.sidechains)
 		if err != nil {
-			s.sidechainMu.Unlock()
+			sw.sidechainMu.Unlock()
 			return err
 		}
 		if len(bestChain) == 0 {
-			s.sidechainMu.Unlock()
+			sw.sidechainMu.Unlock()
 			continue
 		}
 
-		_, err = s.wallet.ValidateHeaderChainDifficulties(bestChain, 0)
+		_, err = sw.wallet.ValidateHeaderChainDifficulties(bestChain, 0)
 		if err != nil {
-			s.sidechainMu.Unlock()
+			sw.sidechainMu.Unlock()
 			return err
 		}
 
-		prevChain, err := s.wallet.ChainSwitch(&s.sidechains, bestChain, nil)
+		prevChain, err := sw.wallet.ChainSwitch(&sw.sidechains, bestChain, nil)
 		if err != nil {
-			s.sidechainMu.Unlock()
+			sw.sidechainMu.Unlock()
 			return err
 		}
 
@@ -1091,7 +1284,7 @@ func (s *Syncer) getHeaders(ctx context.Context, rp *p2p.RemotePeer) error {
 			log.Infof("Reorganize from %v to %v (total %d block(s) reorged)",
 				prevChain[len(prevChain)-1].Hash, bestChain[len(bestChain)-1].Hash, len(prevChain))
 			for _, n := range prevChain {
-				s.sidechains.AddBlockNode(n)
+				sw.sidechains.AddBlockNode(n)
 			}
 		}
 		tip := bestChain[len(bestChain)-1]
@@ -1102,114 +1295,131 @@ func (s *Syncer) getHeaders(ctx context.Context, rp *p2p.RemotePeer) error {
 				len(bestChain), tip.Hash, tip.Header.Height, tip.Header.Timestamp)
 		}
 
-		s.sidechainMu.Unlock()
+		sw.sidechainMu.Unlock()
 
 		// Generate new locators
-		s.locatorMu.Lock()
-		locators, err = s.wallet.BlockLocators(nil)
+		sw.locatorMu.Lock()
+		locators, err = sw.wallet.BlockLocators(nil)
 		if err != nil {
-			s.locatorMu.Unlock()
+			sw.locatorMu.Unlock()
 			return err
 		}
-		s.currentLocators = locators
-		s.locatorGeneration++
-		s.locatorMu.Unlock()
+		sw.currentLocators = locators
+		sw.locatorGeneration++
+		sw.locatorMu.Unlock()
 	}
 }
 
+// startupSync synchronizes every wallet with a newly connected peer.  Only
+// errors synchronizing the wallet the Syncer was created for are returned,
+// while errors synchronizing added wallets are logged.
 func (s *Syncer) startupSync(ctx context.Context, rp *p2p.RemotePeer) error {
+	for _, sw := range s.syncWallets() {
+		err := sw.startupSync(ctx, rp)
+		if err == nil {
+			continue
+		}
+		if sw == s.primary || ctx.Err() != nil {
+			return err
+		}
+		log.Warnf("Failed to synchronize added wallet with %v: %v", rp, err)
+	}
+	return nil
+}
+
+func (sw *syncWallet) startupSync(ctx context.Context, rp *p2p.RemotePeer) error {
 	// Disconnect from the peer if their advertised block height is
 	// significantly behind the wallet's.
-	_, tipHeight := s.wallet.MainChainTip()
+	_, tipHeight := sw.wallet.MainChainTip()
 	if rp.InitialHeight() < tipHeight-6 {
 		return errors.E("peer is not synced")
 	}
-	s.fetchMissingCfiltersStart()
+	sw.fetchMissingCfiltersStart()
 	progress := make(chan wallet.MissingCFilterProgress, 1)
-	go s.wallet.FetchMissingCFiltersWithProgress(ctx, rp, progress)
+	go sw.wallet.FetchMissingCFiltersWithProgress(ctx, rp, progress)
 
 	for p := range progress {
 		if p.Err != nil {
 			return p.Err
 		}
-		s.fetchMissingCfiltersProgress(p.BlockHeightStart, p.BlockHeightEnd)
+		sw.fetchMissingCfiltersProgress(p.BlockHeightStart, p.BlockHeightEnd)
 	}
-	s.fetchMissingCfiltersFinished()
+	sw.fetchMissingCfiltersFinished()
 
 	// Fetch any unseen headers from the peer.
-	s.fetchHeadersStart()
+	sw.fetchHeadersStart()
 	log.Debugf("Fetching headers from %v", rp.RemoteAddr())
-	err := s.getHeaders(ctx, rp)
+	err := sw.getHeaders(ctx, rp)
 	if err != nil {
 		return err
 	}
-	s.fetchHeadersFinished()
+	sw.fetchHeadersFinished()
 
-	if atomic.CompareAndSwapUint32(&s.atomicCatchUpTryLock, 0, 1) {
+	if atomic.CompareAndSwapUint32(&sw.atomicCatchUpTryLock, 0, 1) {
 		err = func() error {
-			rescanPoint, err := s.wallet.RescanPoint()
+			rescanPoint, err := sw.wallet.RescanPoint()
 			if err != nil {
 				return err
 			}
 			if rescanPoint == nil {
-				if !s.loadedFilters {
-					err = s.wallet.LoadActiveDataFilters(ctx, s, true)
+				if !sw.loadedFilters {
+					err = sw.wallet.LoadActiveDataFilters(ctx, sw, true)
 					if err != nil {
 						return err
 					}
-					s.loadedFilters = true
+					sw.loadedFilters = true
 				}
 
-				s.synced()
+				sw.synced()
 
 				return nil
 			}
 			// RescanPoint is != nil so we are not synced to the peer and
 			// check to see if it was previously synced
-			s.unsynced()
+			sw.unsynced()
 
-			s.discoverAddressesStart()
-			err = s.wallet.DiscoverActiveAddresses(ctx, rp, rescanPoint, s.discoverAccounts)
+			sw.discoverAddressesStart()
+			err = sw.wallet.DiscoverActiveAddresses(ctx, rp, rescanPoint, sw.discoverAccounts)
 			if err != nil {
 				return err
 			}
-			s.discoverAddressesFinished()
-			s.discoverAccounts = false
+			sw.discoverAddressesFinished()
+			sw.discoverAccounts = false
 
-			err = s.wallet.LoadActiveDataFilters(ctx, s, true)
+			err = sw.wallet.LoadActiveDataFilters(ctx, sw, true)
 			if err != nil {
 				return err
 			}
-			s.loadedFilters = true
+			sw.loadedFilters = true
 
-			s.rescanStart()
+			sw.rescanStart()
 
-			rescanBlock, err := s.wallet.BlockHeader(rescanPoint)
+			rescanBlock, err := sw.wallet.BlockHeader(rescanPoint)
 			if err != nil {
 				return err
 			}
 			progress := make(chan wallet.RescanProgress, 1)
-			go s.wallet.RescanProgressFromHeight(ctx, s, int32(rescanBlock.Height), progress)
+			go sw.wallet.RescanProgressFromHeight(ctx, sw, int32(rescanBlock.Height), progress)
 
 			for p := range progress {
 				if p.Err != nil {
 					return p.Err
 				}
-				s.rescanProgress(p.ScannedThrough)
+				sw.rescanProgress(p.ScannedThrough)
 			}
-			s.rescanFinished()
+			sw.rescanFinished()
 
-			s.synced()
+			sw.synced()
 
 			return nil
 		}()
-		atomic.StoreUint32(&s.atomicCatchUpTryLock, 0)
+		atomic.StoreUint32(&sw.atomicCatchUpTryLock, 0)
 		if err != nil {
 			return err
 		}
 	}
 
-	unminedTxs, err := s.wallet.UnminedTransactions()
+	unminedTxs, err := sw.wallet.UnminedTransactions()
 	if err != nil {
 		log.Errorf("Cannot load unmined transactions for resending: %v", err)
 		return nil
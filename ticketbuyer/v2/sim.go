@@ -0,0 +1,242 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package ticketbuyer
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/fonero-project/fnod/blockchain"
+	"github.com/fonero-project/fnod/chaincfg"
+	"github.com/fonero-project/fnod/chaincfg/chainhash"
+	"github.com/fonero-project/fnod/fnoutil"
+	"github.com/fonero-project/fnod/wire"
+	"github.com/fonero-project/fnowallet/errors"
+	"github.com/fonero-project/fnowallet/wallet"
+	"github.com/fonero-project/fnowallet/wallet/txrules"
+)
+
+// SimConfig describes a simulation of the ticket buyer over the main chain
+// blocks recorded by a wallet.
+type SimConfig struct {
+	// Ticket buyer config to simulate.  Only Maintain and PoolFees are
+	// considered; the simulated account is funded by Balance instead of a
+	// wallet account.
+	Config
+
+	// Starting balance of the simulated purchasing account
+	Balance fnoutil.Amount
+
+	// Fee rate (per kB) paid by ticket purchases
+	TicketFeeRate fnoutil.Amount
+
+	// First and last main chain blocks to simulate.  The main chain tip is
+	// used when EndHeight is zero or beyond the tip.
+	BeginHeight int32
+	EndHeight   int32
+}
+
+// SimResult describes what a ticket buyer config would have bought, spent and
+// earned.  Ticket outcomes are not known for tickets which never existed, so
+// votes are modeled using the voter count and ticket pool size of each
+// historical block, and vote, expiry and live counts are expected values.
+type SimResult struct {
+	BeginHeight int32
+	EndHeight   int32
+
+	Purchased int
+	Spent     fnoutil.Amount // Ticket prices and fees
+	Fees      fnoutil.Amount // Ticket fees only
+
+	Voted   float64
+	Expired float64
+	Live    float64 // Includes immature tickets
+
+	Earned   fnoutil.Amount // Vote subsidy less stake pool fees
+	Immature fnoutil.Amount // Returned stake and rewards not yet spendable
+	Balance  fnoutil.Amount // Spendable balance after the last block
+}
+
+type simTicket struct {
+	price   fnoutil.Amount
+	poolFee fnoutil.Amount
+	height  int32   // Height of the block the ticket is mined in
+	pending float64 // Probability the ticket has neither voted nor expired
+}
+
+// simChain provides the main chain blocks replayed by a simulation.
+type simChain interface {
+	ChainParams() *chaincfg.Params
+	MainChainTip() (chainhash.Hash, int32)
+	NextStakeDifficultyAfterHeader(h *wire.BlockHeader) (fnoutil.Amount, error)
+	headerAtHeight(height int32) (*wire.BlockHeader, error)
+}
+
+// walletChain implements simChain for the main chain recorded by a wallet.
+type walletChain struct {
+	*wallet.Wallet
+}
+
+func (w walletChain) headerAtHeight(height int32) (*wire.BlockHeader, error) {
+	info, err := w.BlockInfo(wallet.NewBlockIdentifierFromHeight(height))
+	if err != nil {
+		return nil, err
+	}
+	header := new(wire.BlockHeader)
+	err = header.Deserialize(bytes.NewReader(info.Header))
+	if err != nil {
+		return nil, errors.E(errors.Encoding, err)
+	}
+	return header, nil
+}
+
+// Simulate replays the main chain blocks recorded by the wallet, purchasing
+// tickets after each block using the same rules as TB and the ticket price
+// calculated by NextStakeDifficultyAfterHeader.  Tickets are assumed to be
+// mined in the next block.  The wallet is only read from and may be offline.
+func Simulate(ctx context.Context, w *wallet.Wallet, cfg *SimConfig) (*SimResult, error) {
+	const op errors.Op = "ticketbuyer.Simulate"
+	res, err := simulate(ctx, walletChain{w}, cfg)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return res, nil
+}
+
+func simulate(ctx context.Context, w simChain, cfg *SimConfig) (*SimResult, error) {
+	params := w.ChainParams()
+	_, tipHeight := w.MainChainTip()
+	begin, end := cfg.BeginHeight, cfg.EndHeight
+	if end <= 0 || end > tipHeight {
+		end = tipHeight
+	}
+	if begin < 1 || begin > end {
+		return nil, errors.E(errors.Invalid, "begin height out of range")
+	}
+
+	maturity := int32(params.TicketMaturity)
+	expiry := int32(params.TicketExpiry)
+	coinbaseMaturity := int32(params.CoinbaseMaturity)
+	intervalSize := int32(params.StakeDiffWindowSize)
+	subsidyCache := blockchain.NewSubsidyCache(int64(end), params)
+
+	// Stake pool tickets are larger, with an additional input and outputs
+	// for the pool fee.
+	pool := cfg.PoolFees > 0
+	ticketFee := txrules.FeeForSerializeSize(cfg.TicketFeeRate,
+		wallet.EstimatedTicketSize(pool))
+
+	// Returned stake and rewards are only spendable after coinbase maturity.
+	// credits maps the height outputs become spendable to their expected
+	// value.
+	credits := make(map[int32]float64)
+	balance := float64(cfg.Balance)
+	var live []*simTicket
+
+	res := &SimResult{
+		BeginHeight: begin,
+		EndHeight:   end,
+	}
+
+	parent, err := w.headerAtHeight(begin - 1)
+	if err != nil {
+		return nil, err
+	}
+	for height := begin; height <= end; height++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		header, err := w.headerAtHeight(height)
+		if err != nil {
+			return nil, err
+		}
+
+		balance += credits[height]
+		delete(credits, height)
+
+		// Each live ticket is equally likely to be one of the block's voters,
+		// chosen from the pool of the parent block.
+		var voteProb float64
+		if parent.PoolSize != 0 {
+			voteProb = float64(header.Voters) / float64(parent.PoolSize)
+		}
+		subsidy := fnoutil.Amount(blockchain.CalcStakeVoteSubsidy(subsidyCache,
+			int64(height), params))
+		remaining := live[:0]
+		for _, t := range live {
+			switch {
+			case height-t.height > maturity+expiry:
+				res.Expired += t.pending
+				credits[height+coinbaseMaturity] += t.pending * float64(t.price)
+				continue
+			case height-t.height > maturity:
+				v := t.pending * voteProb
+				t.pending -= v
+				reward := subsidy - t.poolFee
+				res.Voted += v
+				res.Earned += fnoutil.Amount(v * float64(reward))
+				credits[height+coinbaseMaturity] += v * float64(t.price+reward)
+			}
+			remaining = append(remaining, t)
+		}
+		live = remaining
+		parent = header
+
+		// Skip purchase when no more tickets may be purchased in this
+		// interval and the next sdiff is unknown.
+		nextIntervalStart := (height/intervalSize + 1) * intervalSize
+		if height+2 == nextIntervalStart {
+			continue
+		}
+
+		spendable := fnoutil.Amount(balance)
+		if spendable < cfg.Maintain {
+			continue
+		}
+		spendable -= cfg.Maintain
+		sdiff, err := w.NextStakeDifficultyAfterHeader(header)
+		if err != nil {
+			return nil, err
+		}
+		cost := sdiff + ticketFee
+		buy := int(spendable / cost)
+		if max := int(params.MaxFreshStakePerBlock); buy > max {
+			buy = max
+		}
+		if buy == 0 {
+			continue
+		}
+		var poolFee fnoutil.Amount
+		if pool {
+			poolFee = txrules.StakePoolTicketFee(sdiff, cfg.TicketFeeRate, height,
+				cfg.PoolFees, params)
+		}
+		for i := 0; i < buy; i++ {
+			live = append(live, &simTicket{
+				price:   sdiff,
+				poolFee: poolFee,
+				height:  height + 1,
+				pending: 1,
+			})
+		}
+		balance -= float64(buy) * float64(cost)
+		res.Purchased += buy
+		res.Spent += fnoutil.Amount(buy) * cost
+		res.Fees += fnoutil.Amount(buy) * ticketFee
+	}
+
+	for _, t := range live {
+		res.Live += t.pending
+	}
+	var immature float64
+	for _, c := range credits {
+		immature += c
+	}
+	res.Immature = fnoutil.Amount(immature)
+	res.Balance = fnoutil.Amount(balance)
+
+	return res, nil
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package ticketbuyer
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/fonero-project/fnod/chaincfg"
+	"github.com/fonero-project/fnod/chaincfg/chainhash"
+	"github.com/fonero-project/fnod/fnoutil"
+	"github.com/fonero-project/fnod/wire"
+	"github.com/fonero-project/fnowallet/errors"
+	"github.com/fonero-project/fnowallet/wallet"
+	"github.com/fonero-project/fnowallet/wallet/txrules"
+)
+
+// testChain is a simChain of headers with a constant ticket price.
+type testChain struct {
+	params  *chaincfg.Params
+	headers []*wire.BlockHeader
+	sdiff   fnoutil.Amount
+}
+
+func newTestChain(params *chaincfg.Params, n int32, poolSize uint32, sdiff fnoutil.Amount) *testChain {
+	c := &testChain{params: params, sdiff: sdiff}
+	for height := int32(0); height <= n; height++ {
+		c.headers = append(c.headers, &wire.BlockHeader{
+			Height:   uint32(height),
+			Voters:   params.TicketsPerBlock,
+			PoolSize: poolSize,
+			SBits:    int64(sdiff),
+		})
+	}
+	return c
+}
+
+func (c *testChain) ChainParams() *chaincfg.Params { return c.params }
+
+func (c *testChain) MainChainTip() (chainhash.Hash, int32) {
+	tip := c.headers[len(c.headers)-1]
+	return tip.BlockHash(), int32(tip.Height)
+}
+
+func (c *testChain) NextStakeDifficultyAfterHeader(h *wire.BlockHeader) (fnoutil.Amount, error) {
+	return c.sdiff, nil
+}
+
+func (c *testChain) headerAtHeight(height int32) (*wire.BlockHeader, error) {
+	if height < 0 || int(height) >= len(c.headers) {
+		return nil, errors.E(errors.NotExist)
+	}
+	return c.headers[height], nil
+}
+
+func TestSimulate(t *testing.T) {
+	t.Parallel()
+	params := &chaincfg.SimNetParams
+	const sdiff = 10e8
+	feeRate := txrules.DefaultRelayFeePerKb
+	c := newTestChain(params, 300, 1000, sdiff)
+
+	tests := []struct {
+		name     string
+		poolFees float64
+		pool     bool
+	}{
+		{"solo", 0, false},
+		{"stake pool", 7.5, true},
+	}
+	for _, test := range tests {
+		cfg := &SimConfig{
+			Config:        Config{PoolFees: test.poolFees},
+			Balance:       1000e8,
+			TicketFeeRate: feeRate,
+			BeginHeight:   1,
+		}
+		res, err := simulate(context.Background(), c, cfg)
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		if res.BeginHeight != 1 || res.EndHeight != 300 {
+			t.Errorf("%s: simulated heights %d-%d, expected 1-300", test.name,
+				res.BeginHeight, res.EndHeight)
+		}
+		if res.Purchased == 0 {
+			t.Fatalf("%s: no tickets purchased", test.name)
+		}
+
+		// Fees are calculated from the fee rate and ticket size.
+		ticketFee := txrules.FeeForSerializeSize(feeRate, wallet.EstimatedTicketSize(test.pool))
+		purchased := fnoutil.Amount(res.Purchased)
+		if res.Fees != purchased*ticketFee {
+			t.Errorf("%s: fees %v, expected %v", test.name, res.Fees, purchased*ticketFee)
+		}
+		if res.Spent != purchased*(sdiff+ticketFee) {
+			t.Errorf("%s: spent %v, expected %v", test.name, res.Spent,
+				purchased*(sdiff+ticketFee))
+		}
+
+		// Every ticket has voted, expired, or is live.
+		outcomes := res.Voted + res.Expired + res.Live
+		if math.Abs(outcomes-float64(res.Purchased)) > 1e-6 {
+			t.Errorf("%s: %v outcomes of %d tickets", test.name, outcomes,
+				res.Purchased)
+		}
+
+		// Funds are either spendable, immature, or locked in live tickets,
+		// and only change by fees and earnings.  Earnings of each ticket
+		// are truncated to whole atoms in each block.
+		have := float64(res.Balance) + float64(res.Immature) + res.Live*sdiff
+		want := float64(cfg.Balance - res.Fees + res.Earned)
+		if math.Abs(have-want) > float64(res.Purchased*len(c.headers)) {
+			t.Errorf("%s: ending funds %v, expected %v", test.name,
+				fnoutil.Amount(have), fnoutil.Amount(want))
+		}
+	}
+
+	// Simulations must begin after the genesis block and end after they
+	// begin.
+	for _, begin := range []int32{0, 301} {
+		cfg := &SimConfig{Balance: 1000e8, BeginHeight: begin, EndHeight: 300}
+		_, err := simulate(context.Background(), c, cfg)
+		if !errors.Is(errors.Invalid, err) {
+			t.Errorf("begin height %d: error %v, expected Invalid", begin, err)
+		}
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/fonero-project/fnod/fnoutil"
 	"github.com/fonero-project/fnowallet/errors"
 	"github.com/fonero-project/fnowallet/wallet"
+	"github.com/fonero-project/fnowallet/wallet/udb"
 )
 
 const minconf = 1
@@ -35,6 +36,10 @@ type Config struct {
 
 	// Stakepool fee percentage (between 0-100)
 	PoolFees float64
+
+	// Delay purchases while the ticket price of the next stake difficulty
+	// window is expected to be lower than the current price
+	WaitForLowerPrice bool
 }
 
 // TB is an automated ticket buyer, buying as many tickets as possible given an
@@ -43,8 +48,9 @@ type Config struct {
 type TB struct {
 	wallet *wallet.Wallet
 
-	cfg Config
-	mu  sync.Mutex
+	cfg   Config
+	grant *wallet.UnlockGrant // Unlock for the scope of the current config
+	mu    sync.Mutex
 }
 
 // New returns a new TB to buy tickets from a wallet using the default config.
@@ -52,14 +58,62 @@ func New(w *wallet.Wallet) *TB {
 	return &TB{wallet: w}
 }
 
+// unlockScope returns the scope of the wallet unlock required to purchase
+// tickets from the purchasing account and vote with the voting account.
+func unlockScope(cfg *Config) *udb.UnlockScope {
+	scope := &udb.UnlockScope{
+		Accounts: []uint32{cfg.Account},
+		Uses:     udb.KeyUseTicket | udb.KeyUseVote,
+	}
+	if cfg.VotingAccount != cfg.Account {
+		scope.Accounts = append(scope.Accounts, cfg.VotingAccount)
+	}
+	return scope
+}
+
+// unlock unlocks the wallet for the scope of the current config, replacing the
+// previous unlock of the ticket buyer.  Unlocking each time also checks that
+// the passphrase remains correct.
+func (tb *TB) unlock(passphrase []byte) error {
+	tb.mu.Lock()
+	scope := unlockScope(&tb.cfg)
+	tb.mu.Unlock()
+	grant, err := tb.wallet.GrantUnlock(passphrase, scope, nil)
+	if err != nil {
+		return err
+	}
+	tb.mu.Lock()
+	prev := tb.grant
+	tb.grant = grant
+	tb.mu.Unlock()
+	if prev != nil {
+		tb.wallet.RevokeUnlock(prev)
+	}
+	return nil
+}
+
+// relock revokes the unlock of the ticket buyer.
+func (tb *TB) relock() {
+	tb.mu.Lock()
+	grant := tb.grant
+	tb.grant = nil
+	tb.mu.Unlock()
+	if grant != nil {
+		tb.wallet.RevokeUnlock(grant)
+	}
+}
+
 // Run executes the ticket buyer.  If the private passphrase is incorrect, or
 // ever becomes incorrect due to a wallet passphrase change, Run exits with an
-// errors.Passphrase error.
+// errors.Passphrase error.  The wallet is only unlocked for the private keys
+// of the purchasing and voting accounts used for tickets and votes, and the
+// unlock is revoked when Run exits.
 func (tb *TB) Run(ctx context.Context, passphrase []byte) error {
-	err := tb.wallet.Unlock(passphrase, nil)
+	err := tb.unlock(passphrase)
 	if err != nil {
 		return err
 	}
+	defer tb.relock()
 
 	c := tb.wallet.NtfnServer.MainTipChangedNotifications()
 	defer c.Done()
@@ -123,7 +177,7 @@ func (tb *TB) buy(ctx context.Context, passphrase []byte, tip *chainhash.Hash) e
 	// Ensure wallet is unlocked with the current passphrase.  If the passphase
 	// is changed, the Run exits and TB must be restarted with the new
 	// passphrase.
-	err = w.Unlock(passphrase, nil)
+	err = tb.unlock(passphrase)
 	if err != nil {
 		return err
 	}
@@ -163,6 +217,7 @@ func (tb *TB) buy(ctx context.Context, passphrase []byte, tip *chainhash.Hash) e
 	votingAddr := tb.cfg.VotingAddr
 	poolFeeAddr := tb.cfg.PoolFeeAddr
 	poolFees := tb.cfg.PoolFees
+	waitForLowerPrice := tb.cfg.WaitForLowerPrice
 	tb.mu.Unlock()
 
 	// Determine how many tickets to buy
@@ -180,6 +235,17 @@ func (tb *TB) buy(ctx context.Context, passphrase []byte, tip *chainhash.Hash) e
 	if err != nil {
 		return err
 	}
+	if waitForLowerPrice {
+		f, err := w.TicketPriceForecast()
+		if err != nil {
+			return err
+		}
+		if f.NextWindow.Expected < sdiff {
+			log.Debugf("Skipping purchase: ticket price expected to decrease "+
+				"to %v at height %v", f.NextWindow.Expected, f.NextWindow.Height)
+			return nil
+		}
+	}
 	buy := int(spendable / sdiff)
 	if buy == 0 {
 		log.Debugf("Skipping purchase: low available balance")
@@ -216,9 +282,20 @@ func (tb *TB) buy(ctx context.Context, passphrase []byte, tip *chainhash.Hash) e
 // AccessConfig runs f with the current config passed as a parameter.  The
 // config is protected by a mutex and this function is safe for concurrent
 // access to read or modify the config.  It is unsafe to leak a pointer to the
-// config, but a copy of *cfg is legal.
+// config, but a copy of *cfg is legal.  The unlock for the previous purchasing
+// and voting accounts is revoked when they are changed, and the wallet is
+// unlocked for the new accounts before the next purchase.
 func (tb *TB) AccessConfig(f func(cfg *Config)) {
 	tb.mu.Lock()
+	prevScope := unlockScope(&tb.cfg)
 	f(&tb.cfg)
+	var grant *wallet.UnlockGrant
+	if !unlockScope(&tb.cfg).Equal(prevScope) {
+		grant = tb.grant
+		tb.grant = nil
+	}
 	tb.mu.Unlock()
+	if grant != nil {
+		tb.wallet.RevokeUnlock(grant)
+	}
 }
@@ -0,0 +1,221 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fonero-project/fnod/chaincfg/chainec"
+	"github.com/fonero-project/fnod/fnoutil"
+	"github.com/fonero-project/fnowallet/errors"
+	"github.com/fonero-project/fnowallet/wallet/udb"
+	"github.com/fonero-project/fnowallet/wallet/walletdb"
+)
+
+func TestAccountPassphrases(t *testing.T) {
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	privKey := func(addr fnoutil.Address) (chainec.PrivateKey, error) {
+		var key chainec.PrivateKey
+		err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+			ns := tx.ReadBucket(waddrmgrNamespaceKey)
+			k, done, err := w.Manager.PrivateKey(ns, addr)
+			if err != nil {
+				return err
+			}
+			done()
+			key = k
+			return nil
+		})
+		return key, err
+	}
+
+	lock := make(chan time.Time)
+	defer close(lock)
+	if err := w.Unlock([]byte("private"), lock); err != nil {
+		t.Fatal(err)
+	}
+	plain, err := w.NextAccount("plain")
+	if err != nil {
+		t.Fatal(err)
+	}
+	secret, err := w.NextAccountWithPassphrase("secret", []byte("account"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	plainAddr, err := w.NewExternalAddress(plain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	secretAddr, err := w.NewExternalAddress(secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Accounts derived from the wallet seed may not have a passphrase.
+	err = w.ChangeAccountPassphrase(plain, []byte("private"), []byte("account"))
+	if !errors.Is(errors.Invalid, err) {
+		t.Errorf("adding passphrase to seed account: expected Invalid error, got %v", err)
+	}
+
+	// The account with a passphrase is not unlocked with the wallet.
+	if _, err := privKey(secretAddr); !errors.Is(errors.Locked, err) {
+		t.Errorf("key of locked account: expected Locked error, got %v", err)
+	}
+
+	// An incorrect account passphrase revokes the unlock and leaves every
+	// account locked.
+	w.Lock()
+	scope := &udb.UnlockScope{}
+	err = w.UnlockScopedAccounts([]byte("private"), scope, nil,
+		map[uint32][]byte{secret: []byte("wrong")})
+	if err == nil {
+		t.Fatal("unlock with incorrect account passphrase succeeded")
+	}
+	if !w.Locked() {
+		t.Errorf("wallet is unlocked after an incorrect account passphrase")
+	}
+	err = w.UnlockScopedAccounts([]byte("private"), scope, nil,
+		map[uint32][]byte{secret: []byte("account")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	plainKey, err := privKey(plainAddr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	secretKey, err := privKey(secretAddr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Locking the account zeroes only its own keys.
+	if err := w.LockAccount(secret); err != nil {
+		t.Fatal(err)
+	}
+	if secretKey.GetD().Sign() != 0 {
+		t.Errorf("key of locked account was not zeroed")
+	}
+	if plainKey.GetD().Sign() == 0 {
+		t.Errorf("key of another account was zeroed")
+	}
+	if _, err := privKey(secretAddr); !errors.Is(errors.Locked, err) {
+		t.Errorf("key of locked account: expected Locked error, got %v", err)
+	}
+	if err := w.LockAccount(plain); !errors.Is(errors.Invalid, err) {
+		t.Errorf("locking account without passphrase: expected Invalid error, got %v", err)
+	}
+
+	// Changing the passphrase requires the old account passphrase.
+	err = w.ChangeAccountPassphrase(secret, []byte("private"), []byte("new"))
+	if err == nil {
+		t.Errorf("account passphrase changed with the wallet passphrase")
+	}
+	err = w.ChangeAccountPassphrase(secret, []byte("account"), []byte("new"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.UnlockAccount(secret, []byte("account")); err == nil {
+		t.Errorf("account unlocked with its old passphrase")
+	}
+	if err := w.UnlockAccount(secret, []byte("new")); err != nil {
+		t.Fatal(err)
+	}
+
+	// The passphrase may be removed and added again, as the account key is
+	// not derived from the wallet seed.
+	if err := w.ChangeAccountPassphrase(secret, []byte("new"), nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := privKey(secretAddr); err != nil {
+		t.Errorf("key of account without passphrase: %v", err)
+	}
+	err = w.ChangeAccountPassphrase(secret, []byte("private"), []byte("again"))
+	if err != nil {
+		t.Errorf("adding passphrase to independent account: %v", err)
+	}
+}
+
+func TestExportAccountKey(t *testing.T) {
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	lock := make(chan time.Time)
+	defer close(lock)
+	if err := w.Unlock([]byte("private"), lock); err != nil {
+		t.Fatal(err)
+	}
+	plain, err := w.NextAccount("plain")
+	if err != nil {
+		t.Fatal(err)
+	}
+	secret, err := w.NextAccountWithPassphrase("secret", []byte("account"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	secretXpub, err := w.MasterPubKey(secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Accounts derived from the wallet seed are recovered with the seed and
+	// may not be exported.
+	if _, err := w.ExportAccountKey(plain, nil); !errors.Is(errors.Invalid, err) {
+		t.Errorf("exporting seed account: expected Invalid error, got %v", err)
+	}
+
+	// The key of an account with its own passphrase is only exported with
+	// that passphrase.
+	if _, err := w.ExportAccountKey(secret, []byte("private")); err == nil {
+		t.Errorf("account key exported with the wallet passphrase")
+	}
+	xpriv, err := w.ExportAccountKey(secret, []byte("account"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer xpriv.Zero()
+	if !xpriv.IsPrivate() {
+		t.Fatalf("exported account key is not private")
+	}
+
+	// Restoring the exported key recreates the account under the new
+	// account passphrase.
+	restored, err := w.ImportAccountKey("restored", xpriv, []byte("restore"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	restoredXpub, err := w.MasterPubKey(restored)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if restoredXpub.String() != secretXpub.String() {
+		t.Errorf("restored account key %v does not match %v",
+			restoredXpub, secretXpub)
+	}
+	if err := w.UnlockAccount(restored, []byte("account")); err == nil {
+		t.Errorf("restored account unlocked with the exported account's passphrase")
+	}
+	if err := w.UnlockAccount(restored, []byte("restore")); err != nil {
+		t.Fatal(err)
+	}
+
+	// An independent account whose passphrase was removed is still not
+	// recoverable from the seed and remains exportable.
+	if err := w.ChangeAccountPassphrase(secret, []byte("account"), nil); err != nil {
+		t.Fatal(err)
+	}
+	again, err := w.ExportAccountKey(secret, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer again.Zero()
+	if again.String() != xpriv.String() {
+		t.Errorf("exported key changed after removing the account passphrase")
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"github.com/fonero-project/fnowallet/auditlog"
+)
+
+// AuditLog returns the audit log of sensitive wallet operations.  The log is
+// nil when auditing is disabled.
+func (w *Wallet) AuditLog() *auditlog.Log {
+	return w.auditLog
+}
+
+// audit records a sensitive operation and its result in the audit log.  The
+// operation has already been performed, so failing to write the entry is
+// logged rather than returned.
+func (w *Wallet) audit(operation string, details map[string]string, err error) {
+	if w.auditLog == nil {
+		return
+	}
+	e := &auditlog.Entry{
+		Source:    auditlog.SourceWallet,
+		Operation: operation,
+		Details:   details,
+	}
+	if err != nil {
+		e.Error = err.Error()
+	}
+	if err := w.auditLog.Append(e); err != nil {
+		log.Errorf("Failed to write audit log entry for %s: %v", operation, err)
+	}
+}
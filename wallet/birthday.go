@@ -0,0 +1,162 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"sort"
+	"time"
+
+	"github.com/fonero-project/fnod/chaincfg/chainhash"
+	"github.com/fonero-project/fnod/wire"
+	"github.com/fonero-project/fnowallet/errors"
+	"github.com/fonero-project/fnowallet/wallet/udb"
+	"github.com/fonero-project/fnowallet/wallet/walletdb"
+)
+
+// birthdayTimeMargin is subtracted from birthday times before comparing them
+// with block timestamps, as the timestamp of a block may precede the time it
+// was mined.
+const birthdayTimeMargin = 24 * time.Hour
+
+// precedesBirthday returns whether the block described by header precedes the
+// birthday, and may not contain transactions relevant to the wallet.
+func precedesBirthday(b *udb.Birthday, header *wire.BlockHeader) bool {
+	if b == nil {
+		return false
+	}
+	if !b.Time.IsZero() {
+		return header.Timestamp.Before(b.Time.Add(-birthdayTimeMargin))
+	}
+	return int32(header.Height) < b.Height
+}
+
+// birthdayBlock returns the first main chain block which does not precede the
+// wallet birthday.  A nil hash is returned when no birthday is recorded or when
+// every main chain block precedes the birthday.
+func (w *Wallet) birthdayBlock(dbtx walletdb.ReadTx) (*chainhash.Hash, int32, error) {
+	b := udb.FetchBirthday(dbtx)
+	if b == nil {
+		return nil, 0, nil
+	}
+	ns := dbtx.ReadBucket(wtxmgrNamespaceKey)
+	_, tipHeight := w.TxStore.MainChainTip(ns)
+
+	height := b.Height
+	if !b.Time.IsZero() {
+		// Block timestamps are not strictly increasing, but are not
+		// expected to be out of order by more than the time margin, so
+		// the main chain is searched as if they were.
+		var err error
+		height = int32(sort.Search(int(tipHeight)+1, func(i int) bool {
+			if err != nil {
+				return true
+			}
+			var hash chainhash.Hash
+			hash, err = w.TxStore.GetMainChainBlockHashForHeight(ns, int32(i))
+			if err != nil {
+				return true
+			}
+			var header *wire.BlockHeader
+			header, err = w.TxStore.GetBlockHeader(dbtx, &hash)
+			if err != nil {
+				return true
+			}
+			return !precedesBirthday(b, header)
+		}))
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+	if height > tipHeight {
+		return nil, 0, nil
+	}
+	hash, err := w.TxStore.GetMainChainBlockHashForHeight(ns, height)
+	if err != nil {
+		return nil, 0, err
+	}
+	return &hash, height, nil
+}
+
+// Birthday returns the wallet birthday, or nil if the wallet has no birthday
+// and may have received transactions in any block.
+func (w *Wallet) Birthday() (*udb.Birthday, error) {
+	const op errors.Op = "wallet.Birthday"
+	var b *udb.Birthday
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		b = udb.FetchBirthday(dbtx)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return b, nil
+}
+
+// BirthdayBlock returns the hash and height of the first main chain block which
+// does not precede the wallet birthday.  Address discovery and rescans begin no
+// earlier than this block.  A nil hash is returned when the wallet has no
+// birthday or when every main chain block precedes the birthday.
+func (w *Wallet) BirthdayBlock() (*chainhash.Hash, int32, error) {
+	const op errors.Op = "wallet.BirthdayBlock"
+	var hash *chainhash.Hash
+	var height int32
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		hash, height, err = w.birthdayBlock(dbtx)
+		return err
+	})
+	if err != nil {
+		return nil, 0, errors.E(op, err)
+	}
+	return hash, height, nil
+}
+
+// SkippableCFilters returns the number of leading headers, which must extend
+// the main chain tip in order, whose committed filters need not be fetched
+// before the blocks are attached to the main chain.  Filters are only skipped
+// for blocks which precede the wallet birthday and are at least
+// MinCFilterPruneDepth blocks below peerHeight, and only while the filters of
+// all previous main chain blocks were also skipped or pruned.  Skipped filters
+// are recorded as pruned, and are fetched if a later rescan requires them.
+func (w *Wallet) SkippableCFilters(headers []*wire.BlockHeader, peerHeight int32) (int, error) {
+	const op errors.Op = "wallet.SkippableCFilters"
+	if len(headers) == 0 {
+		return 0, nil
+	}
+	var n int
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		b := udb.FetchBirthday(dbtx)
+		if b == nil {
+			return nil
+		}
+		ns := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		tipHash, tipHeight := w.TxStore.MainChainTip(ns)
+		if headers[0].PrevBlock != tipHash {
+			return nil
+		}
+		prunedHeight := w.TxStore.PrunedCFiltersHeight(dbtx)
+		if prunedHeight != tipHeight+1 && !(prunedHeight == 0 && tipHeight == 0) {
+			return nil
+		}
+		for _, h := range headers {
+			if !precedesBirthday(b, h) || peerHeight-int32(h.Height) < MinCFilterPruneDepth {
+				break
+			}
+			n++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	return n, nil
+}
+
+// setBirthday records the birthday of a newly created wallet.
+func setBirthday(db walletdb.DB, b *udb.Birthday) error {
+	return walletdb.Update(db, func(dbtx walletdb.ReadWriteTx) error {
+		return udb.SetBirthday(dbtx, b)
+	})
+}
@@ -0,0 +1,167 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"github.com/fonero-project/fnod/chaincfg/chainhash"
+	"github.com/fonero-project/fnowallet/errors"
+	"github.com/fonero-project/fnowallet/wallet/walletdb"
+)
+
+// MinCFilterPruneDepth is the minimum depth below the main chain tip of blocks
+// whose committed filters may be pruned.  Filters of blocks detached by a
+// reorganization must remain available, and reorganizations of this depth are
+// not expected.
+const MinCFilterPruneDepth = 4096
+
+// pruneCFilters removes the committed filters of main chain blocks deeper than
+// the configured prune depth, unless pruning is disabled or held by a rescan or
+// address discovery.  Errors are logged and are not fatal.
+func (w *Wallet) pruneCFilters() {
+	if w.cfilterPruneDepth == 0 {
+		return
+	}
+
+	w.cfilterPruneMu.Lock()
+	defer w.cfilterPruneMu.Unlock()
+	if w.cfilterPruneHolds > 0 {
+		return
+	}
+
+	var n int
+	var height int32
+	err := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		if w.TxStore.IsMissingMainChainCFilters(dbtx) {
+			return nil
+		}
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		_, tipHeight := w.TxStore.MainChainTip(txmgrNs)
+		height = tipHeight - w.cfilterPruneDepth
+		if height < 1 {
+			return nil
+		}
+		var err error
+		n, err = w.TxStore.PruneCFilters(dbtx, height)
+		return err
+	})
+	if err != nil {
+		log.Errorf("Failed to prune cfilters: %v", err)
+		return
+	}
+	if n > 0 {
+		log.Debugf("Pruned %d cfilters below block height %d", n, height)
+	}
+}
+
+// holdCFilters prevents pruning of committed filters until the returned
+// function is called, and fetches any pruned filters of main chain blocks at
+// and above height from the peer.  The filters are pruned again after the hold
+// is released and the main chain is next extended.
+func (w *Wallet) holdCFilters(ctx context.Context, p Peer, height int32) (release func(), err error) {
+	w.cfilterPruneMu.Lock()
+	w.cfilterPruneHolds++
+	w.cfilterPruneMu.Unlock()
+	release = func() {
+		w.cfilterPruneMu.Lock()
+		w.cfilterPruneHolds--
+		w.cfilterPruneMu.Unlock()
+	}
+
+	err = w.fetchPrunedCFilters(ctx, p, height)
+	if err != nil {
+		release()
+		return nil, err
+	}
+	return release, nil
+}
+
+// fetchPrunedCFilters fetches pruned committed filters of main chain blocks at
+// and above height.  Filters are fetched in descending ranges ending below the
+// pruned height, which is lowered as each range is recorded.
+func (w *Wallet) fetchPrunedCFilters(ctx context.Context, p Peer, height int32) error {
+	if height < 1 {
+		height = 1
+	}
+
+	const span = 2000
+	storage := make([]chainhash.Hash, span)
+	storagePtrs := make([]*chainhash.Hash, span)
+	for i := range storage {
+		storagePtrs[i] = &storage[i]
+	}
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		var begin, end int32 // [begin, end)
+		var get []*chainhash.Hash
+		err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+			end = w.TxStore.PrunedCFiltersHeight(dbtx)
+			if height >= end {
+				return nil
+			}
+			begin = end - span
+			if begin < height {
+				begin = height
+			}
+			ns := dbtx.ReadBucket(wtxmgrNamespaceKey)
+			hash, err := w.TxStore.GetMainChainBlockHashForHeight(ns, begin)
+			if err != nil {
+				return err
+			}
+			hashes, err := w.TxStore.GetMainChainBlockHashes(ns, &hash, true,
+				storage[:end-begin])
+			if err != nil {
+				return err
+			}
+			if len(hashes) != int(end-begin) {
+				const op errors.Op = "udb.GetMainChainBlockHashes"
+				return errors.E(op, errors.Bug, "unexpected result count")
+			}
+			get = storagePtrs[:len(hashes)]
+			if get[0] != &hashes[0] {
+				const op errors.Op = "udb.GetMainChainBlockHashes"
+				return errors.E(op, errors.Bug, "unexpected slice reallocation")
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if get == nil {
+			return nil
+		}
+
+		filters, err := p.GetCFilters(ctx, get)
+		if err != nil {
+			return err
+		}
+		err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+			return w.TxStore.InsertPrunedCFilters(dbtx, get, filters)
+		})
+		if err != nil {
+			return err
+		}
+		log.Infof("Fetched pruned cfilters for blocks %v-%v", begin, end-1)
+	}
+}
+
+// PrunedCFiltersHeight returns the height below which the committed filters of
+// main chain blocks have been pruned, or zero if no filters have been pruned.
+func (w *Wallet) PrunedCFiltersHeight() (int32, error) {
+	const op errors.Op = "wallet.PrunedCFiltersHeight"
+	var height int32
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		height = w.TxStore.PrunedCFiltersHeight(dbtx)
+		return nil
+	})
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	return height, nil
+}
@@ -203,6 +203,8 @@ func (w *Wallet) ChainSwitch(forest *SidechainForest, chain []*BlockNode, releva
 	forest.PruneTree(chain[0].Hash)
 	forest.Prune(int32(chain[len(chain)-1].Header.Height), w.chainParams)
 
+	w.pruneCFilters()
+
 	w.NtfnServer.notifyMainChainTipChanged(chainTipChanges)
 	w.NtfnServer.sendAttachedBlockNotification()
 
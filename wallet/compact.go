@@ -0,0 +1,168 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"io"
+	"os"
+	"runtime"
+	"sync"
+
+	"github.com/fonero-project/fnowallet/errors"
+	"github.com/fonero-project/fnowallet/wallet/udb"
+	"github.com/fonero-project/fnowallet/wallet/walletdb"
+)
+
+// swapDB is a database which may be replaced by a compacted copy of itself
+// while it remains open.  Read-write transactions hold swapMu until they end, so
+// no modification is missing from the copy, while read transactions begun
+// before a swap continue to read the replaced database until they end.
+type swapDB struct {
+	swapMu sync.Mutex   // Held by read-write transactions and swaps
+	mu     sync.RWMutex // Protects db
+	db     walletdb.DB
+}
+
+type swapTx struct {
+	walletdb.ReadWriteTx
+	once   sync.Once
+	unlock func()
+}
+
+func (tx *swapTx) Commit() error {
+	err := tx.ReadWriteTx.Commit()
+	tx.once.Do(tx.unlock)
+	return err
+}
+
+func (tx *swapTx) Rollback() error {
+	err := tx.ReadWriteTx.Rollback()
+	tx.once.Do(tx.unlock)
+	return err
+}
+
+func (s *swapDB) current() walletdb.DB {
+	s.mu.RLock()
+	db := s.db
+	s.mu.RUnlock()
+	return db
+}
+
+func (s *swapDB) BeginReadTx() (walletdb.ReadTx, error) {
+	return s.current().BeginReadTx()
+}
+
+func (s *swapDB) BeginReadWriteTx() (walletdb.ReadWriteTx, error) {
+	s.swapMu.Lock()
+	tx, err := s.current().BeginReadWriteTx()
+	if err != nil {
+		s.swapMu.Unlock()
+		return nil, err
+	}
+	return &swapTx{ReadWriteTx: tx, unlock: s.swapMu.Unlock}, nil
+}
+
+func (s *swapDB) Copy(w io.Writer) error {
+	return s.current().Copy(w)
+}
+
+func (s *swapDB) Close() error {
+	s.swapMu.Lock()
+	defer s.swapMu.Unlock()
+	return s.current().Close()
+}
+
+// replaceOpenFileSupported describes whether the operating system allows
+// renaming a file over another file which remains open.
+var replaceOpenFileSupported = runtime.GOOS != "windows"
+
+// CompactDB compacts a database opened by OpenDB or CreateDB, which must store
+// the database in the file at path using driver.  The database is copied into
+// a new file, which then replaces the database file and the open database.
+// Database updates wait for the copy to complete, while reads of the database
+// are unaffected.  The file of the copy is created beside the database file and
+// is removed if compaction fails.
+//
+// Windows does not allow replacing a file which remains open, so compaction
+// errors with errors.Unsupported on Windows, where the database must be
+// compacted while it is not in use.
+func CompactDB(db DB, driver, path string) error {
+	const op errors.Op = "wallet.CompactDB"
+
+	if !replaceOpenFileSupported {
+		return errors.E(op, errors.Unsupported, "an open database can not "+
+			"be compacted on "+runtime.GOOS+"; compact the database while "+
+			"the wallet is not running")
+	}
+
+	s, ok := db.internal().(*swapDB)
+	if !ok {
+		return errors.E(op, errors.Invalid, "database was not opened for compaction")
+	}
+
+	s.swapMu.Lock()
+	defer s.swapMu.Unlock()
+
+	old := s.db
+	compactPath := path + ".compact"
+	compacted, err := copyDB(old, driver, compactPath)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	err = os.Rename(compactPath, path)
+	if err != nil {
+		compacted.Close()
+		os.Remove(compactPath)
+		return errors.E(op, errors.IO, err)
+	}
+
+	s.mu.Lock()
+	s.db = compacted
+	s.mu.Unlock()
+
+	// Closing the replaced database waits for its remaining read
+	// transactions to end.
+	err = old.Close()
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// copyDB copies db into a new database created by driver at path.  The new
+// database is removed if the copy fails.
+func copyDB(db walletdb.DB, driver, path string) (walletdb.DB, error) {
+	// Remove any copy left behind by an interrupted compaction.
+	err := os.Remove(path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, errors.E(errors.IO, err)
+	}
+	compacted, err := walletdb.Create(driver, path)
+	if err != nil {
+		return nil, err
+	}
+	err = walletdb.CopyDB(compacted, db)
+	if err != nil {
+		compacted.Close()
+		os.Remove(path)
+		return nil, err
+	}
+	return compacted, nil
+}
+
+// DatabaseSizes returns the sizes of the wallet database contents by category.
+func (w *Wallet) DatabaseSizes() ([]udb.BucketSize, error) {
+	const op errors.Op = "wallet.DatabaseSizes"
+	var sizes []udb.BucketSize
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		sizes, err = udb.DatabaseSizes(dbtx)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return sizes, nil
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	_ "github.com/fonero-project/fnowallet/wallet/drivers/bdb"
+	"github.com/fonero-project/fnowallet/wallet/walletdb"
+)
+
+func TestCompactDB(t *testing.T) {
+	if !replaceOpenFileSupported {
+		t.Skip("open databases can not be compacted on " + runtime.GOOS)
+	}
+	dir, err := ioutil.TempDir("", "fnowallet.compacttest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "wallet.db")
+
+	db, err := CreateDB("bdb", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	bucketKey, key := []byte("bucket"), []byte("key")
+	value := bytes.Repeat([]byte{1}, 1<<20)
+	err = walletdb.Update(db.internal(), func(tx walletdb.ReadWriteTx) error {
+		b, err := tx.CreateTopLevelBucket(bucketKey)
+		if err != nil {
+			return err
+		}
+		for i := 0; i < 16; i++ {
+			err := b.Put(append(key, byte(i)), value)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = walletdb.Update(db.internal(), func(tx walletdb.ReadWriteTx) error {
+		b := tx.ReadWriteBucket(bucketKey)
+		for i := 1; i < 16; i++ {
+			err := b.Delete(append(key, byte(i)))
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	fi, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sizeBefore := fi.Size()
+
+	// A read transaction begun before compaction continues to read the
+	// replaced database.
+	tx, err := db.internal().BeginReadTx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	done := make(chan error)
+	go func() {
+		done <- CompactDB(db, "bdb", path)
+	}()
+	if !bytes.Equal(tx.ReadBucket(bucketKey).Get(append(key, 0)), value) {
+		t.Fatalf("read transaction lost value during compaction")
+	}
+	tx.Rollback()
+	if err := <-done; err != nil {
+		t.Fatalf("CompactDB: unexpected error: %v", err)
+	}
+
+	fi, err = os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fi.Size() >= sizeBefore {
+		t.Errorf("compacted database size %d is not less than %d", fi.Size(), sizeBefore)
+	}
+	if _, err := os.Stat(path + ".compact"); !os.IsNotExist(err) {
+		t.Errorf("copy of database was not renamed: %v", err)
+	}
+
+	// The open database must be the compacted database and remain writable.
+	err = walletdb.Update(db.internal(), func(tx walletdb.ReadWriteTx) error {
+		b := tx.ReadWriteBucket(bucketKey)
+		if !bytes.Equal(b.Get(append(key, 0)), value) {
+			t.Errorf("compacted database is missing value")
+		}
+		return b.Put(append(key, 1), value)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+	db, err = OpenDB("bdb", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	err = walletdb.View(db.internal(), func(tx walletdb.ReadTx) error {
+		if !bytes.Equal(tx.ReadBucket(bucketKey).Get(append(key, 1)), value) {
+			t.Errorf("value written after compaction is missing")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	unswappable := opaqueDB{db.internal().(*swapDB).db}
+	if err := CompactDB(unswappable, "bdb", path); err == nil {
+		t.Errorf("CompactDB: unexpected success with database not opened by OpenDB")
+	}
+}
@@ -165,11 +165,12 @@ func (w *Wallet) NewUnsignedTransaction(outputs []*wire.TxOut, relayFeePerKb fno
 type secretSource struct {
 	*udb.Manager
 	addrmgrNs walletdb.ReadBucket
+	use       udb.KeyUse
 	doneFuncs []func()
 }
 
 func (s *secretSource) GetKey(addr fnoutil.Address) (chainec.PrivateKey, bool, error) {
-	privKey, done, err := s.Manager.PrivateKey(s.addrmgrNs, addr)
+	privKey, done, err := s.Manager.PrivateKeyForUse(s.addrmgrNs, addr, s.use)
 	if err != nil {
 		return nil, false, err
 	}
@@ -289,22 +290,22 @@ func (w *Wallet) txToOutputs(op errors.Op, outputs []*wire.TxOut, account uint32
 	}
 
 	return w.txToOutputsInternal(op, outputs, account, minconf, n,
-		randomizeChangeIdx, w.RelayFee())
+		randomizeChangeIdx, w.RelayFee(), udb.KeyUseTransaction)
 }
 
 // txToOutputsInternal creates a signed transaction which includes each output
 // from outputs.  Previous outputs to reedeem are chosen from the passed
 // account's UTXO set and minconf policy. An additional output may be added to
 // return change to the wallet.  An appropriate fee is included based on the
-// wallet's current relay fee.  The wallet must be unlocked to create the
-// transaction.  The address pool passed must be locked and engaged in an
+// wallet's current relay fee.  The wallet must be unlocked for the private key
+// use to create the transaction.  The address pool passed must be locked and engaged in an
 // address pool batch call.
 //
 // Fonero: This func also sends the transaction, and if successful, inserts it
 // into the database, rather than delegating this work to the caller as
 // btcwallet does.
 func (w *Wallet) txToOutputsInternal(op errors.Op, outputs []*wire.TxOut, account uint32, minconf int32,
-	n NetworkBackend, randomizeChangeIdx bool, txFee fnoutil.Amount) (*txauthor.AuthoredTx, error) {
+	n NetworkBackend, randomizeChangeIdx bool, txFee fnoutil.Amount, use udb.KeyUse) (*txauthor.AuthoredTx, error) {
 
 	var atx *txauthor.AuthoredTx
 	var changeSourceUpdates []func(walletdb.ReadWriteTx) error
@@ -336,7 +337,7 @@ func (w *Wallet) txToOutputsInternal(op errors.Op, outputs []*wire.TxOut, accoun
 		}
 
 		// Sign the transaction
-		secrets := &secretSource{Manager: w.Manager, addrmgrNs: addrmgrNs}
+		secrets := &secretSource{Manager: w.Manager, addrmgrNs: addrmgrNs, use: use}
 		err = atx.AddAllInputScripts(secrets)
 		for _, done := range secrets.doneFuncs {
 			done()
@@ -366,8 +367,32 @@ func (w *Wallet) txToOutputsInternal(op errors.Op, outputs []*wire.TxOut, accoun
 		return nil, errors.E(op, err)
 	}
 
+	// Enforce the spend policy of the account.  Change addresses of held
+	// transactions are persisted so they are not returned again.
+	spend, err := w.reserveSpend(atx.Tx, false)
+	if err != nil {
+		txHash := atx.Tx.TxHash()
+		if w.isPendingTransaction(&txHash) {
+			uerr := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+				for _, up := range changeSourceUpdates {
+					err := up(dbtx)
+					if err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+			if uerr != nil {
+				log.Errorf("Failed to persist change address of held "+
+					"transaction: %v", uerr)
+			}
+		}
+		return nil, errors.E(op, err)
+	}
+
 	rec, err := udb.NewTxRecordFromMsgTx(atx.Tx, time.Now())
 	if err != nil {
+		spend.cancel()
 		return nil, errors.E(op, err)
 	}
 
@@ -390,10 +415,12 @@ func (w *Wallet) txToOutputsInternal(op errors.Op, outputs []*wire.TxOut, accoun
 		return err
 	})
 	if err != nil {
+		spend.cancel()
 		return nil, errors.E(op, err)
 	}
 	err = n.PublishTransactions(context.TODO(), atx.Tx)
 	if err != nil {
+		spend.cancel()
 		return nil, errors.E(op, err)
 	}
 
@@ -423,16 +450,24 @@ func (w *Wallet) txToMultisig(op errors.Op, account uint32, amount fnoutil.Amoun
 		ctx      *CreatedTx
 		addr     fnoutil.Address
 		msScript []byte
+		held     *heldTxError
 	)
 	err := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
 		var err error
 		ctx, addr, msScript, err = w.txToMultisigInternal(op, dbtx,
 			account, amount, pubkeys, nRequired, minconf)
+		if h, ok := err.(*heldTxError); ok {
+			held = h
+			return nil
+		}
 		return err
 	})
 	if err != nil {
 		return nil, nil, nil, errors.E(op, err)
 	}
+	if held != nil {
+		return nil, nil, nil, errors.E(op, w.holdCommitted(held.tx))
+	}
 	return ctx, addr, msScript, nil
 }
 
@@ -547,7 +582,7 @@ func (w *Wallet) txToMultisigInternal(op errors.Op, dbtx walletdb.ReadWriteTx, a
 		msgtx.AddTxOut(wire.NewTxOut(int64(change), pkScript))
 	}
 
-	err = w.signP2PKHMsgTx(msgtx, forSigning, addrmgrNs)
+	err = w.signP2PKHMsgTx(msgtx, forSigning, addrmgrNs, udb.KeyUseTransaction)
 	if err != nil {
 		return txToMultisigError(errors.E(op, err))
 	}
@@ -557,6 +592,14 @@ func (w *Wallet) txToMultisigInternal(op errors.Op, dbtx walletdb.ReadWriteTx, a
 		return txToMultisigError(errors.E(op, err))
 	}
 
+	_, err = w.reserveSpendTx(dbtx, msgtx, false)
+	if _, ok := err.(*heldTxError); ok {
+		return txToMultisigError(err)
+	}
+	if err != nil {
+		return txToMultisigError(errors.E(op, err))
+	}
+
 	err = n.PublishTransactions(context.TODO(), msgtx)
 	if err != nil {
 		return txToMultisigError(errors.E(op, err))
@@ -618,14 +661,22 @@ func creditScripts(credits []udb.Credit) [][]byte {
 // address. For use when it becomes dusty.
 func (w *Wallet) compressWallet(op errors.Op, maxNumIns int, account uint32, changeAddr fnoutil.Address) (*chainhash.Hash, error) {
 	var hash *chainhash.Hash
+	var held *heldTxError
 	err := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
 		var err error
 		hash, err = w.compressWalletInternal(op, dbtx, maxNumIns, account, changeAddr)
+		if h, ok := err.(*heldTxError); ok {
+			held = h
+			return nil
+		}
 		return err
 	})
 	if err != nil {
 		return nil, errors.E(op, err)
 	}
+	if held != nil {
+		return nil, errors.E(op, w.holdCommitted(held.tx))
+	}
 	return hash, nil
 }
 
@@ -700,7 +751,7 @@ func (w *Wallet) compressWalletInternal(op errors.Op, dbtx walletdb.ReadWriteTx,
 
 	msgtx.TxOut[0].Value = int64(totalAdded - feeEst)
 
-	err = w.signP2PKHMsgTx(msgtx, forSigning, addrmgrNs)
+	err = w.signP2PKHMsgTx(msgtx, forSigning, addrmgrNs, udb.KeyUseTransaction)
 	if err != nil {
 		return nil, errors.E(op, err)
 	}
@@ -714,6 +765,14 @@ func (w *Wallet) compressWalletInternal(op errors.Op, dbtx walletdb.ReadWriteTx,
 		return nil, errors.E(op, err)
 	}
 
+	_, err = w.reserveSpendTx(dbtx, msgtx, false)
+	if _, ok := err.(*heldTxError); ok {
+		return nil, err
+	}
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
 	err = n.PublishTransactions(context.TODO(), msgtx)
 	if err != nil {
 		return nil, errors.E(op, err)
@@ -852,6 +911,48 @@ func makeTicket(params *chaincfg.Params, inputPool *extendedOutPoint, input *ext
 	return mtx, nil
 }
 
+// ticketSize returns the estimated serialize size of a ticket with a stake
+// submission pkScript of submissionScriptSize bytes.
+func ticketSize(submissionScriptSize int, pool bool) int {
+	if !pool {
+		// A solo ticket has:
+		//   - a single input redeeming a P2PKH for the worst case size
+		//   - a P2PKH or P2SH stake submission output
+		//   - a ticket commitment output
+		//   - an OP_SSTXCHANGE tagged P2PKH or P2SH change output
+		//
+		//   NB: The wallet currently only supports P2PKH change addresses.
+		//   The network supports both P2PKH and P2SH change addresses however.
+		inSizes := []int{txsizes.RedeemP2PKHSigScriptSize}
+		outSizes := []int{submissionScriptSize,
+			txsizes.TicketCommitmentScriptSize, txsizes.P2PKHPkScriptSize + 1}
+		return txsizes.EstimateSerializeSizeFromScriptSizes(inSizes,
+			outSizes, 0)
+	}
+
+	// A pool ticket has:
+	//   - two inputs redeeming a P2PKH for the worst case size
+	//   - a P2PKH or P2SH stake submission output
+	//   - two ticket commitment outputs
+	//   - two OP_SSTXCHANGE tagged P2PKH or P2SH change outputs
+	//
+	//   NB: The wallet currently only supports P2PKH change addresses.
+	//   The network supports both P2PKH and P2SH change addresses however.
+	inSizes := []int{txsizes.RedeemP2PKHSigScriptSize,
+		txsizes.RedeemP2PKHSigScriptSize}
+	outSizes := []int{submissionScriptSize,
+		txsizes.TicketCommitmentScriptSize, txsizes.TicketCommitmentScriptSize,
+		txsizes.P2PKHPkScriptSize + 1, txsizes.P2PKHPkScriptSize + 1}
+	return txsizes.EstimateSerializeSizeFromScriptSizes(inSizes,
+		outSizes, 0)
+}
+
+// EstimatedTicketSize returns the estimated serialize size of a solo or stake
+// pool ticket purchased by the wallet with a P2PKH ticket address.
+func EstimatedTicketSize(pool bool) int {
+	return ticketSize(txsizes.P2PKHPkScriptSize+1, pool)
+}
+
 // purchaseTickets indicates to the wallet that a ticket should be purchased
 // using all currently available funds.  The ticket address parameter in the
 // request can be nil in which case the ticket address associated with the
@@ -968,37 +1069,7 @@ func (w *Wallet) purchaseTickets(op errors.Op, req purchaseTicketRequest) ([]*ch
 		ticketFeeIncrement = w.TicketFeeIncrement()
 	}
 
-	if poolAddress == nil {
-		// A solo ticket has:
-		//   - a single input redeeming a P2PKH for the worst case size
-		//   - a P2PKH or P2SH stake submission output
-		//   - a ticket commitment output
-		//   - an OP_SSTXCHANGE tagged P2PKH or P2SH change output
-		//
-		//   NB: The wallet currently only supports P2PKH change addresses.
-		//   The network supports both P2PKH and P2SH change addresses however.
-		inSizes := []int{txsizes.RedeemP2PKHSigScriptSize}
-		outSizes := []int{stakeSubmissionPkScriptSize,
-			txsizes.TicketCommitmentScriptSize, txsizes.P2PKHPkScriptSize + 1}
-		estSize = txsizes.EstimateSerializeSizeFromScriptSizes(inSizes,
-			outSizes, 0)
-	} else {
-		// A pool ticket has:
-		//   - two inputs redeeming a P2PKH for the worst case size
-		//   - a P2PKH or P2SH stake submission output
-		//   - two ticket commitment outputs
-		//   - two OP_SSTXCHANGE tagged P2PKH or P2SH change outputs
-		//
-		//   NB: The wallet currently only supports P2PKH change addresses.
-		//   The network supports both P2PKH and P2SH change addresses however.
-		inSizes := []int{txsizes.RedeemP2PKHSigScriptSize,
-			txsizes.RedeemP2PKHSigScriptSize}
-		outSizes := []int{stakeSubmissionPkScriptSize,
-			txsizes.TicketCommitmentScriptSize, txsizes.TicketCommitmentScriptSize,
-			txsizes.P2PKHPkScriptSize + 1, txsizes.P2PKHPkScriptSize + 1}
-		estSize = txsizes.EstimateSerializeSizeFromScriptSizes(inSizes,
-			outSizes, 0)
-	}
+	estSize = ticketSize(stakeSubmissionPkScriptSize, poolAddress != nil)
 
 	ticketFee = txrules.FeeForSerializeSize(ticketFeeIncrement, estSize)
 	neededPerTicket = ticketFee + ticketPrice
@@ -1072,7 +1143,7 @@ func (w *Wallet) purchaseTickets(op errors.Op, req purchaseTicketRequest) ([]*ch
 		txFeeIncrement = w.RelayFee()
 	}
 	splitTx, err := w.txToOutputsInternal(op, splitOuts, account, req.minConf,
-		n, false, txFeeIncrement)
+		n, false, txFeeIncrement, udb.KeyUseTicket)
 	if err != nil {
 		return nil, err
 	}
@@ -1201,7 +1272,7 @@ func (w *Wallet) purchaseTickets(op errors.Op, req purchaseTicketRequest) ([]*ch
 
 		err = walletdb.View(w.db, func(tx walletdb.ReadTx) error {
 			ns := tx.ReadBucket(waddrmgrNamespaceKey)
-			return w.signP2PKHMsgTx(ticket, forSigning, ns)
+			return w.signP2PKHMsgTx(ticket, forSigning, ns, udb.KeyUseTicket)
 		})
 		if err != nil {
 			return ticketHashes, errors.E(op, err)
@@ -1403,8 +1474,9 @@ func (w *Wallet) findEligibleOutputsAmount(dbtx walletdb.ReadTx, account uint32,
 
 // signP2PKHMsgTx sets the SignatureScript for every item in msgtx.TxIn.
 // It must be called every time a msgtx is changed.
-// Only P2PKH outputs are supported at this point.
-func (w *Wallet) signP2PKHMsgTx(msgtx *wire.MsgTx, prevOutputs []udb.Credit, addrmgrNs walletdb.ReadBucket) error {
+// Only P2PKH outputs are supported at this point.  The private keys must be
+// permitted for the use by an unlock of the wallet.
+func (w *Wallet) signP2PKHMsgTx(msgtx *wire.MsgTx, prevOutputs []udb.Credit, addrmgrNs walletdb.ReadBucket, use udb.KeyUse) error {
 	if len(prevOutputs) != len(msgtx.TxIn) {
 		return errors.Errorf(
 			"Number of prevOutputs (%d) does not match number of tx inputs (%d)",
@@ -1423,7 +1495,7 @@ func (w *Wallet) signP2PKHMsgTx(msgtx *wire.MsgTx, prevOutputs []udb.Credit, add
 			return errors.E(errors.Bug, "previous output address is not P2PKH")
 		}
 
-		privKey, done, err := w.Manager.PrivateKey(addrmgrNs, apkh)
+		privKey, done, err := w.Manager.PrivateKeyForUse(addrmgrNs, apkh, use)
 		if err != nil {
 			return err
 		}
@@ -1455,7 +1527,7 @@ func (w *Wallet) signVoteOrRevocation(addrmgrNs walletdb.ReadBucket, ticketPurch
 	// Prepare functions to look up private key and script secrets so signing
 	// can be performed.
 	var getKey txscript.KeyClosure = func(addr fnoutil.Address) (chainec.PrivateKey, bool, error) {
-		key, done, err := w.Manager.PrivateKey(addrmgrNs, addr)
+		key, done, err := w.Manager.PrivateKeyForUse(addrmgrNs, addr, udb.KeyUseVote)
 		if err != nil {
 			return nil, false, err
 		}
@@ -35,7 +35,7 @@ func OpenDB(driver string, args ...interface{}) (DB, error) {
 	if err != nil {
 		return nil, errors.E(op, err)
 	}
-	return opaqueDB{db}, nil
+	return opaqueDB{&swapDB{db: db}}, nil
 }
 
 // CreateDB creates a new database with some specific driver implementation.
@@ -47,5 +47,17 @@ func CreateDB(driver string, args ...interface{}) (DB, error) {
 	if err != nil {
 		return nil, errors.E(op, err)
 	}
-	return opaqueDB{db}, nil
+	return opaqueDB{&swapDB{db: db}}, nil
+}
+
+// DBExists returns whether a database exists for some specific driver
+// implementation.  Args specify the arguments to open the database and may
+// differ based on driver.
+func DBExists(driver string, args ...interface{}) (bool, error) {
+	const op errors.Op = "wallet.DBExists"
+	exists, err := walletdb.Exists(driver, args...)
+	if err != nil {
+		return false, errors.E(op, err)
+	}
+	return exists, nil
 }
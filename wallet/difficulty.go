@@ -499,6 +499,252 @@ func (w *Wallet) NextStakeDifficultyAfterHeader(h *wire.BlockHeader) (fnoutil.Am
 	return sdiff, nil
 }
 
+// estimateNextStakeDifficultyV2 estimates the stake difficulty of the next
+// retarget interval after curHeader assuming newTickets additional tickets are
+// purchased in the remaining blocks of the current interval.  The estimated
+// size of the ticket pool, including immature tickets, at the retarget height
+// is also returned.
+//
+// The number of new tickets is limited to the maximum number of tickets that
+// could be purchased in the remainder of the interval.
+func (w *Wallet) estimateNextStakeDifficultyV2(dbtx walletdb.ReadTx, curHeader *wire.BlockHeader, newTickets int64) (nextDiff, nextPoolSizeAll int64, err error) {
+	// Calculate the next retarget interval height.
+	curHeight := int64(curHeader.Height)
+	ticketMaturity := int64(w.chainParams.TicketMaturity)
+	intervalSize := w.chainParams.StakeDiffWindowSize
+	blocksUntilRetarget := intervalSize - curHeight%intervalSize
+	nextRetargetHeight := curHeight + blocksUntilRetarget
+
+	// Limit the number of new tickets to the maximum possible number of
+	// tickets that could be sold in the remainder of the interval.
+	maxTicketsPerBlock := int64(w.chainParams.MaxFreshStakePerBlock)
+	maxRemainingTickets := (blocksUntilRetarget - 1) * maxTicketsPerBlock
+	if newTickets > maxRemainingTickets {
+		newTickets = maxRemainingTickets
+	}
+
+	// Stake difficulty before any tickets could possibly be purchased is
+	// the minimum value.
+	stakeDiffStartHeight := int64(w.chainParams.CoinbaseMaturity) + 1
+	if nextRetargetHeight < stakeDiffStartHeight {
+		return w.chainParams.MinimumStakeDiff, 0, nil
+	}
+
+	// Get the pool size and number of tickets that were immature at the
+	// previous retarget interval.
+	//
+	// NOTE: Since the stake difficulty must be calculated based on existing
+	// blocks, it is always calculated for the block after a given block, so
+	// the information for the previous retarget interval must be retrieved
+	// relative to the block just before it to coincide with how it was
+	// originally calculated.
+	var prevPoolSize int64
+	prevRetargetHeight := nextRetargetHeight - intervalSize - 1
+	prevRetargetHeader, err := w.ancestorHeaderAtHeight(dbtx, curHeader, nil, int32(prevRetargetHeight))
+	if err != nil {
+		return 0, 0, err
+	}
+	if prevRetargetHeader != nil {
+		prevPoolSize = int64(prevRetargetHeader.PoolSize)
+	}
+	prevImmatureTickets, err := w.sumPurchasedTickets(dbtx, prevRetargetHeader, nil, ticketMaturity)
+	if err != nil {
+		return 0, 0, err
+	}
+	prevPoolSizeAll := prevPoolSize + prevImmatureTickets
+
+	// Calculate the number of tickets that will still be immature at the
+	// next retarget based on the known (non-estimated) data.
+	//
+	// Note that when the interval size is larger than the ticket maturity,
+	// the current height might be before the maturity floor (the point
+	// after which the remaining tickets will remain immature).  There are
+	// therefore no possible remaining immature tickets from the blocks that
+	// are not being estimated in that case.
+	var remainingImmatureTickets int64
+	nextMaturityFloor := nextRetargetHeight - ticketMaturity - 1
+	if curHeight > nextMaturityFloor {
+		remainingImmatureTickets, err = w.sumPurchasedTickets(dbtx, curHeader, nil, curHeight-nextMaturityFloor)
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+
+	// Add the number of tickets that will still be immature at the next
+	// retarget based on the estimated data.
+	maxImmatureTickets := ticketMaturity * maxTicketsPerBlock
+	if newTickets > maxImmatureTickets {
+		remainingImmatureTickets += maxImmatureTickets
+	} else {
+		remainingImmatureTickets += newTickets
+	}
+
+	// Calculate the number of tickets that will mature in the remainder of
+	// the interval based on the known (non-estimated) data.
+	//
+	// NOTE: The pool size in the block headers does not include the tickets
+	// maturing at the height in which they mature since they are not
+	// eligible for selection until the next block, so exclude them by
+	// starting one block before the next maturity floor.
+	finalMaturingHeight := nextMaturityFloor - 1
+	if finalMaturingHeight > curHeight {
+		finalMaturingHeight = curHeight
+	}
+	finalMaturingHeader, err := w.ancestorHeaderAtHeight(dbtx, curHeader, nil, int32(finalMaturingHeight))
+	if err != nil {
+		return 0, 0, err
+	}
+	firstMaturingHeight := curHeight - ticketMaturity
+	maturingTickets, err := w.sumPurchasedTickets(dbtx, finalMaturingHeader, nil, finalMaturingHeight-firstMaturingHeight+1)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// Add the number of tickets that will mature based on the estimated data.
+	//
+	// Note that when the ticket maturity is greater than or equal to the
+	// interval size, the tickets will not mature in the remaining interval
+	// and therefore are not included.
+	if newTickets > maxImmatureTickets {
+		maturingTickets += newTickets - maxImmatureTickets
+	}
+
+	// Calculate the number of votes that will occur during the remainder of
+	// the interval.
+	stakeValidationHeight := w.chainParams.StakeValidationHeight
+	var pendingVotes int64
+	if nextRetargetHeight > stakeValidationHeight {
+		votingBlocks := blocksUntilRetarget - 1
+		if curHeight < stakeValidationHeight {
+			votingBlocks = nextRetargetHeight - stakeValidationHeight
+		}
+		votesPerBlock := int64(w.chainParams.TicketsPerBlock)
+		pendingVotes = votingBlocks * votesPerBlock
+	}
+
+	// Calculate what the pool size would be as of the next interval.
+	curPoolSize := int64(curHeader.PoolSize)
+	estimatedPoolSize := curPoolSize + maturingTickets - pendingVotes
+	nextPoolSizeAll = estimatedPoolSize + remainingImmatureTickets
+
+	// Return the existing ticket price for the first few intervals to avoid
+	// division by zero and encourage initial pool population.
+	curDiff := curHeader.SBits
+	if prevPoolSizeAll == 0 {
+		return curDiff, nextPoolSizeAll, nil
+	}
+
+	// Calculate and return the final estimated difficulty.
+	nextDiff = calcNextStakeDiffV2(w.chainParams, nextRetargetHeight, curDiff,
+		prevPoolSizeAll, nextPoolSizeAll)
+	return nextDiff, nextPoolSizeAll, nil
+}
+
+// StakeDifficultyForecast describes the projected range of the ticket price
+// of a stake difficulty window.  Min assumes no further tickets are purchased
+// before the window begins, Max assumes the maximum number of tickets are
+// purchased in every remaining block, and Expected assumes tickets continue to
+// be purchased at the rate of the current window.
+type StakeDifficultyForecast struct {
+	Height   int32 // Height of the first block of the window
+	Min      fnoutil.Amount
+	Expected fnoutil.Amount
+	Max      fnoutil.Amount
+}
+
+// TicketPriceForecast describes the current ticket price and the projected
+// ticket prices set at the end of the current and next stake difficulty
+// windows.
+type TicketPriceForecast struct {
+	Height          int32 // Main chain tip height
+	CurrentPrice    fnoutil.Amount
+	NextWindow      StakeDifficultyForecast
+	FollowingWindow StakeDifficultyForecast
+}
+
+// TicketPriceForecast projects the ticket prices of the next two stake
+// difficulty windows after the main chain tip.  The projection for the
+// following window applies the same purchase assumptions to every block of the
+// next window and assumes every block includes all votes.  This function only
+// suceeds when DCP0001 is known to be active.
+func (w *Wallet) TicketPriceForecast() (*TicketPriceForecast, error) {
+	const op errors.Op = "wallet.TicketPriceForecast"
+
+	params := w.chainParams
+	intervalSize := params.StakeDiffWindowSize
+	maxTicketsPerBlock := int64(params.MaxFreshStakePerBlock)
+	votesPerWindow := intervalSize * int64(params.TicketsPerBlock)
+
+	var f TicketPriceForecast
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		tipHash, tipHeight := w.TxStore.MainChainTip(ns)
+		if !deployments.DCP0001.Active(tipHeight, params) {
+			return errors.E(errors.Deployment, "DCP0001 is not known to be active")
+		}
+		tipHeader, err := w.TxStore.GetBlockHeader(dbtx, &tipHash)
+		if err != nil {
+			return err
+		}
+
+		curHeight := int64(tipHeight)
+		blocksUntilRetarget := intervalSize - curHeight%intervalSize
+		nextRetargetHeight := curHeight + blocksUntilRetarget
+
+		// Determine the rate tickets have been purchased at in the current
+		// window.
+		windowBlocks := curHeight - (nextRetargetHeight - intervalSize) + 1
+		purchased, err := w.sumPurchasedTickets(dbtx, tipHeader, nil, windowBlocks)
+		if err != nil {
+			return err
+		}
+		rate := float64(purchased) / float64(windowBlocks)
+
+		// estimate projects the ticket prices of both windows when
+		// newTickets are purchased in the remainder of the current window
+		// and nextTickets are purchased in the next window.
+		estimate := func(newTickets, nextTickets int64) (fnoutil.Amount, fnoutil.Amount, error) {
+			nextDiff, poolSizeAll, err := w.estimateNextStakeDifficultyV2(dbtx,
+				tipHeader, newTickets)
+			if err != nil {
+				return 0, 0, err
+			}
+			followingDiff := nextDiff
+			followingPoolSizeAll := poolSizeAll + nextTickets - votesPerWindow
+			if poolSizeAll > 0 && followingPoolSizeAll > 0 {
+				followingDiff = calcNextStakeDiffV2(params,
+					nextRetargetHeight+intervalSize, nextDiff, poolSizeAll,
+					followingPoolSizeAll)
+			}
+			return fnoutil.Amount(nextDiff), fnoutil.Amount(followingDiff), nil
+		}
+
+		remainingBlocks := blocksUntilRetarget - 1
+		f.Height = tipHeight
+		f.CurrentPrice = fnoutil.Amount(tipHeader.SBits)
+		f.NextWindow.Height = int32(nextRetargetHeight)
+		f.FollowingWindow.Height = int32(nextRetargetHeight + intervalSize)
+		f.NextWindow.Min, f.FollowingWindow.Min, err = estimate(0, 0)
+		if err != nil {
+			return err
+		}
+		f.NextWindow.Expected, f.FollowingWindow.Expected, err = estimate(
+			int64(rate*float64(remainingBlocks)),
+			int64(rate*float64(intervalSize-1)))
+		if err != nil {
+			return err
+		}
+		f.NextWindow.Max, f.FollowingWindow.Max, err = estimate(
+			remainingBlocks*maxTicketsPerBlock,
+			(intervalSize-1)*maxTicketsPerBlock)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return &f, nil
+}
+
 // ValidateHeaderChainDifficulties validates the PoW and PoS difficulties of all
 // blocks in chain[idx:].  The parent of chain[0] must be recorded as wallet
 // main chain block.  If a consensus violation is caught, a subslice of chain
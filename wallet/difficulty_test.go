@@ -0,0 +1,215 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"testing"
+
+	"github.com/fonero-project/fnod/fnoutil"
+	"github.com/fonero-project/fnod/gcs"
+	"github.com/fonero-project/fnod/gcs/blockcf"
+	"github.com/fonero-project/fnod/wire"
+	"github.com/fonero-project/fnowallet/wallet/walletdb"
+)
+
+// stakeChain extends the wallet main chain with headers which purchase a
+// number of tickets in each block and include every vote.  Pool sizes follow
+// from the purchases and votes, and ticket prices are calculated by the wallet.
+type stakeChain struct {
+	t       *testing.T
+	w       *Wallet
+	headers []*wire.BlockHeader
+	votes   int64
+}
+
+func newStakeChain(t *testing.T, w *Wallet) *stakeChain {
+	genesis := w.chainParams.GenesisBlock.Header
+	return &stakeChain{t: t, w: w, headers: []*wire.BlockHeader{&genesis}}
+}
+
+func (c *stakeChain) tip() *wire.BlockHeader {
+	return c.headers[len(c.headers)-1]
+}
+
+// extend adds a block purchasing fresh tickets.  Tickets are only purchased
+// once the stake enabled height is reached.
+func (c *stakeChain) extend(fresh uint8) {
+	params := c.w.chainParams
+	prev := c.tip()
+	height := int64(prev.Height) + 1
+	if height < params.StakeEnabledHeight {
+		fresh = 0
+	}
+	sdiff, err := c.w.NextStakeDifficultyAfterHeader(prev)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+
+	// Tickets enter the pool the block after they mature.
+	var voters uint16
+	if height >= params.StakeValidationHeight {
+		voters = params.TicketsPerBlock
+		c.votes += int64(voters)
+	}
+	var live int64
+	for _, h := range c.headers {
+		if int64(h.Height) <= height-int64(params.TicketMaturity)-1 {
+			live += int64(h.FreshStake)
+		}
+	}
+	poolSize := live - c.votes
+	if poolSize < 0 {
+		c.t.Fatalf("negative pool size at height %d", height)
+	}
+
+	header := &wire.BlockHeader{
+		PrevBlock:  prev.BlockHash(),
+		VoteBits:   fnoutil.BlockValid,
+		Voters:     voters,
+		FreshStake: fresh,
+		PoolSize:   uint32(poolSize),
+		SBits:      int64(sdiff),
+		Height:     uint32(height),
+	}
+	err = walletdb.Update(c.w.db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+		f, err := gcs.FromBytes(0, blockcf.P, nil)
+		if err != nil {
+			return err
+		}
+		return c.w.TxStore.ExtendMainChain(ns, header, f)
+	})
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	c.headers = append(c.headers, header)
+}
+
+func TestEstimateNextStakeDifficultyV2(t *testing.T) {
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	params := w.chainParams
+	intervalSize := params.StakeDiffWindowSize
+	if int64(params.TicketMaturity) < intervalSize {
+		t.Skip("estimates assume purchases remain immature at the next retarget")
+	}
+
+	// Purchase a varying number of tickets in each block, always more than
+	// the number of votes.
+	c := newStakeChain(t, w)
+	fresh := func(height int64) uint8 {
+		return uint8(params.TicketsPerBlock) + 1 + uint8(height%5)
+	}
+	end := params.StakeValidationHeight + 4*intervalSize
+	for height := int64(1); height <= end; height++ {
+		c.extend(fresh(height))
+	}
+
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		for _, h := range c.headers[1:] {
+			curHeight := int64(h.Height)
+			nextRetarget := curHeight + intervalSize - curHeight%intervalSize
+			if nextRetarget > end {
+				break
+			}
+
+			// Estimating with the tickets that were purchased in the
+			// remainder of the interval must result in the actual
+			// ticket price.
+			var remaining int64
+			for height := curHeight + 1; height < nextRetarget; height++ {
+				remaining += int64(c.headers[height].FreshStake)
+			}
+			nextDiff, _, err := w.estimateNextStakeDifficultyV2(dbtx, h, remaining)
+			if err != nil {
+				return err
+			}
+			if want := c.headers[nextRetarget].SBits; nextDiff != want {
+				t.Errorf("height %d: estimated %v, expected %v", curHeight,
+					fnoutil.Amount(nextDiff), fnoutil.Amount(want))
+			}
+
+			// More tickets than can possibly be purchased are limited.
+			maxRemaining := (nextRetarget - curHeight - 1) * int64(params.MaxFreshStakePerBlock)
+			maxDiff, _, err := w.estimateNextStakeDifficultyV2(dbtx, h, maxRemaining)
+			if err != nil {
+				return err
+			}
+			overDiff, _, err := w.estimateNextStakeDifficultyV2(dbtx, h, maxRemaining+1000)
+			if err != nil {
+				return err
+			}
+			if overDiff != maxDiff {
+				t.Errorf("height %d: estimate with excess tickets %v, expected %v",
+					curHeight, fnoutil.Amount(overDiff), fnoutil.Amount(maxDiff))
+			}
+			if maxDiff < nextDiff {
+				t.Errorf("height %d: maximum estimate %v is less than %v",
+					curHeight, fnoutil.Amount(maxDiff), fnoutil.Amount(nextDiff))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTicketPriceForecast(t *testing.T) {
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	params := w.chainParams
+	intervalSize := params.StakeDiffWindowSize
+	if int64(params.TicketMaturity) < intervalSize {
+		t.Skip("estimates assume purchases remain immature at the next retarget")
+	}
+
+	// Purchase tickets at a constant rate, stopping partway through a
+	// window.
+	const rate = 10
+	c := newStakeChain(t, w)
+	tipHeight := params.StakeValidationHeight + 2*intervalSize + intervalSize/2
+	for height := int64(1); height <= tipHeight; height++ {
+		c.extend(rate)
+	}
+
+	f, err := w.TicketPriceForecast()
+	if err != nil {
+		t.Fatal(err)
+	}
+	nextRetarget := tipHeight + intervalSize - tipHeight%intervalSize
+	if f.Height != int32(tipHeight) {
+		t.Errorf("forecast height %d, expected %d", f.Height, tipHeight)
+	}
+	if want := fnoutil.Amount(c.tip().SBits); f.CurrentPrice != want {
+		t.Errorf("current price %v, expected %v", f.CurrentPrice, want)
+	}
+	if f.NextWindow.Height != int32(nextRetarget) {
+		t.Errorf("next window height %d, expected %d", f.NextWindow.Height, nextRetarget)
+	}
+	if f.FollowingWindow.Height != int32(nextRetarget+intervalSize) {
+		t.Errorf("following window height %d, expected %d",
+			f.FollowingWindow.Height, nextRetarget+intervalSize)
+	}
+	for _, window := range []*StakeDifficultyForecast{&f.NextWindow, &f.FollowingWindow} {
+		if window.Min > window.Expected || window.Expected > window.Max {
+			t.Errorf("window at height %d: forecast %v <= %v <= %v is out of order",
+				window.Height, window.Min, window.Expected, window.Max)
+		}
+	}
+
+	// Continuing to purchase at the same rate results in the expected
+	// price of the next window.
+	for height := tipHeight + 1; height <= nextRetarget; height++ {
+		c.extend(rate)
+	}
+	if got := fnoutil.Amount(c.tip().SBits); got != f.NextWindow.Expected {
+		t.Errorf("next window price %v, expected forecast %v", got, f.NextWindow.Expected)
+	}
+}
@@ -414,7 +414,7 @@ func (w *Wallet) filterBlocks(ctx context.Context, startBlock *chainhash.Hash, d
 	return matches, ctx.Err()
 }
 
-func (w *Wallet) findLastUsedAccount(ctx context.Context, p Peer, blockCache blockCommitmentCache, coinTypeXpriv *hd.ExtendedKey) (uint32, error) {
+func (w *Wallet) findLastUsedAccount(ctx context.Context, p Peer, startBlock *chainhash.Hash, blockCache blockCommitmentCache, coinTypeXpriv *hd.ExtendedKey) (uint32, error) {
 	var (
 		gapLimit     = uint32(w.gapLimit)
 		acctGapLimit = uint32(w.accountGapLimit)
@@ -471,7 +471,7 @@ func (w *Wallet) findLastUsedAccount(ctx context.Context, p Peer, blockCache blo
 			}
 		}
 
-		searchBlocks, err := w.filterBlocks(ctx, w.chainParams.GenesisHash, addrScripts)
+		searchBlocks, err := w.filterBlocks(ctx, startBlock, addrScripts)
 		if err != nil {
 			return 0, err
 		}
@@ -739,7 +739,8 @@ func clientFromPeer(p Peer) (*rpc.Client, bool) {
 // DiscoverActiveAddresses searches for future wallet address usage in all
 // blocks starting from startBlock.  If discoverAccts is true, used accounts
 // will be discovered as well.  This feature requires the wallet to be unlocked
-// in order to derive hardened account extended pubkeys.
+// in order to derive hardened account extended pubkeys.  Blocks preceding the
+// wallet birthday are not searched.
 //
 // If the wallet is currently on the legacy coin type and no address or account
 // usage is observed, the wallet will be upgraded to the SLIP0044 coin type and
@@ -767,6 +768,43 @@ func (w *Wallet) DiscoverActiveAddresses(ctx context.Context, p Peer, startBlock
 		return errors.E(op, err)
 	}
 
+	// Discovery matches against the committed filters of blocks from
+	// startBlock, and of all blocks when discovering accounts, so pruned
+	// filters of these blocks must be fetched again.  Blocks preceding the
+	// wallet birthday are not searched.
+	acctStartBlock := w.chainParams.GenesisHash
+	var startHeight, acctStartHeight int32
+	err = walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		header, err := w.TxStore.GetBlockHeader(dbtx, startBlock)
+		if err != nil {
+			return err
+		}
+		startHeight = int32(header.Height)
+		birthday, birthdayHeight, err := w.birthdayBlock(dbtx)
+		if err != nil {
+			return err
+		}
+		if birthday != nil {
+			acctStartBlock, acctStartHeight = birthday, birthdayHeight
+			if birthdayHeight > startHeight {
+				startBlock, startHeight = birthday, birthdayHeight
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	holdHeight := startHeight
+	if discoverAccts && acctStartHeight < holdHeight {
+		holdHeight = acctStartHeight
+	}
+	release, err := w.holdCFilters(ctx, p, holdHeight)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	defer release()
+
 	// Map block hashes to a set of output scripts from the block.  This map is
 	// queried to avoid fetching the same block multiple times, and blocks are
 	// reduced to a set of committed scripts as that is the only thing being
@@ -798,7 +836,7 @@ func (w *Wallet) DiscoverActiveAddresses(ctx context.Context, p Peer, startBlock
 			f := existsAddrIndexFinder{w, client}
 			lastUsed, err = f.findLastUsedAccount(coinTypePrivKey)
 		} else {
-			lastUsed, err = w.findLastUsedAccount(ctx, p, blockAddresses, coinTypePrivKey)
+			lastUsed, err = w.findLastUsedAccount(ctx, p, acctStartBlock, blockAddresses, coinTypePrivKey)
 		}
 		if err != nil {
 			return errors.E(op, err)
@@ -0,0 +1,16 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package memdb registers the memdb driver at init time.  Importing memdb allows
+// the wallet.OpenDB and wallet.CreateDB functions to be called with the
+// following arguments to keep the wallet database in memory:
+//
+//  var name string
+//  db, err := wallet.CreateDB("memdb", name)
+//  if err != nil { /* handle error */ }
+//  db, err = wallet.OpenDB("memdb", name)
+//  if err != nil { /* handle error */ }
+package memdb
+
+import _ "github.com/fonero-project/fnowallet/wallet/internal/memdb" // Register memdb driver during init
@@ -54,6 +54,13 @@ func (tx *transaction) ReadWriteBucket(key []byte) walletdb.ReadWriteBucket {
 	return (*bucket)(boltBucket)
 }
 
+func (tx *transaction) ForEachBucket(fn func(key []byte) error) error {
+	err := tx.boltTx.ForEach(func(name []byte, _ *bolt.Bucket) error {
+		return fn(name)
+	})
+	return convertErr(err)
+}
+
 func (tx *transaction) CreateTopLevelBucket(key []byte) (walletdb.ReadWriteBucket, error) {
 	boltBucket, err := tx.boltTx.CreateBucket(key)
 	if err != nil {
@@ -54,12 +54,24 @@ func createDBDriver(args ...interface{}) (walletdb.DB, error) {
 	return openDB(dbPath, true)
 }
 
+// existsDBDriver is the callback provided during driver registration that
+// checks whether a database file exists.
+func existsDBDriver(args ...interface{}) (bool, error) {
+	dbPath, err := parseArgs("Exists", args...)
+	if err != nil {
+		return false, err
+	}
+
+	return fileExists(dbPath), nil
+}
+
 func init() {
 	// Register the driver.
 	driver := walletdb.Driver{
 		DbType: dbType,
 		Create: createDBDriver,
 		Open:   openDBDriver,
+		Exists: existsDBDriver,
 	}
 	if err := walletdb.RegisterDriver(driver); err != nil {
 		panic(fmt.Sprintf("Failed to register database driver '%s': %v",
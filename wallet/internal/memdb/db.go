@@ -0,0 +1,651 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package memdb
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/boltdb/bolt"
+	"github.com/fonero-project/fnowallet/errors"
+	"github.com/fonero-project/fnowallet/wallet/walletdb"
+)
+
+// Buckets are never modified once committed.  Read transactions traverse the
+// root bucket committed when the transaction began without any locking.  The
+// single read-write transaction copies each bucket it accesses before it may
+// be modified, and the copied root replaces the committed root on commit.
+
+// Keys and values are limited to the sizes permitted by bolt so that every
+// database may be copied to a bolt database.
+const (
+	maxKeySize   = 32768
+	maxValueSize = (1 << 31) - 2
+)
+
+// entry is a key/value pair or a nested bucket of a bucket.
+type entry struct {
+	key    []byte
+	value  []byte
+	bucket *node // Nil for key/value pairs
+}
+
+// node holds the entries of a bucket sorted by key.
+type node struct {
+	entries []entry
+	gen     uint64 // Generation of the write transaction that may modify the node
+}
+
+// search returns the index of the first entry with a key not less than key, and
+// whether that entry has the key.
+func (n *node) search(key []byte) (int, bool) {
+	i := sort.Search(len(n.entries), func(i int) bool {
+		return bytes.Compare(n.entries[i].key, key) >= 0
+	})
+	return i, i < len(n.entries) && bytes.Equal(n.entries[i].key, key)
+}
+
+func (n *node) copy(gen uint64) *node {
+	return &node{entries: append([]entry(nil), n.entries...), gen: gen}
+}
+
+func (n *node) insert(i int, e entry) {
+	n.entries = append(n.entries, entry{})
+	copy(n.entries[i+1:], n.entries[i:])
+	n.entries[i] = e
+}
+
+func (n *node) remove(i int) {
+	copy(n.entries[i:], n.entries[i+1:])
+	n.entries[len(n.entries)-1] = entry{}
+	n.entries = n.entries[:len(n.entries)-1]
+}
+
+func checkKey(key []byte) error {
+	switch {
+	case len(key) == 0:
+		return errors.E(errors.Invalid, "key required")
+	case len(key) > maxKeySize:
+		return errors.E(errors.Invalid, "key too large")
+	}
+	return nil
+}
+
+// transaction represents a database transaction.  It can either be read-only
+// or read-write and implements the walletdb Tx interfaces.
+type transaction struct {
+	db       *db
+	root     *node
+	gen      uint64
+	writable bool
+	closed   bool
+}
+
+func (tx *transaction) rootBucket() *bucket {
+	return &bucket{tx: tx, node: tx.root}
+}
+
+func (tx *transaction) ReadBucket(key []byte) walletdb.ReadBucket {
+	return tx.ReadWriteBucket(key)
+}
+
+func (tx *transaction) ReadWriteBucket(key []byte) walletdb.ReadWriteBucket {
+	return tx.rootBucket().NestedReadWriteBucket(key)
+}
+
+func (tx *transaction) ForEachBucket(fn func(key []byte) error) error {
+	if tx.closed {
+		return errors.E(errors.Invalid, "transaction closed")
+	}
+	// A read-write transaction modifies the entries of its root node in
+	// place, so iterate over a copy in case the function modifies the
+	// transaction.
+	entries := append([]entry(nil), tx.root.entries...)
+	for _, e := range entries {
+		if e.bucket == nil {
+			continue
+		}
+		err := fn(e.key)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (tx *transaction) CreateTopLevelBucket(key []byte) (walletdb.ReadWriteBucket, error) {
+	return tx.rootBucket().CreateBucket(key)
+}
+
+func (tx *transaction) DeleteTopLevelBucket(key []byte) error {
+	return tx.rootBucket().DeleteNestedBucket(key)
+}
+
+// Commit replaces the database with the root bucket modified by the transaction.
+//
+// This function is part of the walletdb.Tx interface implementation.
+func (tx *transaction) Commit() error {
+	if tx.closed {
+		return errors.E(errors.Invalid, "transaction closed")
+	}
+	if !tx.writable {
+		return errors.E(errors.Invalid, "transaction not writable")
+	}
+	tx.db.mu.Lock()
+	tx.db.root = tx.root
+	tx.db.mu.Unlock()
+	tx.close()
+	return nil
+}
+
+// Rollback discards the transaction and any changes made by it.
+//
+// This function is part of the walletdb.Tx interface implementation.
+func (tx *transaction) Rollback() error {
+	if tx.closed {
+		return errors.E(errors.Invalid, "transaction closed")
+	}
+	tx.close()
+	return nil
+}
+
+func (tx *transaction) close() {
+	tx.closed = true
+	tx.root = nil
+	if tx.writable {
+		tx.db.writer.Unlock()
+	}
+}
+
+// bucket is an internal type used to represent a collection of key/value pairs
+// and implements the walletdb Bucket interfaces.  The node of a bucket opened by
+// a read-write transaction is always a copy owned by that transaction.
+type bucket struct {
+	tx   *transaction
+	node *node
+}
+
+// Enforce bucket implements the walletdb Bucket interfaces.
+var _ walletdb.ReadWriteBucket = (*bucket)(nil)
+
+// checkWritable returns an error if the bucket may not be modified.
+func (b *bucket) checkWritable() error {
+	switch {
+	case b.tx.closed:
+		return errors.E(errors.Invalid, "transaction closed")
+	case !b.tx.writable:
+		return errors.E(errors.Invalid, "transaction not writable")
+	}
+	return nil
+}
+
+// nested returns the nested bucket at index i of the bucket's entries.  A
+// read-write transaction copies the nested bucket the first time it is opened.
+func (b *bucket) nested(i int) *bucket {
+	n := b.node.entries[i].bucket
+	if b.tx.writable && n.gen != b.tx.gen {
+		n = n.copy(b.tx.gen)
+		b.node.entries[i].bucket = n
+	}
+	return &bucket{tx: b.tx, node: n}
+}
+
+// NestedReadWriteBucket retrieves a nested bucket with the given key.  Returns
+// nil if the bucket does not exist.
+//
+// This function is part of the walletdb.ReadWriteBucket interface implementation.
+func (b *bucket) NestedReadWriteBucket(key []byte) walletdb.ReadWriteBucket {
+	if b.tx.closed {
+		return nil
+	}
+	i, ok := b.node.search(key)
+	if !ok || b.node.entries[i].bucket == nil {
+		return nil
+	}
+	return b.nested(i)
+}
+
+func (b *bucket) NestedReadBucket(key []byte) walletdb.ReadBucket {
+	return b.NestedReadWriteBucket(key)
+}
+
+// CreateBucket creates and returns a new nested bucket with the given key.
+// Errors with code Exist if the bucket already exists, and Invalid if the key
+// is empty or otherwise invalid for the driver.
+//
+// This function is part of the walletdb.Bucket interface implementation.
+func (b *bucket) CreateBucket(key []byte) (walletdb.ReadWriteBucket, error) {
+	if err := b.checkWritable(); err != nil {
+		return nil, err
+	}
+	if err := checkKey(key); err != nil {
+		return nil, err
+	}
+	i, ok := b.node.search(key)
+	if ok {
+		if b.node.entries[i].bucket != nil {
+			return nil, errors.E(errors.Exist, "bucket already exists")
+		}
+		return nil, errors.E(errors.Invalid, "incompatible value")
+	}
+	n := &node{gen: b.tx.gen}
+	b.node.insert(i, entry{key: append([]byte(nil), key...), bucket: n})
+	return &bucket{tx: b.tx, node: n}, nil
+}
+
+// CreateBucketIfNotExists creates and returns a new nested bucket with the
+// given key if it does not already exist.  Errors with code Invalid if the key
+// is empty or otherwise invalid for the driver.
+//
+// This function is part of the walletdb.Bucket interface implementation.
+func (b *bucket) CreateBucketIfNotExists(key []byte) (walletdb.ReadWriteBucket, error) {
+	if err := b.checkWritable(); err != nil {
+		return nil, err
+	}
+	if err := checkKey(key); err != nil {
+		return nil, err
+	}
+	i, ok := b.node.search(key)
+	if !ok {
+		return b.CreateBucket(key)
+	}
+	if b.node.entries[i].bucket == nil {
+		return nil, errors.E(errors.Invalid, "incompatible value")
+	}
+	return b.nested(i), nil
+}
+
+// DeleteNestedBucket removes a nested bucket with the given key.
+//
+// This function is part of the walletdb.Bucket interface implementation.
+func (b *bucket) DeleteNestedBucket(key []byte) error {
+	if err := b.checkWritable(); err != nil {
+		return err
+	}
+	i, ok := b.node.search(key)
+	if !ok {
+		return errors.E(errors.NotExist, "bucket not found")
+	}
+	if b.node.entries[i].bucket == nil {
+		return errors.E(errors.Invalid, "incompatible value")
+	}
+	b.node.remove(i)
+	return nil
+}
+
+// ForEach invokes the passed function with every key/value pair in the bucket.
+// This includes nested buckets, in which case the value is nil, but it does not
+// include the key/value pairs within those nested buckets.
+//
+// This function is part of the walletdb.Bucket interface implementation.
+func (b *bucket) ForEach(fn func(k, v []byte) error) error {
+	for _, e := range b.node.entries {
+		err := fn(e.key, e.value)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Put saves the specified key/value pair to the bucket.  Keys that do not
+// already exist are added and keys that already exist are overwritten.
+//
+// This function is part of the walletdb.Bucket interface implementation.
+func (b *bucket) Put(key, value []byte) error {
+	if err := b.checkWritable(); err != nil {
+		return err
+	}
+	if err := checkKey(key); err != nil {
+		return err
+	}
+	if len(value) > maxValueSize {
+		return errors.E(errors.Invalid, "value too large")
+	}
+	// Empty values are read as nil, as they are with bolt.
+	if len(value) == 0 {
+		value = nil
+	} else {
+		value = append([]byte(nil), value...)
+	}
+	i, ok := b.node.search(key)
+	if !ok {
+		b.node.insert(i, entry{key: append([]byte(nil), key...), value: value})
+		return nil
+	}
+	if b.node.entries[i].bucket != nil {
+		return errors.E(errors.Invalid, "incompatible value")
+	}
+	b.node.entries[i].value = value
+	return nil
+}
+
+// Get returns the value for the given key.  Returns nil if the key does
+// not exist in this bucket (or nested buckets).
+//
+// This function is part of the walletdb.Bucket interface implementation.
+func (b *bucket) Get(key []byte) []byte {
+	i, ok := b.node.search(key)
+	if !ok {
+		return nil
+	}
+	return b.node.entries[i].value
+}
+
+// Delete removes the specified key from the bucket.  Deleting a key that does
+// not exist does not return an error.
+//
+// This function is part of the walletdb.Bucket interface implementation.
+func (b *bucket) Delete(key []byte) error {
+	if err := b.checkWritable(); err != nil {
+		return err
+	}
+	i, ok := b.node.search(key)
+	if !ok {
+		return nil
+	}
+	if b.node.entries[i].bucket != nil {
+		return errors.E(errors.Invalid, "incompatible value")
+	}
+	b.node.remove(i)
+	return nil
+}
+
+func (b *bucket) ReadCursor() walletdb.ReadCursor {
+	return b.ReadWriteCursor()
+}
+
+// ReadWriteCursor returns a new cursor, allowing for iteration over the bucket's
+// key/value pairs and nested buckets in forward or backward order.
+//
+// This function is part of the walletdb.Bucket interface implementation.
+func (b *bucket) ReadWriteCursor() walletdb.ReadWriteCursor {
+	return &cursor{bucket: b}
+}
+
+// cursor represents a cursor over key/value pairs and nested buckets of a
+// bucket.
+//
+// Note that open cursors are not tracked on bucket changes and any
+// modifications to the bucket, with the exception of cursor.Delete, invalidate
+// the cursor.  After invalidation, the cursor must be repositioned, or the keys
+// and values returned may be unpredictable.
+type cursor struct {
+	bucket  *bucket
+	i       int  // Index of the current entry
+	deleted bool // Current entry was deleted and i indexes the entry after it
+}
+
+// current returns the key/value pair the cursor is at after clamping the
+// cursor to one position before or after the bucket's entries.
+func (c *cursor) current() (key, value []byte) {
+	entries := c.bucket.node.entries
+	switch {
+	case c.i < 0:
+		c.i = -1
+		return nil, nil
+	case c.i >= len(entries):
+		c.i = len(entries)
+		return nil, nil
+	}
+	return entries[c.i].key, entries[c.i].value
+}
+
+// Delete removes the current key/value pair the cursor is at without
+// invalidating the cursor.
+//
+// This function is part of the walletdb.Cursor interface implementation.
+func (c *cursor) Delete() error {
+	if err := c.bucket.checkWritable(); err != nil {
+		return err
+	}
+	entries := c.bucket.node.entries
+	if c.deleted || c.i < 0 || c.i >= len(entries) {
+		return nil
+	}
+	if entries[c.i].bucket != nil {
+		return errors.E(errors.Invalid, "incompatible value")
+	}
+	c.bucket.node.remove(c.i)
+	c.deleted = true
+	return nil
+}
+
+// First positions the cursor at the first key/value pair and returns the pair.
+//
+// This function is part of the walletdb.Cursor interface implementation.
+func (c *cursor) First() (key, value []byte) {
+	c.i, c.deleted = 0, false
+	return c.current()
+}
+
+// Last positions the cursor at the last key/value pair and returns the pair.
+//
+// This function is part of the walletdb.Cursor interface implementation.
+func (c *cursor) Last() (key, value []byte) {
+	c.i, c.deleted = len(c.bucket.node.entries)-1, false
+	return c.current()
+}
+
+// Next moves the cursor one key/value pair forward and returns the new pair.
+//
+// This function is part of the walletdb.Cursor interface implementation.
+func (c *cursor) Next() (key, value []byte) {
+	if !c.deleted {
+		c.i++
+	}
+	c.deleted = false
+	return c.current()
+}
+
+// Prev moves the cursor one key/value pair backward and returns the new pair.
+//
+// This function is part of the walletdb.Cursor interface implementation.
+func (c *cursor) Prev() (key, value []byte) {
+	c.i, c.deleted = c.i-1, false
+	return c.current()
+}
+
+// Seek positions the cursor at the passed seek key. If the key does not exist,
+// the cursor is moved to the next key after seek. Returns the new pair.
+//
+// This function is part of the walletdb.Cursor interface implementation.
+func (c *cursor) Seek(seek []byte) (key, value []byte) {
+	c.i, _ = c.bucket.node.search(seek)
+	c.deleted = false
+	return c.current()
+}
+
+// Closes the cursor
+//
+// This function is part of the walletdb.Cursor interface implementation.
+func (c *cursor) Close() {}
+
+// db represents an in-memory database and implements the walletdb.Db
+// interface.  A named database outlives its handle being closed and is reopened
+// by name.
+type db struct {
+	writer sync.Mutex // Held by the open read-write transaction
+
+	mu     sync.RWMutex // Protects the fields below
+	root   *node
+	gen    uint64
+	closed bool
+}
+
+// Enforce db implements the walletdb.Db interface.
+var _ walletdb.DB = (*db)(nil)
+
+func newDB() *db {
+	return &db{root: new(node)}
+}
+
+func (db *db) BeginReadTx() (walletdb.ReadTx, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	if db.closed {
+		return nil, errors.E(errors.Invalid, "database not open")
+	}
+	return &transaction{db: db, root: db.root}, nil
+}
+
+func (db *db) BeginReadWriteTx() (walletdb.ReadWriteTx, error) {
+	db.writer.Lock()
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if db.closed {
+		db.writer.Unlock()
+		return nil, errors.E(errors.Invalid, "database not open")
+	}
+	db.gen++
+	tx := &transaction{
+		db:       db,
+		root:     db.root.copy(db.gen),
+		gen:      db.gen,
+		writable: true,
+	}
+	return tx, nil
+}
+
+// Copy writes a copy of the database to the provided writer in the bolt
+// database format.  The copy is a snapshot of the database when Copy is
+// called and does not block transactions.
+//
+// This function is part of the walletdb.Db interface implementation.
+func (db *db) Copy(w io.Writer) error {
+	db.mu.RLock()
+	root, closed := db.root, db.closed
+	db.mu.RUnlock()
+	if closed {
+		return errors.E(errors.Invalid, "database not open")
+	}
+
+	// The bolt database is written to a temporary file which is removed once
+	// copied to the writer.
+	f, err := ioutil.TempFile("", "memdb")
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	path := f.Name()
+	f.Close()
+	defer os.Remove(path)
+
+	boltDB, err := bolt.Open(path, 0600, nil)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	err = boltDB.Update(func(tx *bolt.Tx) error {
+		for _, e := range root.entries {
+			b, err := tx.CreateBucket(e.key)
+			if err != nil {
+				return err
+			}
+			if err := copyBucket(b, e.bucket); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err == nil {
+		err = boltDB.View(func(tx *bolt.Tx) error {
+			_, err := tx.WriteTo(w)
+			return err
+		})
+	}
+	closeErr := boltDB.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// copyBucket writes the entries of n and each of its nested buckets to the
+// bolt bucket b.
+func copyBucket(b *bolt.Bucket, n *node) error {
+	for _, e := range n.entries {
+		if e.bucket == nil {
+			if err := b.Put(e.key, e.value); err != nil {
+				return err
+			}
+			continue
+		}
+		nested, err := b.CreateBucket(e.key)
+		if err != nil {
+			return err
+		}
+		if err := copyBucket(nested, e.bucket); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close closes the database after any open read-write transaction is finished.
+// The database is no longer accessible unless it is named and opened again.
+//
+// This function is part of the walletdb.Db interface implementation.
+func (db *db) Close() error {
+	db.writer.Lock()
+	db.mu.Lock()
+	db.closed = true
+	db.mu.Unlock()
+	db.writer.Unlock()
+	return nil
+}
+
+// Named databases are kept for the lifetime of the process so they may be
+// opened again after they are closed.
+var (
+	namedMu sync.Mutex
+	named   = make(map[string]*db)
+)
+
+// openDB opens the named database, creating it when create is set and the
+// database does not exist.  A database created without a name is never added
+// to the named databases.
+func openDB(name string, create bool) (walletdb.DB, error) {
+	if name == "" {
+		if !create {
+			return nil, errors.E(errors.NotExist, "missing database name")
+		}
+		return newDB(), nil
+	}
+
+	namedMu.Lock()
+	defer namedMu.Unlock()
+
+	db, ok := named[name]
+	if !ok {
+		if !create {
+			return nil, errors.E(errors.NotExist, "missing database")
+		}
+		db = newDB()
+		named[name] = db
+		return db, nil
+	}
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if !db.closed {
+		return nil, errors.E(errors.Invalid, "database is already open")
+	}
+	db.closed = false
+	return db, nil
+}
+
+// dbExists returns whether the named database has been created.
+func dbExists(name string) bool {
+	namedMu.Lock()
+	_, ok := named[name]
+	namedMu.Unlock()
+	return ok
+}
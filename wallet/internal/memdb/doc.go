@@ -0,0 +1,39 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+/*
+Package memdb implements an instance of walletdb that keeps the database in
+memory.  Nothing is written to disk, and the database is lost when the process
+exits.
+
+Usage
+
+This package is only a driver to the walletdb package and provides the database
+type of "memdb".  The Create function takes an optional database name as a
+string, and the Open function takes the name of a database previously created by
+this process:
+
+	db, err := walletdb.Create("memdb", "path/to/database.db")
+	if err != nil {
+		// Handle error
+	}
+
+	db, err := walletdb.Open("memdb", "path/to/database.db")
+	if err != nil {
+		// Handle error
+	}
+
+Closing a named database keeps its contents, so the database may be opened again
+by name, while a database created without a name can not be opened after it is
+closed.  Paths are accepted as names so the driver may be used wherever the bdb
+driver is, but are never accessed.
+
+Transactions
+
+Any number of read transactions may run concurrently with a single read-write
+transaction.  Each read transaction observes the database as it was when the
+transaction began.  Copy writes a snapshot of the database in the bolt database
+format, which may be opened with the bdb driver.
+*/
+package memdb
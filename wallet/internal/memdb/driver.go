@@ -0,0 +1,84 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package memdb
+
+import (
+	"fmt"
+
+	"github.com/fonero-project/fnowallet/errors"
+	"github.com/fonero-project/fnowallet/wallet/walletdb"
+)
+
+const (
+	dbType = "memdb"
+)
+
+// parseArgs parses the arguments from the walletdb Open/Create/Exists methods.
+// When optional is set, no arguments may be provided and the empty name is
+// returned.
+func parseArgs(funcName string, optional bool, args ...interface{}) (string, error) {
+	if len(args) == 0 && optional {
+		return "", nil
+	}
+	if len(args) != 1 {
+		return "", errors.Errorf("invalid arguments to %s.%s -- "+
+			"expected database name", dbType, funcName)
+	}
+
+	name, ok := args[0].(string)
+	if !ok {
+		return "", errors.Errorf("first argument to %s.%s is invalid -- "+
+			"expected database name string", dbType, funcName)
+	}
+
+	return name, nil
+}
+
+// openDBDriver is the callback provided during driver registration that opens
+// an existing database for use.
+func openDBDriver(args ...interface{}) (walletdb.DB, error) {
+	name, err := parseArgs("Open", false, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return openDB(name, false)
+}
+
+// createDBDriver is the callback provided during driver registration that
+// creates, initializes, and opens a database for use.
+func createDBDriver(args ...interface{}) (walletdb.DB, error) {
+	name, err := parseArgs("Create", true, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return openDB(name, true)
+}
+
+// existsDBDriver is the callback provided during driver registration that
+// checks whether a database has been created.
+func existsDBDriver(args ...interface{}) (bool, error) {
+	name, err := parseArgs("Exists", false, args...)
+	if err != nil {
+		return false, err
+	}
+
+	return dbExists(name), nil
+}
+
+func init() {
+	// Register the driver.
+	driver := walletdb.Driver{
+		DbType: dbType,
+		Create: createDBDriver,
+		Open:   openDBDriver,
+		Exists: existsDBDriver,
+	}
+	if err := walletdb.RegisterDriver(driver); err != nil {
+		panic(fmt.Sprintf("Failed to register database driver '%s': %v",
+			dbType, err))
+	}
+}
@@ -0,0 +1,248 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package memdb_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/fonero-project/fnowallet/errors"
+	_ "github.com/fonero-project/fnowallet/wallet/internal/bdb"
+	_ "github.com/fonero-project/fnowallet/wallet/internal/memdb"
+	"github.com/fonero-project/fnowallet/wallet/walletdb"
+)
+
+// dbType is the database type name for this driver.
+const dbType = "memdb"
+
+var (
+	bucketKey = []byte("bucket")
+	nestedKey = []byte("nested")
+	key       = []byte("key")
+)
+
+func put(db walletdb.DB, value string) error {
+	return walletdb.Update(db, func(tx walletdb.ReadWriteTx) error {
+		bucket := tx.ReadWriteBucket(bucketKey)
+		if bucket == nil {
+			var err error
+			bucket, err = tx.CreateTopLevelBucket(bucketKey)
+			if err != nil {
+				return err
+			}
+			_, err = bucket.CreateBucket(nestedKey)
+			if err != nil {
+				return err
+			}
+		}
+		err := bucket.Put(key, []byte(value))
+		if err != nil {
+			return err
+		}
+		return bucket.NestedReadWriteBucket(nestedKey).Put(key, []byte(value))
+	})
+}
+
+func get(db walletdb.DB) (value, nestedValue []byte, err error) {
+	err = walletdb.View(db, func(tx walletdb.ReadTx) error {
+		bucket := tx.ReadBucket(bucketKey)
+		if bucket == nil {
+			return errors.E(errors.NotExist, "missing bucket")
+		}
+		value = append(value, bucket.Get(key)...)
+		nestedValue = append(nestedValue, bucket.NestedReadBucket(nestedKey).Get(key)...)
+		return nil
+	})
+	return
+}
+
+// TestCreateOpen ensures that named databases keep their contents when closed
+// and reopened, and that errors related to creating and opening a database
+// are handled properly.
+func TestCreateOpen(t *testing.T) {
+	name := "TestCreateOpen"
+	if _, err := walletdb.Open(dbType, name); !errors.Is(errors.NotExist, err) {
+		t.Fatalf("Open: unexpected error: %v", err)
+	}
+	if exists, err := walletdb.Exists(dbType, name); exists || err != nil {
+		t.Fatalf("Exists: unexpected result %v, %v", exists, err)
+	}
+	if _, err := walletdb.Open(dbType, 1); err == nil {
+		t.Fatalf("Open: unexpected success with invalid name")
+	}
+
+	db, err := walletdb.Create(dbType, name)
+	if err != nil {
+		t.Fatalf("Create: unexpected error: %v", err)
+	}
+	if exists, err := walletdb.Exists(dbType, name); !exists || err != nil {
+		t.Fatalf("Exists: unexpected result %v, %v", exists, err)
+	}
+	if _, err := walletdb.Open(dbType, name); !errors.Is(errors.Invalid, err) {
+		t.Fatalf("Open: unexpected error opening open database: %v", err)
+	}
+	if err := put(db, "value"); err != nil {
+		t.Fatalf("put: %v", err)
+	}
+	if err := db.Close(); err != nil {
+		t.Fatalf("Close: unexpected error: %v", err)
+	}
+	if _, err := db.BeginReadTx(); !errors.Is(errors.Invalid, err) {
+		t.Fatalf("BeginReadTx: unexpected error on closed database: %v", err)
+	}
+
+	db, err = walletdb.Open(dbType, name)
+	if err != nil {
+		t.Fatalf("Open: unexpected error: %v", err)
+	}
+	defer db.Close()
+	value, nestedValue, err := get(db)
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if string(value) != "value" || string(nestedValue) != "value" {
+		t.Fatalf("reopened database has values %q, %q", value, nestedValue)
+	}
+
+	// Databases created without a name are independent.
+	unnamed, err := walletdb.Create(dbType)
+	if err != nil {
+		t.Fatalf("Create: unexpected error: %v", err)
+	}
+	defer unnamed.Close()
+	if _, _, err := get(unnamed); !errors.Is(errors.NotExist, err) {
+		t.Fatalf("unnamed database is not empty: %v", err)
+	}
+}
+
+// TestConcurrentReads ensures that read transactions run concurrently with each
+// other and a read-write transaction, and observe the database as it was when
+// each read transaction began.
+func TestConcurrentReads(t *testing.T) {
+	db, err := walletdb.Create(dbType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	if err := put(db, "old"); err != nil {
+		t.Fatal(err)
+	}
+
+	check := func(tx walletdb.ReadTx, want string) {
+		t.Helper()
+		bucket := tx.ReadBucket(bucketKey)
+		if v := bucket.Get(key); string(v) != want {
+			t.Fatalf("Get: unexpected value %q, want %q", v, want)
+		}
+		if v := bucket.NestedReadBucket(nestedKey).Get(key); string(v) != want {
+			t.Fatalf("Get: unexpected nested value %q, want %q", v, want)
+		}
+	}
+
+	tx1, err := db.BeginReadTx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tx1.Rollback()
+
+	rwtx, err := db.BeginReadWriteTx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx2, err := db.BeginReadTx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tx2.Rollback()
+	bucket := rwtx.ReadWriteBucket(bucketKey)
+	if err := bucket.Put(key, []byte("new")); err != nil {
+		t.Fatal(err)
+	}
+	if err := bucket.NestedReadWriteBucket(nestedKey).Put(key, []byte("new")); err != nil {
+		t.Fatal(err)
+	}
+
+	// Uncommitted values are only observed by the read-write transaction.
+	check(tx1, "old")
+	check(tx2, "old")
+	check(rwtx, "new")
+	if err := rwtx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Read transactions begun before the commit observe the old values,
+	// while later transactions observe the committed values.
+	check(tx1, "old")
+	check(tx2, "old")
+	tx3, err := db.BeginReadTx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tx3.Rollback()
+	check(tx3, "new")
+
+	// Rolled back values are never observed.
+	rwtx, err = db.BeginReadWriteTx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rwtx.ReadWriteBucket(bucketKey).Put(key, []byte("rollback")); err != nil {
+		t.Fatal(err)
+	}
+	if err := rwtx.Rollback(); err != nil {
+		t.Fatal(err)
+	}
+	check(tx3, "new")
+	value, _, err := get(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(value) != "new" {
+		t.Fatalf("rolled back value %q was committed", value)
+	}
+}
+
+// TestCopy ensures that a copied database is a bolt database with the contents
+// of the copied database.
+func TestCopy(t *testing.T) {
+	db, err := walletdb.Create(dbType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	if err := put(db, "value"); err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := db.Copy(&buf); err != nil {
+		t.Fatalf("Copy: unexpected error: %v", err)
+	}
+
+	dir, err := ioutil.TempDir("", "memdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "copy.db")
+	if err := ioutil.WriteFile(path, buf.Bytes(), 0600); err != nil {
+		t.Fatal(err)
+	}
+	boltDB, err := walletdb.Open("bdb", path)
+	if err != nil {
+		t.Fatalf("Open: unexpected error opening copy: %v", err)
+	}
+	defer boltDB.Close()
+	value, nestedValue, err := get(boltDB)
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if string(value) != "value" || string(nestedValue) != "value" {
+		t.Fatalf("copied database has values %q, %q", value, nestedValue)
+	}
+}
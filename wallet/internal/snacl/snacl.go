@@ -14,6 +14,7 @@ import (
 
 	"github.com/fonero-project/fnowallet/errors"
 	"github.com/fonero-project/fnowallet/internal/zero"
+	"golang.org/x/crypto/argon2"
 	"golang.org/x/crypto/nacl/secretbox"
 	"golang.org/x/crypto/scrypt"
 )
@@ -31,8 +32,40 @@ const (
 	DefaultN  = 16384 // 2^14
 	DefaultR  = 8
 	DefaultP  = 1
+
+	// Default Argon2id parameters: memory in KiB, passes, and threads.
+	DefaultArgon2Memory  = 65536 // 64 MiB
+	DefaultArgon2Time    = 3
+	DefaultArgon2Threads = 4
+)
+
+// KDF identifies the key derivation function used to derive a secret key from
+// a passphrase.
+type KDF uint8
+
+// Key derivation functions.
+const (
+	// KDFScrypt derives keys using scrypt.  The N, R and P parameters are the
+	// CPU/memory cost, block size, and parallelization parameters.
+	KDFScrypt KDF = iota
+
+	// KDFArgon2id derives keys using Argon2id.  The N parameter is the
+	// memory in KiB, R is the number of passes over the memory, and P is the
+	// number of threads.
+	KDFArgon2id
 )
 
+func (k KDF) String() string {
+	switch k {
+	case KDFScrypt:
+		return "scrypt"
+	case KDFArgon2id:
+		return "argon2id"
+	default:
+		return "unknown"
+	}
+}
+
 // CryptoKey represents a secret key which can be used to encrypt and decrypt
 // data.
 type CryptoKey [KeySize]byte
@@ -89,13 +122,15 @@ func GenerateCryptoKey() (*CryptoKey, error) {
 	return &key, nil
 }
 
-// Parameters are not secret and can be stored in plain text.
+// Parameters are not secret and can be stored in plain text.  The meaning of
+// N, R and P depends on the KDF.
 type Parameters struct {
 	Salt   [KeySize]byte
 	Digest [sha256.Size]byte
 	N      int
 	R      int
 	P      int
+	KDF    KDF
 }
 
 // SecretKey houses a crypto key and the parameters needed to derive it from a
@@ -107,13 +142,28 @@ type SecretKey struct {
 
 // deriveKey fills out the Key field.
 func (sk *SecretKey) deriveKey(op errors.Op, password *[]byte) error {
-	key, err := scrypt.Key(*password, sk.Parameters.Salt[:],
-		sk.Parameters.N,
-		sk.Parameters.R,
-		sk.Parameters.P,
-		len(sk.Key))
-	if err != nil {
-		return errors.E(op, err)
+	params := &sk.Parameters
+	var key []byte
+	switch params.KDF {
+	case KDFScrypt:
+		var err error
+		key, err = scrypt.Key(*password, params.Salt[:],
+			params.N,
+			params.R,
+			params.P,
+			len(sk.Key))
+		if err != nil {
+			return errors.E(op, errors.Invalid, err)
+		}
+	case KDFArgon2id:
+		if params.N < 8*params.P || params.R < 1 || params.P < 1 || params.P > 255 ||
+			uint64(params.N) > 1<<32-1 || uint64(params.R) > 1<<32-1 {
+			return errors.E(op, errors.Invalid, "invalid argon2id parameters")
+		}
+		key = argon2.IDKey(*password, params.Salt[:], uint32(params.R),
+			uint32(params.N), uint8(params.P), uint32(len(sk.Key)))
+	default:
+		return errors.E(op, errors.Invalid, errors.Errorf("unknown KDF %d", params.KDF))
 	}
 	copy(sk.Key[:], key)
 	zero.Bytes(key)
@@ -137,16 +187,27 @@ func (sk *SecretKey) deriveKey(op errors.Op, password *[]byte) error {
 	return nil
 }
 
+// marshalledLen is the length of marshalled scrypt parameters.  Parameters of
+// other KDFs append the one byte KDF identifier.
+const marshalledLen = KeySize + sha256.Size + 24
+
 // Marshal returns the Parameters field marshalled into a format suitable for
 // storage.  This result of this can be stored in clear text.
 func (sk *SecretKey) Marshal() []byte {
 	params := &sk.Parameters
 
 	// The marshalled format for the the params is as follows:
-	//   <salt><digest><N><R><P>
+	//   <salt><digest><N><R><P>[<KDF>]
 	//
 	// KeySize + sha256.Size + N (8 bytes) + R (8 bytes) + P (8 bytes)
-	marshalled := make([]byte, KeySize+sha256.Size+24)
+	//
+	// The KDF (1 byte) is omitted for scrypt so the parameters of existing
+	// keys remain unchanged.
+	n := marshalledLen
+	if params.KDF != KDFScrypt {
+		n++
+	}
+	marshalled := make([]byte, n)
 
 	b := marshalled
 	copy(b[:KeySize], params.Salt[:])
@@ -158,6 +219,9 @@ func (sk *SecretKey) Marshal() []byte {
 	binary.LittleEndian.PutUint64(b[:8], uint64(params.R))
 	b = b[8:]
 	binary.LittleEndian.PutUint64(b[:8], uint64(params.P))
+	if params.KDF != KDFScrypt {
+		b[8] = byte(params.KDF)
+	}
 
 	return marshalled
 }
@@ -171,10 +235,10 @@ func (sk *SecretKey) Unmarshal(marshalled []byte) error {
 	}
 
 	// The marshalled format for the the params is as follows:
-	//   <salt><digest><N><R><P>
+	//   <salt><digest><N><R><P>[<KDF>]
 	//
 	// KeySize + sha256.Size + N (8 bytes) + R (8 bytes) + P (8 bytes)
-	if len(marshalled) != KeySize+sha256.Size+24 {
+	if len(marshalled) != marshalledLen && len(marshalled) != marshalledLen+1 {
 		return errors.E(op, errors.Encoding, errors.Errorf("bad marshalled data len %d", len(marshalled)))
 	}
 
@@ -188,6 +252,14 @@ func (sk *SecretKey) Unmarshal(marshalled []byte) error {
 	params.R = int(binary.LittleEndian.Uint64(marshalled[:8]))
 	marshalled = marshalled[8:]
 	params.P = int(binary.LittleEndian.Uint64(marshalled[:8]))
+	marshalled = marshalled[8:]
+	params.KDF = KDFScrypt
+	if len(marshalled) != 0 {
+		params.KDF = KDF(marshalled[0])
+		if params.KDF != KDFArgon2id {
+			return errors.E(op, errors.Encoding, errors.Errorf("unknown KDF %d", params.KDF))
+		}
+	}
 
 	return nil
 }
@@ -237,9 +309,21 @@ func (sk *SecretKey) Decrypt(in []byte) ([]byte, error) {
 	return out, nil
 }
 
-// NewSecretKey returns a SecretKey structure based on the passed parameters.
+// NewSecretKey returns a SecretKey structure based on the passed scrypt
+// parameters.
 func NewSecretKey(password *[]byte, N, r, p int) (*SecretKey, error) {
 	const op errors.Op = "snacl.NewSecretKey"
+	return newSecretKey(op, password, KDFScrypt, N, r, p)
+}
+
+// NewSecretKeyKDF returns a SecretKey structure derived using the KDF and its
+// parameters.
+func NewSecretKeyKDF(password *[]byte, kdf KDF, N, r, p int) (*SecretKey, error) {
+	const op errors.Op = "snacl.NewSecretKeyKDF"
+	return newSecretKey(op, password, kdf, N, r, p)
+}
+
+func newSecretKey(op errors.Op, password *[]byte, kdf KDF, N, r, p int) (*SecretKey, error) {
 	sk := SecretKey{
 		Key: (*CryptoKey)(&[KeySize]byte{}),
 	}
@@ -247,6 +331,7 @@ func NewSecretKey(password *[]byte, N, r, p int) (*SecretKey, error) {
 	sk.Parameters.N = N
 	sk.Parameters.R = r
 	sk.Parameters.P = p
+	sk.Parameters.KDF = kdf
 	_, err := io.ReadFull(prng, sk.Parameters.Salt[:])
 	if err != nil {
 		return nil, errors.E(op, err)
@@ -116,3 +116,38 @@ func TestDeriveKeyInvalid(t *testing.T) {
 		t.Errorf("unexpected DeriveKey key failure: %v", err)
 	}
 }
+
+func TestArgon2idSecretKey(t *testing.T) {
+	argon2Key, err := NewSecretKeyKDF(&password, KDFArgon2id, 1024, 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	marshalled := argon2Key.Marshal()
+	if len(marshalled) != len(params)+1 {
+		t.Fatalf("marshalled argon2id parameters have length %d, want %d",
+			len(marshalled), len(params)+1)
+	}
+
+	var sk SecretKey
+	if err := sk.Unmarshal(marshalled); err != nil {
+		t.Fatalf("unexpected unmarshal error: %v", err)
+	}
+	if sk.Parameters.KDF != KDFArgon2id {
+		t.Fatalf("unmarshalled KDF is %v", sk.Parameters.KDF)
+	}
+	if err := sk.DeriveKey(&password); err != nil {
+		t.Fatalf("unexpected DeriveKey error: %v", err)
+	}
+	if !bytes.Equal(sk.Key[:], argon2Key.Key[:]) {
+		t.Errorf("keys not equal")
+	}
+	p := []byte("wrong password")
+	if err := sk.DeriveKey(&p); !errors.Is(errors.Passphrase, err) {
+		t.Errorf("wrong password didn't fail")
+	}
+
+	marshalled[len(marshalled)-1] = 0xff
+	if err := sk.Unmarshal(marshalled); !errors.Is(errors.Encoding, err) {
+		t.Errorf("unknown KDF didn't fail")
+	}
+}
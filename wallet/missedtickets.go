@@ -0,0 +1,347 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/fonero-project/fnod/blockchain/stake"
+	"github.com/fonero-project/fnod/chaincfg"
+	"github.com/fonero-project/fnod/chaincfg/chainhash"
+	"github.com/fonero-project/fnod/wire"
+	"github.com/fonero-project/fnowallet/errors"
+	"github.com/fonero-project/fnowallet/wallet/walletdb"
+)
+
+// maxMissedCombinations limits the number of combinations of wallet tickets
+// tested as the missed winners of a single block.
+const maxMissedCombinations = 100000
+
+// missedBlocksPerFetch is the number of blocks requested from a peer at once
+// when searching for missed tickets.
+const missedBlocksPerFetch = 16
+
+// liveTicket is an unspent and matured wallet ticket and the height of the
+// block it was mined in.
+type liveTicket struct {
+	hash   chainhash.Hash
+	height int32
+}
+
+// missedTicketsScan records the wallet tickets found to have been missed by
+// missedTicketsFromBlocks, and the main chain blocks each live ticket has been
+// checked through.  It is only kept in memory, so tickets are checked again
+// from maturity after a restart.
+type missedTicketsScan struct {
+	mu        sync.Mutex
+	tipHash   chainhash.Hash
+	tipHeight int32
+	checked   map[chainhash.Hash]int32
+	missed    map[chainhash.Hash]struct{}
+}
+
+// combinations returns n choose k, or a value greater than limit when the
+// result exceeds limit.
+func combinations(n, k, limit int) int {
+	c := 1
+	for i := 0; i < k; i++ {
+		c = c * (n - i) / (i + 1)
+		if c > limit {
+			return limit + 1
+		}
+	}
+	return c
+}
+
+// lotteryMissedTickets returns the candidate tickets which are proven to have
+// been selected by the ticket lottery to vote on the block with header parent
+// and which did not vote in its child block with header child.  voted are the
+// tickets of the votes included in the child block.
+//
+// The lottery winners are not included in block headers, but the child header
+// commits to the lottery's pool size and final state, and the parent header is
+// the seed of the lottery.  This determines the pool positions of the winners,
+// and because the live ticket pool is ordered by ticket hash, the order the
+// winners were selected in.  A set of candidates is proven to be the missed
+// winners when, together with the voted tickets, it reproduces the final state.
+// Missed winners are only found when every winner which did not vote is a
+// candidate.
+func lotteryMissedTickets(params *chaincfg.Params, parent, child *wire.BlockHeader,
+	voted, candidates []chainhash.Hash) ([]chainhash.Hash, error) {
+
+	n := int(params.TicketsPerBlock)
+	m := n - len(voted)
+	if m <= 0 || m > len(candidates) || int(child.PoolSize) < n {
+		return nil, nil
+	}
+	if combinations(len(candidates), m, maxMissedCombinations) > maxMissedCombinations {
+		log.Debugf("Skipping missed ticket search for block %v: too many "+
+			"combinations of %d candidate tickets", child.PrevBlock, len(candidates))
+		return nil, nil
+	}
+
+	var buf bytes.Buffer
+	buf.Grow(wire.MaxBlockHeaderPayload)
+	err := parent.Serialize(&buf)
+	if err != nil {
+		return nil, err
+	}
+	prng := stake.NewHash256PRNGFromIV(stake.CalcHash256PRNGIV(buf.Bytes()))
+	idxs, err := stake.FindTicketIdxs(int(child.PoolSize), params.TicketsPerBlock, prng)
+	if err != nil {
+		return nil, err
+	}
+	lastHash := prng.StateHash()
+
+	// ranks[i] is the position, among the winners ordered by ticket hash, of
+	// the i'th selected winner.
+	sorted := append([]int(nil), idxs...)
+	sort.Ints(sorted)
+	ranks := make([]int, n)
+	for i, idx := range idxs {
+		ranks[i] = sort.SearchInts(sorted, idx)
+	}
+
+	const hashSize = chainhash.HashSize
+	state := make([]byte, (n+1)*hashSize)
+	copy(state[n*hashSize:], lastHash[:])
+	winners := make([]chainhash.Hash, 0, n)
+	reproduces := func(missed []chainhash.Hash) bool {
+		winners = append(winners[:0], voted...)
+		winners = append(winners, missed...)
+		sort.Slice(winners, func(i, j int) bool {
+			return bytes.Compare(winners[i][:], winners[j][:]) < 0
+		})
+		for i, r := range ranks {
+			copy(state[i*hashSize:], winners[r][:])
+		}
+		return bytes.Equal(chainhash.HashB(state)[:6], child.FinalState[:])
+	}
+
+	// Test every combination of m candidates, in lexicographic order of
+	// candidate indexes.
+	c := make([]int, m)
+	for i := range c {
+		c[i] = i
+	}
+	missed := make([]chainhash.Hash, m)
+	for {
+		for i, ci := range c {
+			missed[i] = candidates[ci]
+		}
+		if reproduces(missed) {
+			return missed, nil
+		}
+		i := m - 1
+		for i >= 0 && c[i] == len(candidates)-m+i {
+			i--
+		}
+		if i < 0 {
+			return nil, nil
+		}
+		c[i]++
+		for j := i + 1; j < m; j++ {
+			c[j] = c[j-1] + 1
+		}
+	}
+}
+
+// missedBlock describes a main chain block with fewer votes than tickets
+// selected to vote, and the wallet tickets which may have been missed.
+type missedBlock struct {
+	hash       chainhash.Hash
+	parent     *wire.BlockHeader
+	header     *wire.BlockHeader
+	candidates []chainhash.Hash
+}
+
+// missedTicketsFromBlocks returns the live tickets which were selected to vote
+// and missed.  It is used with network backends which do not implement
+// MissedTicketsChecker.  Missed votes are found from the main chain headers
+// saved by the wallet, and the votes of blocks with fewer votes than tickets
+// per block are fetched from the peer p.
+func (w *Wallet) missedTicketsFromBlocks(ctx context.Context, p Peer, live []liveTicket) (map[chainhash.Hash]struct{}, error) {
+	const op errors.Op = "wallet.missedTicketsFromBlocks"
+
+	params := w.chainParams
+	maturity := int32(params.TicketMaturity)
+	expiry := maturity + int32(params.TicketExpiry)
+
+	s := &w.missedScan
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var tipHash chainhash.Hash
+	var tipHeight int32
+	var blocks []*missedBlock
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		tipHash, tipHeight = w.TxStore.MainChainTip(ns)
+
+		// Start over when the previously checked tip was reorged out of
+		// the main chain.
+		if s.checked != nil {
+			hash, err := w.TxStore.GetMainChainBlockHashForHeight(ns, s.tipHeight)
+			if err != nil && !errors.Is(errors.NotExist, err) {
+				return err
+			}
+			if err != nil || hash != s.tipHash {
+				s.checked = nil
+				s.missed = nil
+			}
+		}
+		checked := make(map[chainhash.Hash]int32, len(live))
+		missed := make(map[chainhash.Hash]struct{})
+		for i := range live {
+			t := &live[i]
+			if _, ok := s.missed[t.hash]; ok {
+				missed[t.hash] = struct{}{}
+				continue
+			}
+			// The first block which may include a vote of the
+			// ticket is checked first.
+			h := t.height + maturity
+			if c, ok := s.checked[t.hash]; ok && c > h {
+				h = c
+			}
+			checked[t.hash] = h
+		}
+		s.checked, s.missed = checked, missed
+
+		start := tipHeight + 1
+		for _, h := range checked {
+			if h+1 < start {
+				start = h + 1
+			}
+		}
+		if svh := int32(params.StakeValidationHeight); start < svh {
+			start = svh
+		}
+
+		var parent *wire.BlockHeader
+		for height := start; height <= tipHeight; height++ {
+			hash, err := w.TxStore.GetMainChainBlockHashForHeight(ns, height)
+			if err != nil {
+				return err
+			}
+			header, err := w.TxStore.GetBlockHeader(dbtx, &hash)
+			if err != nil {
+				return err
+			}
+			prev := parent
+			parent = header
+			if int(header.Voters) >= int(params.TicketsPerBlock) {
+				continue
+			}
+
+			// Tickets which were live after connecting the parent
+			// block are candidates.  Tickets maturing at the parent
+			// are included, as inexact candidates are never found
+			// to be missed.
+			var candidates []chainhash.Hash
+			for i := range live {
+				t := &live[i]
+				age := height - 1 - t.height
+				c, ok := checked[t.hash]
+				if ok && c < height && age >= maturity && age <= expiry {
+					candidates = append(candidates, t.hash)
+				}
+			}
+			m := int(params.TicketsPerBlock) - int(header.Voters)
+			if len(candidates) < m {
+				continue
+			}
+			if prev == nil {
+				prev, err = w.TxStore.GetBlockHeader(dbtx, &header.PrevBlock)
+				if err != nil {
+					return err
+				}
+			}
+			blocks = append(blocks, &missedBlock{
+				hash:       hash,
+				parent:     prev,
+				header:     header,
+				candidates: candidates,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	for len(blocks) != 0 {
+		batch := blocks
+		if len(batch) > missedBlocksPerFetch {
+			batch = batch[:missedBlocksPerFetch]
+		}
+		blocks = blocks[len(batch):]
+
+		hashes := make([]*chainhash.Hash, len(batch))
+		for i, b := range batch {
+			hashes[i] = &b.hash
+		}
+		msgs, err := p.GetBlocks(ctx, hashes)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		if len(msgs) != len(batch) {
+			return nil, errors.E(op, errors.Protocol, "peer returned wrong number of blocks")
+		}
+		for i, b := range batch {
+			if msgs[i].BlockHash() != b.hash {
+				return nil, errors.E(op, errors.Protocol, "peer returned unrequested block")
+			}
+			var voted []chainhash.Hash
+			for _, tx := range msgs[i].STransactions {
+				if !stake.IsSSGen(tx) {
+					continue
+				}
+				votedOn, _ := stake.SSGenBlockVotedOn(tx)
+				if votedOn == b.header.PrevBlock {
+					voted = append(voted, tx.TxIn[1].PreviousOutPoint.Hash)
+				}
+			}
+			candidates := b.candidates[:0]
+			for _, c := range b.candidates {
+				if !hashInSlice(c, voted) {
+					candidates = append(candidates, c)
+				}
+			}
+			missed, err := lotteryMissedTickets(params, b.parent, b.header, voted, candidates)
+			if err != nil {
+				return nil, errors.E(op, err)
+			}
+			for _, h := range missed {
+				log.Debugf("Ticket %v missed its vote on block %v", &h, &b.header.PrevBlock)
+				s.missed[h] = struct{}{}
+				delete(s.checked, h)
+			}
+		}
+	}
+
+	for h := range s.checked {
+		s.checked[h] = tipHeight
+	}
+	s.tipHash, s.tipHeight = tipHash, tipHeight
+
+	missed := make(map[chainhash.Hash]struct{}, len(s.missed))
+	for h := range s.missed {
+		missed[h] = struct{}{}
+	}
+	return missed, nil
+}
+
+func hashInSlice(h chainhash.Hash, hashes []chainhash.Hash) bool {
+	for i := range hashes {
+		if hashes[i] == h {
+			return true
+		}
+	}
+	return false
+}
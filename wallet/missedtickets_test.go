@@ -0,0 +1,174 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/fonero-project/fnod/blockchain/stake"
+	"github.com/fonero-project/fnod/chaincfg"
+	"github.com/fonero-project/fnod/chaincfg/chainhash"
+	"github.com/fonero-project/fnod/wire"
+)
+
+// lottery selects the winners of the ticket lottery seeded by parent from the
+// sorted pool, and returns the winners and the child header committing to the
+// lottery.
+func lottery(t *testing.T, params *chaincfg.Params, parent *wire.BlockHeader, pool []chainhash.Hash) ([]chainhash.Hash, *wire.BlockHeader) {
+	var buf bytes.Buffer
+	err := parent.Serialize(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	prng := stake.NewHash256PRNGFromIV(stake.CalcHash256PRNGIV(buf.Bytes()))
+	idxs, err := stake.FindTicketIdxs(len(pool), params.TicketsPerBlock, prng)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var state []byte
+	winners := make([]chainhash.Hash, len(idxs))
+	for i, idx := range idxs {
+		winners[i] = pool[idx]
+		state = append(state, pool[idx][:]...)
+	}
+	lastHash := prng.StateHash()
+	state = append(state, lastHash[:]...)
+	child := &wire.BlockHeader{
+		PrevBlock: parent.BlockHash(),
+		Height:    parent.Height + 1,
+		PoolSize:  uint32(len(pool)),
+	}
+	copy(child.FinalState[:], chainhash.HashB(state)[:6])
+	return winners, child
+}
+
+func TestLotteryMissedTickets(t *testing.T) {
+	t.Parallel()
+	params := &chaincfg.SimNetParams
+
+	pool := make([]chainhash.Hash, 200)
+	for i := range pool {
+		pool[i] = chainhash.HashH([]byte{byte(i)})
+	}
+	sort.Slice(pool, func(i, j int) bool {
+		return bytes.Compare(pool[i][:], pool[j][:]) < 0
+	})
+	parent := &wire.BlockHeader{Height: 1000, Nonce: 1}
+	winners, child := lottery(t, params, parent, pool)
+	isWinner := func(h chainhash.Hash) bool { return hashInSlice(h, winners) }
+	var losers []chainhash.Hash
+	for _, h := range pool {
+		if !isWinner(h) {
+			losers = append(losers, h)
+		}
+		if len(losers) == 10 {
+			break
+		}
+	}
+
+	tests := []struct {
+		name       string
+		voted      []chainhash.Hash
+		candidates []chainhash.Hash
+		missed     []chainhash.Hash
+	}{{
+		name:       "all voted",
+		voted:      winners,
+		candidates: losers,
+	}, {
+		name:       "one missed",
+		voted:      winners[1:],
+		candidates: append([]chainhash.Hash{winners[0]}, losers...),
+		missed:     winners[:1],
+	}, {
+		name:       "two missed",
+		voted:      winners[2:],
+		candidates: append(append([]chainhash.Hash(nil), losers...), winners[1], winners[0]),
+		missed:     []chainhash.Hash{winners[1], winners[0]},
+	}, {
+		name:       "missed winner is not a candidate",
+		voted:      winners[2:],
+		candidates: append([]chainhash.Hash{winners[0]}, losers...),
+	}, {
+		name:       "only losers",
+		voted:      winners[1:],
+		candidates: losers,
+	}}
+	for _, test := range tests {
+		missed, err := lotteryMissedTickets(params, parent, child, test.voted, test.candidates)
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		if len(missed) != len(test.missed) {
+			t.Errorf("%s: found %d missed tickets, expected %d", test.name,
+				len(missed), len(test.missed))
+			continue
+		}
+		for i := range missed {
+			if missed[i] != test.missed[i] {
+				t.Errorf("%s: missed ticket %d is %v, expected %v", test.name,
+					i, &missed[i], &test.missed[i])
+			}
+		}
+	}
+
+	// A different parent seeds a different lottery.
+	other := *parent
+	other.Nonce++
+	missed, err := lotteryMissedTickets(params, &other, child, winners[1:], winners[:1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(missed) != 0 {
+		t.Errorf("found missed tickets of a lottery with a different seed")
+	}
+}
+
+func TestNotifyRevocationsSlowClient(t *testing.T) {
+	t.Parallel()
+	s := newNotificationServer(nil)
+	slow := s.RevocationNotifications()
+	fast := s.RevocationNotifications()
+	defer fast.Done()
+
+	n := &RevocationNotification{Results: []RevocationResult{{}}}
+	sent := make(chan struct{})
+	go func() {
+		s.notifyRevocations(n)
+		close(sent)
+	}()
+
+	// The blocked send to the slow client must not prevent registering
+	// clients or removing the slow client.
+	registered := make(chan struct{})
+	go func() {
+		c := s.RevocationNotifications()
+		c.Done()
+		close(registered)
+	}()
+	select {
+	case <-registered:
+	case <-time.After(5 * time.Second):
+		t.Fatal("registering a client blocked on a notification send")
+	}
+	slow.Done()
+
+	select {
+	case got := <-fast.C:
+		if got != n {
+			t.Errorf("received wrong notification")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("notification not received")
+	}
+	select {
+	case <-sent:
+	case <-time.After(5 * time.Second):
+		t.Fatal("notifyRevocations did not return")
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/fonero-project/fnod/gcs"
 	"github.com/fonero-project/fnod/wire"
 	"github.com/fonero-project/fnowallet/errors"
+	"github.com/jrick/bitset"
 )
 
 // Peer provides wallets with a subset of Fonero network functionality available
@@ -40,6 +41,15 @@ type NetworkBackend interface {
 	StakeDifficulty(ctx context.Context) (fnoutil.Amount, error)
 }
 
+// MissedTicketsChecker is an optional interface implemented by network backends
+// that are able to report which tickets were selected to vote but missed.
+// Wallets using backends that do not implement this interface find missed
+// tickets from block headers and votes, which requires fetching blocks and only
+// finds missed tickets when all missed winners of a block are wallet tickets.
+type MissedTicketsChecker interface {
+	ExistsMissedTickets(ctx context.Context, tickets []*chainhash.Hash) (bitset.Bytes, error)
+}
+
 // NetworkBackend returns the currently associated network backend of the
 // wallet, or an error if the no backend is currently set.
 func (w *Wallet) NetworkBackend() (NetworkBackend, error) {
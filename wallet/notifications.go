@@ -45,6 +45,7 @@ type NotificationServer struct {
 	accountClients    []chan *AccountNotification
 	tipChangedClients []chan *MainTipChangedNotification
 	confClients       []*ConfirmationNotificationsClient
+	revocationClients []*RevocationNotificationsClient
 	mu                sync.Mutex // Only protects registered clients
 	wallet            *Wallet    // smells like hacks
 }
@@ -889,7 +890,11 @@ func (c *ConfirmationNotificationsClient) Watch(txHashes []*chainhash.Hash, stop
 	if err != nil {
 		r = nil
 	}
-	c.r <- &confNtfnResult{r, err}
+	select {
+	case c.r <- &confNtfnResult{r, err}:
+	case <-c.ctx.Done():
+		return
+	}
 
 	c.mu.Lock()
 	for _, h := range txHashes {
@@ -987,3 +992,73 @@ func (c *ConfirmationNotificationsClient) process(tipHeight int32) {
 	case <-c.ctx.Done():
 	}
 }
+
+// RevocationNotification describes the outcome of revoking missed and expired
+// tickets at the main chain tip height Height.
+type RevocationNotification struct {
+	Height  int32
+	Results []RevocationResult
+}
+
+// RevocationNotificationsClient receives RevocationNotifications over the
+// channel C.
+type RevocationNotificationsClient struct {
+	C      chan *RevocationNotification
+	done   chan struct{}
+	server *NotificationServer
+}
+
+// RevocationNotifications returns a client for receiving
+// RevocationNotifications over a channel.  The channel is unbuffered.  When
+// finished, the client's Done method should be called to disassociate the
+// client from the server.
+func (s *NotificationServer) RevocationNotifications() RevocationNotificationsClient {
+	c := &RevocationNotificationsClient{
+		C:      make(chan *RevocationNotification),
+		done:   make(chan struct{}),
+		server: s,
+	}
+	s.mu.Lock()
+	s.revocationClients = append(s.revocationClients, c)
+	s.mu.Unlock()
+	return *c
+}
+
+// Done deregisters the client from the server.  Notifications are no longer
+// delivered after Done returns, but the channel C is not closed.  It must be
+// called exactly once when the client is finished receiving notifications.
+func (c *RevocationNotificationsClient) Done() {
+	close(c.done)
+	s := c.server
+	s.mu.Lock()
+	clients := s.revocationClients
+	for i, client := range clients {
+		if c.C == client.C {
+			clients[i] = clients[len(clients)-1]
+			s.revocationClients = clients[:len(clients)-1]
+			break
+		}
+	}
+	s.mu.Unlock()
+}
+
+func (s *NotificationServer) notifyRevocations(n *RevocationNotification) {
+	if len(n.Results) == 0 {
+		return
+	}
+
+	// Clients are sent to without holding the mutex, so a client which is
+	// slow to receive does not block registering or removing other
+	// clients.  Sends are abandoned when the client is done.
+	s.mu.Lock()
+	clients := make([]*RevocationNotificationsClient, len(s.revocationClients))
+	copy(clients, s.revocationClients)
+	s.mu.Unlock()
+
+	for _, c := range clients {
+		select {
+		case c.C <- n:
+		case <-c.done:
+		}
+	}
+}
@@ -205,6 +205,30 @@ func (w *Wallet) SaveRescanned(hash *chainhash.Hash, txs []*wire.MsgTx) error {
 func (w *Wallet) rescan(ctx context.Context, n NetworkBackend,
 	startHash *chainhash.Hash, height int32, p chan<- RescanProgress) error {
 
+	// Blocks preceding the wallet birthday are not rescanned.
+	var birthday *chainhash.Hash
+	var birthdayHeight int32
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		birthday, birthdayHeight, err = w.birthdayBlock(dbtx)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if birthday != nil && birthdayHeight > height {
+		log.Infof("Starting rescan at wallet birthday block %v (height %d)",
+			birthday, birthdayHeight)
+		startHash, height = birthday, birthdayHeight
+	}
+
+	// Backends may use the committed filters of rescanned blocks.
+	release, err := w.holdCFilters(ctx, n, height)
+	if err != nil {
+		return err
+	}
+	defer release()
+
 	blockHashStorage := make([]chainhash.Hash, maxBlocksPerRescan)
 	rescanFrom := *startHash
 	inclusive := true
@@ -263,7 +287,8 @@ func (w *Wallet) rescan(ctx context.Context, n NetworkBackend,
 }
 
 // Rescan starts a rescan of the wallet for all blocks on the main chain
-// beginning at startHash.  This function blocks until the rescan completes.
+// beginning at startHash, or at the wallet birthday block if startHash precedes
+// it.  This function blocks until the rescan completes.
 func (w *Wallet) Rescan(ctx context.Context, n NetworkBackend, startHash *chainhash.Hash) error {
 	const op errors.Op = "wallet.Rescan"
 
@@ -401,5 +426,14 @@ func (w *Wallet) rescanPoint(dbtx walletdb.ReadTx) (*chainhash.Hash, error) {
 		log.Info(err)
 		return nil, err
 	}
+
+	// Blocks preceding the wallet birthday do not need to be processed.
+	birthday, birthdayHeight, err := w.birthdayBlock(dbtx)
+	if err != nil {
+		return nil, err
+	}
+	if birthday != nil && birthdayHeight > int32(h.Height)+1 {
+		return birthday, nil
+	}
 	return &rescanPoint, nil
 }
@@ -5,13 +5,11 @@
 package wallet
 
 import (
-	"io/ioutil"
-	"os"
 	"testing"
 
 	"github.com/fonero-project/fnod/chaincfg"
 	"github.com/fonero-project/fnod/fnoutil"
-	_ "github.com/fonero-project/fnowallet/wallet/drivers/bdb"
+	_ "github.com/fonero-project/fnowallet/wallet/drivers/memdb"
 	"github.com/fonero-project/fnowallet/wallet/walletdb"
 )
 
@@ -23,20 +21,14 @@ var basicWalletConfig = Config{
 }
 
 func testWallet(t *testing.T, cfg *Config) (w *Wallet, teardown func()) {
-	f, err := ioutil.TempFile("", "fnowallet.testdb")
-	if err != nil {
-		t.Fatal(err)
-	}
-	f.Close()
-	db, err := walletdb.Create("bdb", f.Name())
+	db, err := walletdb.Create("memdb")
 	if err != nil {
 		t.Fatal(err)
 	}
 	rm := func() {
 		db.Close()
-		os.Remove(f.Name())
 	}
-	err = Create(opaqueDB{db}, []byte(InsecurePubPassphrase), []byte("private"), nil, cfg.Params)
+	err = Create(opaqueDB{db}, []byte(InsecurePubPassphrase), []byte("private"), nil, nil, cfg.Params)
 	if err != nil {
 		rm()
 		t.Fatal(err)
@@ -0,0 +1,694 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"sort"
+	"time"
+
+	"github.com/fonero-project/fnod/blockchain/stake"
+	"github.com/fonero-project/fnod/chaincfg/chainhash"
+	"github.com/fonero-project/fnod/fnoutil"
+	"github.com/fonero-project/fnod/txscript"
+	"github.com/fonero-project/fnod/wire"
+	"github.com/fonero-project/fnowallet/errors"
+	"github.com/fonero-project/fnowallet/wallet/walletdb"
+)
+
+// Spend policies restrict the regular transactions created or published by the
+// wallet which spend the outputs of an account.  The amount spent from an
+// account by a transaction is the total value of its outputs which do not pay
+// the account itself, including outputs paying other accounts of the wallet,
+// and it is counted against the policy of every account which funds an input.
+// Policies are enforced by SendOutputs and the other methods creating
+// transactions from account outputs, and by PublishTransaction, so they apply
+// to every RPC which sends funds.  Stake transactions are not restricted.
+//
+// The amounts spent by each account are recorded in the spendpolicy namespace
+// of the database so that daily limits persist across restarts.  Transactions
+// held for approval are recorded in the pendingtx namespace, and the outputs
+// they spend are locked until they are approved or rejected.
+
+// spendLimitPeriod is the rolling period of the daily spend limit.
+const spendLimitPeriod = 24 * time.Hour
+
+// wspendpolicyNamespaceKey is the top level bucket recording the amounts spent
+// by accounts with a daily spend limit.  Keys are the big endian account
+// number, the big endian time of the spend in nanoseconds since the Unix
+// epoch, and the transaction hash.  Values are the big endian amount.
+var wspendpolicyNamespaceKey = []byte("wspendpolicy")
+
+// wpendingtxNamespaceKey is the top level bucket recording the transactions
+// held until they are approved.  Keys are transaction hashes.  Values are the
+// big endian time the transaction was held in nanoseconds since the Unix
+// epoch, the big endian amount spent, the big endian count and numbers of the
+// funding accounts, and the serialized transaction.
+var wpendingtxNamespaceKey = []byte("wpendingtx")
+
+// SpendPolicy restricts the transactions spending the outputs of an account.
+// Zero values do not restrict transactions.
+type SpendPolicy struct {
+	// DailyLimit is the maximum amount which may be spent during any 24
+	// hour period.
+	DailyLimit fnoutil.Amount
+
+	// Destinations are the only addresses outside of the account which
+	// may be paid, including addresses of other accounts of the wallet.
+	// Any address may be paid when empty.
+	Destinations []fnoutil.Address
+
+	// MaxFeeRate is the maximum fee rate, per kB, of a transaction.
+	// Transactions spending outputs unknown to the wallet are rejected as
+	// their fee rate can not be checked.
+	MaxFeeRate fnoutil.Amount
+
+	// ApprovalThreshold is the amount, spent by a single transaction, at
+	// which the transaction is held until it is approved.  Approvals are
+	// authenticated by a passphrase other than the wallet's, whose salted
+	// hash is HMAC-SHA256 of the passphrase keyed by ApprovalSalt.
+	ApprovalThreshold fnoutil.Amount
+	ApprovalSalt      string
+	ApprovalHash      []byte
+}
+
+// PendingTransaction is a signed transaction held until it is approved.
+type PendingTransaction struct {
+	Tx       *wire.MsgTx
+	Accounts []uint32
+	Amount   fnoutil.Amount // Amount paid outside of the funding accounts
+	Created  time.Time
+}
+
+// SetSpendPolicy sets the spend policy of an account.  A nil policy removes the
+// account's policy, unless a policy is configured for the account's name.
+func (w *Wallet) SetSpendPolicy(account uint32, p *SpendPolicy) {
+	w.spendPolicyMu.Lock()
+	defer w.spendPolicyMu.Unlock()
+	if p == nil {
+		delete(w.spendPolicies, account)
+		return
+	}
+	if w.spendPolicies == nil {
+		w.spendPolicies = make(map[uint32]*SpendPolicy)
+	}
+	w.spendPolicies[account] = p
+}
+
+// SpendPolicy returns the spend policy of an account, or nil if the account is
+// not restricted.
+func (w *Wallet) SpendPolicy(account uint32) *SpendPolicy {
+	var p *SpendPolicy
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		w.spendPolicyMu.Lock()
+		p = w.accountSpendPolicy(addrmgrNs, account)
+		w.spendPolicyMu.Unlock()
+		return nil
+	})
+	if err != nil {
+		log.Errorf("Failed to read spend policy of account %d: %v", account, err)
+	}
+	return p
+}
+
+// accountSpendPolicy returns the policy of an account, or nil if the account is
+// not restricted.  Policies configured by account name are resolved by the
+// account's current name and then apply to the account number even if the
+// account is renamed.  The spendPolicyMu mutex must be held.
+func (w *Wallet) accountSpendPolicy(addrmgrNs walletdb.ReadBucket, account uint32) *SpendPolicy {
+	if p, ok := w.spendPolicies[account]; ok {
+		return p
+	}
+	if len(w.namedSpendPolicies) == 0 {
+		return nil
+	}
+	name, err := w.Manager.AccountName(addrmgrNs, account)
+	if err != nil {
+		return nil
+	}
+	p := w.namedSpendPolicies[name]
+	if p == nil {
+		return nil
+	}
+	if w.spendPolicies == nil {
+		w.spendPolicies = make(map[uint32]*SpendPolicy)
+	}
+	w.spendPolicies[account] = p
+	return p
+}
+
+// openSpendPolicies applies the spend policies configured by account name to
+// the existing accounts and locks the outputs spent by held transactions.
+func (w *Wallet) openSpendPolicies(named map[string]*SpendPolicy) error {
+	w.namedSpendPolicies = named
+	return walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		w.spendPolicyMu.Lock()
+		for name := range named {
+			acct, err := w.Manager.LookupAccount(addrmgrNs, name)
+			if errors.Is(errors.NotExist, err) {
+				log.Infof("Spend policy of account %q applies once the "+
+					"account is created", name)
+				continue
+			}
+			if err != nil {
+				w.spendPolicyMu.Unlock()
+				return err
+			}
+			w.accountSpendPolicy(addrmgrNs, acct)
+			log.Infof("Restricting spends of account %q by policy", name)
+		}
+		w.spendPolicyMu.Unlock()
+
+		ns := dbtx.ReadBucket(wpendingtxNamespaceKey)
+		if ns == nil {
+			return nil
+		}
+		return ns.ForEach(func(k, v []byte) error {
+			p, err := deserializePendingTx(v)
+			if err != nil {
+				return err
+			}
+			for _, in := range p.Tx.TxIn {
+				w.LockOutpoint(in.PreviousOutPoint)
+			}
+			return nil
+		})
+	})
+}
+
+// PendingTransactions returns the transactions held until they are approved,
+// sorted by the time they were held.
+func (w *Wallet) PendingTransactions() ([]*PendingTransaction, error) {
+	const op errors.Op = "wallet.PendingTransactions"
+	var pending []*PendingTransaction
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(wpendingtxNamespaceKey)
+		if ns == nil {
+			return nil
+		}
+		return ns.ForEach(func(k, v []byte) error {
+			p, err := deserializePendingTx(v)
+			if err != nil {
+				return err
+			}
+			pending = append(pending, p)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	sort.Slice(pending, func(i, j int) bool {
+		return pending[i].Created.Before(pending[j].Created)
+	})
+	return pending, nil
+}
+
+// ApprovePendingTransaction authenticates the approval of a held transaction
+// and publishes it.  The passphrase must authenticate the approval of the
+// policy of every account funding the transaction which requires approvals.
+// The other restrictions of the policies are checked again before the
+// transaction is published.  Once approved, the transaction is removed from
+// the pending transactions even if it fails to publish.
+func (w *Wallet) ApprovePendingTransaction(txHash *chainhash.Hash, passphrase []byte,
+	n NetworkBackend) error {
+
+	const opf = "wallet.ApprovePendingTransaction(%v)"
+
+	var p *PendingTransaction
+	err := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		var err error
+		p, err = removePendingTx(dbtx, txHash)
+		if err != nil {
+			return err
+		}
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		w.spendPolicyMu.Lock()
+		defer w.spendPolicyMu.Unlock()
+		verified := false
+		for _, acct := range p.Accounts {
+			policy := w.accountSpendPolicy(addrmgrNs, acct)
+			if policy == nil || policy.ApprovalThreshold == 0 {
+				continue
+			}
+			mac := hmac.New(sha256.New, []byte(policy.ApprovalSalt))
+			mac.Write(passphrase)
+			if !hmac.Equal(mac.Sum(nil), policy.ApprovalHash) {
+				return errors.E(errors.Passphrase, "invalid approval passphrase")
+			}
+			verified = true
+		}
+		if !verified {
+			return errors.E(errors.Invalid, "no policy of the funding accounts "+
+				"requires approval")
+		}
+		return nil
+	})
+	if err != nil {
+		op := errors.Opf(opf, txHash)
+		return errors.E(op, err)
+	}
+	for _, in := range p.Tx.TxIn {
+		w.UnlockOutpoint(in.PreviousOutPoint)
+	}
+
+	w.audit("ApprovePendingTransaction", map[string]string{"txhash": txHash.String()}, nil)
+
+	serializedTx, err := p.Tx.Bytes()
+	if err != nil {
+		op := errors.Opf(opf, txHash)
+		return errors.E(op, err)
+	}
+	_, err = w.publishTransaction(p.Tx, serializedTx, n, true)
+	return err
+}
+
+// isPendingTransaction returns whether a transaction is held for approval.
+func (w *Wallet) isPendingTransaction(txHash *chainhash.Hash) bool {
+	var pending bool
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(wpendingtxNamespaceKey)
+		pending = ns != nil && ns.Get(txHash[:]) != nil
+		return nil
+	})
+	return err == nil && pending
+}
+
+// RejectPendingTransaction removes a held transaction without publishing it.
+func (w *Wallet) RejectPendingTransaction(txHash *chainhash.Hash) error {
+	const opf = "wallet.RejectPendingTransaction(%v)"
+	var p *PendingTransaction
+	err := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		var err error
+		p, err = removePendingTx(dbtx, txHash)
+		return err
+	})
+	if err != nil {
+		op := errors.Opf(opf, txHash)
+		return errors.E(op, err)
+	}
+	for _, in := range p.Tx.TxIn {
+		w.UnlockOutpoint(in.PreviousOutPoint)
+	}
+	w.audit("RejectPendingTransaction", map[string]string{"txhash": txHash.String()}, nil)
+	return nil
+}
+
+// heldTxError is returned by reserveSpendTx after recording a transaction
+// held until it is approved.  The database transaction must be committed to
+// keep the held transaction, and holdCommitted called afterwards.
+type heldTxError struct {
+	tx *PendingTransaction
+}
+
+func (e *heldTxError) Error() string {
+	return "transaction is held until it is approved"
+}
+
+// holdCommitted locks the outputs spent by a held transaction after it is
+// recorded and returns the error reported to the creator of the transaction.
+func (w *Wallet) holdCommitted(p *PendingTransaction) error {
+	for _, in := range p.Tx.TxIn {
+		w.LockOutpoint(in.PreviousOutPoint)
+	}
+	txHash := p.Tx.TxHash()
+	w.audit("HoldTransaction", map[string]string{
+		"txhash": txHash.String(),
+		"amount": p.Amount.String(),
+	}, nil)
+	return errors.E(errors.Policy, errors.Errorf("transaction %v spending %v "+
+		"is held until it is approved", &txHash, p.Amount))
+}
+
+// spendReservation records a spend counted against the daily limits of
+// account policies before the transaction is published.
+type spendReservation struct {
+	w    *Wallet
+	keys [][]byte
+}
+
+// cancel removes the recorded spend after the transaction failed to publish.
+func (r *spendReservation) cancel() {
+	if r == nil || len(r.keys) == 0 {
+		return
+	}
+	err := walletdb.Update(r.w.db, func(dbtx walletdb.ReadWriteTx) error {
+		b := dbtx.ReadWriteBucket(wspendpolicyNamespaceKey)
+		if b == nil {
+			return nil
+		}
+		for _, k := range r.keys {
+			if err := b.Delete(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.Errorf("Failed to remove spend of unpublished transaction: %v", err)
+	}
+}
+
+// reserveSpend checks a transaction against the spend policies of the accounts
+// funding it and records the amount it spends.  Transactions spending at least
+// an approval threshold are held, and an error with the Policy kind is
+// returned, unless the transaction was approved.  The reservation is nil when
+// no policy applies.
+func (w *Wallet) reserveSpend(tx *wire.MsgTx, approved bool) (*spendReservation, error) {
+	w.spendPolicyMu.Lock()
+	restricted := len(w.spendPolicies) != 0 || len(w.namedSpendPolicies) != 0
+	w.spendPolicyMu.Unlock()
+	if !restricted {
+		return nil, nil
+	}
+	var r *spendReservation
+	var held *heldTxError
+	err := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		var err error
+		r, err = w.reserveSpendTx(dbtx, tx, approved)
+		if h, ok := err.(*heldTxError); ok {
+			held = h
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if held != nil {
+		return nil, w.holdCommitted(held.tx)
+	}
+	return r, nil
+}
+
+// reserveSpendTx implements reserveSpend using an open database transaction.
+// Callers which publish the transaction before committing the database
+// transaction do not need to cancel the reservation as it is removed by
+// rolling back the database transaction.  A held transaction is recorded and
+// a *heldTxError is returned, after which the caller must commit the database
+// transaction and report the error returned by holdCommitted.
+func (w *Wallet) reserveSpendTx(dbtx walletdb.ReadWriteTx, tx *wire.MsgTx, approved bool) (*spendReservation, error) {
+	w.spendPolicyMu.Lock()
+	defer w.spendPolicyMu.Unlock()
+
+	if len(w.spendPolicies) == 0 && len(w.namedSpendPolicies) == 0 {
+		return nil, nil
+	}
+	if stake.DetermineTxType(tx) != stake.TxTypeRegular {
+		return nil, nil
+	}
+
+	addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+	txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+	txHash := tx.TxHash()
+	now := time.Now()
+
+	// Find the restricted accounts funding the transaction.
+	var accounts []uint32
+	seen := make(map[uint32]struct{})
+	for _, in := range tx.TxIn {
+		prevTx, err := w.TxStore.Tx(txmgrNs, &in.PreviousOutPoint.Hash)
+		if errors.Is(errors.NotExist, err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if in.PreviousOutPoint.Index >= uint32(len(prevTx.TxOut)) {
+			continue
+		}
+		prevOut := prevTx.TxOut[in.PreviousOutPoint.Index]
+		_, addrs, _, _ := txscript.ExtractPkScriptAddrs(prevOut.Version,
+			prevOut.PkScript, w.chainParams)
+		for _, a := range addrs {
+			acct, err := w.Manager.AddrAccount(addrmgrNs, a)
+			if err != nil {
+				continue
+			}
+			if w.accountSpendPolicy(addrmgrNs, acct) == nil {
+				continue
+			}
+			if _, ok := seen[acct]; !ok {
+				seen[acct] = struct{}{}
+				accounts = append(accounts, acct)
+			}
+		}
+	}
+	if len(accounts) == 0 {
+		return nil, nil
+	}
+
+	// Sum the outputs spent by each funding account and record their
+	// destinations.  Only change and other outputs paying the funding
+	// account itself are exempt; outputs paying other accounts of the
+	// wallet are counted, as those funds are no longer restricted by the
+	// account's policy.  Destinations of outputs without a single address
+	// are nil and are never allowed by a destination list.
+	spent := make(map[uint32]fnoutil.Amount, len(accounts))
+	external := make(map[uint32][]fnoutil.Address, len(accounts))
+	var spentTotal fnoutil.Amount
+	for _, out := range tx.TxOut {
+		if out.Value == 0 {
+			continue
+		}
+		_, addrs, _, _ := txscript.ExtractPkScriptAddrs(out.Version,
+			out.PkScript, w.chainParams)
+		var dest fnoutil.Address
+		var outAcct uint32
+		var owned bool
+		if len(addrs) == 1 {
+			dest = addrs[0]
+			acct, err := w.Manager.AddrAccount(addrmgrNs, dest)
+			outAcct, owned = acct, err == nil
+		}
+		paysFunding := false
+		for _, acct := range accounts {
+			if owned && outAcct == acct {
+				paysFunding = true
+				continue
+			}
+			spent[acct] += fnoutil.Amount(out.Value)
+			external[acct] = append(external[acct], dest)
+		}
+		if !paysFunding {
+			spentTotal += fnoutil.Amount(out.Value)
+		}
+	}
+
+	totalInput, err := w.TxStore.TotalInput(dbtx, tx)
+	if err != nil {
+		return nil, err
+	}
+	var feeRate fnoutil.Amount
+	if totalInput != 0 {
+		var totalOutput int64
+		for _, out := range tx.TxOut {
+			totalOutput += out.Value
+		}
+		fee := totalInput - fnoutil.Amount(totalOutput)
+		feeRate = fee * 1000 / fnoutil.Amount(tx.SerializeSize())
+	}
+
+	spendNs, err := dbtx.CreateTopLevelBucket(wspendpolicyNamespaceKey)
+	if err != nil {
+		return nil, err
+	}
+	for _, acct := range accounts {
+		p := w.spendPolicies[acct]
+		if len(p.Destinations) != 0 {
+			for _, a := range external[acct] {
+				if !containsAddress(p.Destinations, a) {
+					return nil, errors.E(errors.Policy, errors.Errorf(
+						"account %d may not pay %v", acct, a))
+				}
+			}
+		}
+		if p.MaxFeeRate != 0 && totalInput == 0 {
+			return nil, errors.E(errors.Policy, errors.Errorf("fee rate of "+
+				"account %d spend can not be checked: transaction spends "+
+				"unknown outputs", acct))
+		}
+		if p.MaxFeeRate != 0 && feeRate > p.MaxFeeRate {
+			return nil, errors.E(errors.Policy, errors.Errorf("fee rate %v/kB "+
+				"exceeds the maximum %v/kB of account %d", feeRate,
+				p.MaxFeeRate, acct))
+		}
+		if p.DailyLimit != 0 {
+			total := spent[acct] + spentSince(spendNs, acct, now.Add(-spendLimitPeriod))
+			if total > p.DailyLimit {
+				return nil, errors.E(errors.Policy, errors.Errorf("spending %v "+
+					"would bring the total spent by account %d in 24 hours "+
+					"to %v, exceeding the daily limit %v", spent[acct], acct,
+					total, p.DailyLimit))
+			}
+		}
+	}
+
+	if !approved {
+		for _, acct := range accounts {
+			p := w.spendPolicies[acct]
+			if p.ApprovalThreshold == 0 || spent[acct] < p.ApprovalThreshold {
+				continue
+			}
+			held := &PendingTransaction{
+				Tx:       tx,
+				Accounts: accounts,
+				Amount:   spentTotal,
+				Created:  now,
+			}
+			err := putPendingTx(dbtx, held)
+			if err != nil {
+				return nil, err
+			}
+			return nil, &heldTxError{tx: held}
+		}
+	}
+
+	r := &spendReservation{w: w}
+	for _, acct := range accounts {
+		if w.spendPolicies[acct].DailyLimit == 0 {
+			continue
+		}
+		err := pruneSpends(spendNs, acct, now.Add(-spendLimitPeriod))
+		if err != nil {
+			return nil, err
+		}
+		var v [8]byte
+		binary.BigEndian.PutUint64(v[:], uint64(spent[acct]))
+		k := spendKey(acct, now, &txHash)
+		err = spendNs.Put(k, v[:])
+		if err != nil {
+			return nil, err
+		}
+		r.keys = append(r.keys, k)
+	}
+	return r, nil
+}
+
+func containsAddress(addrs []fnoutil.Address, a fnoutil.Address) bool {
+	if a == nil {
+		return false
+	}
+	for _, allowed := range addrs {
+		if allowed.EncodeAddress() == a.EncodeAddress() {
+			return true
+		}
+	}
+	return false
+}
+
+func spendKey(account uint32, t time.Time, txHash *chainhash.Hash) []byte {
+	k := make([]byte, 4+8+chainhash.HashSize)
+	binary.BigEndian.PutUint32(k, account)
+	binary.BigEndian.PutUint64(k[4:], uint64(t.UnixNano()))
+	copy(k[12:], txHash[:])
+	return k
+}
+
+// spentSince sums the amounts spent by an account after a time.
+func spentSince(ns walletdb.ReadBucket, account uint32, since time.Time) fnoutil.Amount {
+	if ns == nil {
+		return 0
+	}
+	var prefix [4]byte
+	binary.BigEndian.PutUint32(prefix[:], account)
+	start := spendKey(account, since, &chainhash.Hash{})
+	var total fnoutil.Amount
+	c := ns.ReadCursor()
+	defer c.Close()
+	for k, v := c.Seek(start); k != nil && bytes.HasPrefix(k, prefix[:]); k, v = c.Next() {
+		if len(v) == 8 {
+			total += fnoutil.Amount(binary.BigEndian.Uint64(v))
+		}
+	}
+	return total
+}
+
+// pruneSpends removes the spends of an account recorded before a time.
+func pruneSpends(ns walletdb.ReadWriteBucket, account uint32, before time.Time) error {
+	var prefix [4]byte
+	binary.BigEndian.PutUint32(prefix[:], account)
+	end := spendKey(account, before, &chainhash.Hash{})
+	var stale [][]byte
+	c := ns.ReadCursor()
+	for k, _ := c.Seek(prefix[:]); k != nil && bytes.Compare(k, end) < 0; k, _ = c.Next() {
+		stale = append(stale, append([]byte(nil), k...))
+	}
+	c.Close()
+	for _, k := range stale {
+		if err := ns.Delete(k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// putPendingTx records a held transaction.
+func putPendingTx(dbtx walletdb.ReadWriteTx, p *PendingTransaction) error {
+	ns, err := dbtx.CreateTopLevelBucket(wpendingtxNamespaceKey)
+	if err != nil {
+		return err
+	}
+	serializedTx, err := p.Tx.Bytes()
+	if err != nil {
+		return err
+	}
+	v := make([]byte, 8+8+4+4*len(p.Accounts), 8+8+4+4*len(p.Accounts)+len(serializedTx))
+	binary.BigEndian.PutUint64(v, uint64(p.Created.UnixNano()))
+	binary.BigEndian.PutUint64(v[8:], uint64(p.Amount))
+	binary.BigEndian.PutUint32(v[16:], uint32(len(p.Accounts)))
+	for i, acct := range p.Accounts {
+		binary.BigEndian.PutUint32(v[20+4*i:], acct)
+	}
+	v = append(v, serializedTx...)
+	txHash := p.Tx.TxHash()
+	return ns.Put(txHash[:], v)
+}
+
+// removePendingTx removes and returns a held transaction.
+func removePendingTx(dbtx walletdb.ReadWriteTx, txHash *chainhash.Hash) (*PendingTransaction, error) {
+	ns := dbtx.ReadWriteBucket(wpendingtxNamespaceKey)
+	var v []byte
+	if ns != nil {
+		v = ns.Get(txHash[:])
+	}
+	if v == nil {
+		return nil, errors.E(errors.NotExist, "no pending transaction")
+	}
+	p, err := deserializePendingTx(v)
+	if err != nil {
+		return nil, err
+	}
+	return p, ns.Delete(txHash[:])
+}
+
+func deserializePendingTx(v []byte) (*PendingTransaction, error) {
+	if len(v) < 20 {
+		return nil, errors.E(errors.IO, "short pending transaction record")
+	}
+	n := int(binary.BigEndian.Uint32(v[16:]))
+	if len(v) < 20+4*n {
+		return nil, errors.E(errors.IO, "short pending transaction record")
+	}
+	p := &PendingTransaction{
+		Tx:       new(wire.MsgTx),
+		Accounts: make([]uint32, n),
+		Amount:   fnoutil.Amount(binary.BigEndian.Uint64(v[8:])),
+		Created:  time.Unix(0, int64(binary.BigEndian.Uint64(v))),
+	}
+	for i := range p.Accounts {
+		p.Accounts[i] = binary.BigEndian.Uint32(v[20+4*i:])
+	}
+	err := p.Tx.Deserialize(bytes.NewReader(v[20+4*n:]))
+	if err != nil {
+		return nil, errors.E(errors.IO, err)
+	}
+	return p, nil
+}
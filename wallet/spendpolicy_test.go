@@ -0,0 +1,312 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fonero-project/fnod/chaincfg/chainhash"
+	"github.com/fonero-project/fnod/fnoec"
+	"github.com/fonero-project/fnod/fnoutil"
+	"github.com/fonero-project/fnod/txscript"
+	"github.com/fonero-project/fnod/wire"
+	"github.com/fonero-project/fnowallet/errors"
+	"github.com/fonero-project/fnowallet/wallet/udb"
+	"github.com/fonero-project/fnowallet/wallet/walletdb"
+)
+
+// spendPolicyTest funds an account of a test wallet and creates transactions
+// spending the funded output.
+type spendPolicyTest struct {
+	t        *testing.T
+	w        *Wallet
+	funding  wire.OutPoint
+	change   []byte
+	external fnoutil.Address
+}
+
+func newSpendPolicyTest(t *testing.T, w *Wallet, account uint32) *spendPolicyTest {
+	addr, err := w.NewExternalAddress(account)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fundingTx := wire.NewMsgTx()
+	fundingTx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: chainhash.Hash{1}}, 10e8, nil))
+	fundingTx.AddTxOut(wire.NewTxOut(10e8, pkScript))
+	err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		rec, err := udb.NewTxRecordFromMsgTx(fundingTx, time.Now())
+		if err != nil {
+			return err
+		}
+		_, err = w.processTransactionRecord(dbtx, rec, nil, nil)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	changeAddr, err := w.NewInternalAddress(account)
+	if err != nil {
+		t.Fatal(err)
+	}
+	change, err := txscript.PayToAddrScript(changeAddr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	external, err := fnoutil.NewAddressPubKeyHash(make([]byte, 20),
+		w.chainParams, fnoec.STEcdsaSecp256k1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &spendPolicyTest{
+		t:        t,
+		w:        w,
+		funding:  wire.OutPoint{Hash: fundingTx.TxHash()},
+		change:   change,
+		external: external,
+	}
+}
+
+// spend creates a transaction paying amount to an address outside of the
+// wallet, with a fee of 0.0001 coins, and returning the change to the wallet.
+func (s *spendPolicyTest) spend(to fnoutil.Address, amount fnoutil.Amount) *wire.MsgTx {
+	pkScript, err := txscript.PayToAddrScript(to)
+	if err != nil {
+		s.t.Fatal(err)
+	}
+	tx := wire.NewMsgTx()
+	tx.AddTxIn(wire.NewTxIn(&s.funding, 10e8, nil))
+	tx.AddTxOut(wire.NewTxOut(int64(amount), pkScript))
+	tx.AddTxOut(wire.NewTxOut(int64(10e8-amount-1e4), s.change))
+	return tx
+}
+
+func (s *spendPolicyTest) reserve(tx *wire.MsgTx) error {
+	return walletdb.Update(s.w.db, func(dbtx walletdb.ReadWriteTx) error {
+		_, err := s.w.reserveSpendTx(dbtx, tx, false)
+		return err
+	})
+}
+
+func TestSpendPolicy(t *testing.T) {
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+	s := newSpendPolicyTest(t, w, 0)
+
+	allowed, err := fnoutil.NewAddressPubKeyHash(make([]byte, 20),
+		w.chainParams, fnoec.STEcdsaSecp256k1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := fnoutil.NewAddressPubKeyHash(append(make([]byte, 19), 1),
+		w.chainParams, fnoec.STEcdsaSecp256k1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w.SetSpendPolicy(0, &SpendPolicy{Destinations: []fnoutil.Address{allowed}})
+	if err := s.reserve(s.spend(allowed, 1e8)); err != nil {
+		t.Errorf("paying allowed destination: %v", err)
+	}
+	if err := s.reserve(s.spend(other, 1e8)); !errors.Is(errors.Policy, err) {
+		t.Errorf("paying other destination: expected Policy error, got %v", err)
+	}
+
+	// The daily limit error reports the total spent including the new
+	// transaction.
+	w.SetSpendPolicy(0, &SpendPolicy{DailyLimit: 3e8})
+	if err := s.reserve(s.spend(s.external, 2e8)); err != nil {
+		t.Fatalf("spending within the daily limit: %v", err)
+	}
+	err = s.reserve(s.spend(s.external, 2e8+1))
+	if !errors.Is(errors.Policy, err) {
+		t.Fatalf("exceeding the daily limit: expected Policy error, got %v", err)
+	}
+	if total := fnoutil.Amount(4e8 + 1).String(); !strings.Contains(err.Error(), total) {
+		t.Errorf("daily limit error %q does not report the total %v", err, total)
+	}
+
+	// Fee rates of transactions spending unknown outputs can not be checked.
+	w.SetSpendPolicy(0, &SpendPolicy{MaxFeeRate: 1e8})
+	tx := s.spend(s.external, 1e8)
+	if err := s.reserve(tx); err != nil {
+		t.Errorf("spending within the maximum fee rate: %v", err)
+	}
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: chainhash.Hash{2}}, 1e8, nil))
+	if err := s.reserve(tx); !errors.Is(errors.Policy, err) {
+		t.Errorf("spending unknown output: expected Policy error, got %v", err)
+	}
+}
+
+func TestHeldTransactions(t *testing.T) {
+	const salt = "salt"
+	mac := hmac.New(sha256.New, []byte(salt))
+	mac.Write([]byte("approve"))
+	policy := &SpendPolicy{
+		ApprovalThreshold: 1e8,
+		ApprovalSalt:      salt,
+		ApprovalHash:      mac.Sum(nil),
+	}
+	cfg := basicWalletConfig
+	cfg.SpendPolicies = map[string]*SpendPolicy{"default": policy}
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+	s := newSpendPolicyTest(t, w, 0)
+
+	held := s.spend(s.external, 2e8)
+	heldHash := held.TxHash()
+	_, err := w.reserveSpend(held, false)
+	if !errors.Is(errors.Policy, err) {
+		t.Fatalf("expected Policy error holding transaction, got %v", err)
+	}
+	if !w.LockedOutpoint(s.funding) {
+		t.Errorf("output spent by held transaction is not locked")
+	}
+
+	// Held transactions and their locked outputs persist after the wallet
+	// is reopened.
+	w2, err := Open(&cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pending, err := w2.PendingTransactions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) != 1 || pending[0].Tx.TxHash() != heldHash ||
+		pending[0].Amount != 2e8 || len(pending[0].Accounts) != 1 {
+		t.Fatalf("reopened wallet has pending transactions %+v", pending)
+	}
+	if !w2.LockedOutpoint(s.funding) {
+		t.Errorf("output spent by held transaction is not locked after reopening")
+	}
+
+	err = w2.ApprovePendingTransaction(&heldHash, []byte("wrong"), nil)
+	if !errors.Is(errors.Passphrase, err) {
+		t.Errorf("expected Passphrase error approving with wrong passphrase, got %v", err)
+	}
+	if !w2.isPendingTransaction(&heldHash) {
+		t.Errorf("transaction is not held after a failed approval")
+	}
+	if err := w2.RejectPendingTransaction(&heldHash); err != nil {
+		t.Fatal(err)
+	}
+	if w2.isPendingTransaction(&heldHash) || w2.LockedOutpoint(s.funding) {
+		t.Errorf("rejected transaction is held or its output is locked")
+	}
+	err = w2.RejectPendingTransaction(&heldHash)
+	if !errors.Is(errors.NotExist, err) {
+		t.Errorf("expected NotExist error rejecting twice, got %v", err)
+	}
+
+	// Transactions below the threshold are not held.
+	if err := s.reserve(s.spend(s.external, 1e8-1)); err != nil {
+		t.Errorf("spending below the approval threshold: %v", err)
+	}
+}
+
+func TestNamedSpendPolicies(t *testing.T) {
+	policy := &SpendPolicy{DailyLimit: 1e8}
+	cfg := basicWalletConfig
+	cfg.SpendPolicies = map[string]*SpendPolicy{
+		"default": policy,
+		"shop":    policy,
+	}
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	if w.SpendPolicy(0) != policy {
+		t.Errorf("policy of the default account was not applied")
+	}
+
+	lock := make(chan time.Time)
+	defer close(lock)
+	if err := w.Unlock([]byte("private"), lock); err != nil {
+		t.Fatal(err)
+	}
+	other, err := w.NextAccount("other")
+	if err != nil {
+		t.Fatal(err)
+	}
+	shop, err := w.NextAccount("shop")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w.SpendPolicy(other) != nil {
+		t.Errorf("account without a policy is restricted")
+	}
+	if w.SpendPolicy(shop) != policy {
+		t.Errorf("policy of an account created after opening was not applied")
+	}
+	if err := w.RenameAccount(shop, "renamed"); err != nil {
+		t.Fatal(err)
+	}
+	if w.SpendPolicy(shop) != policy {
+		t.Errorf("policy of a renamed account was removed")
+	}
+
+	s := newSpendPolicyTest(t, w, shop)
+	if err := s.reserve(s.spend(s.external, 2e8)); !errors.Is(errors.Policy, err) {
+		t.Errorf("spend from renamed account: expected Policy error, got %v", err)
+	}
+}
+
+func TestSpendPolicyAccountTransfer(t *testing.T) {
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	lock := make(chan time.Time)
+	defer close(lock)
+	if err := w.Unlock([]byte("private"), lock); err != nil {
+		t.Fatal(err)
+	}
+	other, err := w.NextAccount("other")
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherAddr, err := w.NewExternalAddress(other)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sameAddr, err := w.NewExternalAddress(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := newSpendPolicyTest(t, w, 0)
+
+	// Paying the restricted account itself is not counted.
+	w.SetSpendPolicy(0, &SpendPolicy{DailyLimit: 1e8})
+	if err := s.reserve(s.spend(sameAddr, 5e8)); err != nil {
+		t.Errorf("paying the restricted account: %v", err)
+	}
+
+	// Paying an unrestricted account of the same wallet is counted against
+	// every limit of the restricted account.
+	if err := s.reserve(s.spend(otherAddr, 2e8)); !errors.Is(errors.Policy, err) {
+		t.Errorf("transfer exceeding the daily limit: expected Policy error, got %v", err)
+	}
+	w.SetSpendPolicy(0, &SpendPolicy{Destinations: []fnoutil.Address{s.external}})
+	if err := s.reserve(s.spend(otherAddr, 1e8)); !errors.Is(errors.Policy, err) {
+		t.Errorf("transfer to unlisted destination: expected Policy error, got %v", err)
+	}
+	w.SetSpendPolicy(0, &SpendPolicy{
+		ApprovalThreshold: 1e8,
+		ApprovalSalt:      "salt",
+		ApprovalHash:      make([]byte, sha256.Size),
+	})
+	if err := s.reserve(s.spend(otherAddr, 1e8)); !errors.Is(errors.Policy, err) {
+		t.Errorf("transfer above the approval threshold: expected Policy error, got %v", err)
+	}
+}
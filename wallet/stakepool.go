@@ -5,10 +5,15 @@
 package wallet
 
 import (
+	"context"
+
+	"github.com/fonero-project/fnod/blockchain/stake"
+	"github.com/fonero-project/fnod/chaincfg"
+	"github.com/fonero-project/fnod/chaincfg/chainhash"
 	"github.com/fonero-project/fnod/fnoutil"
 	"github.com/fonero-project/fnowallet/errors"
-	"github.com/fonero-project/fnowallet/wallet/walletdb"
 	"github.com/fonero-project/fnowallet/wallet/udb"
+	"github.com/fonero-project/fnowallet/wallet/walletdb"
 )
 
 // StakePoolUserInfo returns the stake pool user information for a user
@@ -35,3 +40,245 @@ func (w *Wallet) StakePoolUserInfo(userAddress fnoutil.Address) (*udb.StakePoolU
 	}
 	return user, nil
 }
+
+// StakePoolFees describes the ticket activity and pool fees of one or more
+// stake pool users over a block range.
+type StakePoolFees struct {
+	// Tickets is the number of tickets mined in the block range.
+	Tickets int
+
+	// Voted and Revoked are the number of tickets spent by votes and
+	// revocations mined in the block range.
+	Voted   int
+	Revoked int
+
+	// Live is the number of tickets which are unspent as of the end of the
+	// block range and have not yet expired.  Expired is the number of
+	// tickets which are unspent and are past the ticket expiry, and must be
+	// revoked.  Tickets which were missed but not yet revoked are counted as
+	// live until they expire.
+	Live    int
+	Expired int
+
+	// Earned is the total of all fees paid to the pool by votes and
+	// revocations mined in the block range.
+	Earned fnoutil.Amount
+
+	// Outstanding is the total of all fees committed to the pool by
+	// tickets which remain unspent as of the end of the block range.
+	Outstanding fnoutil.Amount
+}
+
+func (f *StakePoolFees) add(other *StakePoolFees) {
+	f.Tickets += other.Tickets
+	f.Voted += other.Voted
+	f.Revoked += other.Revoked
+	f.Live += other.Live
+	f.Expired += other.Expired
+	f.Earned += other.Earned
+	f.Outstanding += other.Outstanding
+}
+
+// StakePoolUserFees is the fee accounting for a single stake pool user.
+type StakePoolUserFees struct {
+	User fnoutil.Address
+	StakePoolFees
+}
+
+// StakePoolFeeTotals is the fee accounting summed over every reported stake
+// pool user.
+type StakePoolFeeTotals struct {
+	Users       int
+	BeginHeight int32
+	EndHeight   int32
+	StakePoolFees
+}
+
+// poolFeeCommitment returns the pool fee committed to by the first commitment
+// output of a stake pool ticket.
+func poolFeeCommitment(ticket *udb.TxDetails) (fnoutil.Amount, error) {
+	if len(ticket.MsgTx.TxOut) < 2 {
+		return 0, errors.E(errors.Invalid, errors.Errorf("ticket %v has no commitment outputs", &ticket.Hash))
+	}
+	return stake.AmountFromSStxPkScrCommitment(ticket.MsgTx.TxOut[1].PkScript)
+}
+
+// poolFeePayout returns the amount paid to the pool's commitment by a vote or
+// revocation.  Votes pay the first commitment in the output following the
+// block reference and vote bits, while revocations pay it in the first output.
+func poolFeePayout(spender *udb.TxDetails) (fnoutil.Amount, bool) {
+	switch {
+	case spender.TxType == stake.TxTypeSSGen && len(spender.MsgTx.TxOut) > 2:
+		return fnoutil.Amount(spender.MsgTx.TxOut[2].Value), true
+	case spender.TxType == stake.TxTypeSSRtx && len(spender.MsgTx.TxOut) > 0:
+		return fnoutil.Amount(spender.MsgTx.TxOut[0].Value), true
+	default:
+		return 0, false
+	}
+}
+
+// stakePoolUserFees performs the fee accounting for the tickets of a single
+// stake pool user between the begin and end heights, inclusive.  Transactions
+// are looked up with txDetails, which must return an error with kind NotExist
+// for unrecorded transactions.
+func stakePoolUserFees(params *chaincfg.Params, txDetails func(*chainhash.Hash) (*udb.TxDetails, error),
+	tickets []*udb.PoolTicket, begin, end int32) (*StakePoolFees, error) {
+
+	fees := new(StakePoolFees)
+	for _, t := range tickets {
+		ticket, err := txDetails(&t.Ticket)
+		if err != nil {
+			return nil, err
+		}
+
+		// The ticket height recorded by the stake manager is not
+		// guaranteed to be correct, so prefer the height of the block
+		// recorded by the transaction store.
+		ticketHeight := int32(t.HeightTicket)
+		if ticket.Block.Height != -1 {
+			ticketHeight = ticket.Block.Height
+		}
+		if ticketHeight > end {
+			continue
+		}
+		if ticketHeight >= begin {
+			fees.Tickets++
+		}
+
+		commitment, err := poolFeeCommitment(ticket)
+		if err != nil {
+			return nil, err
+		}
+
+		spentHeight := int32(t.HeightSpent)
+		if t.Status == udb.TSImmatureOrLive || spentHeight > end {
+			fees.Outstanding += commitment
+			if ticketExpired(params, ticketHeight, end) {
+				fees.Expired++
+			} else {
+				fees.Live++
+			}
+			continue
+		}
+		if spentHeight < begin {
+			continue
+		}
+
+		switch t.Status {
+		case udb.TSVoted:
+			fees.Voted++
+		case udb.TSMissed:
+			fees.Revoked++
+		}
+
+		// Use the exact amount paid to the pool when the spending
+		// transaction is recorded, and the committed amount otherwise.
+		payout := commitment
+		spender, err := txDetails(&t.SpentBy)
+		switch {
+		case err == nil:
+			if amount, ok := poolFeePayout(spender); ok {
+				payout = amount
+			}
+		case !errors.Is(errors.NotExist, err):
+			return nil, err
+		}
+		fees.Earned += payout
+	}
+	return fees, nil
+}
+
+// StakePoolFeeReport performs fee accounting for stake pool users between the
+// begin and end block heights, inclusive.  An end height of zero, or any height
+// beyond the main chain tip, reports through the main chain tip.  If users is
+// empty, every stake pool user is reported.  The function f is called with the
+// fees of each user, and iteration stops early if it returns an error or the
+// context is cancelled.  The totals of all reported users are returned.
+//
+// Fees are computed in a single database transaction, but f is called only
+// after the transaction has ended, so slow callers do not hold the database
+// open.
+func (w *Wallet) StakePoolFeeReport(ctx context.Context, begin, end int32, users []fnoutil.Address,
+	f func(*StakePoolUserFees) error) (*StakePoolFeeTotals, error) {
+
+	const op errors.Op = "wallet.StakePoolFeeReport"
+
+	if begin < 0 {
+		return nil, errors.E(op, errors.Invalid, "begin height must be non-negative")
+	}
+	var filter map[[20]byte]struct{}
+	if len(users) != 0 {
+		filter = make(map[[20]byte]struct{}, len(users))
+		for _, user := range users {
+			switch user.(type) {
+			case *fnoutil.AddressPubKeyHash: // ok
+			case *fnoutil.AddressScriptHash: // ok
+			default:
+				return nil, errors.E(op, errors.Invalid, "address must be P2PKH or P2SH")
+			}
+			var h [20]byte
+			copy(h[:], user.ScriptAddress())
+			filter[h] = struct{}{}
+		}
+	}
+
+	totals := new(StakePoolFeeTotals)
+	var userFees []*StakePoolUserFees
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		stakemgrNs := dbtx.ReadBucket(wstakemgrNamespaceKey)
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+
+		_, tipHeight := w.TxStore.MainChainTip(txmgrNs)
+		if end == 0 || end > tipHeight {
+			end = tipHeight
+		}
+		if begin > end {
+			return errors.E(errors.Invalid, "begin height is beyond the end height")
+		}
+		totals.BeginHeight = begin
+		totals.EndHeight = end
+		txDetails := func(hash *chainhash.Hash) (*udb.TxDetails, error) {
+			return w.TxStore.TxDetails(txmgrNs, hash)
+		}
+
+		return w.StakeMgr.ForEachStakePoolUser(stakemgrNs, func(user fnoutil.Address, tickets []*udb.PoolTicket) error {
+			if filter != nil {
+				var h [20]byte
+				copy(h[:], user.ScriptAddress())
+				if _, ok := filter[h]; !ok {
+					return nil
+				}
+			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			default:
+			}
+
+			fees, err := stakePoolUserFees(w.chainParams, txDetails, tickets, begin, end)
+			if err != nil {
+				return err
+			}
+			totals.Users++
+			totals.add(fees)
+			userFees = append(userFees, &StakePoolUserFees{User: user, StakePoolFees: *fees})
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	for _, fees := range userFees {
+		select {
+		case <-ctx.Done():
+			return nil, errors.E(op, ctx.Err())
+		default:
+		}
+		err := f(fees)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+	}
+	return totals, nil
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"testing"
+
+	"github.com/fonero-project/fnod/blockchain/stake"
+	"github.com/fonero-project/fnod/chaincfg"
+	"github.com/fonero-project/fnod/chaincfg/chainhash"
+	"github.com/fonero-project/fnod/fnoutil"
+	"github.com/fonero-project/fnod/txscript"
+	"github.com/fonero-project/fnod/wire"
+	"github.com/fonero-project/fnowallet/errors"
+	"github.com/fonero-project/fnowallet/wallet/udb"
+)
+
+func TestStakePoolUserFees(t *testing.T) {
+	t.Parallel()
+	params := &chaincfg.SimNetParams
+	poolAddr, err := fnoutil.NewAddressPubKeyHash(make([]byte, 20), params, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	commitment, err := txscript.GenerateSStxAddrPush(poolAddr, 4e6, defaultTicketFeeLimits)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	txs := make(map[chainhash.Hash]*udb.TxDetails)
+	ticket := func(n byte, height int32) chainhash.Hash {
+		tx := &udb.TxDetails{}
+		tx.Hash = chainhash.Hash{n}
+		tx.TxType = stake.TxTypeSStx
+		tx.MsgTx.AddTxOut(wire.NewTxOut(1e8, nil))
+		tx.MsgTx.AddTxOut(wire.NewTxOut(0, commitment))
+		tx.Block.Height = height
+		txs[tx.Hash] = tx
+		return tx.Hash
+	}
+	spender := func(n byte, txType stake.TxType, payout int64) chainhash.Hash {
+		tx := &udb.TxDetails{}
+		tx.Hash = chainhash.Hash{n}
+		tx.TxType = txType
+		if txType == stake.TxTypeSSGen {
+			tx.MsgTx.AddTxOut(wire.NewTxOut(0, nil))
+			tx.MsgTx.AddTxOut(wire.NewTxOut(0, nil))
+		}
+		tx.MsgTx.AddTxOut(wire.NewTxOut(payout, nil))
+		txs[tx.Hash] = tx
+		return tx.Hash
+	}
+	txDetails := func(hash *chainhash.Hash) (*udb.TxDetails, error) {
+		tx, ok := txs[*hash]
+		if !ok {
+			return nil, errors.E(errors.NotExist)
+		}
+		return tx, nil
+	}
+
+	expiry := int32(params.TicketMaturity) + int32(params.TicketExpiry)
+	tickets := []*udb.PoolTicket{
+		// Voted in range, paying more than the commitment.
+		{Ticket: ticket(1, 10), Status: udb.TSVoted, HeightSpent: 20, SpentBy: spender(101, stake.TxTypeSSGen, 5e6)},
+		// Revoked in range with the revocation unrecorded.
+		{Ticket: ticket(2, 5), Status: udb.TSMissed, HeightSpent: 30, SpentBy: chainhash.Hash{102}},
+		// Live.
+		{Ticket: ticket(3, 15), Status: udb.TSImmatureOrLive},
+		// Voted after the end of the range.
+		{Ticket: ticket(4, 40), Status: udb.TSVoted, HeightSpent: 150, SpentBy: spender(104, stake.TxTypeSSGen, 5e6)},
+		// Mined after the end of the range.
+		{Ticket: ticket(5, 120), Status: udb.TSImmatureOrLive},
+		// Voted before the beginning of the range.
+		{Ticket: ticket(6, 1), Status: udb.TSVoted, HeightSpent: 2, SpentBy: spender(106, stake.TxTypeSSGen, 5e6)},
+		// Revoked in range with a recorded revocation.
+		{Ticket: ticket(7, 8), Status: udb.TSMissed, HeightSpent: 50, SpentBy: spender(107, stake.TxTypeSSRtx, 3e6)},
+	}
+
+	fees, err := stakePoolUserFees(params, txDetails, tickets, 3, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := StakePoolFees{
+		Tickets:     5,
+		Voted:       1,
+		Revoked:     2,
+		Live:        2,
+		Earned:      5e6 + 4e6 + 3e6,
+		Outstanding: 2 * 4e6,
+	}
+	if *fees != want {
+		t.Errorf("fees %+v, expected %+v", *fees, want)
+	}
+
+	// Unspent tickets are expired once the end of the range is past the
+	// ticket expiry.
+	fees, err = stakePoolUserFees(params, txDetails, tickets[2:3], 0, 15+expiry+1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = StakePoolFees{Tickets: 1, Expired: 1, Outstanding: 4e6}
+	if *fees != want {
+		t.Errorf("fees %+v, expected %+v", *fees, want)
+	}
+
+	// Missing tickets are an error.
+	missing := []*udb.PoolTicket{{Ticket: chainhash.Hash{200}, Status: udb.TSImmatureOrLive}}
+	_, err = stakePoolUserFees(params, txDetails, missing, 0, 100)
+	if !errors.Is(errors.NotExist, err) {
+		t.Errorf("missing ticket returned error %v, expected NotExist", err)
+	}
+}
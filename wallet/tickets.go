@@ -506,3 +506,276 @@ func (w *Wallet) RevokeExpiredTickets(ctx context.Context, p Peer) (err error) {
 
 	return nil
 }
+
+// RevocationReason describes why a ticket is able to be revoked.
+type RevocationReason int
+
+// Revocation reasons.
+const (
+	RevocationReasonMissed RevocationReason = iota
+	RevocationReasonExpired
+)
+
+func (r RevocationReason) String() string {
+	switch r {
+	case RevocationReasonMissed:
+		return "missed"
+	case RevocationReasonExpired:
+		return "expired"
+	default:
+		return "unknown"
+	}
+}
+
+// RevocationPolicy controls the revocations created by RevokeTicketsWithPolicy.
+type RevocationPolicy struct {
+	// Fee rate (per kB) paid by each revocation.  The wallet's relay fee is
+	// used when zero.
+	FeeRate fnoutil.Amount
+
+	// Maximum fee paid by a single revocation.  Revocations that would pay
+	// more than this are not created.  Zero disables the ceiling.
+	MaxFee fnoutil.Amount
+
+	// Maximum number of revocations published at once.  Remaining revocable
+	// tickets are left for a later call.  Zero disables the limit.
+	BatchSize int
+}
+
+// RevocationResult describes the outcome of revoking a single ticket.  Err is
+// non-nil when the ticket was not revoked or the revocation could not be
+// published.
+type RevocationResult struct {
+	Ticket     chainhash.Hash
+	Reason     RevocationReason
+	Revocation *chainhash.Hash // nil if no revocation was created
+	Fee        fnoutil.Amount
+	Err        error
+}
+
+type revocableTicket struct {
+	hash   chainhash.Hash
+	reason RevocationReason
+}
+
+// revocableTickets returns all unspent tickets that are past expiry, and all
+// unspent tickets that were missed.  Missed tickets are reported by the network
+// backend when it implements MissedTicketsChecker, and are otherwise found from
+// block headers and votes.
+func (w *Wallet) revocableTickets(ctx context.Context, n NetworkBackend) ([]revocableTicket, int32, error) {
+	var revocable []revocableTicket
+	var live []liveTicket
+	var tipHeight int32
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		_, tipHeight = w.TxStore.MainChainTip(ns)
+
+		it := w.TxStore.IterateTickets(dbtx)
+		defer it.Close()
+		for it.Next() {
+			// Spent tickets are excluded
+			if it.SpenderHash != (chainhash.Hash{}) {
+				continue
+			}
+
+			switch {
+			case ticketExpired(w.chainParams, it.Block.Height, tipHeight):
+				revocable = append(revocable, revocableTicket{
+					hash:   it.TxRecord.Hash,
+					reason: RevocationReasonExpired,
+				})
+			case ticketMatured(w.chainParams, it.Block.Height, tipHeight):
+				live = append(live, liveTicket{
+					hash:   it.TxRecord.Hash,
+					height: it.Block.Height,
+				})
+			}
+		}
+		return it.Err()
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(live) == 0 {
+		return revocable, tipHeight, nil
+	}
+
+	if c, ok := n.(MissedTicketsChecker); ok {
+		hashes := make([]*chainhash.Hash, len(live))
+		for i := range live {
+			hashes[i] = &live[i].hash
+		}
+		missed, err := c.ExistsMissedTickets(ctx, hashes)
+		if err != nil {
+			return nil, 0, err
+		}
+		for i := range live {
+			if missed.Get(i) {
+				revocable = append(revocable, revocableTicket{
+					hash:   live[i].hash,
+					reason: RevocationReasonMissed,
+				})
+			}
+		}
+		return revocable, tipHeight, nil
+	}
+
+	missed, err := w.missedTicketsFromBlocks(ctx, n, live)
+	if err != nil {
+		return nil, 0, err
+	}
+	for i := range live {
+		if _, ok := missed[live[i].hash]; ok {
+			revocable = append(revocable, revocableTicket{
+				hash:   live[i].hash,
+				reason: RevocationReasonMissed,
+			})
+		}
+	}
+	return revocable, tipHeight, nil
+}
+
+// RevokeTicketsWithPolicy revokes all unspent tickets with voting authority
+// controlled by the wallet that are either expired or missed.  Expired tickets
+// are detected from the main chain recorded by the wallet.  Missed tickets are
+// reported by backends implementing MissedTicketsChecker, and with other
+// backends, are found by checking the ticket lottery commitments of main chain
+// headers against the votes of blocks fetched from the backend.
+//
+// Revocations are created with the fee rate of the policy, and revocations
+// paying more than the policy's fee ceiling are skipped.  At most BatchSize
+// revocations are published.  The outcome of each attempted revocation is
+// returned and delivered to RevocationNotifications clients.  The wallet must
+// be unlocked to sign the revocations.
+func (w *Wallet) RevokeTicketsWithPolicy(ctx context.Context, n NetworkBackend, policy *RevocationPolicy) ([]RevocationResult, error) {
+	const op errors.Op = "wallet.RevokeTicketsWithPolicy"
+
+	revocable, tipHeight, err := w.revocableTickets(ctx, n)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if len(revocable) == 0 {
+		return nil, nil
+	}
+
+	feePerKb := policy.FeeRate
+	if feePerKb == 0 {
+		feePerKb = w.RelayFee()
+	}
+
+	var results []RevocationResult
+	var revocations []*wire.MsgTx
+	err = walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		for i := range revocable {
+			if policy.BatchSize > 0 && len(revocations) >= policy.BatchSize {
+				break
+			}
+
+			ticketHash := &revocable[i].hash
+			ticketPurchase, err := w.TxStore.Tx(txmgrNs, ticketHash)
+			if err != nil {
+				return err
+			}
+
+			// Don't create revocations when this wallet doesn't have voting
+			// authority.
+			owned, err := w.hasVotingAuthority(addrmgrNs, ticketPurchase)
+			if err != nil {
+				return err
+			}
+			if !owned {
+				continue
+			}
+
+			result := RevocationResult{
+				Ticket: *ticketHash,
+				Reason: revocable[i].reason,
+			}
+			revocation, err := createUnsignedRevocation(ticketHash,
+				ticketPurchase, feePerKb)
+			if err != nil {
+				result.Err = err
+				results = append(results, result)
+				continue
+			}
+			fee := ticketPurchase.TxOut[0].Value
+			for _, out := range revocation.TxOut {
+				fee -= out.Value
+			}
+			result.Fee = fnoutil.Amount(fee)
+			if policy.MaxFee > 0 && result.Fee > policy.MaxFee {
+				result.Err = errors.E(errors.Policy, errors.Errorf(
+					"revocation fee %v exceeds ceiling %v",
+					result.Fee, policy.MaxFee))
+				results = append(results, result)
+				continue
+			}
+			err = w.signRevocation(addrmgrNs, ticketPurchase, revocation)
+			if err != nil {
+				return err
+			}
+			revocationHash := revocation.TxHash()
+			result.Revocation = &revocationHash
+			results = append(results, result)
+			revocations = append(revocations, revocation)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	if len(revocations) != 0 {
+		var watchOutPoints []wire.OutPoint
+		err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+			for _, revocation := range revocations {
+				rec, err := udb.NewTxRecordFromMsgTx(revocation, time.Now())
+				if err != nil {
+					return err
+				}
+				watch, err := w.processTransactionRecord(dbtx, rec, nil, nil)
+				if err != nil {
+					return err
+				}
+				watchOutPoints = append(watchOutPoints, watch...)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+
+		// Revocations that fail to publish remain recorded as unmined
+		// transactions and will be published again with other unmined
+		// transactions.
+		err = n.PublishTransactions(ctx, revocations...)
+		for i := range results {
+			r := &results[i]
+			if r.Revocation == nil {
+				continue
+			}
+			if err != nil {
+				r.Err = err
+				continue
+			}
+			log.Infof("Revoked %v ticket %v with revocation %v", r.Reason,
+				&r.Ticket, r.Revocation)
+		}
+
+		if len(watchOutPoints) != 0 {
+			err := n.LoadTxFilter(ctx, false, nil, watchOutPoints)
+			if err != nil {
+				log.Errorf("Failed to watch outpoints: %v", err)
+			}
+		}
+	}
+
+	w.NtfnServer.notifyRevocations(&RevocationNotification{
+		Height:  tipHeight,
+		Results: results,
+	})
+
+	return results, nil
+}
@@ -0,0 +1,386 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"github.com/fonero-project/fnod/hdkeychain"
+	"github.com/fonero-project/fnowallet/errors"
+	"github.com/fonero-project/fnowallet/internal/zero"
+	"github.com/fonero-project/fnowallet/wallet/internal/snacl"
+	"github.com/fonero-project/fnowallet/wallet/walletdb"
+)
+
+// Accounts may encrypt their extended private key with their own passphrase
+// rather than the crypto private key unlocked by the wallet's private
+// passphrase.  Unlocking the manager does not unlock these accounts, and each
+// must be unlocked separately with UnlockAccount after the manager is unlocked.
+//
+// BIP0044 account keys are derived from the coin type key, which remains
+// encrypted with the crypto private key, so the keys of accounts with their own
+// passphrase are created from a new random seed instead.  These accounts are
+// not recoverable from the wallet's seed, and accounts derived from the seed
+// may not be given a passphrase.  Their keys must be backed up separately with
+// ExportAccountKey and restored with ImportAccountWithPassphrase.
+
+// encryptAccountKey encrypts a serialized account extended private key.  When
+// the passphrase is empty, the key is encrypted with the crypto private key and
+// the returned master key parameters are nil.  Otherwise, the key is encrypted
+// with a new master key derived from the passphrase, and the parameters of
+// that key are returned.  The crypto private key must be unlocked when the
+// passphrase is empty.
+func (m *Manager) encryptAccountKey(xpriv, passphrase []byte) (encrypted, params []byte, err error) {
+	if len(passphrase) == 0 {
+		encrypted, err = m.cryptoKeyPriv.Encrypt(xpriv)
+		if err != nil {
+			return nil, nil, errors.E(errors.Crypto, errors.Errorf("encrypt account privkey: %v", err))
+		}
+		return encrypted, nil, nil
+	}
+
+	masterKey, err := newSecretKey(&passphrase, &defaultScryptOptions)
+	if err != nil {
+		return nil, nil, errors.E(errors.Crypto, errors.Errorf("create account master key: %v", err))
+	}
+	defer masterKey.Zero()
+	encrypted, err = masterKey.Encrypt(xpriv)
+	if err != nil {
+		return nil, nil, errors.E(errors.Crypto, errors.Errorf("encrypt account privkey: %v", err))
+	}
+	return encrypted, masterKey.Marshal(), nil
+}
+
+// decryptAccountKey decrypts the extended private key of an account encrypted
+// with its own passphrase.
+func decryptAccountKey(acctInfo *accountInfo, passphrase []byte) ([]byte, error) {
+	secretKey := snacl.SecretKey{Key: &snacl.CryptoKey{}}
+	err := secretKey.Unmarshal(acctInfo.acctKeyParams)
+	if err != nil {
+		return nil, errors.E(errors.IO, err)
+	}
+	if err := secretKey.DeriveKey(&passphrase); err != nil {
+		return nil, err
+	}
+	defer secretKey.Zero()
+	decrypted, err := secretKey.Decrypt(acctInfo.acctKeyEncrypted)
+	if err != nil {
+		return nil, errors.E(errors.Crypto, errors.Errorf("decrypt account %q privkey: %v", acctInfo.acctName, err))
+	}
+	return decrypted, nil
+}
+
+// AccountHasPassphrase returns whether the account private key is encrypted
+// with its own passphrase.
+func (m *Manager) AccountHasPassphrase(ns walletdb.ReadBucket, account uint32) (bool, error) {
+	defer m.mtx.Unlock()
+	m.mtx.Lock()
+
+	acctInfo, err := m.loadAccountInfo(ns, account)
+	if err != nil {
+		return false, err
+	}
+	return acctInfo.acctKeyParams != nil, nil
+}
+
+// ExportAccountKey returns the extended private key of an account which is not
+// derived from the wallet's seed, so it may be backed up and restored with
+// ImportAccountWithPassphrase.  The key of an account with its own passphrase
+// is decrypted with that passphrase, which is ignored for other accounts.
+// Accounts derived from the seed may not be exported, as they are recovered
+// with the seed.  The key should be cleared by the caller when finished.  This
+// method requires the manager to be unlocked.
+func (m *Manager) ExportAccountKey(ns walletdb.ReadBucket, account uint32, passphrase []byte) (*hdkeychain.ExtendedKey, error) {
+	defer m.mtx.Unlock()
+	m.mtx.Lock()
+
+	if m.watchingOnly {
+		return nil, errors.E(errors.WatchingOnly)
+	}
+	if m.locked {
+		return nil, errors.E(errors.Locked)
+	}
+	if isReservedAccountNum(account) {
+		return nil, errors.E(errors.Invalid, "reserved account")
+	}
+
+	err := m.authorizeKey(&account, KeyUseExport, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	acctInfo, err := m.loadAccountInfo(ns, account)
+	if err != nil {
+		return nil, err
+	}
+	if acctInfo.acctKeyParams == nil {
+		derived, err := seedDerivedAccount(ns, m.cryptoKeyPriv, account, acctInfo)
+		if err != nil {
+			return nil, err
+		}
+		if derived {
+			return nil, errors.E(errors.Invalid, errors.Errorf("account %q is "+
+				"derived from the wallet seed", acctInfo.acctName))
+		}
+		if acctInfo.acctKeyPriv == nil {
+			return nil, errors.E(errors.Locked, errors.Errorf("account %q is locked", acctInfo.acctName))
+		}
+		// Return a copy so the cached key is not cleared by the caller.
+		return hdkeychain.NewKeyFromString(acctInfo.acctKeyPriv.String())
+	}
+	decrypted, err := decryptAccountKey(acctInfo, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	acctKeyPriv, err := hdkeychain.NewKeyFromString(string(decrypted))
+	zero.Bytes(decrypted)
+	if err != nil {
+		return nil, errors.E(errors.IO, err)
+	}
+	return acctKeyPriv, nil
+}
+
+// UnlockAccount decrypts the private key of an account encrypted with its own
+// passphrase.  The manager must already be unlocked, and the account remains
+// unlocked until it is locked with LockAccount or the manager is locked.
+func (m *Manager) UnlockAccount(ns walletdb.ReadBucket, account uint32, passphrase []byte) error {
+	return m.UnlockAccounts(ns, map[uint32][]byte{account: passphrase})
+}
+
+// UnlockAccounts unlocks several accounts as UnlockAccount does, keyed by
+// account number.  No account is unlocked unless every passphrase is correct.
+func (m *Manager) UnlockAccounts(ns walletdb.ReadBucket, passphrases map[uint32][]byte) error {
+	defer m.mtx.Unlock()
+	m.mtx.Lock()
+
+	if m.watchingOnly {
+		return errors.E(errors.WatchingOnly)
+	}
+	if m.locked {
+		return errors.E(errors.Locked)
+	}
+
+	keys := make(map[*accountInfo]*hdkeychain.ExtendedKey, len(passphrases))
+	zeroKeys := func() {
+		for _, k := range keys {
+			k.Zero()
+		}
+	}
+	for account, passphrase := range passphrases {
+		acctInfo, err := m.loadAccountInfo(ns, account)
+		if err != nil {
+			zeroKeys()
+			return err
+		}
+		if acctInfo.acctKeyParams == nil {
+			zeroKeys()
+			return errors.E(errors.Invalid, errors.Errorf("account %q has no passphrase", acctInfo.acctName))
+		}
+		decrypted, err := decryptAccountKey(acctInfo, passphrase)
+		if err != nil {
+			zeroKeys()
+			return err
+		}
+		acctKeyPriv, err := hdkeychain.NewKeyFromString(string(decrypted))
+		zero.Bytes(decrypted)
+		if err != nil {
+			zeroKeys()
+			return errors.E(errors.IO, err)
+		}
+		keys[acctInfo] = acctKeyPriv
+	}
+	for acctInfo, acctKeyPriv := range keys {
+		if acctInfo.acctKeyPriv != nil {
+			acctInfo.acctKeyPriv.Zero()
+		}
+		acctInfo.acctKeyPriv = acctKeyPriv
+	}
+	return nil
+}
+
+// LockAccount removes the private key of an account encrypted with its own
+// passphrase from memory.  Private keys previously returned for addresses of
+// the account are zeroed, and must be derived again by later calls to
+// PrivateKey.
+func (m *Manager) LockAccount(account uint32) error {
+	defer m.mtx.Unlock()
+	m.mtx.Lock()
+
+	acctInfo, ok := m.acctInfo[account]
+	if !ok || acctInfo.acctKeyParams == nil {
+		return errors.E(errors.Invalid, errors.Errorf("account %d has no passphrase", account))
+	}
+	if acctInfo.acctKeyPriv == nil {
+		return nil
+	}
+	acctInfo.acctKeyPriv.Zero()
+	acctInfo.acctKeyPriv = nil
+
+	m.returnedSecretsMu.Lock()
+	for hash160, acct := range m.returnedPrivKeyAccounts {
+		if acct != account {
+			continue
+		}
+		zero.BigInt(m.returnedPrivKeys[hash160].GetD())
+		delete(m.returnedPrivKeys, hash160)
+		delete(m.returnedPrivKeyAccounts, hash160)
+	}
+	m.returnedSecretsMu.Unlock()
+
+	return nil
+}
+
+// ChangeAccountPassphrase changes the passphrase encrypting the private key of
+// an account.  The old passphrase is the account passphrase when the account
+// has one, and the wallet's private passphrase otherwise.  An empty new
+// passphrase removes the account passphrase and encrypts the key with the
+// wallet's private passphrase again, which requires the manager to be
+// unlocked.  Accounts derived from the wallet's seed may not be given a
+// passphrase, as their keys are derivable with the wallet's private
+// passphrase.  The account is locked after its passphrase is changed.
+func (m *Manager) ChangeAccountPassphrase(ns walletdb.ReadWriteBucket, account uint32, oldPassphrase, newPassphrase []byte) error {
+	defer m.mtx.Unlock()
+	m.mtx.Lock()
+
+	if m.watchingOnly {
+		return errors.E(errors.WatchingOnly)
+	}
+	if isReservedAccountNum(account) {
+		return errors.E(errors.Invalid, "reserved account")
+	}
+	// The default account key is replaced when upgrading to the SLIP0044
+	// coin type, and is always encrypted with the crypto private key.
+	if account == DefaultAccountNum {
+		return errors.E(errors.Invalid, "default account may not have a passphrase")
+	}
+
+	acctInfo, err := m.loadAccountInfo(ns, account)
+	if err != nil {
+		return err
+	}
+	if acctInfo.acctKeyParams == nil && len(newPassphrase) == 0 {
+		return errors.E(errors.Invalid, errors.Errorf("account %q has no passphrase", acctInfo.acctName))
+	}
+	if len(newPassphrase) == 0 && m.locked {
+		return errors.E(errors.Locked, "removing an account passphrase requires an unlocked wallet")
+	}
+
+	// Decrypt the account private key using the old passphrase.  When the
+	// account has no passphrase of its own, the old passphrase is checked
+	// using a copy of the master private key to ensure the current state is
+	// not altered.
+	var decrypted []byte
+	if acctInfo.acctKeyParams != nil {
+		decrypted, err = decryptAccountKey(acctInfo, oldPassphrase)
+		if err != nil {
+			return err
+		}
+	} else {
+		secretKey := snacl.SecretKey{Key: &snacl.CryptoKey{}}
+		secretKey.Parameters = m.masterKeyPriv.Parameters
+		if err := secretKey.DeriveKey(&oldPassphrase); err != nil {
+			return err
+		}
+		decPriv, err := secretKey.Decrypt(m.cryptoKeyPrivEncrypted)
+		secretKey.Zero()
+		if err != nil {
+			return errors.E(errors.Crypto, errors.Errorf("decrypt crypto privkey: %v", err))
+		}
+		var cryptoKeyPriv cryptoKey
+		cryptoKeyPriv.CopyBytes(decPriv)
+		zero.Bytes(decPriv)
+		derived, err := seedDerivedAccount(ns, &cryptoKeyPriv, account, acctInfo)
+		if err != nil {
+			cryptoKeyPriv.Zero()
+			return err
+		}
+		if derived {
+			cryptoKeyPriv.Zero()
+			return errors.E(errors.Invalid, errors.Errorf("account %q is derived "+
+				"from the wallet seed and may not have a passphrase", acctInfo.acctName))
+		}
+		decrypted, err = cryptoKeyPriv.Decrypt(acctInfo.acctKeyEncrypted)
+		cryptoKeyPriv.Zero()
+		if err != nil {
+			return errors.E(errors.Crypto, errors.Errorf("decrypt account %q privkey: %v", acctInfo.acctName, err))
+		}
+	}
+	encrypted, params, err := m.encryptAccountKey(decrypted, newPassphrase)
+	zero.Bytes(decrypted)
+	if err != nil {
+		return err
+	}
+
+	row, err := fetchAccountInfo(ns, account, DBVersion)
+	if err != nil {
+		return err
+	}
+	row = bip0044AccountInfo(row.pubKeyEncrypted, encrypted,
+		0, 0, row.lastUsedExternalIndex, row.lastUsedInternalIndex,
+		row.lastReturnedExternalIndex, row.lastReturnedInternalIndex,
+		row.name, DBVersion)
+	err = putAccountRow(ns, account, &row.dbAccountRow)
+	if err != nil {
+		return err
+	}
+	err = putAccountPassphraseParams(ns, account, params)
+	if err != nil {
+		return err
+	}
+
+	// Update the cached account after the db writes succeed.  An account
+	// without a passphrase is unlocked with the manager, and accounts with a
+	// passphrase must be unlocked again with the new passphrase.
+	acctInfo.acctKeyEncrypted = encrypted
+	acctInfo.acctKeyParams = params
+	if acctInfo.acctKeyPriv != nil {
+		acctInfo.acctKeyPriv.Zero()
+		acctInfo.acctKeyPriv = nil
+	}
+	if params == nil {
+		decrypted, err := m.cryptoKeyPriv.Decrypt(encrypted)
+		if err != nil {
+			return errors.E(errors.Crypto, errors.Errorf("decrypt account %q privkey: %v", acctInfo.acctName, err))
+		}
+		acctInfo.acctKeyPriv, err = hdkeychain.NewKeyFromString(string(decrypted))
+		zero.Bytes(decrypted)
+		if err != nil {
+			return errors.E(errors.IO, err)
+		}
+	}
+	return nil
+}
+
+// seedDerivedAccount returns whether the extended key of an account is derived
+// from the wallet's cointype key, which is decrypted with cryptoKeyPriv.
+func seedDerivedAccount(ns walletdb.ReadBucket, cryptoKeyPriv EncryptorDecryptor, account uint32,
+	acctInfo *accountInfo) (bool, error) {
+
+	_, coinTypePrivEnc, err := fetchCoinTypeKeys(ns)
+	if err != nil {
+		return false, err
+	}
+	serializedKeyPriv, err := cryptoKeyPriv.Decrypt(coinTypePrivEnc)
+	if err != nil {
+		return false, errors.E(errors.Crypto, errors.Errorf("decrypt cointype privkey: %v", err))
+	}
+	coinTypeKeyPriv, err := hdkeychain.NewKeyFromString(string(serializedKeyPriv))
+	zero.Bytes(serializedKeyPriv)
+	if err != nil {
+		return false, errors.E(errors.IO, err)
+	}
+	acctKeyPriv, err := deriveAccountKey(coinTypeKeyPriv, account)
+	coinTypeKeyPriv.Zero()
+	if err == hdkeychain.ErrInvalidChild {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	acctKeyPub, err := acctKeyPriv.Neuter()
+	acctKeyPriv.Zero()
+	if err != nil {
+		return false, err
+	}
+	return acctKeyPub.String() == acctInfo.acctKeyPub.String(), nil
+}
@@ -151,6 +151,14 @@ var (
 	// e.g. last account number
 	metaBucketName = []byte("meta")
 
+	// acctPassBucketName is used to store the master key parameters of
+	// accounts whose private extended keys are encrypted with their own
+	// passphrase rather than the crypto private key.  Entries map the
+	// account id to the marshaled parameters.
+	//
+	// This was added by database version 12.
+	acctPassBucketName = []byte("acctpass")
+
 	// addrPoolMetaKeyLen is the byte length of the address pool
 	// prefixes. It is 11 bytes for the prefix and 4 bytes for
 	// the account number.
@@ -178,6 +186,11 @@ var (
 	// in the manager
 	lastAccountName = []byte("lastaccount")
 
+	// votingAccountKeyPrefix is the prefix of meta bucket keys marking the
+	// accounts created as voting accounts.  The account number is appended
+	// to the prefix.  Accounts without a key are not voting accounts.
+	votingAccountKeyPrefix = []byte("votingacct")
+
 	mainBucketName = []byte("main")
 
 	// Db related key names (main bucket).
@@ -298,6 +311,36 @@ func putMasterKeyParams(ns walletdb.ReadWriteBucket, pubParams, privParams []byt
 	return nil
 }
 
+// fetchAccountPassphraseParams loads the master key parameters of an account
+// encrypted with its own passphrase.  Nil is returned when the account is
+// encrypted with the crypto private key.
+func fetchAccountPassphraseParams(ns walletdb.ReadBucket, account uint32) []byte {
+	bucket := ns.NestedReadBucket(acctPassBucketName)
+	val := bucket.Get(uint32ToBytes(account))
+	if val == nil {
+		return nil
+	}
+	params := make([]byte, len(val))
+	copy(params, val)
+	return params
+}
+
+// putAccountPassphraseParams stores the master key parameters of an account
+// encrypted with its own passphrase.  Nil parameters remove the entry.
+func putAccountPassphraseParams(ns walletdb.ReadWriteBucket, account uint32, params []byte) error {
+	bucket := ns.NestedReadWriteBucket(acctPassBucketName)
+	var err error
+	if params == nil {
+		err = bucket.Delete(uint32ToBytes(account))
+	} else {
+		err = bucket.Put(uint32ToBytes(account), params)
+	}
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
 // fetchCoinTypeKeys loads the encrypted cointype keys which are in turn used to
 // derive the extended keys for all accounts.  If both the legacy and SLIP0044
 // coin type keys are saved, the legacy keys are used for backwards
@@ -821,6 +864,29 @@ func putAccountInfo(ns walletdb.ReadWriteBucket, account uint32, row *dbBIP0044A
 	return putAccountNameIndex(ns, account, row.name)
 }
 
+// votingAccountKey returns the meta bucket key marking a voting account.
+func votingAccountKey(account uint32) []byte {
+	k := make([]byte, 0, len(votingAccountKeyPrefix)+4)
+	k = append(k, votingAccountKeyPrefix...)
+	return append(k, uint32ToBytes(account)...)
+}
+
+// fetchVotingAccount returns whether an account is marked as a voting account.
+func fetchVotingAccount(ns walletdb.ReadBucket, account uint32) bool {
+	bucket := ns.NestedReadBucket(metaBucketName)
+	return bucket.Get(votingAccountKey(account)) != nil
+}
+
+// putVotingAccount marks an account as a voting account.
+func putVotingAccount(ns walletdb.ReadWriteBucket, account uint32) error {
+	bucket := ns.NestedReadWriteBucket(metaBucketName)
+	err := bucket.Put(votingAccountKey(account), []byte{1})
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
 // putLastAccount stores the provided metadata - last account - to the database.
 func putLastAccount(ns walletdb.ReadWriteBucket, account uint32) error {
 	bucket := ns.NestedReadWriteBucket(metaBucketName)
@@ -1427,6 +1493,11 @@ func createManagerNS(ns walletdb.ReadWriteBucket) error {
 		errors.E(errors.IO, err)
 	}
 
+	_, err = ns.CreateBucket(acctPassBucketName)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
 	if err := putLastAccount(ns, DefaultAccountNum); err != nil {
 		return err
 	}
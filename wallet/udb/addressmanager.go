@@ -109,9 +109,22 @@ func normalizeAddress(addr fnoutil.Address) fnoutil.Address {
 	}
 }
 
-// ScryptOptions is used to hold the scrypt parameters needed when deriving new
-// passphrase keys.
+// KDF identifies the key derivation function used to derive master keys from
+// passphrases.
+type KDF = snacl.KDF
+
+// Key derivation functions.
+const (
+	KDFScrypt   = snacl.KDFScrypt
+	KDFArgon2id = snacl.KDFArgon2id
+)
+
+// ScryptOptions is used to hold the key derivation function and parameters
+// needed when deriving new passphrase keys.  Despite the name, the options may
+// select Argon2id, in which case N is the memory in KiB, R is the number of
+// passes, and P is the number of threads.
 type ScryptOptions struct {
+	KDF     KDF
 	N, R, P int
 }
 
@@ -122,6 +135,25 @@ var defaultScryptOptions = ScryptOptions{
 	P: 1,
 }
 
+// DefaultScryptOptions returns the default parameters of a key derivation
+// function.
+func DefaultScryptOptions(kdf KDF) (*ScryptOptions, error) {
+	switch kdf {
+	case KDFScrypt:
+		o := defaultScryptOptions
+		return &o, nil
+	case KDFArgon2id:
+		return &ScryptOptions{
+			KDF: KDFArgon2id,
+			N:   snacl.DefaultArgon2Memory,
+			R:   snacl.DefaultArgon2Time,
+			P:   snacl.DefaultArgon2Threads,
+		}, nil
+	default:
+		return nil, errors.E(errors.Invalid, errors.Errorf("unknown KDF %d", kdf))
+	}
+}
+
 // accountInfo houses the current state of the internal and external branches
 // of an account along with the extended keys needed to derive new keys.  It
 // also handles locking by keeping an encrypted version of the serialized
@@ -136,6 +168,13 @@ type accountInfo struct {
 	acctKeyEncrypted []byte
 	acctKeyPriv      *hdkeychain.ExtendedKey
 	acctKeyPub       *hdkeychain.ExtendedKey
+
+	// acctKeyParams are the master key parameters of an account whose
+	// private key is encrypted with its own passphrase, and nil when the
+	// private key is encrypted with the crypto private key.  The private
+	// key of these accounts remains nil after the manager is unlocked until
+	// the account is unlocked.
+	acctKeyParams []byte
 }
 
 // AccountProperties contains properties associated with each account, such as
@@ -154,7 +193,7 @@ type AccountProperties struct {
 
 // defaultNewSecretKey returns a new secret key.  See newSecretKey.
 func defaultNewSecretKey(passphrase *[]byte, config *ScryptOptions) (*snacl.SecretKey, error) {
-	return snacl.NewSecretKey(passphrase, config.N, config.R, config.P)
+	return snacl.NewSecretKeyKDF(passphrase, config.KDF, config.N, config.R, config.P)
 }
 
 // newSecretKey is used as a way to replace the new secret key generation
@@ -244,11 +283,20 @@ type Manager struct {
 	returnedPrivKeys  map[[ripemd160.Size]byte]chainec.PrivateKey
 	returnedScripts   map[[ripemd160.Size]byte][]byte
 
+	// returnedPrivKeyAccounts records the account of each returned private
+	// key so that locking an account zeroes only its own keys.
+	returnedPrivKeyAccounts map[[ripemd160.Size]byte]uint32
+
 	chainParams  *chaincfg.Params
 	watchingOnly bool
 	locked       bool
 	closed       bool
 
+	// unlockGrants records the scope of each unlock while the manager is
+	// unlocked.  Private keys are only returned when permitted by a grant.
+	unlockGrants    map[uint64]*unlockGrant
+	lastUnlockGrant uint64
+
 	// acctInfo houses information about accounts including what is needed
 	// to generate deterministic chained keys for each created account.
 	acctInfo map[uint32]*accountInfo
@@ -313,6 +361,7 @@ func (m *Manager) lock() {
 		zero.Bytes(script)
 	}
 	m.returnedPrivKeys = nil
+	m.returnedPrivKeyAccounts = nil
 	m.returnedScripts = nil
 	m.returnedSecretsMu.Unlock()
 
@@ -329,6 +378,7 @@ func (m *Manager) lock() {
 	// which uses a separate derived key from the database even when it is
 	// locked.
 
+	m.unlockGrants = nil
 	m.locked = true
 }
 
@@ -403,6 +453,9 @@ func deriveKey(acctInfo *accountInfo, branch, index uint32, private bool) (*hdke
 	// private child derivation.
 	acctKey := acctInfo.acctKeyPub
 	if private {
+		if acctInfo.acctKeyPriv == nil {
+			return nil, errors.E(errors.Locked, errors.Errorf("account %q is locked", acctInfo.acctName))
+		}
 		acctKey = acctInfo.acctKeyPriv
 	}
 
@@ -475,9 +528,10 @@ func (m *Manager) loadAccountInfo(ns walletdb.ReadBucket, account uint32) (*acco
 		acctName:         row.name,
 		acctKeyEncrypted: row.privKeyEncrypted,
 		acctKeyPub:       acctKeyPub,
+		acctKeyParams:    fetchAccountPassphraseParams(ns, account),
 	}
 
-	if !m.locked {
+	if !m.locked && acctInfo.acctKeyParams == nil {
 		// Use the crypto private key to decrypt the account private
 		// extended keys.
 		decrypted, err := m.cryptoKeyPriv.Decrypt(acctInfo.acctKeyEncrypted)
@@ -570,6 +624,58 @@ func (m *Manager) AccountExtendedPubKey(dbtx walletdb.ReadTx, account uint32) (*
 	return acctInfo.acctKeyPub, nil
 }
 
+// AccountExtendedPrivKey returns the extended private key of an account.  The
+// key should be cleared by the caller when finished.  This method requires the
+// wallet to be unlocked.
+func (m *Manager) AccountExtendedPrivKey(dbtx walletdb.ReadTx, account uint32) (*hdkeychain.ExtendedKey, error) {
+	ns := dbtx.ReadBucket(waddrmgrBucketKey)
+	if account == ImportedAddrAccount {
+		return nil, errors.E(errors.Invalid, "imported account has no extended privkey")
+	}
+
+	defer m.mtx.Unlock()
+	m.mtx.Lock()
+
+	if m.watchingOnly {
+		return nil, errors.E(errors.WatchingOnly)
+	}
+	if m.locked {
+		return nil, errors.E(errors.Locked)
+	}
+
+	err := m.authorizeKey(&account, KeyUseExport, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	acctInfo, err := m.loadAccountInfo(ns, account)
+	if err != nil {
+		return nil, err
+	}
+	if acctInfo.acctKeyPriv == nil {
+		return nil, errors.E(errors.Locked, errors.Errorf("account %q is locked", acctInfo.acctName))
+	}
+	// Return a copy so the cached key is not cleared by the caller.
+	return hdkeychain.NewKeyFromString(acctInfo.acctKeyPriv.String())
+}
+
+// MarkVotingAccount records that an account was created as a voting account,
+// allowing its extended private key to be exported for a voting-only wallet.
+func (m *Manager) MarkVotingAccount(ns walletdb.ReadWriteBucket, account uint32) error {
+	if account == ImportedAddrAccount {
+		return errors.E(errors.Invalid, "imported account can not be a voting account")
+	}
+	if _, err := fetchAccountName(ns, account); err != nil {
+		return err
+	}
+	return putVotingAccount(ns, account)
+}
+
+// IsVotingAccount returns whether an account was created as a voting account.
+func (m *Manager) IsVotingAccount(ns walletdb.ReadBucket, account uint32) bool {
+	return fetchVotingAccount(ns, account)
+}
+
 // AccountBranchExtendedPubKey returns the extended public key of an account's
 // branch, which then can be used to derive addresses belonging to the account.
 func (m *Manager) AccountBranchExtendedPubKey(dbtx walletdb.ReadTx, account, branch uint32) (*hdkeychain.ExtendedKey, error) {
@@ -580,13 +686,58 @@ func (m *Manager) AccountBranchExtendedPubKey(dbtx walletdb.ReadTx, account, bra
 	return acctXpub.Child(branch)
 }
 
+// VerifySeed returns whether the seed derives the extended public key of the
+// default account at the coin type currently in use.  The manager does not
+// need to be unlocked, and neither the seed nor any key derived from it is
+// stored.
+func (m *Manager) VerifySeed(dbtx walletdb.ReadTx, seed []byte) (bool, error) {
+	coinType, err := m.CoinType(dbtx)
+	if err != nil {
+		return false, err
+	}
+	acctXpub, err := m.AccountExtendedPubKey(dbtx, DefaultAccountNum)
+	if err != nil {
+		return false, err
+	}
+
+	root, err := hdkeychain.NewMaster(seed, m.chainParams)
+	if err != nil {
+		return false, errors.E(errors.Invalid, err)
+	}
+	defer root.Zero()
+	coinTypeKeyPriv, err := deriveCoinTypeKey(root, coinType)
+	if err == hdkeychain.ErrInvalidChild {
+		return false, errors.E(errors.Seed, hdkeychain.ErrUnusableSeed)
+	}
+	if err != nil {
+		return false, err
+	}
+	defer coinTypeKeyPriv.Zero()
+	acctKeyPriv, err := deriveAccountKey(coinTypeKeyPriv, DefaultAccountNum)
+	if err == hdkeychain.ErrInvalidChild {
+		return false, errors.E(errors.Seed, hdkeychain.ErrUnusableSeed)
+	}
+	if err != nil {
+		return false, err
+	}
+	defer acctKeyPriv.Zero()
+	acctKeyPub, err := acctKeyPriv.Neuter()
+	if err != nil {
+		return false, err
+	}
+	return acctKeyPub.String() == acctXpub.String(), nil
+}
+
 // CoinTypePrivKey returns the coin type private key at the BIP0044 path
 // m/44'/<coin type>' (coin type child indexes differ by the network).  The key
 // and all derived private keys should be cleared by the caller when finished.
-// This method requires the wallet to be unlocked.
+// This method requires the wallet to be unlocked by an unlock permitting every
+// use of the private keys of every account.
 func (m *Manager) CoinTypePrivKey(dbtx walletdb.ReadTx) (*hdkeychain.ExtendedKey, error) {
-	defer m.mtx.RUnlock()
-	m.mtx.RLock()
+	// Lock the manager mutex for writes to take a signature from a limited
+	// unlock grant.
+	defer m.mtx.Unlock()
+	m.mtx.Lock()
 
 	if m.locked {
 		return nil, errors.E(errors.Locked)
@@ -594,6 +745,10 @@ func (m *Manager) CoinTypePrivKey(dbtx walletdb.ReadTx) (*hdkeychain.ExtendedKey
 	if m.watchingOnly {
 		return nil, errors.E(errors.WatchingOnly)
 	}
+	err := m.authorizeKey(nil, KeyUseAny, nil)
+	if err != nil {
+		return nil, err
+	}
 
 	ns := dbtx.ReadBucket(waddrmgrBucketKey)
 
@@ -904,10 +1059,12 @@ func (m *Manager) AddrAccount(ns walletdb.ReadBucket, address fnoutil.Address) (
 // ChangePassphrase changes either the public or private passphrase to the
 // provided value depending on the private flag.  In order to change the private
 // password, the address manager must not be watching-only.  The new passphrase
-// keys are derived using the scrypt parameters in the options, so changing the
-// passphrase may be used to bump the computational difficulty needed to brute
-// force the passphrase.
-func (m *Manager) ChangePassphrase(ns walletdb.ReadWriteBucket, oldPassphrase, newPassphrase []byte, private bool) error {
+// keys are derived using the key derivation function and parameters in the
+// options, or the default scrypt parameters when config is nil, so changing
+// the passphrase may be used to bump the computational difficulty needed to
+// brute force the passphrase.  The same passphrase may be passed as both the
+// old and new passphrase to only change the parameters.
+func (m *Manager) ChangePassphrase(ns walletdb.ReadWriteBucket, oldPassphrase, newPassphrase []byte, private bool, config *ScryptOptions) error {
 	defer m.mtx.Unlock()
 	m.mtx.Lock()
 
@@ -933,7 +1090,13 @@ func (m *Manager) ChangePassphrase(ns walletdb.ReadWriteBucket, oldPassphrase, n
 
 	// Generate a new master key from the passphrase which is used to secure
 	// the actual secret keys.
-	newMasterKey, err := newSecretKey(&newPassphrase, &defaultScryptOptions)
+	if config == nil {
+		config = &defaultScryptOptions
+	}
+	newMasterKey, err := newSecretKey(&newPassphrase, config)
+	if errors.Is(errors.Invalid, err) {
+		return err
+	}
 	if err != nil {
 		return errors.Errorf("create new master privkey: %v", err)
 	}
@@ -1100,6 +1263,7 @@ func (m *Manager) ConvertToWatchingOnly(ns walletdb.ReadWriteBucket) error {
 		zero.Bytes(script)
 	}
 	m.returnedPrivKeys = nil
+	m.returnedPrivKeyAccounts = nil
 	m.returnedScripts = nil
 	m.returnedSecretsMu.Unlock()
 
@@ -1302,14 +1466,19 @@ func (m *Manager) LookupAccount(ns walletdb.ReadBucket, name string) (uint32, er
 // invalid passphrase will return an error.  Otherwise, the derived secret key
 // is stored in memory until the address manager is locked.  Any failures that
 // occur during this function will result in the address manager being locked,
-// even if it was already unlocked prior to calling this function.
+// even if it was already unlocked prior to calling this function.  Access to
+// every private key is permitted until the manager is locked.
 //
 // This function will return an error if invoked on a watching-only address
 // manager.
 func (m *Manager) Unlock(ns walletdb.ReadBucket, passphrase []byte) error {
-	defer m.mtx.Unlock()
-	m.mtx.Lock()
+	_, err := m.UnlockScoped(ns, passphrase, nil)
+	return err
+}
 
+// unlock derives the master private key from the specified passphrase as
+// described by Unlock.  The manager mutex must be held for writes.
+func (m *Manager) unlock(ns walletdb.ReadBucket, passphrase []byte) error {
 	// A watching-only address manager can't be unlocked.
 	if m.watchingOnly {
 		return errors.E(errors.WatchingOnly, "cannot unlock watching wallet")
@@ -1345,8 +1514,12 @@ func (m *Manager) Unlock(ns walletdb.ReadBucket, passphrase []byte) error {
 	zero.Bytes(decryptedKey)
 
 	// Use the crypto private key to decrypt all of the account private
-	// extended keys.
+	// extended keys.  Accounts encrypted with their own passphrase remain
+	// locked.
 	for account, acctInfo := range m.acctInfo {
+		if acctInfo.acctKeyParams != nil {
+			continue
+		}
 		decrypted, err := m.cryptoKeyPriv.Decrypt(acctInfo.acctKeyEncrypted)
 		if err != nil {
 			m.lock()
@@ -1540,7 +1713,7 @@ func (m *Manager) syncAccountToAddrIndex(ns walletdb.ReadWriteBucket, account ui
 		if err != nil {
 			return err
 		}
-		if m.locked {
+		if m.locked || acctInfo.acctKeyPriv == nil {
 			break
 		}
 		xprivBranch, err = acctInfo.acctKeyPriv.Child(branch)
@@ -1627,6 +1800,17 @@ func ValidateAccountName(name string) error {
 // access to the cointype keys (from which extended account keys are derived),
 // it requires the manager to be unlocked.
 func (m *Manager) NewAccount(ns walletdb.ReadWriteBucket, name string) (uint32, error) {
+	return m.NewAccountWithPassphrase(ns, name, nil)
+}
+
+// NewAccountWithPassphrase creates and returns a new account as NewAccount
+// does.  When the passphrase is not empty, the account private key is
+// encrypted with the passphrase instead of the wallet's private passphrase,
+// and the account must be unlocked with UnlockAccount before its private keys
+// may be used.  The keys of these accounts are created from a new random seed
+// rather than derived from the cointype key, so they are not recoverable from
+// the wallet's seed and must be backed up with ExportAccountKey.
+func (m *Manager) NewAccountWithPassphrase(ns walletdb.ReadWriteBucket, name string, passphrase []byte) (uint32, error) {
 	defer m.mtx.Unlock()
 	m.mtx.Lock()
 
@@ -1656,6 +1840,22 @@ func (m *Manager) NewAccount(ns walletdb.ReadWriteBucket, name string) (uint32,
 		return 0, err
 	}
 	account++
+
+	// Keys of accounts with their own passphrase must not be derivable with
+	// the wallet's private passphrase.
+	if len(passphrase) != 0 {
+		acctKeyPriv, err := newIndependentAccountKey(m.chainParams, account)
+		if err != nil {
+			return 0, err
+		}
+		err = m.putAccountKeys(ns, account, name, acctKeyPriv, passphrase)
+		acctKeyPriv.Zero()
+		if err != nil {
+			return 0, err
+		}
+		return account, nil
+	}
+
 	// Fetch the cointype key which will be used to derive the next account
 	// extended keys
 	_, coinTypePrivEnc, err := fetchCoinTypeKeys(ns)
@@ -1681,20 +1881,94 @@ func (m *Manager) NewAccount(ns walletdb.ReadWriteBucket, name string) (uint32,
 	if err != nil {
 		return 0, err
 	}
-	acctKeyPub, err := acctKeyPriv.Neuter()
+	err = m.putAccountKeys(ns, account, name, acctKeyPriv, passphrase)
+	acctKeyPriv.Zero()
 	if err != nil {
 		return 0, err
 	}
+	return account, nil
+}
+
+// ImportAccount creates and returns a new account using the provided extended
+// account private key rather than deriving it from the wallet's cointype key.
+// This allows a wallet to hold the keys of a single account of another wallet,
+// such as the voting account of a wallet which purchases tickets offline.
+// Imported accounts are stored as any other BIP0044 account, but are not
+// recoverable from the wallet's seed.  This method requires the manager to be
+// unlocked.
+func (m *Manager) ImportAccount(ns walletdb.ReadWriteBucket, name string, acctKeyPriv *hdkeychain.ExtendedKey) (uint32, error) {
+	return m.ImportAccountWithPassphrase(ns, name, acctKeyPriv, nil)
+}
+
+// ImportAccountWithPassphrase imports an account as ImportAccount does.  When
+// the passphrase is not empty, the account private key is encrypted with the
+// passphrase as with NewAccountWithPassphrase, so an account key backed up with
+// ExportAccountKey is restored with its own passphrase.
+func (m *Manager) ImportAccountWithPassphrase(ns walletdb.ReadWriteBucket, name string,
+	acctKeyPriv *hdkeychain.ExtendedKey, passphrase []byte) (uint32, error) {
+
+	defer m.mtx.Unlock()
+	m.mtx.Lock()
+
+	if m.watchingOnly {
+		return 0, errors.E(errors.WatchingOnly)
+	}
+	if m.locked {
+		return 0, errors.E(errors.Locked)
+	}
+
+	if !acctKeyPriv.IsPrivate() {
+		return 0, errors.E(errors.Invalid, "account key is not private")
+	}
+	if !acctKeyPriv.IsForNet(m.chainParams) {
+		return 0, errors.E(errors.Invalid, "wrong network")
+	}
+	if err := checkBranchKeys(acctKeyPriv); err != nil {
+		return 0, errors.E(errors.Invalid, err)
+	}
+
+	if err := ValidateAccountName(name); err != nil {
+		return 0, err
+	}
+	_, err := fetchAccountByName(ns, name)
+	if err == nil {
+		return 0, errors.E(errors.Exist, errors.Errorf("account named %q already exists", name))
+	}
+
+	account, err := fetchLastAccount(ns)
+	if err != nil {
+		return 0, err
+	}
+	account++
+	err = m.putAccountKeys(ns, account, name, acctKeyPriv, passphrase)
+	if err != nil {
+		return 0, err
+	}
+	return account, nil
+}
+
+// putAccountKeys encrypts the extended keys of a new account and saves them as
+// the latest account.  The private key is encrypted with the passphrase when it
+// is not empty.  The manager must be unlocked and the mutex held.
+func (m *Manager) putAccountKeys(ns walletdb.ReadWriteBucket, account uint32, name string, acctKeyPriv *hdkeychain.ExtendedKey, passphrase []byte) error {
+	acctKeyPub, err := acctKeyPriv.Neuter()
+	if err != nil {
+		return err
+	}
 	// Encrypt the default account keys with the associated crypto keys.
 	apes := acctKeyPub.String()
 	acctPubEnc, err := m.cryptoKeyPub.Encrypt([]byte(apes))
 	if err != nil {
-		return 0, errors.E(errors.Crypto, errors.Errorf("encrypt account pubkey: %v", err))
+		return errors.E(errors.Crypto, errors.Errorf("encrypt account pubkey: %v", err))
 	}
 	apes = acctKeyPriv.String()
-	acctPrivEnc, err := m.cryptoKeyPriv.Encrypt([]byte(apes))
+	acctPrivEnc, params, err := m.encryptAccountKey([]byte(apes), passphrase)
+	if err != nil {
+		return err
+	}
+	err = putAccountPassphraseParams(ns, account, params)
 	if err != nil {
-		return 0, errors.E(errors.Crypto, errors.Errorf("encrypt account privkey: %v", err))
+		return err
 	}
 	// We have the encrypted account extended keys, so save them to the
 	// database
@@ -1702,15 +1976,11 @@ func (m *Manager) NewAccount(ns walletdb.ReadWriteBucket, name string) (uint32,
 		^uint32(0), ^uint32(0), ^uint32(0), ^uint32(0), name, DBVersion)
 	err = putAccountInfo(ns, account, row)
 	if err != nil {
-		return 0, err
+		return err
 	}
 
 	// Save last account metadata
-	if err := putLastAccount(ns, account); err != nil {
-		return 0, err
-	}
-
-	return account, nil
+	return putLastAccount(ns, account)
 }
 
 // RenameAccount renames an account stored in the manager based on the
@@ -1828,8 +2098,16 @@ func (m *Manager) ForEachActiveAddress(ns walletdb.ReadBucket, fn func(addr fnou
 // when the private key is no longer being used.  Failure to do so will cause
 // deadlocks when the manager is locked.
 func (m *Manager) PrivateKey(ns walletdb.ReadBucket, addr fnoutil.Address) (key chainec.PrivateKey, done func(), err error) {
+	return m.PrivateKeyForUse(ns, addr, KeyUseAny)
+}
+
+// PrivateKeyForUse retreives the private key for a P2PK or P2PKH address as
+// PrivateKey does when an unlock scope of the manager permits the key to be
+// used for use.
+func (m *Manager) PrivateKeyForUse(ns walletdb.ReadBucket, addr fnoutil.Address, use KeyUse) (key chainec.PrivateKey, done func(), err error) {
 	// Lock the manager mutex for writes.  This protects read access to m.locked
-	// and write access to m.returnedPrivKeys and the cached accounts.
+	// and write access to m.returnedPrivKeys, the cached accounts, and the
+	// unlock grants.
 	defer m.mtx.Unlock()
 	m.mtx.Lock()
 
@@ -1842,6 +2120,24 @@ func (m *Manager) PrivateKey(ns walletdb.ReadBucket, addr fnoutil.Address) (key
 		return nil, nil, errors.E(errors.Locked)
 	}
 
+	// The account of the address must be known to check the unlock scopes,
+	// even when the key has already been returned.
+	addrInterface, err := fetchAddress(ns, addr.Hash160()[:])
+	if err != nil {
+		return nil, nil, err
+	}
+	var account uint32
+	switch a := addrInterface.(type) {
+	case *dbChainAddressRow:
+		account = a.account
+	case *dbImportedAddressRow:
+		account = a.account
+	}
+	err = m.authorizeKey(&account, use, addr.Hash160())
+	if err != nil {
+		return nil, nil, err
+	}
+
 	// If the private key for this address' hash160 has already been returned,
 	// return it again.
 	if m.returnedPrivKeys != nil {
@@ -1858,10 +2154,6 @@ func (m *Manager) PrivateKey(ns walletdb.ReadBucket, addr fnoutil.Address) (key
 	// account xpriv with the correct branch and child indexes.  For imported
 	// keys, the encrypted private key is simply retreived from the database and
 	// decrypted.
-	addrInterface, err := fetchAddress(ns, addr.Hash160()[:])
-	if err != nil {
-		return nil, nil, err
-	}
 	switch a := addrInterface.(type) {
 	case *dbChainAddressRow:
 		xpriv, err := m.deriveKeyFromPath(ns, a.account, a.branch, a.index, true)
@@ -1904,8 +2196,10 @@ func (m *Manager) PrivateKey(ns walletdb.ReadBucket, addr fnoutil.Address) (key
 	// Add the key to the manager so it can be zeroed on wallet lock.
 	if m.returnedPrivKeys == nil {
 		m.returnedPrivKeys = make(map[[ripemd160.Size]byte]chainec.PrivateKey)
+		m.returnedPrivKeyAccounts = make(map[[ripemd160.Size]byte]uint32)
 	}
 	m.returnedPrivKeys[*addr.Hash160()] = key
+	m.returnedPrivKeyAccounts[*addr.Hash160()] = account
 
 	return key, done, nil
 }
@@ -2110,6 +2404,53 @@ func deriveAccountKey(coinTypeKey *hdkeychain.ExtendedKey, account uint32) (*hdk
 	return coinTypeKey.Child(account + hdkeychain.HardenedKeyStart)
 }
 
+// newIndependentAccountKey creates the extended key of an account from a new
+// random seed rather than the wallet's seed.  Seeds deriving an invalid child
+// are discarded.
+func newIndependentAccountKey(params *chaincfg.Params, account uint32) (*hdkeychain.ExtendedKey, error) {
+	_, coinType := CoinTypes(params)
+	for {
+		seed, err := hdkeychain.GenerateSeed(hdkeychain.RecommendedSeedLen)
+		if err != nil {
+			return nil, err
+		}
+		root, err := hdkeychain.NewMaster(seed, params)
+		zero.Bytes(seed)
+		if err == hdkeychain.ErrUnusableSeed {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		coinTypeKey, err := deriveCoinTypeKey(root, coinType)
+		root.Zero()
+		if err == hdkeychain.ErrInvalidChild {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		acctKey, err := deriveAccountKey(coinTypeKey, account)
+		coinTypeKey.Zero()
+		if err == hdkeychain.ErrInvalidChild {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		err = checkBranchKeys(acctKey)
+		if err == hdkeychain.ErrInvalidChild {
+			acctKey.Zero()
+			continue
+		}
+		if err != nil {
+			acctKey.Zero()
+			return nil, err
+		}
+		return acctKey, nil
+	}
+}
+
 // checkBranchKeys ensures deriving the extended keys for the internal and
 // external branches given an account key does not result in an invalid child
 // error which means the chosen seed is not usable.  This conforms to the
@@ -0,0 +1,67 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"time"
+
+	"github.com/fonero-project/fnowallet/errors"
+	"github.com/fonero-project/fnowallet/wallet/walletdb"
+)
+
+// Birthday describes the earliest point in the block chain at which a wallet
+// may have received transactions, by either a time or a block height.  When
+// Time is not the zero value, the birthday is the time and Height is ignored.
+type Birthday struct {
+	Time   time.Time
+	Height int32
+}
+
+// The root bucket's birthday k/v pair records the wallet birthday.  The value
+// is the birthday time as a unix timestamp (or zero when the birthday is a
+// block height), followed by the block height.
+//
+//   [0:8]  Unix time (8 bytes)
+//   [8:12] Block height (4 bytes)
+
+func valueBirthday(b *Birthday) []byte {
+	v := make([]byte, 12)
+	if !b.Time.IsZero() {
+		byteOrder.PutUint64(v, uint64(b.Time.Unix()))
+	} else {
+		byteOrder.PutUint32(v[8:12], uint32(b.Height))
+	}
+	return v
+}
+
+// FetchBirthday returns the wallet birthday, or nil if no birthday is recorded.
+func FetchBirthday(dbtx walletdb.ReadTx) *Birthday {
+	v := dbtx.ReadBucket(wtxmgrBucketKey).Get(rootBirthday)
+	if len(v) != 12 {
+		return nil
+	}
+	b := new(Birthday)
+	if t := int64(byteOrder.Uint64(v)); t != 0 {
+		b.Time = time.Unix(t, 0)
+	} else {
+		b.Height = int32(byteOrder.Uint32(v[8:12]))
+	}
+	return b
+}
+
+// SetBirthday records the wallet birthday.
+func SetBirthday(dbtx walletdb.ReadWriteTx, b *Birthday) error {
+	if b.Time.IsZero() && b.Height < 0 {
+		return errors.E(errors.Invalid, "birthday height may not be negative")
+	}
+	if !b.Time.IsZero() && b.Time.Unix() <= 0 {
+		return errors.E(errors.Invalid, "birthday time precedes the unix epoch")
+	}
+	err := dbtx.ReadWriteBucket(wtxmgrBucketKey).Put(rootBirthday, valueBirthday(b))
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fonero-project/fnod/wire"
+	"github.com/fonero-project/fnowallet/errors"
+	"github.com/fonero-project/fnowallet/wallet/walletdb"
+)
+
+func TestBirthday(t *testing.T) {
+	db, _, teardown, err := setup()
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []*Birthday{
+		{Time: time.Unix(1546300800, 0)},
+		{Height: 100000},
+		{Height: 0},
+	}
+	err = walletdb.Update(db, func(dbtx walletdb.ReadWriteTx) error {
+		if b := FetchBirthday(dbtx); b != nil {
+			t.Errorf("unexpected birthday %v before setting one", b)
+		}
+		for _, test := range tests {
+			err := SetBirthday(dbtx, test)
+			if err != nil {
+				return err
+			}
+			b := FetchBirthday(dbtx)
+			if b == nil || !b.Time.Equal(test.Time) || b.Height != test.Height {
+				t.Errorf("fetched birthday %v, expected %v", b, test)
+			}
+		}
+
+		err := SetBirthday(dbtx, &Birthday{Height: -1})
+		if !errors.Is(errors.Invalid, err) {
+			t.Errorf("setting negative birthday height: unexpected error %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestExtendMainChainWithoutCFilters(t *testing.T) {
+	db, s, teardown, err := setup()
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	g := makeBlockGenerator()
+	headers := make([]*wire.BlockHeader, 6)
+	for i := range headers {
+		headers[i] = g.generate(0)
+	}
+	headerData := makeHeaderDataSlice(headers...)
+	filters := emptyFilters(len(headers))
+	for i := 0; i < 3; i++ {
+		filters[i] = nil
+	}
+
+	err = walletdb.Update(db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(wtxmgrBucketKey)
+		addrmgrNs := dbtx.ReadBucket(waddrmgrBucketKey)
+		err := insertMainChainHeaders(s, ns, addrmgrNs, headerData, filters)
+		if err != nil {
+			return err
+		}
+		if h := s.PrunedCFiltersHeight(dbtx); h != 4 {
+			t.Errorf("pruned height is %d, expected 4", h)
+		}
+		for i := range headerData {
+			_, err := s.CFilter(dbtx, &headerData[i].BlockHash)
+			switch {
+			case i < 3 && !errors.Is(errors.NotExist, err):
+				t.Errorf("omitted cfilter at height %d: unexpected error %v", i+1, err)
+			case i >= 3 && err != nil:
+				t.Errorf("cfilter at height %d is missing: %v", i+1, err)
+			}
+		}
+
+		// Filters may not be omitted after blocks with recorded filters.
+		err = s.ExtendMainChain(ns, g.generate(0), nil)
+		if !errors.Is(errors.Invalid, err) {
+			t.Errorf("omitting cfilter after recorded cfilters: unexpected error %v", err)
+		}
+
+		// Removing blocks lowers the pruned height to the first removed
+		// block.
+		err = s.Rollback(ns, addrmgrNs, 2)
+		if err != nil {
+			return err
+		}
+		if h := s.PrunedCFiltersHeight(dbtx); h != 2 {
+			t.Errorf("pruned height is %d after rollback, expected 2", h)
+		}
+		err = s.ExtendMainChain(ns, headers[1], nil)
+		if err != nil {
+			return err
+		}
+		if h := s.PrunedCFiltersHeight(dbtx); h != 3 {
+			t.Errorf("pruned height is %d, expected 3", h)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -5,9 +5,12 @@
 package udb
 
 import (
+	"bytes"
+
 	"github.com/fonero-project/fnod/chaincfg/chainhash"
 	"github.com/fonero-project/fnod/gcs"
 	"github.com/fonero-project/fnod/gcs/blockcf"
+	"github.com/fonero-project/fnowallet/errors"
 	"github.com/fonero-project/fnowallet/wallet/walletdb"
 )
 
@@ -22,3 +25,126 @@ func (s *Store) CFilter(dbtx walletdb.ReadTx, blockHash *chainhash.Hash) (*gcs.F
 	copy(vc, v)
 	return gcs.FromNBytes(blockcf.P, vc)
 }
+
+// Committed filters of main chain blocks may be pruned to reduce the size of
+// the database.  The root bucket's pruned cfilters k/v pair records the height
+// of the first main chain block which has not been pruned, and filters of main
+// chain blocks below this height, excluding the genesis block, must be fetched
+// again before they are used.  Block headers are never pruned, as ancestor
+// headers are required to validate the difficulties of new blocks.
+
+func fetchPrunedCFiltersHeight(ns walletdb.ReadBucket) int32 {
+	v := ns.Get(rootPrunedCFilters)
+	if len(v) != 4 {
+		return 0
+	}
+	return int32(byteOrder.Uint32(v))
+}
+
+func putPrunedCFiltersHeight(ns walletdb.ReadWriteBucket, height int32) error {
+	v := make([]byte, 4)
+	byteOrder.PutUint32(v, uint32(height))
+	err := ns.Put(rootPrunedCFilters, v)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// PrunedCFiltersHeight returns the height below which the committed filters of
+// main chain blocks, other than the genesis block, have been pruned.  Zero is
+// returned if no filters have been pruned.
+func (s *Store) PrunedCFiltersHeight(dbtx walletdb.ReadTx) int32 {
+	return fetchPrunedCFiltersHeight(dbtx.ReadBucket(wtxmgrBucketKey))
+}
+
+// PruneCFilters removes the committed filters of main chain blocks below
+// height, other than the genesis block, and returns the number of removed
+// filters.  Filters may not be pruned while any are missing from the main
+// chain, and the filter of the main chain tip may not be pruned.
+func (s *Store) PruneCFilters(dbtx walletdb.ReadWriteTx, height int32) (int, error) {
+	if s.IsMissingMainChainCFilters(dbtx) {
+		return 0, errors.E(errors.Invalid, "cfilters may not be pruned while main chain cfilters are missing")
+	}
+	ns := dbtx.ReadWriteBucket(wtxmgrBucketKey)
+	if _, tipHeight := s.MainChainTip(ns); height > tipHeight {
+		return 0, errors.E(errors.Invalid, "cfilter of main chain tip may not be pruned")
+	}
+
+	prunedHeight := fetchPrunedCFiltersHeight(ns)
+	if height <= prunedHeight {
+		return 0, nil
+	}
+	start := prunedHeight
+	if start < 1 {
+		start = 1
+	}
+	blockRecords := ns.NestedReadBucket(bucketBlocks)
+	cfilters := ns.NestedReadWriteBucket(bucketCFilters)
+	var hash chainhash.Hash
+	for h := start; h < height; h++ {
+		v := blockRecords.Get(keyBlockRecord(h))
+		if v == nil {
+			return 0, errors.E(errors.NotExist, errors.Errorf("no block at height %v in main chain", h))
+		}
+		copy(hash[:], extractRawBlockRecordHash(v))
+		err := cfilters.Delete(hash[:])
+		if err != nil {
+			return 0, errors.E(errors.IO, err)
+		}
+	}
+	err := putPrunedCFiltersHeight(ns, height)
+	if err != nil {
+		return 0, err
+	}
+	return int(height - start), nil
+}
+
+// InsertPrunedCFilters records committed filters, fetched again after being
+// pruned, for each main chain block specified by blockHashes.  The blocks must
+// be consecutive main chain blocks.  When the filters of every pruned block
+// above the first block are recorded, the pruned height is lowered to the
+// height of the first block.
+func (s *Store) InsertPrunedCFilters(dbtx walletdb.ReadWriteTx, blockHashes []*chainhash.Hash, filters []*gcs.Filter) error {
+	if len(blockHashes) != len(filters) {
+		return errors.E(errors.Invalid, "slices must have equal len")
+	}
+	if len(blockHashes) == 0 {
+		return nil
+	}
+
+	ns := dbtx.ReadWriteBucket(wtxmgrBucketKey)
+	var firstHeight int32
+	for i, blockHash := range blockHashes {
+		header := existsBlockHeader(ns, blockHash[:])
+		if header == nil {
+			return errors.E(errors.NotExist, errors.Errorf("missing header for block %v", blockHash))
+		}
+		height := extractBlockHeaderHeight(header)
+		_, v := existsBlockRecord(ns, height)
+		if v == nil || !bytes.Equal(extractRawBlockRecordHash(v), blockHash[:]) {
+			return errors.E(errors.Invalid, errors.Errorf("block %v is not in the main chain", blockHash))
+		}
+		if i == 0 {
+			firstHeight = height
+		} else if height != firstHeight+int32(i) {
+			return errors.E(errors.Invalid, "block hashes are not consecutive")
+		}
+	}
+	for i, blockHash := range blockHashes {
+		err := putRawCFilter(ns, blockHash[:], filters[i].NBytes())
+		if err != nil {
+			return err
+		}
+	}
+
+	lastHeight := firstHeight + int32(len(blockHashes)) - 1
+	prunedHeight := fetchPrunedCFiltersHeight(ns)
+	if firstHeight < prunedHeight && lastHeight+1 >= prunedHeight {
+		if firstHeight <= 1 {
+			firstHeight = 0
+		}
+		return putPrunedCFiltersHeight(ns, firstHeight)
+	}
+	return nil
+}
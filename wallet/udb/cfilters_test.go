@@ -0,0 +1,119 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"testing"
+
+	"github.com/fonero-project/fnod/chaincfg/chainhash"
+	"github.com/fonero-project/fnod/wire"
+	"github.com/fonero-project/fnowallet/errors"
+	"github.com/fonero-project/fnowallet/wallet/walletdb"
+)
+
+func TestPruneCFilters(t *testing.T) {
+	db, s, teardown, err := setup()
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const tipHeight = 10
+	g := makeBlockGenerator()
+	headers := make([]*wire.BlockHeader, tipHeight)
+	for i := range headers {
+		headers[i] = g.generate(0)
+	}
+	headerData := makeHeaderDataSlice(headers...)
+	hashes := make([]*chainhash.Hash, tipHeight+1) // Indexed by height
+	for i := range headerData {
+		hashes[i+1] = &headerData[i].BlockHash
+	}
+
+	// checkPruned ensures that the cfilters of exactly the blocks below
+	// height (excluding genesis) are pruned.
+	checkPruned := func(dbtx walletdb.ReadTx, height int32) {
+		t.Helper()
+		if h := s.PrunedCFiltersHeight(dbtx); h != height {
+			t.Errorf("pruned height is %d, expected %d", h, height)
+		}
+		for i := int32(1); i <= tipHeight; i++ {
+			_, err := s.CFilter(dbtx, hashes[i])
+			switch {
+			case i < height && !errors.Is(errors.NotExist, err):
+				t.Errorf("cfilter at height %d is not pruned: %v", i, err)
+			case i >= height && err != nil:
+				t.Errorf("cfilter at height %d is missing: %v", i, err)
+			}
+		}
+	}
+
+	err = walletdb.Update(db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(wtxmgrBucketKey)
+		addrmgrNs := dbtx.ReadBucket(waddrmgrBucketKey)
+		err := insertMainChainHeaders(s, ns, addrmgrNs, headerData, emptyFilters(tipHeight))
+		if err != nil {
+			return err
+		}
+		checkPruned(dbtx, 0)
+
+		if _, err := s.PruneCFilters(dbtx, tipHeight+1); !errors.Is(errors.Invalid, err) {
+			t.Errorf("pruning main chain tip cfilter: unexpected error %v", err)
+		}
+		n, err := s.PruneCFilters(dbtx, 5)
+		if err != nil {
+			return err
+		}
+		if n != 4 {
+			t.Errorf("pruned %d cfilters, expected 4", n)
+		}
+		checkPruned(dbtx, 5)
+
+		// Pruning below the pruned height has no effect.
+		n, err = s.PruneCFilters(dbtx, 3)
+		if err != nil {
+			return err
+		}
+		if n != 0 {
+			t.Errorf("pruned %d cfilters below pruned height", n)
+		}
+		checkPruned(dbtx, 5)
+		n, err = s.PruneCFilters(dbtx, 7)
+		if err != nil {
+			return err
+		}
+		if n != 2 {
+			t.Errorf("pruned %d cfilters, expected 2", n)
+		}
+		checkPruned(dbtx, 7)
+
+		// Filters must be inserted for consecutive main chain blocks.
+		err = s.InsertPrunedCFilters(dbtx, []*chainhash.Hash{hashes[2], hashes[4]}, emptyFilters(2))
+		if !errors.Is(errors.Invalid, err) {
+			t.Errorf("inserting nonconsecutive cfilters: unexpected error %v", err)
+		}
+		err = s.InsertPrunedCFilters(dbtx, []*chainhash.Hash{&chainhash.Hash{}}, emptyFilters(1))
+		if !errors.Is(errors.NotExist, err) {
+			t.Errorf("inserting unknown block cfilter: unexpected error %v", err)
+		}
+
+		// Inserting the filters of blocks above the pruned height lowers
+		// the pruned height.
+		err = s.InsertPrunedCFilters(dbtx, hashes[4:7], emptyFilters(3))
+		if err != nil {
+			return err
+		}
+		checkPruned(dbtx, 4)
+		err = s.InsertPrunedCFilters(dbtx, hashes[1:4], emptyFilters(3))
+		if err != nil {
+			return err
+		}
+		checkPruned(dbtx, 0)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
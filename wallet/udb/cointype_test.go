@@ -210,3 +210,50 @@ func TestCoinTypeUpgrade(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestVerifySeed(t *testing.T) {
+	t.Parallel()
+
+	db, teardown := tempDB(t)
+	defer teardown()
+
+	params := &chaincfg.TestNetParams
+
+	err := Initialize(db, params, seed, pubPass, privPassphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m, _, _, err := Open(db, params, pubPass)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	otherSeed := append([]byte(nil), seed...)
+	otherSeed[0] ^= 1
+
+	err = walletdb.View(db, func(dbtx walletdb.ReadTx) error {
+		match, err := m.VerifySeed(dbtx, seed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !match {
+			t.Errorf("wallet seed does not verify")
+		}
+		match, err = m.VerifySeed(dbtx, otherSeed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if match {
+			t.Errorf("different seed verifies")
+		}
+		_, err = m.VerifySeed(dbtx, seed[:8])
+		if !errors.Is(errors.Invalid, err) {
+			t.Errorf("short seed: expected Invalid error, got %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"bytes"
+
+	"github.com/fonero-project/fnowallet/wallet/walletdb"
+)
+
+// Categories of database contents reported by DatabaseSizes.
+const (
+	SizeTransactions = "transactions"
+	SizeHeaders      = "headers"
+	SizeCFilters     = "cfilters"
+	SizeAddresses    = "addresses"
+	SizeStake        = "stake"
+	SizeOther        = "other"
+)
+
+// BucketSize describes the size of the database contents of one category.
+type BucketSize struct {
+	// Category is one of the Size* category constants.
+	Category string
+
+	// Keys is the number of keys with a value, excluding the keys of
+	// nested buckets.
+	Keys int64
+
+	// Bytes is the total length of every key and value.  This excludes
+	// the overhead and free space of the database file, and is an
+	// estimate of the file size after compaction.
+	Bytes int64
+}
+
+// DatabaseSizes returns the sizes of the wallet database contents by category,
+// in the order the categories are declared.  Transactions include all
+// transaction manager buckets except for block headers and records
+// (categorized as headers) and committed filters, while the address and stake
+// categories include the address manager and stake manager buckets.
+func DatabaseSizes(dbtx walletdb.ReadTx) ([]BucketSize, error) {
+	sizes := []BucketSize{
+		{Category: SizeTransactions},
+		{Category: SizeHeaders},
+		{Category: SizeCFilters},
+		{Category: SizeAddresses},
+		{Category: SizeStake},
+		{Category: SizeOther},
+	}
+	const (
+		transactions = iota
+		headers
+		cfilters
+		addresses
+		stake
+		other
+	)
+
+	err := dbtx.ForEachBucket(func(key []byte) error {
+		b := dbtx.ReadBucket(key)
+		switch {
+		case bytes.Equal(key, wtxmgrBucketKey):
+			return b.ForEach(func(k, v []byte) error {
+				nested := b.NestedReadBucket(k)
+				if nested == nil {
+					sizes[transactions].add(k, v)
+					return nil
+				}
+				i := transactions
+				switch {
+				case bytes.Equal(k, bucketHeaders), bytes.Equal(k, bucketBlocks):
+					i = headers
+				case bytes.Equal(k, bucketCFilters):
+					i = cfilters
+				}
+				sizes[i].Bytes += int64(len(k))
+				return sizes[i].addBucket(nested)
+			})
+		case bytes.Equal(key, waddrmgrBucketKey):
+			return sizes[addresses].addBucket(b)
+		case bytes.Equal(key, wstakemgrBucketKey):
+			return sizes[stake].addBucket(b)
+		default:
+			return sizes[other].addBucket(b)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sizes, nil
+}
+
+func (s *BucketSize) add(k, v []byte) {
+	s.Keys++
+	s.Bytes += int64(len(k) + len(v))
+}
+
+// addBucket adds the size of every key and value of a bucket and its nested
+// buckets.
+func (s *BucketSize) addBucket(b walletdb.ReadBucket) error {
+	return b.ForEach(func(k, v []byte) error {
+		if v == nil {
+			if nested := b.NestedReadBucket(k); nested != nil {
+				s.Bytes += int64(len(k))
+				return s.addBucket(nested)
+			}
+		}
+		s.add(k, v)
+		return nil
+	})
+}
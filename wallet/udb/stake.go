@@ -346,6 +346,29 @@ func (s *StakeStore) StakePoolUserInfo(ns walletdb.ReadBucket, user fnoutil.Addr
 	return stakePoolUserInfo(ns, user)
 }
 
+// ForEachStakePoolUser calls fn with the tickets recorded for every stake pool
+// user.  The user is identified by the voting address of its first ticket, and
+// users without any recorded tickets are skipped.  Iteration stops early if fn
+// returns an error.
+func (s *StakeStore) ForEachStakePoolUser(ns walletdb.ReadBucket, fn func(user fnoutil.Address, tickets []*PoolTicket) error) error {
+	return forEachStakePoolUserTickets(ns, func(scriptHash [20]byte, tickets []*PoolTicket) error {
+		if len(tickets) == 0 {
+			return nil
+		}
+		user, err := s.sstxAddress(ns, &tickets[0].Ticket)
+		if err != nil {
+			// The ticket purchase is missing from the stake
+			// manager, so the voting address type is unknown.
+			// Assume P2SH, as this is what stake pools use.
+			user, err = fnoutil.NewAddressScriptHashFromHash(scriptHash[:], s.Params)
+			if err != nil {
+				return err
+			}
+		}
+		return fn(user, tickets)
+	})
+}
+
 // loadManager returns a new stake manager that results from loading it from
 // the passed opened database.  The public passphrase is required to decrypt the
 // public keys.
@@ -472,6 +472,30 @@ func fetchStakePoolUserTickets(ns walletdb.ReadBucket, scriptHash [20]byte) ([]*
 	return deserializeUserTickets(val)
 }
 
+// forEachStakePoolUserTickets calls fn with the script hash and tickets of
+// every stake pool user recorded in the meta bucket, breaking early on error.
+func forEachStakePoolUserTickets(ns walletdb.ReadBucket, fn func(scriptHash [20]byte, tickets []*PoolTicket) error) error {
+	bucket := ns.NestedReadBucket(metaBucketName)
+	c := bucket.ReadCursor()
+	defer c.Close()
+	for k, v := c.Seek(stakePoolTicketsPrefix); bytes.HasPrefix(k, stakePoolTicketsPrefix); k, v = c.Next() {
+		if len(k) != stakePoolTicketsPrefixSize+scriptHashSize {
+			continue
+		}
+		var scriptHash [20]byte
+		copy(scriptHash[:], k[stakePoolTicketsPrefixSize:])
+		tickets, err := deserializeUserTickets(v)
+		if err != nil {
+			return errors.E(errors.IO, err)
+		}
+		err = fn(scriptHash, tickets)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // duplicateExistsInUserTickets checks to see if an exact duplicated of a
 // record already exists in a slice of user ticket records.
 func duplicateExistsInUserTickets(record *PoolTicket, records []*PoolTicket) bool {
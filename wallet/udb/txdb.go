@@ -155,6 +155,9 @@ var (
 	rootTipBlock     = []byte("tip")
 	rootHaveCFilters = []byte("havecfilters")
 	rootLastTxsBlock = []byte("lasttxsblock")
+
+	rootPrunedCFilters = []byte("prunedcfilters")
+	rootBirthday       = []byte("birthday")
 )
 
 // The root bucket's mined balance k/v pair records the total balance for all
@@ -188,6 +188,11 @@ func (s *Store) MainChainTip(ns walletdb.ReadBucket) (chainhash.Hash, int32) {
 //
 // The main chain tip may not be extended unless compact filters have been saved
 // for all existing main chain blocks.
+//
+// The filter may be nil when the filters of every existing main chain block,
+// other than the genesis block, are pruned, in which case the filter of the
+// block is recorded as pruned.  This allows the filters of blocks preceding the
+// wallet birthday to never be fetched.
 func (s *Store) ExtendMainChain(ns walletdb.ReadWriteBucket, header *wire.BlockHeader, f *gcs.Filter) error {
 	height := int32(header.Height)
 	if height < 1 {
@@ -220,6 +225,10 @@ func (s *Store) ExtendMainChain(ns walletdb.ReadWriteBucket, header *wire.BlockH
 	if currentTipHeight+1 != height {
 		return errors.E(errors.Invalid, "invalid height for next block")
 	}
+	prunedHeight := fetchPrunedCFiltersHeight(ns)
+	if f == nil && prunedHeight != height && !(prunedHeight == 0 && height == 1) {
+		return errors.E(errors.Invalid, "cfilter may only be omitted after blocks with pruned cfilters")
+	}
 
 	var err error
 	if approvesParent(header.VoteBits) {
@@ -256,7 +265,10 @@ func (s *Store) ExtendMainChain(ns walletdb.ReadWriteBucket, header *wire.BlockH
 		return err
 	}
 
-	// Save the compact filter
+	// Save the compact filter, or record it as pruned.
+	if f == nil {
+		return putPrunedCFiltersHeight(ns, height+1)
+	}
 	return putRawCFilter(ns, blockHash[:], f.NBytes())
 }
 
@@ -1597,6 +1609,14 @@ func (s *Store) rollback(ns walletdb.ReadWriteBucket, addrmgrNs walletdb.ReadBuc
 		return errors.E(errors.Invalid, "cannot rollback the genesis block")
 	}
 
+	// Removed blocks may not remain recorded as having pruned cfilters.
+	if fetchPrunedCFiltersHeight(ns) > height {
+		err := putPrunedCFiltersHeight(ns, height)
+		if err != nil {
+			return err
+		}
+	}
+
 	minedBalance, err := fetchMinedBalance(ns)
 	if err != nil {
 		return err
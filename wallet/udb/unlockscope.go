@@ -0,0 +1,208 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"strings"
+
+	"github.com/fonero-project/fnowallet/errors"
+	"github.com/fonero-project/fnowallet/wallet/walletdb"
+	"golang.org/x/crypto/ripemd160"
+)
+
+// KeyUse describes the operations a private key may be used for.  Uses are
+// combined as a bit set.
+type KeyUse uint32
+
+// Private key uses.
+const (
+	KeyUseTransaction KeyUse = 1 << iota // Signing regular transactions
+	KeyUseTicket                         // Signing ticket purchases and their split transactions
+	KeyUseVote                           // Signing votes and revocations
+	KeyUseMessage                        // Signing messages
+	KeyUseExport                         // Exporting private keys
+
+	// KeyUseAny permits every use.  It is the only use permitting the
+	// private keys returned by PrivateKey, which does not describe how the
+	// key is used.
+	KeyUseAny KeyUse = 0
+)
+
+var keyUseNames = []string{"transaction", "ticket", "vote", "message", "export"}
+
+// ParseKeyUse returns the use named by s.
+func ParseKeyUse(s string) (KeyUse, error) {
+	for i, name := range keyUseNames {
+		if s == name {
+			return 1 << uint(i), nil
+		}
+	}
+	return 0, errors.E(errors.Invalid, errors.Errorf("unknown key use %q", s))
+}
+
+func (u KeyUse) String() string {
+	if u == KeyUseAny {
+		return "any"
+	}
+	var names []string
+	for i, name := range keyUseNames {
+		if u&(1<<uint(i)) != 0 {
+			names = append(names, name)
+		}
+	}
+	return strings.Join(names, ",")
+}
+
+// UnlockScope restricts the private keys an unlocked manager returns.  The zero
+// value permits every private key.
+type UnlockScope struct {
+	// Accounts limits private keys to addresses of these accounts.  All
+	// accounts are permitted when empty.
+	Accounts []uint32
+
+	// Uses limits the operations private keys may be used for.  All uses
+	// are permitted when KeyUseAny.
+	Uses KeyUse
+
+	// Signatures limits the number of private keys which are returned.
+	// Each returned key counts as a single signature even when it is used
+	// to sign multiple inputs, while each export of an account key counts
+	// as a signature.  Unlimited when zero.
+	Signatures uint32
+}
+
+// Equal returns whether two scopes permit the same private keys.
+func (s *UnlockScope) Equal(t *UnlockScope) bool {
+	if s.Uses != t.Uses || s.Signatures != t.Signatures ||
+		(len(s.Accounts) == 0) != (len(t.Accounts) == 0) {
+		return false
+	}
+	for _, a := range s.Accounts {
+		if !t.hasAccount(a) {
+			return false
+		}
+	}
+	for _, a := range t.Accounts {
+		if !s.hasAccount(a) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *UnlockScope) hasAccount(account uint32) bool {
+	for _, a := range s.Accounts {
+		if a == account {
+			return true
+		}
+	}
+	return false
+}
+
+// unlockGrant is the scope of a single unlock of the manager.
+type unlockGrant struct {
+	scope      UnlockScope
+	signatures uint32 // Remaining signatures when limited
+
+	// Hash160s of the keys which took a signature from a limited grant.
+	signed map[[ripemd160.Size]byte]struct{}
+}
+
+// permits returns whether the grant permits a private key of an account to be
+// used for use, ignoring the signature limit.  When account is nil, keys of
+// every account must be permitted.
+func (g *unlockGrant) permits(account *uint32, use KeyUse) bool {
+	if g.scope.Uses != KeyUseAny && (use == KeyUseAny || g.scope.Uses&use != use) {
+		return false
+	}
+	if len(g.scope.Accounts) == 0 {
+		return true
+	}
+	return account != nil && g.scope.hasAccount(*account)
+}
+
+// authorizeKey checks whether any unlock grant permits a private key of an
+// account to be used for use, and takes a signature from a limited grant when
+// no unlimited grant permits it.  A key identified by its hash160 takes a
+// single signature from a limited grant however many times it is used, while
+// every use of a key which is not identified (nil hash160) takes a signature.
+// When account is nil, keys of every account must be permitted.  The manager
+// mutex must be held for writes.
+func (m *Manager) authorizeKey(account *uint32, use KeyUse, hash160 *[ripemd160.Size]byte) error {
+	var limited *unlockGrant
+	for _, g := range m.unlockGrants {
+		if !g.permits(account, use) {
+			continue
+		}
+		if g.scope.Signatures == 0 {
+			return nil
+		}
+		if hash160 != nil {
+			if _, ok := g.signed[*hash160]; ok {
+				return nil
+			}
+		}
+		if limited == nil && g.signatures != 0 {
+			limited = g
+		}
+	}
+	if limited == nil {
+		return errors.E(errors.Permission, "private key access is outside the unlock scope")
+	}
+	limited.signatures--
+	if hash160 != nil {
+		if limited.signed == nil {
+			limited.signed = make(map[[ripemd160.Size]byte]struct{})
+		}
+		limited.signed[*hash160] = struct{}{}
+	}
+	return nil
+}
+
+// UnlockScoped unlocks the manager as Unlock does, but only permits access to
+// the private keys allowed by scope.  A nil scope permits every private key.
+// When the manager is already unlocked, the scope is granted in addition to
+// the scopes of previous unlocks.  The returned identifier may be passed to
+// RevokeUnlock to remove the scope without locking the manager for the scopes
+// of other unlocks.
+func (m *Manager) UnlockScoped(ns walletdb.ReadBucket, passphrase []byte, scope *UnlockScope) (uint64, error) {
+	defer m.mtx.Unlock()
+	m.mtx.Lock()
+
+	err := m.unlock(ns, passphrase)
+	if err != nil {
+		return 0, err
+	}
+
+	g := new(unlockGrant)
+	if scope != nil {
+		g.scope = *scope
+		g.scope.Accounts = append([]uint32(nil), scope.Accounts...)
+		g.signatures = scope.Signatures
+	}
+	if m.unlockGrants == nil {
+		m.unlockGrants = make(map[uint64]*unlockGrant)
+	}
+	m.lastUnlockGrant++
+	m.unlockGrants[m.lastUnlockGrant] = g
+	return m.lastUnlockGrant, nil
+}
+
+// RevokeUnlock removes the scope granted by an unlock.  The manager is locked
+// when no other unlock scopes remain, and the return value reports whether the
+// manager is locked.
+func (m *Manager) RevokeUnlock(id uint64) (locked bool) {
+	defer m.mtx.Unlock()
+	m.mtx.Lock()
+
+	if m.locked {
+		return true
+	}
+	delete(m.unlockGrants, id)
+	if len(m.unlockGrants) == 0 && !m.watchingOnly {
+		m.lock()
+	}
+	return m.locked
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"testing"
+
+	"github.com/fonero-project/fnowallet/errors"
+	"golang.org/x/crypto/ripemd160"
+)
+
+func TestAuthorizeKey(t *testing.T) {
+	m := &Manager{unlockGrants: map[uint64]*unlockGrant{
+		1: {scope: UnlockScope{Accounts: []uint32{1}, Uses: KeyUseTicket | KeyUseVote}},
+		2: {scope: UnlockScope{Uses: KeyUseMessage, Signatures: 2}, signatures: 2},
+	}}
+	acct := func(a uint32) *uint32 { return &a }
+
+	tests := []struct {
+		account *uint32
+		use     KeyUse
+		ok      bool
+	}{
+		{acct(1), KeyUseTicket, true},
+		{acct(1), KeyUseVote, true},
+		{acct(0), KeyUseTicket, false},      // Account outside scope
+		{acct(1), KeyUseTransaction, false}, // Use outside scope
+		{acct(1), KeyUseAny, false},         // Unspecified use
+		{acct(1), KeyUseTicket | KeyUseTransaction, false},
+		{nil, KeyUseTicket, false},      // Every account
+		{acct(0), KeyUseMessage, true},  // First limited signature
+		{acct(5), KeyUseMessage, true},  // Second limited signature
+		{acct(0), KeyUseMessage, false}, // Signatures exhausted
+	}
+	for i, test := range tests {
+		err := m.authorizeKey(test.account, test.use, nil)
+		if test.ok && err != nil {
+			t.Errorf("test %d: unexpected error: %v", i, err)
+		}
+		if !test.ok && !errors.Is(errors.Permission, err) {
+			t.Errorf("test %d: expected Permission error, got %v", i, err)
+		}
+	}
+
+	// An unrestricted grant permits every key.
+	m.unlockGrants[3] = new(unlockGrant)
+	if err := m.authorizeKey(nil, KeyUseAny, nil); err != nil {
+		t.Errorf("unrestricted grant: unexpected error: %v", err)
+	}
+}
+
+func TestAuthorizeKeySignatures(t *testing.T) {
+	m := &Manager{unlockGrants: map[uint64]*unlockGrant{
+		1: {scope: UnlockScope{Uses: KeyUseTransaction, Signatures: 1}, signatures: 1},
+	}}
+	var account uint32
+	key1 := &[ripemd160.Size]byte{1}
+	key2 := &[ripemd160.Size]byte{2}
+
+	// A transaction spending several outputs of the same address uses
+	// the key once per input, but takes a single signature.
+	for i := 0; i < 3; i++ {
+		err := m.authorizeKey(&account, KeyUseTransaction, key1)
+		if err != nil {
+			t.Fatalf("input %d signed by the same key: unexpected error: %v", i, err)
+		}
+	}
+	err := m.authorizeKey(&account, KeyUseTransaction, key2)
+	if !errors.Is(errors.Permission, err) {
+		t.Errorf("second key: expected Permission error, got %v", err)
+	}
+	err = m.authorizeKey(&account, KeyUseTransaction, nil)
+	if !errors.Is(errors.Permission, err) {
+		t.Errorf("unidentified key: expected Permission error, got %v", err)
+	}
+
+	// The key does not take a signature from another grant.
+	m.unlockGrants[2] = &unlockGrant{
+		scope:      UnlockScope{Uses: KeyUseTransaction, Signatures: 1},
+		signatures: 1,
+	}
+	if err := m.authorizeKey(&account, KeyUseTransaction, key1); err != nil {
+		t.Errorf("key signed before a new grant: unexpected error: %v", err)
+	}
+	if m.unlockGrants[2].signatures != 1 {
+		t.Errorf("key already signed took a signature from another grant")
+	}
+}
+
+func TestUnlockScopeEqual(t *testing.T) {
+	a := &UnlockScope{Accounts: []uint32{1, 2}, Uses: KeyUseTicket}
+	b := &UnlockScope{Accounts: []uint32{2, 1, 1}, Uses: KeyUseTicket}
+	if !a.Equal(b) || !b.Equal(a) {
+		t.Errorf("scopes with the same accounts are not equal")
+	}
+	c := &UnlockScope{Accounts: []uint32{1}, Uses: KeyUseTicket}
+	if a.Equal(c) || c.Equal(a) {
+		t.Errorf("scopes with different accounts are equal")
+	}
+	if a.Equal(&UnlockScope{}) {
+		t.Errorf("restricted scope equals unrestricted scope")
+	}
+}
@@ -99,10 +99,24 @@ const (
 	// from properly-synced wallets.
 	lastProcessedTxsBlockVersion = 11
 
+	// accountPassphrasesVersion is the twelfth version of the database.  It
+	// adds an address manager bucket recording the master key parameters of
+	// accounts whose private extended keys are encrypted with their own
+	// passphrase.  The keys of these accounts can not be decrypted by older
+	// software.
+	accountPassphrasesVersion = 12
+
+	// kdfTypeVersion is the thirteenth version of the database.  It allows
+	// the marshalled master key parameters to end with a byte identifying the
+	// key derivation function, which is appended when the private passphrase
+	// is changed to use Argon2id.  Older software can not read these
+	// parameters.  No data is modified by the upgrade.
+	kdfTypeVersion = 13
+
 	// DBVersion is the latest version of the database that is understood by the
 	// program.  Databases with recorded versions higher than this will fail to
 	// open (meaning any upgrades prevent reverting to older software).
-	DBVersion = lastProcessedTxsBlockVersion
+	DBVersion = kdfTypeVersion
 )
 
 // upgrades maps between old database versions and the upgrade function to
@@ -119,6 +133,8 @@ var upgrades = [...]func(walletdb.ReadWriteTx, []byte, *chaincfg.Params) error{
 	hasExpiryFixedVersion - 1:        hasExpiryFixedUpgrade,
 	cfVersion - 1:                    cfUpgrade,
 	lastProcessedTxsBlockVersion - 1: lastProcessedTxsBlockUpgrade,
+	accountPassphrasesVersion - 1:    accountPassphrasesUpgrade,
+	kdfTypeVersion - 1:               kdfTypeUpgrade,
 }
 
 func lastUsedAddressIndexUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
@@ -803,6 +819,53 @@ func lastProcessedTxsBlockUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []by
 	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
 }
 
+func accountPassphrasesUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 11
+	const newVersion = 12
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	addrmgrBucket := tx.ReadWriteBucket(waddrmgrBucketKey)
+
+	// Assert that this function is only called on version 11 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "accountPassphrasesUpgrade inappropriately called")
+	}
+
+	// Create the bucket of account passphrase parameters.  All existing
+	// accounts are encrypted with the crypto private key.
+	_, err = addrmgrBucket.CreateBucket(acctPassBucketName)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func kdfTypeUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 12
+	const newVersion = 13
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+
+	// Assert that this function is only called on version 12 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "kdfTypeUpgrade inappropriately called")
+	}
+
+	// Existing master key parameters are all scrypt parameters, which are
+	// marshalled without the KDF byte, so only the version changes.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
 // Upgrade checks whether the any upgrades are necessary before the database is
 // ready for application usage.  If any are, they are performed.
 func Upgrade(db walletdb.DB, publicPassphrase []byte, params *chaincfg.Params) error {
@@ -18,7 +18,9 @@ import (
 	"github.com/fonero-project/fnod/chaincfg"
 	"github.com/fonero-project/fnod/chaincfg/chainhash"
 	"github.com/fonero-project/fnod/wire"
+	"github.com/fonero-project/fnowallet/errors"
 	_ "github.com/fonero-project/fnowallet/wallet/drivers/bdb"
+	_ "github.com/fonero-project/fnowallet/wallet/drivers/memdb"
 	"github.com/fonero-project/fnowallet/wallet/walletdb"
 )
 
@@ -412,3 +414,68 @@ func verifyV8Upgrade(t *testing.T, db walletdb.DB) {
 		t.Error(err)
 	}
 }
+
+func TestAccountPassphrasesUpgrade(t *testing.T) {
+	t.Parallel()
+
+	db, err := walletdb.Create("memdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	err = walletdb.Update(db, func(tx walletdb.ReadWriteTx) error {
+		metadataBucket, err := tx.CreateTopLevelBucket(unifiedDBMetadata{}.rootBucketKey())
+		if err != nil {
+			return err
+		}
+		_, err = tx.CreateTopLevelBucket(waddrmgrBucketKey)
+		if err != nil {
+			return err
+		}
+		return unifiedDBMetadata{}.putVersion(metadataBucket, accountPassphrasesVersion-1)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = Upgrade(db, pubPass, &chaincfg.TestNetParams)
+	if err != nil {
+		t.Fatalf("Upgrade failed: %v", err)
+	}
+	err = walletdb.View(db, func(tx walletdb.ReadTx) error {
+		metadataBucket := tx.ReadBucket(unifiedDBMetadata{}.rootBucketKey())
+		version, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+		if err != nil {
+			return err
+		}
+		if version != DBVersion {
+			t.Errorf("upgraded to version %d, expected %d", version, DBVersion)
+		}
+		ns := tx.ReadBucket(waddrmgrBucketKey)
+		if ns.NestedReadBucket(acctPassBucketName) == nil {
+			t.Fatal("account passphrases bucket was not created")
+		}
+		if params := fetchAccountPassphraseParams(ns, 1); params != nil {
+			t.Errorf("existing account has passphrase parameters")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = walletdb.Update(db, func(tx walletdb.ReadWriteTx) error {
+		return accountPassphrasesUpgrade(tx, pubPass, &chaincfg.TestNetParams)
+	})
+	if !errors.Is(errors.Invalid, err) {
+		t.Errorf("upgrading version %d database: expected Invalid error, got %v",
+			DBVersion, err)
+	}
+	err = walletdb.Update(db, func(tx walletdb.ReadWriteTx) error {
+		return kdfTypeUpgrade(tx, pubPass, &chaincfg.TestNetParams)
+	})
+	if !errors.Is(errors.Invalid, err) {
+		t.Errorf("upgrading version %d database: expected Invalid error, got %v",
+			DBVersion, err)
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fonero-project/fnowallet/wallet/udb"
+)
+
+func TestUnlockGrants(t *testing.T) {
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	pass := []byte("private")
+	scope := &udb.UnlockScope{Uses: udb.KeyUseVote}
+
+	// A grant does not replace another caller's unlock of the same scope,
+	// so revoking the grant leaves the wallet unlocked.
+	if err := w.UnlockScoped(pass, scope, make(chan time.Time)); err != nil {
+		t.Fatal(err)
+	}
+	g, err := w.GrantUnlock(pass, scope, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.RevokeUnlock(g)
+	if w.Locked() {
+		t.Fatal("revoking a grant locked another unlock of the same scope")
+	}
+	w.Lock()
+
+	// Another caller's unlock of the same scope is not dropped in favor of
+	// a grant without a timeout.
+	g, err = w.GrantUnlock(pass, scope, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.UnlockScoped(pass, scope, make(chan time.Time)); err != nil {
+		t.Fatal(err)
+	}
+	w.RevokeUnlock(g)
+	if w.Locked() {
+		t.Fatal("unlock of the same scope as a grant was dropped")
+	}
+	w.Lock()
+
+	// Grants of the same scope are independent, and revoking a grant which
+	// is no longer in effect does nothing.
+	g1, err := w.GrantUnlock(pass, scope, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g2, err := w.GrantUnlock(pass, scope, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.RevokeUnlock(g1)
+	if w.Locked() {
+		t.Fatal("revoking one grant revoked another")
+	}
+	w.RevokeUnlock(g)
+	w.RevokeUnlock(g1)
+	if w.Locked() {
+		t.Fatal("revoking a grant twice revoked another")
+	}
+	w.RevokeUnlock(g2)
+	if !w.Locked() {
+		t.Fatal("wallet is unlocked after revoking every grant")
+	}
+}
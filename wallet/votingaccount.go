@@ -0,0 +1,320 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/fonero-project/fnod/blockchain/stake"
+	"github.com/fonero-project/fnod/fnoutil"
+	"github.com/fonero-project/fnod/hdkeychain"
+	"github.com/fonero-project/fnod/txscript"
+	"github.com/fonero-project/fnod/wire"
+	"github.com/fonero-project/fnowallet/errors"
+	"github.com/fonero-project/fnowallet/wallet/udb"
+	"github.com/fonero-project/fnowallet/wallet/walletdb"
+)
+
+// A voting-only wallet holds the keys of a single account of another (cold)
+// wallet which purchases tickets assigning voting rights to addresses of that
+// account.  The voting account is created by the cold wallet with
+// NextVotingAccount, exported with ExportVotingAccount, and imported into the
+// voting wallet with ImportVotingAccount.  Tickets purchased by the cold wallet
+// are reported by VotingAccountTickets and added to the voting wallet with
+// AddVotingTickets, after which they are voted as any other ticket when voting
+// is enabled.
+
+// VotingTicket describes a ticket which assigns voting rights to an address of
+// a voting account.
+type VotingTicket struct {
+	Ticket *wire.MsgTx
+
+	// Branch and child index of the ticket's voting address
+	Branch uint32
+	Child  uint32
+}
+
+// NextVotingAccount creates the next account as NextAccount does, and marks it
+// as a voting account which may be exported with ExportVotingAccount.  Voting
+// accounts should only be used for the voting addresses of tickets, as any
+// other funds received by the account are spendable by the voting-only wallet.
+func (w *Wallet) NextVotingAccount(name string) (uint32, error) {
+	const op errors.Op = "wallet.NextVotingAccount"
+	return w.nextAccount(op, name, nil, true)
+}
+
+// ExportVotingAccount returns the extended private key of a voting account
+// created by NextVotingAccount so it may be imported by a voting-only wallet.
+// Other accounts may not be exported.  The key should be cleared by the caller
+// when finished.  This method requires the wallet to be unlocked.
+func (w *Wallet) ExportVotingAccount(account uint32) (*hdkeychain.ExtendedKey, error) {
+	const op errors.Op = "wallet.ExportVotingAccount"
+	var xpriv *hdkeychain.ExtendedKey
+	err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+		if !w.Manager.IsVotingAccount(addrmgrNs, account) {
+			return errors.E(errors.Invalid, errors.Errorf("account %d is "+
+				"not a voting account", account))
+		}
+		var err error
+		xpriv, err = w.Manager.AccountExtendedPrivKey(tx, account)
+		return err
+	})
+	w.audit("ExportVotingAccount", map[string]string{
+		"account": strconv.FormatUint(uint64(account), 10),
+	}, err)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return xpriv, nil
+}
+
+// ImportVotingAccount creates a new account from the extended private key of
+// another wallet's voting account.  Addresses up to the gap limit are watched
+// for tickets.  Imported accounts are not recoverable from the wallet's seed.
+// This method requires the wallet to be unlocked.
+func (w *Wallet) ImportVotingAccount(name string, xpriv *hdkeychain.ExtendedKey) (uint32, error) {
+	const op errors.Op = "wallet.ImportVotingAccount"
+	account, err := w.importAccount(name, xpriv, nil)
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	return account, nil
+}
+
+// importAccount creates a new account from an extended private key, which is
+// encrypted with the passphrase when it is not empty.  Addresses up to the gap
+// limit are synced and watched.  Errors are wrapped by the caller.
+func (w *Wallet) importAccount(name string, xpriv *hdkeychain.ExtendedKey, passphrase []byte) (uint32, error) {
+	var account uint32
+	var props *udb.AccountProperties
+	var xpub *hdkeychain.ExtendedKey
+	err := walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+		addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+
+		var err error
+		account, err = w.Manager.ImportAccountWithPassphrase(addrmgrNs, name, xpriv, passphrase)
+		if err != nil {
+			return err
+		}
+
+		props, err = w.Manager.AccountProperties(addrmgrNs, account)
+		if err != nil {
+			return err
+		}
+
+		xpub, err = w.Manager.AccountExtendedPubKey(tx, account)
+		if err != nil {
+			return err
+		}
+
+		gapLimit := uint32(w.gapLimit)
+		err = w.Manager.SyncAccountToAddrIndex(addrmgrNs, account,
+			gapLimit, udb.ExternalBranch)
+		if err != nil {
+			return err
+		}
+		return w.Manager.SyncAccountToAddrIndex(addrmgrNs, account,
+			gapLimit, udb.InternalBranch)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	extKey, intKey, err := deriveBranches(xpub)
+	if err != nil {
+		return 0, err
+	}
+	w.addressBuffersMu.Lock()
+	w.addressBuffers[account] = &bip0044AccountData{
+		albExternal: addressBuffer{branchXpub: extKey, lastUsed: ^uint32(0)},
+		albInternal: addressBuffer{branchXpub: intKey, lastUsed: ^uint32(0)},
+	}
+	w.addressBuffersMu.Unlock()
+
+	if n, err := w.NetworkBackend(); err == nil {
+		var addrs []fnoutil.Address
+		for _, branchKey := range []*hdkeychain.ExtendedKey{extKey, intKey} {
+			a, err := deriveChildAddresses(branchKey, 0,
+				uint32(w.gapLimit), w.chainParams)
+			if err != nil {
+				return 0, err
+			}
+			addrs = append(addrs, a...)
+		}
+		err = n.LoadTxFilter(context.TODO(), false, addrs, nil)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	w.NtfnServer.notifyAccountProperties(props)
+
+	return account, nil
+}
+
+// VotingAccountTickets returns all tickets recorded by the wallet which assign
+// voting rights to addresses of an account.  It is used by the ticket
+// purchasing wallet to inform a voting-only wallet of the tickets it must
+// vote.
+func (w *Wallet) VotingAccountTickets(account uint32) ([]VotingTicket, error) {
+	const op errors.Op = "wallet.VotingAccountTickets"
+
+	type votingAddr struct {
+		branch, child uint32
+	}
+	var tickets []VotingTicket
+	err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		// Index the account's addresses so each ticket is only checked
+		// once.
+		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+		addrs := make(map[string]votingAddr)
+		err := w.Manager.ForEachAccountAddress(addrmgrNs, account,
+			func(maddr udb.ManagedAddress) error {
+				a, ok := maddr.(udb.ManagedPubKeyAddress)
+				if !ok {
+					return nil
+				}
+				branch := udb.ExternalBranch
+				if a.Internal() {
+					branch = udb.InternalBranch
+				}
+				addrs[a.Address().EncodeAddress()] = votingAddr{branch, a.Index()}
+				return nil
+			})
+		if err != nil {
+			return err
+		}
+		if len(addrs) == 0 {
+			return nil
+		}
+
+		it := w.TxStore.IterateTickets(tx)
+		defer it.Close()
+		for it.Next() {
+			ticket := &it.MsgTx
+			txOut := ticket.TxOut[0]
+			_, ticketAddrs, _, err := txscript.ExtractPkScriptAddrs(
+				txOut.Version, txOut.PkScript, w.chainParams)
+			if err != nil || len(ticketAddrs) != 1 {
+				continue
+			}
+			a, ok := addrs[ticketAddrs[0].EncodeAddress()]
+			if !ok {
+				continue
+			}
+			tickets = append(tickets, VotingTicket{
+				Ticket: ticket.Copy(),
+				Branch: a.branch,
+				Child:  a.child,
+			})
+		}
+		return it.Err()
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return tickets, nil
+}
+
+// AddVotingTickets adds tickets purchased by another wallet to be voted by a
+// voting account.  Each ticket's voting address must be the address of the
+// account at the described branch and child index.  Addresses are synced up to
+// the highest child index so the wallet holds the keys to vote each ticket.
+func (w *Wallet) AddVotingTickets(account uint32, tickets []VotingTicket) error {
+	const op errors.Op = "wallet.AddVotingTickets"
+
+	var xpub *hdkeychain.ExtendedKey
+	err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		var err error
+		xpub, err = w.Manager.AccountExtendedPubKey(tx, account)
+		return err
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	extKey, intKey, err := deriveBranches(xpub)
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	// Check every ticket votes with the described address before modifying
+	// the wallet.
+	var maxChild [2]uint32
+	var synced [2]bool
+	votingAddrs := make([]fnoutil.Address, 0, len(tickets))
+	for i := range tickets {
+		t := &tickets[i]
+		if stake.CheckSStx(t.Ticket) != nil {
+			txHash := t.Ticket.TxHash()
+			return errors.E(op, errors.Invalid, errors.Errorf("%v is not a ticket", &txHash))
+		}
+		var branchKey *hdkeychain.ExtendedKey
+		switch t.Branch {
+		case udb.ExternalBranch:
+			branchKey = extKey
+		case udb.InternalBranch:
+			branchKey = intKey
+		default:
+			return errors.E(op, errors.Invalid, "branch must be external (0) or internal (1)")
+		}
+		addrs, err := deriveChildAddresses(branchKey, t.Child, 1, w.chainParams)
+		if err != nil {
+			return errors.E(op, err)
+		}
+		txOut := t.Ticket.TxOut[0]
+		_, ticketAddrs, _, err := txscript.ExtractPkScriptAddrs(txOut.Version,
+			txOut.PkScript, w.chainParams)
+		if err != nil || len(ticketAddrs) != 1 ||
+			ticketAddrs[0].EncodeAddress() != addrs[0].EncodeAddress() {
+			txHash := t.Ticket.TxHash()
+			return errors.E(op, errors.Invalid, errors.Errorf("ticket %v "+
+				"does not vote with account %d address %d/%d", &txHash,
+				account, t.Branch, t.Child))
+		}
+		votingAddrs = append(votingAddrs, addrs[0])
+		if !synced[t.Branch] || t.Child > maxChild[t.Branch] {
+			maxChild[t.Branch] = t.Child
+			synced[t.Branch] = true
+		}
+	}
+
+	err = walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+		ns := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+		for branch := range synced {
+			if !synced[branch] {
+				continue
+			}
+			err := w.Manager.SyncAccountToAddrIndex(ns, account,
+				maxChild[branch], uint32(branch))
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	for i := range tickets {
+		err := w.AddTicket(tickets[i].Ticket)
+		if err != nil {
+			return errors.E(op, err)
+		}
+	}
+
+	// Watch the voting addresses for the mined tickets, votes, and
+	// revocations.
+	if n, err := w.NetworkBackend(); err == nil && len(votingAddrs) != 0 {
+		err = n.LoadTxFilter(context.TODO(), false, votingAddrs, nil)
+		if err != nil {
+			return errors.E(op, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fonero-project/fnowallet/errors"
+)
+
+func TestExportVotingAccount(t *testing.T) {
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	lock := make(chan time.Time)
+	defer close(lock)
+	err := w.Unlock([]byte("private"), lock)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	account, err := w.NextAccount("regular")
+	if err != nil {
+		t.Fatal(err)
+	}
+	voting, err := w.NextVotingAccount("voting")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, acct := range []uint32{0, account} {
+		_, err := w.ExportVotingAccount(acct)
+		if !errors.Is(errors.Invalid, err) {
+			t.Errorf("exporting account %d: expected Invalid error, got %v", acct, err)
+		}
+	}
+	xpriv, err := w.ExportVotingAccount(voting)
+	if err != nil {
+		t.Fatalf("exporting voting account: %v", err)
+	}
+	if !xpriv.IsPrivate() {
+		t.Errorf("exported voting account key is not private")
+	}
+
+	tickets, err := w.VotingAccountTickets(voting)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tickets) != 0 {
+		t.Errorf("found %d tickets for a new voting account", len(tickets))
+	}
+}
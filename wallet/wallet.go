@@ -30,6 +30,7 @@ import (
 	fnorpcclient "github.com/fonero-project/fnod/rpcclient"
 	"github.com/fonero-project/fnod/txscript"
 	"github.com/fonero-project/fnod/wire"
+	"github.com/fonero-project/fnowallet/auditlog"
 	"github.com/fonero-project/fnowallet/deployments"
 	"github.com/fonero-project/fnowallet/errors"
 	"github.com/fonero-project/fnowallet/wallet/walletdb"
@@ -97,11 +98,24 @@ type Wallet struct {
 	gapLimit        int
 	accountGapLimit int
 
+	// Committed filter pruning.  Pruning is held while rescans and address
+	// discovery use filters of pruned blocks.
+	cfilterPruneDepth int32
+	cfilterPruneMu    sync.Mutex
+	cfilterPruneHolds int
+
+	// Missed tickets found without a MissedTicketsChecker backend.
+	missedScan missedTicketsScan
+
 	networkBackend   NetworkBackend
 	networkBackendMu sync.Mutex
 
 	lockedOutpoints map[wire.OutPoint]struct{}
 
+	spendPolicyMu      sync.Mutex
+	spendPolicies      map[uint32]*SpendPolicy
+	namedSpendPolicies map[string]*SpendPolicy
+
 	relayFee               fnoutil.Amount
 	relayFeeMu             sync.Mutex
 	ticketFeeIncrementLock sync.Mutex
@@ -109,6 +123,10 @@ type Wallet struct {
 	DisallowFree           bool
 	AllowHighFees          bool
 
+	// Change outputs of sent transactions remain the last output when
+	// change randomization is disabled.
+	disableChangeRandomization bool
+
 	// Channel for transaction creation requests.
 	consolidateRequests      chan consolidateRequest
 	createTxRequests         chan createTxRequest
@@ -125,6 +143,7 @@ type Wallet struct {
 
 	// Channels for the manager locker.
 	unlockRequests     chan unlockRequest
+	revokeUnlocks      chan uint64
 	lockRequests       chan struct{}
 	holdUnlockRequests chan chan heldUnlock
 	lockState          chan bool
@@ -132,6 +151,8 @@ type Wallet struct {
 
 	NtfnServer *NotificationServer
 
+	auditLog *auditlog.Log
+
 	chainParams *chaincfg.Params
 
 	wg sync.WaitGroup
@@ -161,6 +182,26 @@ type Config struct {
 	AllowHighFees       bool
 	RelayFee            float64
 	Params              *chaincfg.Params
+
+	AuditLog *auditlog.Log
+
+	// CFilterPruneDepth enables pruning of the committed filters of main
+	// chain blocks this many blocks below the main chain tip.  Pruning is
+	// disabled when zero, and the depth must otherwise be at least
+	// MinCFilterPruneDepth.
+	CFilterPruneDepth int32
+
+	// SpendPolicies restricts spending from accounts by name.  Policies
+	// apply to accounts created after the wallet is opened, and continue to
+	// apply to renamed accounts.
+	SpendPolicies map[string]*SpendPolicy
+
+	// DisableChangeRandomization keeps the change output of sent
+	// transactions as the last output rather than moving it to a random
+	// position.  This makes created transactions reproducible for testing
+	// and must not be used by wallets holding real funds, as the change
+	// position reveals which output is change.
+	DisableChangeRandomization bool
 }
 
 // FetchOutput fetches the associated transaction output given an outpoint.
@@ -1191,7 +1232,7 @@ out:
 				continue
 			}
 			tx, err := w.txToOutputs("wallet.SendOutputs", txr.outputs,
-				txr.account, txr.minconf, true)
+				txr.account, txr.minconf, !w.disableChangeRandomization)
 			heldUnlock.release()
 			txr.resp <- createTxResponse{tx, err}
 
@@ -1283,12 +1324,16 @@ func (w *Wallet) PurchaseTickets(minBalance, spendLimit fnoutil.Amount, minConf
 type (
 	unlockRequest struct {
 		passphrase []byte
+		scope      *udb.UnlockScope // nil permits every private key.
 		lockAfter  <-chan time.Time // nil prevents the timeout.
+		accounts   map[uint32][]byte
+		grant      *UnlockGrant // Set for unlocks owned by a single caller.
 		err        chan error
 	}
 
 	changePassphraseRequest struct {
 		old, new []byte
+		config   *udb.ScryptOptions // nil uses the default KDF parameters
 		err      chan error
 	}
 
@@ -1298,54 +1343,164 @@ type (
 	// *must* be released (preferably with a defer) or the wallet
 	// will forever remain unlocked.
 	heldUnlock chan struct{}
+
+	// activeUnlock records the scope of an unlock which is in effect.
+	activeUnlock struct {
+		scope  udb.UnlockScope
+		timed  bool
+		owned  bool          // Granted to a single caller by GrantUnlock
+		cancel chan struct{} // Closed when the unlock is no longer in effect
+	}
 )
 
 // walletLocker manages the locked/unlocked state of a wallet.
 func (w *Wallet) walletLocker() {
-	var timeout <-chan time.Time
+	// Unlocks in effect keyed by the identifiers of their manager grants.
+	// Unlocks with a timeout are revoked when the timeout expires, and the
+	// manager is locked once no unlocks remain.  Unlocks made by Unlock and
+	// UnlockScoped share a single owner and may replace each other, while
+	// each unlock made by GrantUnlock is owned by its caller alone and is
+	// only removed by its timeout, RevokeUnlock, or locking the wallet.
+	unlocks := make(map[uint64]*activeUnlock)
+	expired := make(chan uint64)
 	holdChan := make(heldUnlock)
 	quit := w.quitChan()
+	clearUnlocks := func() {
+		for _, u := range unlocks {
+			close(u.cancel)
+		}
+		unlocks = make(map[uint64]*activeUnlock)
+	}
+	revoke := func(id uint64) {
+		u, ok := unlocks[id]
+		if !ok {
+			return
+		}
+		close(u.cancel)
+		delete(unlocks, id)
+		if w.Manager.RevokeUnlock(id) {
+			log.Info("The wallet has been locked.")
+		}
+	}
 out:
 	for {
 		select {
 		case req := <-w.unlockRequests:
-			hadTimeout := timeout != nil
 			var wasLocked bool
+			var id uint64
 			err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
 				wasLocked = w.Manager.IsLocked()
 				addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
-				return w.Manager.Unlock(addrmgrNs, req.passphrase)
+				var err error
+				id, err = w.Manager.UnlockScoped(addrmgrNs, req.passphrase, req.scope)
+				return err
 			})
 			if err != nil {
 				if !wasLocked {
 					log.Info("The wallet has been locked due to an incorrect passphrase.")
 				}
+				clearUnlocks()
 				req.err <- err
 				continue
 			}
-			// When the wallet was already unlocked without any timeout, do not
-			// set the timeout and instead wait until an explicit lock is
-			// performed.  Read the timeout in a new goroutine so that callers
-			// won't deadlock on the send.
-			if !wasLocked && !hadTimeout && req.lockAfter != nil {
-				go func() { <-req.lockAfter }()
-			} else {
-				timeout = req.lockAfter
+
+			// Revoke the unlock, leaving the accounts locked, when an
+			// account passphrase is incorrect.
+			if len(req.accounts) != 0 {
+				err = walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+					addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+					return w.Manager.UnlockAccounts(addrmgrNs, req.accounts)
+				})
+				if err != nil {
+					w.Manager.RevokeUnlock(id)
+					req.err <- err
+					continue
+				}
 			}
-			switch {
-			case (wasLocked || hadTimeout) && timeout == nil:
-				log.Info("The wallet has been unlocked without a time limit")
-			case (wasLocked || !hadTimeout) && timeout != nil:
-				log.Info("The wallet has been temporarily unlocked")
+
+			var scope udb.UnlockScope
+			if req.scope != nil {
+				scope = *req.scope
+			}
+			timed := req.lockAfter != nil
+
+			// An unlock replaces an earlier unlock of the same owner
+			// with the same scope and no signature limit, so its timeout
+			// replaces the earlier timeout.  When the earlier unlock has
+			// no timeout, the new unlock is dropped instead.  The timeout
+			// of a replaced or dropped unlock is no longer read.  Granted
+			// unlocks are never replaced.
+			owned := req.grant != nil
+			replaced := false
+			for prevID, prev := range unlocks {
+				if owned || prev.owned || scope.Signatures != 0 ||
+					!prev.scope.Equal(&scope) {
+					continue
+				}
+				if !prev.timed && timed {
+					w.Manager.RevokeUnlock(id)
+					id = 0
+				} else {
+					w.Manager.RevokeUnlock(prevID)
+					close(prev.cancel)
+					delete(unlocks, prevID)
+				}
+				replaced = true
+				break
+			}
+			if id != 0 {
+				u := &activeUnlock{
+					scope:  scope,
+					timed:  timed,
+					owned:  owned,
+					cancel: make(chan struct{}),
+				}
+				unlocks[id] = u
+				if owned {
+					req.grant.id = id
+				}
+				if timed {
+					go func(id uint64, lockAfter <-chan time.Time, cancel <-chan struct{}) {
+						select {
+						case <-lockAfter:
+							select {
+							case expired <- id:
+							case <-cancel:
+							case <-quit:
+							}
+						case <-cancel:
+						case <-quit:
+						}
+					}(id, req.lockAfter, u.cancel)
+				}
+			}
+			if wasLocked || !replaced {
+				limited := ""
+				if req.scope != nil {
+					limited = " for a limited scope"
+				}
+				if timed {
+					log.Infof("The wallet has been temporarily unlocked%s", limited)
+				} else {
+					log.Infof("The wallet has been unlocked without a time limit%s", limited)
+				}
 			}
 			req.err <- nil
 			continue
 
+		case id := <-expired:
+			revoke(id)
+			continue
+
+		case id := <-w.revokeUnlocks:
+			revoke(id)
+			continue
+
 		case req := <-w.changePassphrase:
 			err := walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
 				addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
 				return w.Manager.ChangePassphrase(addrmgrNs, req.old,
-					req.new, true)
+					req.new, true, req.config)
 			})
 			req.err <- err
 			continue
@@ -1359,17 +1514,9 @@ out:
 			req <- holdChan
 			<-holdChan // Block until the lock is released.
 
-			// If, after holding onto the unlocked wallet for some
-			// time, the timeout has expired, lock it now instead
-			// of hoping it gets unlocked next time the top level
-			// select runs.
-			select {
-			case <-timeout:
-				// Let the top level select fallthrough so the
-				// wallet is locked.
-			default:
-				continue
-			}
+			// Timeouts which expired while the unlock was held are
+			// received by the top level select.
+			continue
 
 		case w.lockState <- w.Manager.IsLocked():
 			continue
@@ -1378,12 +1525,11 @@ out:
 			break out
 
 		case <-w.lockRequests:
-		case <-timeout:
 		}
 
-		// Select statement fell through by an explicit lock or the
-		// timer expiring.  Lock the manager here.
-		timeout = nil
+		// Select statement fell through by an explicit lock.  Lock the
+		// manager here.
+		clearUnlocks()
 		err := w.Manager.Lock()
 		if err != nil && !errors.Is(errors.Locked, err) {
 			log.Errorf("Could not lock wallet: %v", err)
@@ -1394,11 +1540,12 @@ out:
 	w.wg.Done()
 }
 
-// Unlock unlocks the wallet's address manager and relocks it after timeout has
-// expired.  If the wallet is already unlocked and the new passphrase is
-// correct, the current timeout is replaced with the new one.  The wallet will
-// be locked if the passphrase is incorrect or any other error occurs during the
-// unlock.
+// Unlock unlocks the wallet's address manager for every private key and
+// relocks it after timeout has expired.  If the wallet is already unlocked for
+// every private key and the new passphrase is correct, the current timeout is
+// replaced with the new one, unless the wallet was unlocked without a timeout.
+// The wallet will be locked if the passphrase is incorrect or any other error
+// occurs during the unlock.
 func (w *Wallet) Unlock(passphrase []byte, lock <-chan time.Time) error {
 	const op errors.Op = "wallet.Unlock"
 	err := make(chan error, 1)
@@ -1408,6 +1555,109 @@ func (w *Wallet) Unlock(passphrase []byte, lock <-chan time.Time) error {
 		err:        err,
 	}
 	e := <-err
+	w.audit("Unlock", nil, e)
+	if e != nil {
+		return errors.E(op, e)
+	}
+	return nil
+}
+
+// UnlockScoped unlocks the wallet's address manager as Unlock does, but only
+// permits access to the private keys allowed by scope.  The scope is granted
+// in addition to the scopes of other unlocks and is revoked after timeout has
+// expired.  The wallet is locked when no unlocks remain in effect.  An unlock
+// with the same scope and no signature limit replaces an earlier one made by
+// Unlock or UnlockScoped, but never an unlock granted by GrantUnlock.  The
+// timeout is not read once the unlock is replaced or the wallet is locked, so
+// sends on lock must not block.
+func (w *Wallet) UnlockScoped(passphrase []byte, scope *udb.UnlockScope, lock <-chan time.Time) error {
+	const op errors.Op = "wallet.UnlockScoped"
+	if scope == nil {
+		return w.Unlock(passphrase, lock)
+	}
+	return w.unlockScoped(op, passphrase, scope, lock, nil, nil)
+}
+
+// UnlockGrant is an unlock of the wallet owned by the caller of GrantUnlock.
+// Other unlocks never replace or revoke a grant, and a grant never replaces
+// the unlocks of other callers.
+type UnlockGrant struct {
+	id uint64 // Manager grant identifier, zero before the unlock succeeds
+}
+
+// GrantUnlock unlocks the wallet's address manager as UnlockScoped does and
+// returns the grant of the unlock, which remains in effect until the timeout
+// expires, the grant is passed to RevokeUnlock, or the wallet is locked.  A
+// nil scope permits every private key, and a nil lock prevents the timeout.
+// Callers must revoke the grant once the unlock is no longer needed.
+func (w *Wallet) GrantUnlock(passphrase []byte, scope *udb.UnlockScope, lock <-chan time.Time) (*UnlockGrant, error) {
+	const op errors.Op = "wallet.GrantUnlock"
+	if scope == nil {
+		scope = new(udb.UnlockScope)
+	}
+	g := new(UnlockGrant)
+	err := w.unlockScoped(op, passphrase, scope, lock, nil, g)
+	if err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// RevokeUnlock revokes an unlock granted by GrantUnlock without affecting the
+// unlocks of other callers.  The wallet is locked when no unlocks remain in
+// effect.  Revoking a grant which is no longer in effect does nothing.
+func (w *Wallet) RevokeUnlock(g *UnlockGrant) {
+	select {
+	case w.revokeUnlocks <- g.id:
+	case <-w.quitChan():
+	}
+}
+
+// UnlockScopedAccounts unlocks the wallet as UnlockScoped does, and unlocks the
+// accounts encrypted with their own passphrase keyed by account number.  The
+// unlock is revoked, and every account remains locked, when any account
+// passphrase is incorrect.
+func (w *Wallet) UnlockScopedAccounts(passphrase []byte, scope *udb.UnlockScope, lock <-chan time.Time,
+	accounts map[uint32][]byte) error {
+
+	const op errors.Op = "wallet.UnlockScopedAccounts"
+	if scope == nil {
+		scope = new(udb.UnlockScope)
+	}
+	return w.unlockScoped(op, passphrase, scope, lock, accounts, nil)
+}
+
+func (w *Wallet) unlockScoped(op errors.Op, passphrase []byte, scope *udb.UnlockScope, lock <-chan time.Time,
+	accountPassphrases map[uint32][]byte, grant *UnlockGrant) error {
+
+	err := make(chan error, 1)
+	w.unlockRequests <- unlockRequest{
+		passphrase: passphrase,
+		scope:      scope,
+		lockAfter:  lock,
+		accounts:   accountPassphrases,
+		grant:      grant,
+		err:        err,
+	}
+	e := <-err
+	accounts := make([]string, len(scope.Accounts))
+	for i, a := range scope.Accounts {
+		accounts[i] = strconv.FormatUint(uint64(a), 10)
+	}
+	details := map[string]string{
+		"accounts":   strings.Join(accounts, ","),
+		"uses":       scope.Uses.String(),
+		"signatures": strconv.FormatUint(uint64(scope.Signatures), 10),
+	}
+	if len(accountPassphrases) != 0 {
+		unlocked := make([]string, 0, len(accountPassphrases))
+		for a := range accountPassphrases {
+			unlocked = append(unlocked, strconv.FormatUint(uint64(a), 10))
+		}
+		sort.Strings(unlocked)
+		details["unlockedaccounts"] = strings.Join(unlocked, ",")
+	}
+	w.audit("UnlockScoped", details, e)
 	if e != nil {
 		return errors.E(op, e)
 	}
@@ -1453,26 +1703,138 @@ func (c heldUnlock) release() {
 // before the password change.
 func (w *Wallet) ChangePrivatePassphrase(old, new []byte) error {
 	const op errors.Op = "wallet.ChangePrivatePassphrase"
+	return w.changePrivatePassphrase(op, old, new, nil)
+}
+
+// ChangePrivatePassphraseKDF changes the private passphrase as
+// ChangePrivatePassphrase does, but derives the new master key using the key
+// derivation function and parameters of config.  The old passphrase may be
+// reused as the new passphrase to only strengthen the key derivation.
+func (w *Wallet) ChangePrivatePassphraseKDF(old, new []byte, config *udb.ScryptOptions) error {
+	const op errors.Op = "wallet.ChangePrivatePassphraseKDF"
+	return w.changePrivatePassphrase(op, old, new, config)
+}
+
+func (w *Wallet) changePrivatePassphrase(op errors.Op, old, new []byte, config *udb.ScryptOptions) error {
 	err := make(chan error, 1)
 	w.changePassphrase <- changePassphraseRequest{
-		old: old,
-		new: new,
-		err: err,
+		old:    old,
+		new:    new,
+		config: config,
+		err:    err,
 	}
 	e := <-err
+	var details map[string]string
+	if config != nil {
+		details = map[string]string{"kdf": config.KDF.String()}
+	}
+	w.audit("ChangePrivatePassphrase", details, e)
 	if e != nil {
 		return errors.E(op, e)
 	}
 	return nil
 }
 
+// UnlockAccount unlocks an account encrypted with its own passphrase.  The
+// wallet must already be unlocked, and the account is locked again when the
+// wallet is locked.
+func (w *Wallet) UnlockAccount(account uint32, passphrase []byte) error {
+	const op errors.Op = "wallet.UnlockAccount"
+	err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+		return w.Manager.UnlockAccount(addrmgrNs, account, passphrase)
+	})
+	w.audit("UnlockAccount", map[string]string{
+		"account": strconv.FormatUint(uint64(account), 10),
+	}, err)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// LockAccount locks an account encrypted with its own passphrase without
+// locking the rest of the wallet.
+func (w *Wallet) LockAccount(account uint32) error {
+	const op errors.Op = "wallet.LockAccount"
+	err := w.Manager.LockAccount(account)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// ChangeAccountPassphrase changes the passphrase encrypting an account's
+// private key from old to new.  The old passphrase is the wallet's private
+// passphrase when the account does not have its own passphrase.  An empty new
+// passphrase encrypts the account with the wallet's private passphrase again,
+// which requires the wallet to be unlocked.  Accounts derived from the wallet's
+// seed may not be given a passphrase.
+func (w *Wallet) ChangeAccountPassphrase(account uint32, old, new []byte) error {
+	const op errors.Op = "wallet.ChangeAccountPassphrase"
+	err := walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+		addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+		return w.Manager.ChangeAccountPassphrase(addrmgrNs, account, old, new)
+	})
+	w.audit("ChangeAccountPassphrase", map[string]string{
+		"account": strconv.FormatUint(uint64(account), 10),
+	}, err)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// ExportAccountKey returns the extended private key of an account which is not
+// recoverable from the wallet's seed, such as an account created with its own
+// passphrase, so that it may be backed up and later restored with
+// ImportAccountKey.  The key of an account with its own passphrase is decrypted
+// with that passphrase.  The key should be cleared by the caller when
+// finished.  This method requires the wallet to be unlocked.
+func (w *Wallet) ExportAccountKey(account uint32, passphrase []byte) (*hdkeychain.ExtendedKey, error) {
+	const op errors.Op = "wallet.ExportAccountKey"
+	var xpriv *hdkeychain.ExtendedKey
+	err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+		var err error
+		xpriv, err = w.Manager.ExportAccountKey(addrmgrNs, account, passphrase)
+		return err
+	})
+	w.audit("ExportAccountKey", map[string]string{
+		"account": strconv.FormatUint(uint64(account), 10),
+	}, err)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return xpriv, nil
+}
+
+// ImportAccountKey restores an account from an extended private key returned
+// by ExportAccountKey.  When the passphrase is not empty, the account private
+// key is encrypted with it as with NextAccountWithPassphrase.  Addresses up to
+// the gap limit are watched for transactions, and the account should be
+// rescanned to discover any later addresses.  This method requires the wallet
+// to be unlocked.
+func (w *Wallet) ImportAccountKey(name string, xpriv *hdkeychain.ExtendedKey, passphrase []byte) (uint32, error) {
+	const op errors.Op = "wallet.ImportAccountKey"
+	account, err := w.importAccount(name, xpriv, passphrase)
+	w.audit("ImportAccountKey", map[string]string{
+		"name": name,
+	}, err)
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	return account, nil
+}
+
 // ChangePublicPassphrase modifies the public passphrase of the wallet.
 func (w *Wallet) ChangePublicPassphrase(old, new []byte) error {
 	const op errors.Op = "wallet.ChangePublicPassphrase"
 	err := walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
 		addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
-		return w.Manager.ChangePassphrase(addrmgrNs, old, new, false)
+		return w.Manager.ChangePassphrase(addrmgrNs, old, new, false, nil)
 	})
+	w.audit("ChangePublicPassphrase", nil, err)
 	if err != nil {
 		return errors.E(op, err)
 	}
@@ -1578,6 +1940,9 @@ func (w *Wallet) PubKeyForAddress(a fnoutil.Address) (chainec.PublicKey, error)
 // associated private key.
 func (w *Wallet) SignMessage(msg string, addr fnoutil.Address) (sig []byte, err error) {
 	const op errors.Op = "wallet.SignMessage"
+	defer func() {
+		w.audit("SignMessage", map[string]string{"address": addr.EncodeAddress()}, err)
+	}()
 	var buf bytes.Buffer
 	wire.WriteVarString(&buf, 0, "Fonero Signed Message:\n")
 	wire.WriteVarString(&buf, 0, msg)
@@ -1592,7 +1957,7 @@ func (w *Wallet) SignMessage(msg string, addr fnoutil.Address) (sig []byte, err
 	err = walletdb.View(w.db, func(tx walletdb.ReadTx) error {
 		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
 		var err error
-		privKey, done, err = w.Manager.PrivateKey(addrmgrNs, addr)
+		privKey, done, err = w.Manager.PrivateKeyForUse(addrmgrNs, addr, udb.KeyUseMessage)
 		return err
 	})
 	if err != nil {
@@ -1746,6 +2111,10 @@ func (w *Wallet) RenameAccount(account uint32, newName string) error {
 	var props *udb.AccountProperties
 	err := walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
 		addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+		// Keep restricting the account by any policy of its old name.
+		w.spendPolicyMu.Lock()
+		w.accountSpendPolicy(addrmgrNs, account)
+		w.spendPolicyMu.Unlock()
 		err := w.Manager.RenameAccount(addrmgrNs, account, newName)
 		if err != nil {
 			return err
@@ -1767,6 +2136,22 @@ func (w *Wallet) RenameAccount(account uint32, newName string) error {
 // spec, which allows no unused account gaps).
 func (w *Wallet) NextAccount(name string) (uint32, error) {
 	const op errors.Op = "wallet.NextAccount"
+	return w.nextAccount(op, name, nil, false)
+}
+
+// NextAccountWithPassphrase creates the next account as NextAccount does, but
+// encrypts the account's private key with its own passphrase rather than the
+// wallet's private passphrase.  The account must be unlocked with
+// UnlockAccount after the wallet is unlocked before its private keys may be
+// used.  The account key is created from a new random seed, so the account is
+// not recoverable from the wallet's seed and must be backed up with
+// ExportAccountKey.
+func (w *Wallet) NextAccountWithPassphrase(name string, passphrase []byte) (uint32, error) {
+	const op errors.Op = "wallet.NextAccountWithPassphrase"
+	return w.nextAccount(op, name, passphrase, false)
+}
+
+func (w *Wallet) nextAccount(op errors.Op, name string, passphrase []byte, voting bool) (uint32, error) {
 	maxEmptyAccounts := uint32(w.accountGapLimit)
 	var account uint32
 	var props *udb.AccountProperties
@@ -1801,10 +2186,16 @@ func (w *Wallet) NextAccount(name string) (uint32, error) {
 			return errors.New("last 100 accounts have no transaction history")
 		}
 
-		account, err = w.Manager.NewAccount(addrmgrNs, name)
+		account, err = w.Manager.NewAccountWithPassphrase(addrmgrNs, name, passphrase)
 		if err != nil {
 			return err
 		}
+		if voting {
+			err = w.Manager.MarkVotingAccount(addrmgrNs, account)
+			if err != nil {
+				return err
+			}
+		}
 
 		props, err = w.Manager.AccountProperties(addrmgrNs, account)
 		if err != nil {
@@ -1887,6 +2278,24 @@ func (w *Wallet) MasterPubKey(account uint32) (*hdkeychain.ExtendedKey, error) {
 	return extKey, nil
 }
 
+// VerifySeed returns whether the seed is the seed of the wallet, by comparing
+// the extended public key of the default account derived from the seed with
+// the wallet's.  The wallet does not need to be unlocked, and nothing derived
+// from the seed is saved.
+func (w *Wallet) VerifySeed(seed []byte) (bool, error) {
+	const op errors.Op = "wallet.VerifySeed"
+	var match bool
+	err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		var err error
+		match, err = w.Manager.VerifySeed(tx, seed)
+		return err
+	})
+	if err != nil {
+		return false, errors.E(op, err)
+	}
+	return match, nil
+}
+
 // GetTransactionsByHashes returns all known transactions identified by a slice
 // of transaction hashes.  It is possible that not all transactions are found,
 // and in this case the known results will be returned along with an inventory
@@ -3186,8 +3595,11 @@ func (w *Wallet) ListUnspent(minconf, maxconf int32, addresses map[string]struct
 
 // DumpWIFPrivateKey returns the WIF encoded private key for a
 // single wallet address.
-func (w *Wallet) DumpWIFPrivateKey(addr fnoutil.Address) (string, error) {
+func (w *Wallet) DumpWIFPrivateKey(addr fnoutil.Address) (_ string, err error) {
 	const op errors.Op = "wallet.DumpWIFPrivateKey"
+	defer func() {
+		w.audit("DumpWIFPrivateKey", map[string]string{"address": addr.EncodeAddress()}, err)
+	}()
 	var privKey chainec.PrivateKey
 	var done func()
 	defer func() {
@@ -3195,10 +3607,10 @@ func (w *Wallet) DumpWIFPrivateKey(addr fnoutil.Address) (string, error) {
 			done()
 		}
 	}()
-	err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+	err = walletdb.View(w.db, func(tx walletdb.ReadTx) error {
 		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
 		var err error
-		privKey, done, err = w.Manager.PrivateKey(addrmgrNs, addr)
+		privKey, done, err = w.Manager.PrivateKeyForUse(addrmgrNs, addr, udb.KeyUseExport)
 		return err
 	})
 	if err != nil {
@@ -3994,7 +4406,7 @@ func (w *Wallet) SignTransaction(tx *wire.MsgTx, hashType txscript.SigHashType,
 					return nil, false, errors.Errorf("address %v is not "+
 						"a pubkey address", address.Address().EncodeAddress())
 				}
-				key, done, err := w.Manager.PrivateKey(addrmgrNs, addr)
+				key, done, err := w.Manager.PrivateKeyForUse(addrmgrNs, addr, udb.KeyUseTransaction)
 				if err != nil {
 					return nil, false, err
 				}
@@ -4103,6 +4515,10 @@ func (w *Wallet) SignTransaction(tx *wire.MsgTx, hashType txscript.SigHashType,
 		}
 		return nil
 	})
+	w.audit("SignTransaction", map[string]string{
+		"txhash":           tx.TxHash().String(),
+		"signature_errors": strconv.Itoa(len(signErrors)),
+	}, err)
 	if err != nil {
 		return nil, errors.E(op, err)
 	}
@@ -4114,6 +4530,13 @@ func (w *Wallet) SignTransaction(tx *wire.MsgTx, hashType txscript.SigHashType,
 // address.
 func (w *Wallet) CreateSignature(tx *wire.MsgTx, idx uint32, addr fnoutil.Address, hashType txscript.SigHashType, prevPkScript []byte) (sig, pubkey []byte, err error) {
 	const op errors.Op = "wallet.CreateSignature"
+	defer func() {
+		w.audit("CreateSignature", map[string]string{
+			"txhash":  tx.TxHash().String(),
+			"input":   strconv.FormatUint(uint64(idx), 10),
+			"address": addr.EncodeAddress(),
+		}, err)
+	}()
 	var privKey chainec.PrivateKey
 	var pubKey chainec.PublicKey
 	var done func()
@@ -4127,7 +4550,7 @@ func (w *Wallet) CreateSignature(tx *wire.MsgTx, idx uint32, addr fnoutil.Addres
 		ns := dbtx.ReadBucket(waddrmgrNamespaceKey)
 
 		var err error
-		privKey, done, err = w.Manager.PrivateKey(ns, addr)
+		privKey, done, err = w.Manager.PrivateKeyForUse(ns, addr, udb.KeyUseTransaction)
 		if err != nil {
 			return err
 		}
@@ -4214,12 +4637,23 @@ func (w *Wallet) PurgeUnminedTransaction(hash *chainhash.Hash) error {
 // consensus RPC server so it can be propagated to other nodes and eventually
 // mined.  If the send fails, the transaction is not added to the wallet.
 func (w *Wallet) PublishTransaction(tx *wire.MsgTx, serializedTx []byte, n NetworkBackend) (*chainhash.Hash, error) {
+	return w.publishTransaction(tx, serializedTx, n, false)
+}
+
+// publishTransaction implements PublishTransaction.  Approved transactions
+// are not held for approval by spend policies.
+func (w *Wallet) publishTransaction(tx *wire.MsgTx, serializedTx []byte, n NetworkBackend,
+	approved bool) (_ *chainhash.Hash, err error) {
+
 	const opf = "wallet.PublishTransaction(%v)"
 
 	txHash := tx.TxHash()
+	defer func() {
+		w.audit("PublishTransaction", map[string]string{"txhash": txHash.String()}, err)
+	}()
 
 	var relevant bool
-	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+	err = walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
 		relevant = w.isRelevantTx(dbtx, tx)
 
 		// Prevent high fee transactions from being published, if disabled and
@@ -4242,6 +4676,13 @@ func (w *Wallet) PublishTransaction(tx *wire.MsgTx, serializedTx []byte, n Netwo
 		return nil, errors.E(op, err)
 	}
 
+	// Enforce the spend policies of the accounts funding the transaction.
+	spend, err := w.reserveSpend(tx, approved)
+	if err != nil {
+		op := errors.Opf(opf, &txHash)
+		return nil, errors.E(op, err)
+	}
+
 	var watchOutPoints []wire.OutPoint
 	if relevant {
 		err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
@@ -4253,6 +4694,7 @@ func (w *Wallet) PublishTransaction(tx *wire.MsgTx, serializedTx []byte, n Netwo
 			return err
 		})
 		if err != nil {
+			spend.cancel()
 			op := errors.Opf(opf, &txHash)
 			return nil, errors.E(op, err)
 		}
@@ -4260,6 +4702,7 @@ func (w *Wallet) PublishTransaction(tx *wire.MsgTx, serializedTx []byte, n Netwo
 
 	err = n.PublishTransactions(context.TODO(), tx)
 	if err != nil {
+		spend.cancel()
 		if relevant {
 			if err := w.PurgeUnminedTransaction(&txHash); err != nil {
 				log.Warnf("Failed to purge added unmined transaction: %v", err)
@@ -4343,7 +4786,12 @@ func (w *Wallet) NeedsAccountsSync() (bool, error) {
 // Create creates an new wallet, writing it to an empty database.  If the passed
 // seed is non-nil, it is used.  Otherwise, a secure random seed of the
 // recommended length is generated.
-func Create(db DB, pubPass, privPass, seed []byte, params *chaincfg.Params) error {
+//
+// If birthday is non-nil, it is recorded as the earliest point at which the
+// wallet may have received transactions, and earlier blocks are not searched
+// by address discovery and rescans.  When a seed is generated and birthday is
+// nil, the current time is recorded as the birthday.
+func Create(db DB, pubPass, privPass, seed []byte, birthday *udb.Birthday, params *chaincfg.Params) error {
 	const op errors.Op = "wallet.Create"
 	// If a seed was provided, ensure that it is of valid length. Otherwise,
 	// we generate a random seed for the wallet with the recommended seed
@@ -4354,6 +4802,9 @@ func Create(db DB, pubPass, privPass, seed []byte, params *chaincfg.Params) erro
 			return errors.E(op, err)
 		}
 		seed = hdSeed
+		if birthday == nil {
+			birthday = &udb.Birthday{Time: time.Now()}
+		}
 	}
 	if len(seed) < hdkeychain.MinSeedBytes || len(seed) > hdkeychain.MaxSeedBytes {
 		return errors.E(op, hdkeychain.ErrInvalidSeedLen)
@@ -4363,16 +4814,30 @@ func Create(db DB, pubPass, privPass, seed []byte, params *chaincfg.Params) erro
 	if err != nil {
 		return errors.E(op, err)
 	}
+	if birthday != nil {
+		err = setBirthday(db.internal(), birthday)
+		if err != nil {
+			return errors.E(op, err)
+		}
+	}
 	return nil
 }
 
-// CreateWatchOnly creates a watchonly wallet on the provided db.
-func CreateWatchOnly(db DB, extendedPubKey string, pubPass []byte, params *chaincfg.Params) error {
+// CreateWatchOnly creates a watchonly wallet on the provided db.  If birthday
+// is non-nil, it is recorded as the earliest point at which the wallet may have
+// received transactions.
+func CreateWatchOnly(db DB, extendedPubKey string, pubPass []byte, birthday *udb.Birthday, params *chaincfg.Params) error {
 	const op errors.Op = "wallet.CreateWatchOnly"
 	err := udb.InitializeWatchOnly(db.internal(), params, extendedPubKey, pubPass)
 	if err != nil {
 		return errors.E(op, err)
 	}
+	if birthday != nil {
+		err = setBirthday(db.internal(), birthday)
+		if err != nil {
+			return errors.E(op, err)
+		}
+	}
 	return nil
 }
 
@@ -4442,6 +4907,11 @@ func decodeStakePoolColdExtKey(encStr string, params *chaincfg.Params) (map[stri
 // configuration options and sets it up it according to the rest of options.
 func Open(cfg *Config) (*Wallet, error) {
 	const op errors.Op = "wallet.Open"
+	if cfg.CFilterPruneDepth != 0 && cfg.CFilterPruneDepth < MinCFilterPruneDepth {
+		return nil, errors.E(op, errors.Invalid, errors.Errorf("cfilter prune "+
+			"depth must be at least %d", MinCFilterPruneDepth))
+	}
+
 	// Migrate to the unified DB if necessary.
 	db := cfg.DB.internal()
 	needsMigration, err := udb.NeedsMigration(db)
@@ -4472,14 +4942,19 @@ func Open(cfg *Config) (*Wallet, error) {
 		poolFees:      cfg.PoolFees,
 
 		// LoaderOptions
-		gapLimit:        cfg.GapLimit,
-		AllowHighFees:   cfg.AllowHighFees,
-		accountGapLimit: cfg.AccountGapLimit,
+		gapLimit:          cfg.GapLimit,
+		AllowHighFees:     cfg.AllowHighFees,
+		accountGapLimit:   cfg.AccountGapLimit,
+		cfilterPruneDepth: cfg.CFilterPruneDepth,
+
+		disableChangeRandomization: cfg.DisableChangeRandomization,
 
 		// Chain params
 		subsidyCache: blockchain.NewSubsidyCache(0, cfg.Params),
 		chainParams:  cfg.Params,
 
+		auditLog: cfg.AuditLog,
+
 		lockedOutpoints: map[wire.OutPoint]struct{}{},
 
 		consolidateRequests:      make(chan consolidateRequest),
@@ -4488,6 +4963,7 @@ func Open(cfg *Config) (*Wallet, error) {
 		purchaseTicketRequests:   make(chan purchaseTicketRequest),
 		addressBuffers:           make(map[uint32]*bip0044AccountData),
 		unlockRequests:           make(chan unlockRequest),
+		revokeUnlocks:            make(chan uint64),
 		lockRequests:             make(chan struct{}),
 		holdUnlockRequests:       make(chan chan heldUnlock),
 		lockState:                make(chan bool),
@@ -4547,6 +5023,11 @@ func Open(cfg *Config) (*Wallet, error) {
 	w.NtfnServer = newNotificationServer(w)
 	w.voteBits = vb
 
+	err = w.openSpendPolicies(cfg.SpendPolicies)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
 	w.stakePoolColdAddrs, err = decodeStakePoolColdExtKey(cfg.StakePoolColdExtKey,
 		cfg.Params)
 	if err != nil {
@@ -0,0 +1,53 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package walletdb
+
+import (
+	"github.com/fonero-project/fnowallet/errors"
+)
+
+// CopyDB copies every top level bucket of the database src, and all of their
+// keys, values, and nested buckets, into the database dst.  The copy is made in
+// a single read-write transaction of dst using a single read transaction of
+// src, and is therefore a consistent snapshot of src.  Any top level bucket of
+// src which already exists in dst causes the copy to fail with errors.Exist
+// without modifying dst.
+//
+// Copying a database into a newly-created database of a driver which stores
+// databases in files results in a file without the free pages left behind by
+// modifications to the copied database.
+func CopyDB(dst, src DB) error {
+	return View(src, func(srcTx ReadTx) error {
+		return Update(dst, func(dstTx ReadWriteTx) error {
+			return srcTx.ForEachBucket(func(key []byte) error {
+				b, err := dstTx.CreateTopLevelBucket(key)
+				if err != nil {
+					return err
+				}
+				return copyBucket(b, srcTx.ReadBucket(key))
+			})
+		})
+	})
+}
+
+// copyBucket recursively copies the contents of src into dst.
+func copyBucket(dst ReadWriteBucket, src ReadBucket) error {
+	if src == nil {
+		return errors.E(errors.Bug, "iterated bucket does not exist")
+	}
+	return src.ForEach(func(k, v []byte) error {
+		// A nil value may key either a nested bucket or an empty value.
+		if v == nil {
+			if nested := src.NestedReadBucket(k); nested != nil {
+				b, err := dst.CreateBucket(k)
+				if err != nil {
+					return err
+				}
+				return copyBucket(b, nested)
+			}
+		}
+		return dst.Put(k, v)
+	})
+}
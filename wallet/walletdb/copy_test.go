@@ -0,0 +1,131 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package walletdb_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/fonero-project/fnowallet/errors"
+	"github.com/fonero-project/fnowallet/wallet/walletdb"
+)
+
+// dumpBucket records every key of a bucket and its nested buckets, keyed by the
+// path of bucket keys, with a trailing slash for nested buckets.
+func dumpBucket(dump map[string]string, prefix string, b walletdb.ReadBucket) error {
+	return b.ForEach(func(k, v []byte) error {
+		path := prefix + string(k)
+		if nested := b.NestedReadBucket(k); nested != nil {
+			dump[path+"/"] = ""
+			return dumpBucket(dump, path+"/", nested)
+		}
+		dump[path] = string(v)
+		return nil
+	})
+}
+
+func dumpDB(db walletdb.DB) (map[string]string, error) {
+	dump := make(map[string]string)
+	err := walletdb.View(db, func(tx walletdb.ReadTx) error {
+		return tx.ForEachBucket(func(key []byte) error {
+			prefix := string(key) + "/"
+			dump[prefix] = ""
+			return dumpBucket(dump, prefix, tx.ReadBucket(key))
+		})
+	})
+	return dump, err
+}
+
+// TestCopyDB ensures that CopyDB copies the contents of a database between
+// databases of each driver.
+func TestCopyDB(t *testing.T) {
+	dir, err := ioutil.TempDir("", "walletdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	tests := []struct {
+		name             string
+		srcType, dstType string
+		srcArgs, dstArgs []interface{}
+	}{
+		{"memdb to bdb", "memdb", "bdb", nil, []interface{}{filepath.Join(dir, "dst.db")}},
+		{"bdb to memdb", "bdb", "memdb", []interface{}{filepath.Join(dir, "src.db")}, nil},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			src, err := walletdb.Create(test.srcType, test.srcArgs...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer src.Close()
+			dst, err := walletdb.Create(test.dstType, test.dstArgs...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer dst.Close()
+
+			err = walletdb.Update(src, func(tx walletdb.ReadWriteTx) error {
+				b1, err := tx.CreateTopLevelBucket([]byte("b1"))
+				if err != nil {
+					return err
+				}
+				if err := b1.Put([]byte("k1"), []byte("v1")); err != nil {
+					return err
+				}
+				if err := b1.Put([]byte("empty"), nil); err != nil {
+					return err
+				}
+				nested, err := b1.CreateBucket([]byte("nested"))
+				if err != nil {
+					return err
+				}
+				if err := nested.Put([]byte("k2"), []byte("v2")); err != nil {
+					return err
+				}
+				if _, err := nested.CreateBucket([]byte("empty bucket")); err != nil {
+					return err
+				}
+				_, err = tx.CreateTopLevelBucket([]byte("b2"))
+				return err
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := walletdb.CopyDB(dst, src); err != nil {
+				t.Fatalf("CopyDB: unexpected error: %v", err)
+			}
+			want, err := dumpDB(src)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := dumpDB(dst)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Fatalf("copied database %v, want %v", got, want)
+			}
+
+			// Copying again must fail without modifying the copy.
+			if err := walletdb.CopyDB(dst, src); !errors.Is(errors.Exist, err) {
+				t.Fatalf("CopyDB: unexpected error copying into a "+
+					"nonempty database: %v", err)
+			}
+			got, err = dumpDB(dst)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Fatalf("failed copy modified database: %v, want %v", got, want)
+			}
+		})
+	}
+}
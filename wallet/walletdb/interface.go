@@ -21,6 +21,11 @@ type ReadTx interface {
 	// described by the key does not exist, nil is returned.
 	ReadBucket(key []byte) ReadBucket
 
+	// ForEachBucket invokes the passed function with the key of every top
+	// level bucket.  The key is only valid during the function call.  An
+	// error returned by the function ends the iteration and is returned.
+	ForEachBucket(func(key []byte) error) error
+
 	// Rollback closes the transaction, discarding changes (if any) if the
 	// database was modified by a write transaction.
 	Rollback() error
@@ -255,6 +260,11 @@ type Driver struct {
 	// Open is the function that will be invoked with all user-specified
 	// arguments to open the database.
 	Open func(args ...interface{}) (DB, error)
+
+	// Exists is the function that will be invoked with all user-specified
+	// arguments to check whether the database exists.  It may be nil if
+	// the driver can not check for existing databases.
+	Exists func(args ...interface{}) (bool, error)
 }
 
 // driverList holds all of the registered database backends.
@@ -308,3 +318,19 @@ func Open(dbType string, args ...interface{}) (DB, error) {
 
 	return drv.Open(args...)
 }
+
+// Exists returns whether a database of the specified type exists.  The
+// arguments are specific to the database type driver, and are the same as
+// those used to open the database.
+func Exists(dbType string, args ...interface{}) (bool, error) {
+	const op errors.Op = "walletdb.Exists"
+	drv, exists := drivers[dbType]
+	if !exists {
+		return false, errors.E(op, errors.Invalid, errors.Errorf("driver %q is not registered", dbType))
+	}
+	if drv.Exists == nil {
+		return false, errors.E(op, errors.Invalid, errors.Errorf("driver %q can not check for existing databases", dbType))
+	}
+
+	return drv.Exists(args...)
+}
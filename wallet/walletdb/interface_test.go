@@ -1,32 +1,24 @@
 // Copyright (c) 2014 The btcsuite developers
-// Copyright (c) 2015 The Decred developers
+// Copyright (c) 2015-2019 The Decred developers
 // Use of this source code is governed by an ISC
 // license that can be found in the LICENSE file.
 
-// This file intended to be copied into each backend driver directory.  Each
-// driver should have their own driver_test.go file which creates a database and
-// invokes the testInterface function in this file to ensure the driver properly
-// implements the interface.  See the bdb backend driver for a working example.
-//
-// NOTE: When copying this file into the backend driver folder, the package name
-// will need to be changed accordingly.
-
-// Test must be updated for API changes.
-//+build disabled
-
 package walletdb_test
 
 import (
-	"fmt"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 
+	"github.com/fonero-project/fnowallet/errors"
+	_ "github.com/fonero-project/fnowallet/wallet/internal/bdb"
+	_ "github.com/fonero-project/fnowallet/wallet/internal/memdb"
 	"github.com/fonero-project/fnowallet/wallet/walletdb"
 )
 
-// subTestFailError is used to signal that a sub test returned false.
-var subTestFailError = errors.Errorf("sub test failure")
-
 // testContext is used to store context information about a running test which
 // is passed into helper functions.
 type testContext struct {
@@ -49,7 +41,7 @@ func rollbackValues(values map[string]string) map[string]string {
 // testGetValues checks that all of the provided key/value pairs can be
 // retrieved from the database and the retrieved values match the provided
 // values.
-func testGetValues(tc *testContext, bucket walletdb.Bucket, values map[string]string) bool {
+func testGetValues(tc *testContext, bucket walletdb.ReadBucket, values map[string]string) bool {
 	for k, v := range values {
 		var vBytes []byte
 		if v != "" {
@@ -69,7 +61,7 @@ func testGetValues(tc *testContext, bucket walletdb.Bucket, values map[string]st
 
 // testPutValues stores all of the provided key/value pairs in the provided
 // bucket while checking for errors.
-func testPutValues(tc *testContext, bucket walletdb.Bucket, values map[string]string) bool {
+func testPutValues(tc *testContext, bucket walletdb.ReadWriteBucket, values map[string]string) bool {
 	for k, v := range values {
 		var vBytes []byte
 		if v != "" {
@@ -86,7 +78,7 @@ func testPutValues(tc *testContext, bucket walletdb.Bucket, values map[string]st
 
 // testDeleteValues removes all of the provided key/value pairs from the
 // provided bucket.
-func testDeleteValues(tc *testContext, bucket walletdb.Bucket, values map[string]string) bool {
+func testDeleteValues(tc *testContext, bucket walletdb.ReadWriteBucket, values map[string]string) bool {
 	for k := range values {
 		if err := bucket.Delete([]byte(k)); err != nil {
 			tc.t.Errorf("Delete: unexpected error: %v", err)
@@ -99,7 +91,7 @@ func testDeleteValues(tc *testContext, bucket walletdb.Bucket, values map[string
 
 // testNestedBucket reruns the testBucketInterface against a nested bucket along
 // with a counter to only test a couple of level deep.
-func testNestedBucket(tc *testContext, testBucket walletdb.Bucket) bool {
+func testNestedBucket(tc *testContext, testBucket walletdb.ReadWriteBucket) bool {
 	// Don't go more than 2 nested level deep.
 	if tc.bucketDepth > 1 {
 		return true
@@ -109,155 +101,120 @@ func testNestedBucket(tc *testContext, testBucket walletdb.Bucket) bool {
 	defer func() {
 		tc.bucketDepth--
 	}()
-	if !testBucketInterface(tc, testBucket) {
-		return false
-	}
-
-	return true
+	return testBucketInterface(tc, testBucket)
 }
 
-// testBucketInterface ensures the bucket interface is working properly by
-// exercising all of its functions.
-func testBucketInterface(tc *testContext, bucket walletdb.Bucket) bool {
-	if bucket.Writable() != tc.isWritable {
-		tc.t.Errorf("Bucket writable state does not match.")
+// testCursorInterface ensures the cursor interface is working properly by
+// positioning a cursor over a bucket's key/value pairs and nested buckets.
+func testCursorInterface(tc *testContext, bucket walletdb.ReadWriteBucket) bool {
+	keyValues := map[string]string{
+		"cursorkey1": "foo1",
+		"cursorkey2": "foo2",
+		"cursorkey4": "foo4",
+	}
+	if !testPutValues(tc, bucket, keyValues) {
+		return false
+	}
+	nestedName := []byte("cursorkey3")
+	if _, err := bucket.CreateBucket(nestedName); err != nil {
+		tc.t.Errorf("CreateBucket: unexpected error: %v", err)
 		return false
 	}
 
-	if tc.isWritable {
-		// keyValues holds the keys and values to use when putting
-		// values into the bucket.
-		var keyValues = map[string]string{
-			"bucketkey1": "foo1",
-			"bucketkey2": "foo2",
-			"bucketkey3": "foo3",
-		}
-		if !testPutValues(tc, bucket, keyValues) {
-			return false
-		}
-
-		if !testGetValues(tc, bucket, keyValues) {
-			return false
-		}
-
-		// Iterate all of the keys using ForEach while making sure the
-		// stored values are the expected values.
-		keysFound := make(map[string]struct{}, len(keyValues))
-		err := bucket.ForEach(func(k, v []byte) error {
-			kString := string(k)
-			wantV, ok := keyValues[kString]
-			if !ok {
-				return errors.Errorf("ForEach: key '%s' should "+
-					"exist", kString)
-			}
-
-			if !reflect.DeepEqual(v, []byte(wantV)) {
-				return errors.Errorf("ForEach: value for key '%s' "+
-					"does not match - got %s, want %s",
-					kString, v, wantV)
-			}
-
-			keysFound[kString] = struct{}{}
-			return nil
-		})
-		if err != nil {
-			tc.t.Errorf("%v", err)
-			return false
-		}
-
-		// Ensure all keys were iterated.
-		for k := range keyValues {
-			if _, ok := keysFound[k]; !ok {
-				tc.t.Errorf("ForEach: key '%s' was not iterated "+
-					"when it should have been", k)
-				return false
-			}
-		}
-
-		// Delete the keys and ensure they were deleted.
-		if !testDeleteValues(tc, bucket, keyValues) {
-			return false
-		}
-		if !testGetValues(tc, bucket, rollbackValues(keyValues)) {
-			return false
-		}
-
-		// Ensure creating a new bucket works as expected.
-		testBucketName := []byte("testbucket")
-		testBucket, err := bucket.CreateBucket(testBucketName)
-		if err != nil {
-			tc.t.Errorf("CreateBucket: unexpected error: %v", err)
-			return false
-		}
-		if !testNestedBucket(tc, testBucket) {
-			return false
-		}
-
-		// Ensure creating a bucket that already exists fails with the
-		// expected error.
-		if _, err := bucket.CreateBucket(testBucketName); !errors.Is(errors.Exist, err) {
-			tc.t.Errorf("CreateBucket: unexpected error: %v", err
-			return false
-		}
-
-		// Ensure CreateBucketIfNotExists returns an existing bucket.
-		testBucket, err = bucket.CreateBucketIfNotExists(testBucketName)
-		if err != nil {
-			tc.t.Errorf("CreateBucketIfNotExists: unexpected "+
-				"error: %v", err)
-			return false
-		}
-		if !testNestedBucket(tc, testBucket) {
+	type pair struct{ k, v string }
+	check := func(method string, k, v []byte, want pair) bool {
+		if string(k) != want.k || string(v) != want.v {
+			tc.t.Errorf("%s: unexpected pair - got (%s, %s), want (%s, %s)",
+				method, k, v, want.k, want.v)
 			return false
 		}
+		return true
+	}
 
-		// Ensure retrieving and existing bucket works as expected.
-		testBucket = bucket.Bucket(testBucketName)
-		if !testNestedBucket(tc, testBucket) {
-			return false
-		}
+	c := bucket.ReadWriteCursor()
+	k, v := c.First()
+	if !check("First", k, v, pair{"cursorkey1", "foo1"}) {
+		return false
+	}
+	k, v = c.Next()
+	if !check("Next", k, v, pair{"cursorkey2", "foo2"}) {
+		return false
+	}
+	k, v = c.Next()
+	if !check("Next", k, v, pair{"cursorkey3", ""}) {
+		return false
+	}
+	if v != nil {
+		tc.t.Errorf("Next: nested bucket has non-nil value")
+		return false
+	}
+	k, v = c.Next()
+	if !check("Next", k, v, pair{"cursorkey4", "foo4"}) {
+		return false
+	}
+	if k, v = c.Next(); k != nil || v != nil {
+		tc.t.Errorf("Next: unexpected pair after last key (%s, %s)", k, v)
+		return false
+	}
+	k, v = c.Last()
+	if !check("Last", k, v, pair{"cursorkey4", "foo4"}) {
+		return false
+	}
+	k, v = c.Prev()
+	if !check("Prev", k, v, pair{"cursorkey3", ""}) {
+		return false
+	}
+	k, v = c.Seek([]byte("cursorkey2"))
+	if !check("Seek", k, v, pair{"cursorkey2", "foo2"}) {
+		return false
+	}
+	k, v = c.Seek([]byte("cursorkey21"))
+	if !check("Seek", k, v, pair{"cursorkey3", ""}) {
+		return false
+	}
+	if k, v = c.Seek([]byte("cursorkey5")); k != nil || v != nil {
+		tc.t.Errorf("Seek: unexpected pair after last key (%s, %s)", k, v)
+		return false
+	}
 
-		// Ensure deleting a bucket works as intended.
-		if err := bucket.DeleteBucket(testBucketName); err != nil {
-			tc.t.Errorf("DeleteBucket: unexpected error: %v", err)
-			return false
-		}
-		if b := bucket.Bucket(testBucketName); b != nil {
-			tc.t.Errorf("DeleteBucket: bucket '%s' still exists",
-				testBucketName)
-			return false
-		}
+	// Deleting a nested bucket with the cursor must fail, while deleting a
+	// key/value pair removes it from the bucket.
+	c.Seek(nestedName)
+	if err := c.Delete(); !errors.Is(errors.Invalid, err) {
+		tc.t.Errorf("Cursor.Delete: unexpected error: %v", err)
+		return false
+	}
+	c.Seek([]byte("cursorkey2"))
+	if err := c.Delete(); err != nil {
+		tc.t.Errorf("Cursor.Delete: unexpected error: %v", err)
+		return false
+	}
+	c.Close()
+	if v := bucket.Get([]byte("cursorkey2")); v != nil {
+		tc.t.Errorf("Cursor.Delete: key was not deleted")
+		return false
+	}
 
-		// Ensure deleting a bucket that doesn't exist returns the
-		// expected error.
-		if err := bucket.DeleteBucket(testBucketName); !errors.Is(errors.NotExist, err) {
-			tc.t.Errorf("DeleteBucket: unexpected error: %v", err)
-			return false
-		}
+	// Clean up the bucket for future calls.
+	if err := bucket.DeleteNestedBucket(nestedName); err != nil {
+		tc.t.Errorf("DeleteNestedBucket: unexpected error: %v", err)
+		return false
+	}
+	if !testDeleteValues(tc, bucket, keyValues) {
+		return false
+	}
+	if !walletdb.BucketIsEmpty(bucket) {
+		tc.t.Errorf("BucketIsEmpty: bucket is not empty")
+		return false
+	}
 
-		// Ensure CreateBucketIfNotExists creates a new bucket when
-		// it doesn't already exist.
-		testBucket, err = bucket.CreateBucketIfNotExists(testBucketName)
-		if err != nil {
-			tc.t.Errorf("CreateBucketIfNotExists: unexpected error: %v", err)
-			return false
-		}
-		if !testNestedBucket(tc, testBucket) {
-			return false
-		}
+	return true
+}
 
-		// Delete the test bucket to avoid leaving it around for future
-		// calls.
-		if err := bucket.DeleteBucket(testBucketName); err != nil {
-			tc.t.Errorf("DeleteBucket: unexpected error: %v", err)
-			return false
-		}
-		if b := bucket.Bucket(testBucketName); b != nil {
-			tc.t.Errorf("DeleteBucket: bucket '%s' still exists",
-				testBucketName)
-			return false
-		}
-	} else {
+// testBucketInterface ensures the bucket interface is working properly by
+// exercising all of its functions.
+func testBucketInterface(tc *testContext, bucket walletdb.ReadWriteBucket) bool {
+	if !tc.isWritable {
 		// Put should fail with bucket that is not writable.
 		failBytes := []byte("fail")
 		if err := bucket.Put(failBytes, failBytes); !errors.Is(errors.Invalid, err) {
@@ -284,18 +241,193 @@ func testBucketInterface(tc *testContext, bucket walletdb.Bucket) bool {
 			return false
 		}
 
-		// DeleteBucket should fail with bucket that is not writable.
-		if err := bucket.DeleteBucket(failBytes); !errors.Is(errors.Invalid, err) {
-			tc.t.Errorf("DeleteBucket: unexpected error: %v", err)
+		// DeleteNestedBucket should fail with bucket that is not
+		// writable.
+		if err := bucket.DeleteNestedBucket(failBytes); !errors.Is(errors.Invalid, err) {
+			tc.t.Errorf("DeleteNestedBucket: unexpected error: %v", err)
 			return false
 		}
+
+		return true
 	}
 
-	return true
+	// keyValues holds the keys and values to use when putting values into
+	// the bucket.
+	var keyValues = map[string]string{
+		"bucketkey1": "foo1",
+		"bucketkey2": "foo2",
+		"bucketkey3": "foo3",
+	}
+	if !testPutValues(tc, bucket, keyValues) {
+		return false
+	}
+
+	if !testGetValues(tc, bucket, keyValues) {
+		return false
+	}
+
+	// Iterate all of the keys using ForEach while making sure the stored
+	// values are the expected values.
+	keysFound := make(map[string]struct{}, len(keyValues))
+	err := bucket.ForEach(func(k, v []byte) error {
+		kString := string(k)
+		wantV, ok := keyValues[kString]
+		if !ok {
+			return errors.Errorf("ForEach: key '%s' should "+
+				"exist", kString)
+		}
+
+		if !reflect.DeepEqual(v, []byte(wantV)) {
+			return errors.Errorf("ForEach: value for key '%s' "+
+				"does not match - got %s, want %s",
+				kString, v, wantV)
+		}
+
+		keysFound[kString] = struct{}{}
+		return nil
+	})
+	if err != nil {
+		tc.t.Errorf("%v", err)
+		return false
+	}
+
+	// Ensure all keys were iterated.
+	for k := range keyValues {
+		if _, ok := keysFound[k]; !ok {
+			tc.t.Errorf("ForEach: key '%s' was not iterated "+
+				"when it should have been", k)
+			return false
+		}
+	}
+
+	// Delete the keys and ensure they were deleted.
+	if !testDeleteValues(tc, bucket, keyValues) {
+		return false
+	}
+	if !testGetValues(tc, bucket, rollbackValues(keyValues)) {
+		return false
+	}
+
+	// Ensure creating a new bucket works as expected.
+	testBucketName := []byte("testbucket")
+	testBucket, err := bucket.CreateBucket(testBucketName)
+	if err != nil {
+		tc.t.Errorf("CreateBucket: unexpected error: %v", err)
+		return false
+	}
+	if !testNestedBucket(tc, testBucket) {
+		return false
+	}
+
+	// Ensure creating a bucket that already exists fails with the expected
+	// error.
+	if _, err := bucket.CreateBucket(testBucketName); !errors.Is(errors.Exist, err) {
+		tc.t.Errorf("CreateBucket: unexpected error: %v", err)
+		return false
+	}
+
+	// Ensure CreateBucketIfNotExists returns an existing bucket.
+	testBucket, err = bucket.CreateBucketIfNotExists(testBucketName)
+	if err != nil {
+		tc.t.Errorf("CreateBucketIfNotExists: unexpected "+
+			"error: %v", err)
+		return false
+	}
+	if !testNestedBucket(tc, testBucket) {
+		return false
+	}
+
+	// Ensure retrieving an existing bucket works as expected.
+	testBucket = bucket.NestedReadWriteBucket(testBucketName)
+	if !testNestedBucket(tc, testBucket) {
+		return false
+	}
+
+	// Ensure the nested bucket has no value.
+	if v := bucket.Get(testBucketName); v != nil {
+		tc.t.Errorf("Get: nested bucket has value %s", v)
+		return false
+	}
+
+	// Ensure values may not replace the nested bucket.
+	if err := bucket.Put(testBucketName, []byte("fail")); !errors.Is(errors.Invalid, err) {
+		tc.t.Errorf("Put: unexpected error: %v", err)
+		return false
+	}
+	if err := bucket.Delete(testBucketName); !errors.Is(errors.Invalid, err) {
+		tc.t.Errorf("Delete: unexpected error: %v", err)
+		return false
+	}
+
+	// Ensure deleting a bucket works as intended.
+	if err := bucket.DeleteNestedBucket(testBucketName); err != nil {
+		tc.t.Errorf("DeleteNestedBucket: unexpected error: %v", err)
+		return false
+	}
+	if b := bucket.NestedReadWriteBucket(testBucketName); b != nil {
+		tc.t.Errorf("DeleteNestedBucket: bucket '%s' still exists",
+			testBucketName)
+		return false
+	}
+
+	// Ensure deleting a bucket that doesn't exist returns the expected
+	// error.
+	if err := bucket.DeleteNestedBucket(testBucketName); !errors.Is(errors.NotExist, err) {
+		tc.t.Errorf("DeleteNestedBucket: unexpected error: %v", err)
+		return false
+	}
+
+	// Ensure CreateBucketIfNotExists creates a new bucket when it doesn't
+	// already exist.
+	testBucket, err = bucket.CreateBucketIfNotExists(testBucketName)
+	if err != nil {
+		tc.t.Errorf("CreateBucketIfNotExists: unexpected error: %v", err)
+		return false
+	}
+	if !testNestedBucket(tc, testBucket) {
+		return false
+	}
+
+	// Delete the test bucket to avoid leaving it around for future calls.
+	if err := bucket.DeleteNestedBucket(testBucketName); err != nil {
+		tc.t.Errorf("DeleteNestedBucket: unexpected error: %v", err)
+		return false
+	}
+	if b := bucket.NestedReadWriteBucket(testBucketName); b != nil {
+		tc.t.Errorf("DeleteNestedBucket: bucket '%s' still exists",
+			testBucketName)
+		return false
+	}
+
+	// Ensure values may not replace a nested bucket and nested buckets may
+	// not replace values.
+	if err := bucket.Put(testBucketName, []byte("value")); err != nil {
+		tc.t.Errorf("Put: unexpected error: %v", err)
+		return false
+	}
+	if _, err := bucket.CreateBucket(testBucketName); !errors.Is(errors.Invalid, err) {
+		tc.t.Errorf("CreateBucket: unexpected error: %v", err)
+		return false
+	}
+	if _, err := bucket.CreateBucketIfNotExists(testBucketName); !errors.Is(errors.Invalid, err) {
+		tc.t.Errorf("CreateBucketIfNotExists: unexpected error: %v", err)
+		return false
+	}
+	if err := bucket.DeleteNestedBucket(testBucketName); !errors.Is(errors.Invalid, err) {
+		tc.t.Errorf("DeleteNestedBucket: unexpected error: %v", err)
+		return false
+	}
+	if err := bucket.Delete(testBucketName); err != nil {
+		tc.t.Errorf("Delete: unexpected error: %v", err)
+		return false
+	}
+
+	return testCursorInterface(tc, bucket)
 }
 
-// testManualTxInterface ensures that manual transactions work as expected.
-func testManualTxInterface(tc *testContext, namespace walletdb.Namespace) bool {
+// testTxInterface ensures that manual transactions work as expected for the
+// top level bucket with the provided key.
+func testTxInterface(tc *testContext, bucketKey []byte) bool {
 	// populateValues tests that populating values works as expected.
 	//
 	// When the writable flag is false, a read-only tranasction is created,
@@ -307,59 +439,79 @@ func testManualTxInterface(tc *testContext, namespace walletdb.Namespace) bool {
 	// performed, and then the transaction is either commited or rolled
 	// back depending on the flag.
 	populateValues := func(writable, rollback bool, putValues map[string]string) bool {
-		tx, err := namespace.Begin(writable)
+		var tx walletdb.ReadTx
+		var bucket walletdb.ReadWriteBucket
+		var err error
+		if writable {
+			var rwtx walletdb.ReadWriteTx
+			rwtx, err = tc.db.BeginReadWriteTx()
+			if err == nil {
+				tx = rwtx
+				bucket = rwtx.ReadWriteBucket(bucketKey)
+			}
+		} else {
+			tx, err = tc.db.BeginReadTx()
+			if err == nil {
+				// Read-only buckets are checked to fail writes
+				// when the driver's buckets implement them.
+				bucket, _ = tx.ReadBucket(bucketKey).(walletdb.ReadWriteBucket)
+			}
+		}
 		if err != nil {
 			tc.t.Errorf("Begin: unexpected error %v", err)
 			return false
 		}
-
-		rootBucket := tx.RootBucket()
-		if rootBucket == nil {
-			tc.t.Errorf("RootBucket: unexpected nil root bucket")
+		if bucket == nil {
+			tc.t.Errorf("Bucket: unexpected nil bucket")
 			_ = tx.Rollback()
 			return false
 		}
 
 		tc.isWritable = writable
-		if !testBucketInterface(tc, rootBucket) {
+		if !testBucketInterface(tc, bucket) {
 			_ = tx.Rollback()
 			return false
 		}
 
 		if !writable {
-			// The transaction is not writable, so it should fail
-			// the commit.
-			if err := tx.Commit(); !errors.Is(errors.Invalid, err) {
-				tc.t.Errorf("Commit: unexpected error: %v", err)
-				_ = tx.Rollback()
+			// The transaction is not writable, so it should fail the
+			// commit.
+			type committer interface {
+				Commit() error
+			}
+			if c, ok := tx.(committer); ok {
+				if err := c.Commit(); !errors.Is(errors.Invalid, err) {
+					tc.t.Errorf("Commit: unexpected error: %v", err)
+					_ = tx.Rollback()
+					return false
+				}
+			}
+
+			// Rollback the transaction.
+			if err := tx.Rollback(); err != nil {
+				tc.t.Errorf("Rollback: unexpected error %v", err)
 				return false
 			}
+			return true
+		}
 
+		if !testPutValues(tc, bucket, putValues) {
+			_ = tx.Rollback()
+			return false
+		}
+
+		if rollback {
 			// Rollback the transaction.
 			if err := tx.Rollback(); err != nil {
 				tc.t.Errorf("Rollback: unexpected error %v", err)
 				return false
 			}
 		} else {
-			if !testPutValues(tc, rootBucket, putValues) {
+			// The commit should succeed.
+			if err := tx.(walletdb.ReadWriteTx).Commit(); err != nil {
+				tc.t.Errorf("Commit: unexpected error %v", err)
 				return false
 			}
-
-			if rollback {
-				// Rollback the transaction.
-				if err := tx.Rollback(); err != nil {
-					tc.t.Errorf("Rollback: unexpected "+
-						"error %v", err)
-					return false
-				}
-			} else {
-				// The commit should succeed.
-				if err := tx.Commit(); err != nil {
-					tc.t.Errorf("Commit: unexpected error "+
-						"%v", err)
-					return false
-				}
-			}
 		}
 
 		return true
@@ -369,70 +521,48 @@ func testManualTxInterface(tc *testContext, namespace walletdb.Namespace) bool {
 	// the key/value pairs specified in the expectedValues parameter match
 	// what's in the database.
 	checkValues := func(expectedValues map[string]string) bool {
-		// Begin another read-only transaction to ensure...
-		tx, err := namespace.Begin(false)
+		err := walletdb.View(tc.db, func(tx walletdb.ReadTx) error {
+			bucket := tx.ReadBucket(bucketKey)
+			if bucket == nil {
+				return errors.Errorf("ReadBucket: unexpected nil bucket")
+			}
+			if !testGetValues(tc, bucket, expectedValues) {
+				return errors.Errorf("unexpected values")
+			}
+			return nil
+		})
 		if err != nil {
-			tc.t.Errorf("Begin: unexpected error %v", err)
+			tc.t.Errorf("View: %v", err)
 			return false
 		}
-
-		rootBucket := tx.RootBucket()
-		if rootBucket == nil {
-			tc.t.Errorf("RootBucket: unexpected nil root bucket")
-			_ = tx.Rollback()
-			return false
-		}
-
-		if !testGetValues(tc, rootBucket, expectedValues) {
-			_ = tx.Rollback()
-			return false
-		}
-
-		// Rollback the read-only transaction.
-		if err := tx.Rollback(); err != nil {
-			tc.t.Errorf("Commit: unexpected error %v", err)
-			return false
-		}
-
 		return true
 	}
 
-	// deleteValues starts a read-write transaction and deletes the keys
-	// in the passed key/value pairs.
+	// deleteValues starts a read-write transaction and deletes the keys in
+	// the passed key/value pairs.
 	deleteValues := func(values map[string]string) bool {
-		tx, err := namespace.Begin(true)
-		if err != nil {
-
-		}
-
-		rootBucket := tx.RootBucket()
-		if rootBucket == nil {
-			tc.t.Errorf("RootBucket: unexpected nil root bucket")
-			_ = tx.Rollback()
-			return false
-		}
-
-		// Delete the keys and ensure they were deleted.
-		if !testDeleteValues(tc, rootBucket, values) {
-			_ = tx.Rollback()
-			return false
-		}
-		if !testGetValues(tc, rootBucket, rollbackValues(values)) {
-			_ = tx.Rollback()
-			return false
-		}
+		err := walletdb.Update(tc.db, func(tx walletdb.ReadWriteTx) error {
+			bucket := tx.ReadWriteBucket(bucketKey)
+			if bucket == nil {
+				return errors.Errorf("ReadWriteBucket: unexpected nil bucket")
+			}
 
-		// Commit the changes and ensure it was successful.
-		if err := tx.Commit(); err != nil {
-			tc.t.Errorf("Commit: unexpected error %v", err)
+			// Delete the keys and ensure they were deleted.
+			if !testDeleteValues(tc, bucket, values) ||
+				!testGetValues(tc, bucket, rollbackValues(values)) {
+				return errors.Errorf("unexpected values")
+			}
+			return nil
+		})
+		if err != nil {
+			tc.t.Errorf("Update: %v", err)
 			return false
 		}
-
 		return true
 	}
 
-	// keyValues holds the keys and values to use when putting values
-	// into a bucket.
+	// keyValues holds the keys and values to use when putting values into a
+	// bucket.
 	var keyValues = map[string]string{
 		"umtxkey1": "foo1",
 		"umtxkey2": "foo2",
@@ -467,284 +597,114 @@ func testManualTxInterface(tc *testContext, namespace walletdb.Namespace) bool {
 	}
 
 	// Clean up the keys.
-	if !deleteValues(keyValues) {
-		return false
-	}
-
-	return true
+	return deleteValues(keyValues)
 }
 
-// testNamespaceAndTxInterfaces creates a namespace using the provided key and
-// tests all facets of it interface as well as  transaction and bucket
-// interfaces under it.
-func testNamespaceAndTxInterfaces(tc *testContext, namespaceKey string) bool {
-	namespaceKeyBytes := []byte(namespaceKey)
-	namespace, err := tc.db.Namespace(namespaceKeyBytes)
-	if err != nil {
-		tc.t.Errorf("Namespace: unexpected error: %v", err)
-		return false
-	}
-	defer func() {
-		// Remove the namespace now that the tests are done for it.
-		if err := tc.db.DeleteNamespace(namespaceKeyBytes); err != nil {
-			tc.t.Errorf("DeleteNamespace: unexpected error: %v", err)
-			return
-		}
-	}()
-
-	if !testManualTxInterface(tc, namespace) {
-		return false
-	}
-
-	// keyValues holds the keys and values to use when putting values
-	// into a bucket.
-	var keyValues = map[string]string{
-		"mtxkey1": "foo1",
-		"mtxkey2": "foo2",
-		"mtxkey3": "foo3",
-	}
-
-	// Test the bucket interface via a managed read-only transaction.
-	err = namespace.View(func(tx walletdb.Tx) error {
-		rootBucket := tx.RootBucket()
-		if rootBucket == nil {
-			return errors.Errorf("RootBucket: unexpected nil root bucket")
-		}
-
-		tc.isWritable = false
-		if !testBucketInterface(tc, rootBucket) {
-			return subTestFailError
-		}
-
-		return nil
+// testTopLevelBucketInterface creates a top level bucket using the provided key
+// and tests the transaction and bucket interfaces under it.
+func testTopLevelBucketInterface(tc *testContext, bucketKey []byte) bool {
+	err := walletdb.Update(tc.db, func(tx walletdb.ReadWriteTx) error {
+		_, err := tx.CreateTopLevelBucket(bucketKey)
+		return err
 	})
 	if err != nil {
-		if err != subTestFailError {
-			tc.t.Errorf("%v", err)
-		}
-		return false
-	}
-
-	// Ensure errors returned from the user-supplied View function are
-	// returned.
-	viewError := errors.Errorf("example view error")
-	err = namespace.View(func(tx walletdb.Tx) error {
-		return viewError
-	})
-	if err != viewError {
-		tc.t.Errorf("View: inner function error not returned - got "+
-			"%v, want %v", err, viewError)
+		tc.t.Errorf("CreateTopLevelBucket: unexpected error: %v", err)
 		return false
 	}
 
-	// Test the bucket interface via a managed read-write transaction.
-	// Also, put a series of values and force a rollback so the following
-	// code can ensure the values were not stored.
-	forceRollbackError := errors.Errorf("force rollback")
-	err = namespace.Update(func(tx walletdb.Tx) error {
-		rootBucket := tx.RootBucket()
-		if rootBucket == nil {
-			return errors.Errorf("RootBucket: unexpected nil root bucket")
-		}
-
-		tc.isWritable = true
-		if !testBucketInterface(tc, rootBucket) {
-			return subTestFailError
-		}
-
-		if !testPutValues(tc, rootBucket, keyValues) {
-			return subTestFailError
-		}
-
-		// Return an error to force a rollback.
-		return forceRollbackError
-	})
-	if err != forceRollbackError {
-		if err == subTestFailError {
-			return false
-		}
-
-		tc.t.Errorf("Update: inner function error not returned - got "+
-			"%v, want %v", err, forceRollbackError)
-		return false
-	}
-
-	// Ensure the values that should have not been stored due to the forced
-	// rollback above were not actually stored.
-	err = namespace.View(func(tx walletdb.Tx) error {
-		rootBucket := tx.RootBucket()
-		if rootBucket == nil {
-			return errors.Errorf("RootBucket: unexpected nil root bucket")
+	// Ensure the new bucket is iterated by ForEachBucket.
+	err = walletdb.View(tc.db, func(tx walletdb.ReadTx) error {
+		found := false
+		err := tx.ForEachBucket(func(key []byte) error {
+			if bytes.Equal(key, bucketKey) {
+				found = true
+			}
+			return nil
+		})
+		if err != nil {
+			return errors.Errorf("ForEachBucket: unexpected error: %v", err)
 		}
-
-		if !testGetValues(tc, rootBucket, rollbackValues(keyValues)) {
-			return subTestFailError
+		if !found {
+			return errors.Errorf("ForEachBucket: bucket '%s' not found", bucketKey)
 		}
-
 		return nil
 	})
 	if err != nil {
-		if err != subTestFailError {
-			tc.t.Errorf("%v", err)
-		}
+		tc.t.Errorf("%v", err)
 		return false
 	}
 
-	// Store a series of values via a managed read-write transaction.
-	err = namespace.Update(func(tx walletdb.Tx) error {
-		rootBucket := tx.RootBucket()
-		if rootBucket == nil {
-			return errors.Errorf("RootBucket: unexpected nil root bucket")
-		}
-
-		if !testPutValues(tc, rootBucket, keyValues) {
-			return subTestFailError
-		}
-
-		return nil
-	})
-	if err != nil {
-		if err != subTestFailError {
-			tc.t.Errorf("%v", err)
-		}
+	if !testTxInterface(tc, bucketKey) {
 		return false
 	}
 
-	// Ensure the values stored above were committed as expected.
-	err = namespace.View(func(tx walletdb.Tx) error {
-		rootBucket := tx.RootBucket()
-		if rootBucket == nil {
-			return errors.Errorf("RootBucket: unexpected nil root bucket")
+	err = walletdb.Update(tc.db, func(tx walletdb.ReadWriteTx) error {
+		// Ensure creating a top level bucket that already exists fails
+		// with the expected error.
+		if _, err := tx.CreateTopLevelBucket(bucketKey); !errors.Is(errors.Exist, err) {
+			return errors.Errorf("CreateTopLevelBucket: unexpected error: %v", err)
 		}
-
-		if !testGetValues(tc, rootBucket, keyValues) {
-			return subTestFailError
-		}
-
-		return nil
-	})
-	if err != nil {
-		if err != subTestFailError {
-			tc.t.Errorf("%v", err)
+		if err := tx.DeleteTopLevelBucket(bucketKey); err != nil {
+			return errors.Errorf("DeleteTopLevelBucket: unexpected error: %v", err)
 		}
-		return false
-	}
-
-	// Clean up the values stored above in a managed read-write transaction.
-	err = namespace.Update(func(tx walletdb.Tx) error {
-		rootBucket := tx.RootBucket()
-		if rootBucket == nil {
-			return errors.Errorf("RootBucket: unexpected nil root bucket")
+		if tx.ReadWriteBucket(bucketKey) != nil {
+			return errors.Errorf("DeleteTopLevelBucket: bucket '%s' still exists", bucketKey)
 		}
-
-		if !testDeleteValues(tc, rootBucket, keyValues) {
-			return subTestFailError
+		// Ensure deleting a top level bucket that doesn't exist returns
+		// the expected error.
+		if err := tx.DeleteTopLevelBucket(bucketKey); !errors.Is(errors.NotExist, err) {
+			return errors.Errorf("DeleteTopLevelBucket: unexpected error: %v", err)
 		}
-
 		return nil
 	})
 	if err != nil {
-		if err != subTestFailError {
-			tc.t.Errorf("%v", err)
-		}
+		tc.t.Errorf("%v", err)
 		return false
 	}
 
 	return true
 }
 
-// testAdditionalErrors performs some tests for error cases not covered
-// elsewhere in the tests and therefore improves negative test coverage.
+// testAdditionalErrors checks a few more error conditions not covered
+// elsewhere.
 func testAdditionalErrors(tc *testContext) bool {
-	// Create a new namespace and then intentionally delete the namespace
-	// bucket out from under it to force errors.
-	ns3Key := []byte("ns3")
-	ns3, err := tc.db.Namespace(ns3Key)
-	if err != nil {
-		tc.t.Errorf("Namespace: unexpected error: %v", err)
-		return false
-	}
-	if err := tc.db.DeleteNamespace(ns3Key); err != nil {
-		tc.t.Errorf("DeleteNamespace: unexpected error: %v", err)
-		return false
-	}
-
-	// Ensure Begin fails when the namespace bucket does not exist.
-	if _, err := ns3.Begin(false); !errors.Is(errors.NotExist, err) {
-		tc.t.Errorf("Begin: unexpected error: %v", err)
-		return false
-	}
-
-	// Ensure View fails when the namespace bucket does not exist.
-	err = ns3.View(func(tx walletdb.Tx) error {
-		return nil
-	})
-	if !errors.Is(errors.NotExist, err) {
-		tc.t.Errorf("View: unexpected error: %v", err)
-		return false
-	}
-
-	// Ensure Update fails when the namespace bucket does not exist.
-	err = ns3.Update(func(tx walletdb.Tx) error {
-		return nil
-	})
-	if !errors.Is(errors.NotExist, err) {
-		tc.t.Errorf("Update: unexpected error: %v", err)
-		return false
-	}
-
-	// Recreate the namespace to bring the bucket back.
-	ns3, err = tc.db.Namespace(ns3Key)
-	if err != nil {
-		tc.t.Errorf("Namespace: unexpected error: %v", err)
-		return false
-	}
-	defer func() {
-		// Remove the namespace now that the tests are done for it.
-		if err := tc.db.DeleteNamespace(ns3Key); err != nil {
-			tc.t.Errorf("DeleteNamespace: unexpected error: %v", err)
-			return
-		}
-	}()
-
-	err = ns3.Update(func(tx walletdb.Tx) error {
-		rootBucket := tx.RootBucket()
-		if rootBucket == nil {
-			return errors.Errorf("RootBucket: unexpected nil root bucket")
+	bucketKey := []byte("errors")
+	err := walletdb.Update(tc.db, func(tx walletdb.ReadWriteTx) error {
+		bucket, err := tx.CreateTopLevelBucket(bucketKey)
+		if err != nil {
+			return err
 		}
 
 		// Ensure CreateBucket returns the expected error when no bucket
 		// key is specified.
-		if _, err := rootBucket.CreateBucket(nil); !errors.Is(errors.Invalid, err) {
+		if _, err := bucket.CreateBucket(nil); !errors.Is(errors.Invalid, err) {
 			return errors.Errorf("CreateBucket: unexpected error: %v", err)
 		}
 
-		// Ensure DeleteBucket returns the expected error when no bucket
-		// key is specified.
-		if err := rootBucket.DeleteBucket(nil); !errors.Is(errors.Invalid, err) {
-			return errors.Errorf("DeleteBucket: unexpected error: %v", err)
+		// Ensure DeleteNestedBucket errors when no bucket key is
+		// specified.
+		if err := bucket.DeleteNestedBucket(nil); err == nil {
+			return errors.Errorf("DeleteNestedBucket: unexpected success")
 		}
 
 		// Ensure Put returns the expected error when no key is
 		// specified.
-		if err := rootBucket.Put(nil, nil); !errors.Is(errors.Invalid, err) {
+		if err := bucket.Put(nil, nil); !errors.Is(errors.Invalid, err) {
 			return errors.Errorf("Put: unexpected error: %v", err)
 		}
 
-		return nil
+		return tx.DeleteTopLevelBucket(bucketKey)
 	})
 	if err != nil {
-		if err != subTestFailError {
-			tc.t.Errorf("%v", err)
-		}
+		tc.t.Errorf("%v", err)
 		return false
 	}
 
 	// Ensure that attempting to rollback or commit a transaction that is
 	// already closed returns the expected error.
-	tx, err := ns3.Begin(false)
+	tx, err := tc.db.BeginReadWriteTx()
 	if err != nil {
-		tc.t.Errorf("Begin: unexpected error: %v", err)
+		tc.t.Errorf("BeginReadWriteTx: unexpected error: %v", err)
 		return false
 	}
 	if err := tx.Rollback(); err != nil {
@@ -755,9 +715,8 @@ func testAdditionalErrors(tc *testContext) bool {
 		tc.t.Errorf("Rollback: unexpected error: %v", err)
 		return false
 	}
-	if err := tx.Commit(); err != wantErr {
-		tc.t.Errorf("Commit: unexpected error - got %v, want %v", err,
-			wantErr)
+	if err := tx.Commit(); !errors.Is(errors.Invalid, err) {
+		tc.t.Errorf("Commit: unexpected error: %v", err)
 		return false
 	}
 
@@ -770,18 +729,45 @@ func testInterface(t *testing.T, db walletdb.DB) {
 	// Create a test context to pass around.
 	context := testContext{t: t, db: db}
 
-	// Create a namespace and test the interface for it.
-	if !testNamespaceAndTxInterfaces(&context, "ns1") {
+	// Create a top level bucket and test the interface for it.
+	if !testTopLevelBucketInterface(&context, []byte("bucket1")) {
 		return
 	}
 
-	// Create a second namespace and test the interface for it.
-	if !testNamespaceAndTxInterfaces(&context, "ns2") {
+	// Create a second top level bucket and test the interface for it.
+	if !testTopLevelBucketInterface(&context, []byte("bucket2")) {
 		return
 	}
 
 	// Check a few more error conditions not covered elsewhere.
-	if !testAdditionalErrors(&context) {
-		return
+	testAdditionalErrors(&context)
+}
+
+// TestInterface performs all interfaces tests for each database driver.
+func TestInterface(t *testing.T) {
+	dir, err := ioutil.TempDir("", "walletdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	drivers := []struct {
+		dbType string
+		args   []interface{}
+	}{
+		{"bdb", []interface{}{filepath.Join(dir, "interfacetest.db")}},
+		{"memdb", nil},
+	}
+	for _, d := range drivers {
+		t.Run(d.dbType, func(t *testing.T) {
+			db, err := walletdb.Create(d.dbType, d.args...)
+			if err != nil {
+				t.Fatalf("Failed to create test database (%s) %v", d.dbType, err)
+			}
+			defer db.Close()
+
+			// Run all of the interface tests against the database.
+			testInterface(t, db)
+		})
 	}
 }
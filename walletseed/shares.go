@@ -0,0 +1,278 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package walletseed
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/binary"
+	"io"
+	"strings"
+
+	"github.com/fonero-project/fnod/hdkeychain"
+	"github.com/fonero-project/fnowallet/errors"
+	"github.com/fonero-project/fnowallet/internal/zero"
+	"github.com/fonero-project/fnowallet/pgpwordlist"
+)
+
+// Seeds are split into shares using Shamir's secret sharing over GF(256),
+// similar to SLIP-0039.  Any threshold number of shares of a split recover the
+// seed, while fewer shares reveal nothing about it.  A digest of the seed is
+// shared together with the seed so that combining shares which do not belong
+// together is detected once enough shares are provided.
+//
+// The binary encoding of a share is as follows:
+//
+//   <version><group><threshold><count><index><value><checksum>
+//
+// version (1 byte), group (2 bytes), threshold (1 byte), count (1 byte),
+// index (1 byte), value (seed length + 4 bytes), checksum (2 bytes)
+//
+// Shares are written as PGP word lists of this encoding.
+
+const (
+	shareVersion      = 0
+	shareHeaderLen    = 6
+	shareDigestLen    = 4
+	shareChecksumLen  = 2
+	minShareThreshold = 2
+)
+
+// SeedShare is a single share of a seed split by SplitSeed.
+type SeedShare struct {
+	// Group identifies the shares created by the same split.  It is chosen
+	// randomly for each split.
+	Group uint16
+
+	// Threshold is the number of shares required to recover the seed, and
+	// Count is the number of shares created by the split.
+	Threshold uint8
+	Count     uint8
+
+	// Index is the index of the share, from 1 through Count.
+	Index uint8
+
+	// Value is the share of the seed and its digest.
+	Value []byte
+}
+
+// GF(256) arithmetic using the AES reduction polynomial x^8+x^4+x^3+x+1.
+var gfExp [510]byte
+var gfLog [256]byte
+
+func init() {
+	x := byte(1)
+	for i := 0; i < 255; i++ {
+		gfExp[i] = x
+		gfExp[i+255] = x
+		gfLog[x] = byte(i)
+		// Multiply by the generator 3.
+		hi := x & 0x80
+		x2 := x << 1
+		if hi != 0 {
+			x2 ^= 0x1b
+		}
+		x ^= x2
+	}
+}
+
+func gfMul(a, b byte) byte {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return gfExp[int(gfLog[a])+int(gfLog[b])]
+}
+
+func gfDiv(a, b byte) byte {
+	if a == 0 {
+		return 0
+	}
+	return gfExp[int(gfLog[a])+255-int(gfLog[b])]
+}
+
+// seedDigest returns the digest of the seed shared with it.
+func seedDigest(seed []byte) []byte {
+	intermediateHash := sha256.Sum256(seed)
+	h := sha256.Sum256(intermediateHash[:])
+	return h[:shareDigestLen]
+}
+
+// SplitSeed splits a seed into count shares, any threshold of which recover the
+// seed with CombineSeedShares.  The threshold must be at least two and may not
+// exceed count, which may not exceed 255.
+func SplitSeed(seed []byte, threshold, count int) ([]*SeedShare, error) {
+	const op errors.Op = "walletseed.SplitSeed"
+	if len(seed) < hdkeychain.MinSeedBytes || len(seed) > hdkeychain.MaxSeedBytes {
+		return nil, errors.E(op, errors.Invalid, hdkeychain.ErrInvalidSeedLen)
+	}
+	if threshold < minShareThreshold || threshold > count || count > 255 {
+		return nil, errors.E(op, errors.Invalid, errors.Errorf("invalid "+
+			"threshold %d of %d shares", threshold, count))
+	}
+
+	var group [2]byte
+	_, err := io.ReadFull(rand.Reader, group[:])
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	secret := append(append([]byte(nil), seed...), seedDigest(seed)...)
+
+	shares := make([]*SeedShare, count)
+	for i := range shares {
+		shares[i] = &SeedShare{
+			Group:     binary.BigEndian.Uint16(group[:]),
+			Threshold: uint8(threshold),
+			Count:     uint8(count),
+			Index:     uint8(i + 1),
+			Value:     make([]byte, len(secret)),
+		}
+	}
+
+	// Each byte of the secret is the constant term of a random polynomial of
+	// degree threshold-1, and each share holds the polynomial evaluated at
+	// its index.
+	coeffs := make([]byte, threshold)
+	defer zero.Bytes(coeffs)
+	for b := range secret {
+		coeffs[0] = secret[b]
+		_, err := io.ReadFull(rand.Reader, coeffs[1:])
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		for _, share := range shares {
+			var y byte
+			for i := len(coeffs) - 1; i >= 0; i-- {
+				y = gfMul(y, share.Index) ^ coeffs[i]
+			}
+			share.Value[b] = y
+		}
+	}
+	zero.Bytes(secret)
+
+	return shares, nil
+}
+
+// CombineSeedShares recovers a seed from the shares of a single split.  At
+// least the threshold number of shares must be provided.
+func CombineSeedShares(shares []*SeedShare) ([]byte, error) {
+	const op errors.Op = "walletseed.CombineSeedShares"
+	if len(shares) == 0 {
+		return nil, errors.E(op, errors.Invalid, "no shares")
+	}
+	first := shares[0]
+	if len(shares) < int(first.Threshold) {
+		return nil, errors.E(op, errors.Invalid, errors.Errorf("%d of %d "+
+			"required shares", len(shares), first.Threshold))
+	}
+	seen := make(map[uint8]bool)
+	for _, s := range shares {
+		if s.Group != first.Group || s.Threshold != first.Threshold ||
+			s.Count != first.Count || len(s.Value) != len(first.Value) {
+			return nil, errors.E(op, errors.Invalid, "shares are from different splits")
+		}
+		if s.Index == 0 || s.Index > s.Count {
+			return nil, errors.E(op, errors.Invalid, errors.Errorf("invalid share index %d", s.Index))
+		}
+		if seen[s.Index] {
+			return nil, errors.E(op, errors.Invalid, errors.Errorf("duplicate share %d", s.Index))
+		}
+		seen[s.Index] = true
+	}
+	shares = shares[:first.Threshold]
+
+	// Interpolate the polynomial of each byte at zero.
+	secret := make([]byte, len(first.Value))
+	for i, si := range shares {
+		// Lagrange basis polynomial of share i evaluated at zero.
+		basis := byte(1)
+		for j, sj := range shares {
+			if i != j {
+				basis = gfMul(basis, gfDiv(sj.Index, sj.Index^si.Index))
+			}
+		}
+		for b := range secret {
+			secret[b] ^= gfMul(basis, si.Value[b])
+		}
+	}
+
+	seed := secret[:len(secret)-shareDigestLen]
+	digest := secret[len(secret)-shareDigestLen:]
+	if subtle.ConstantTimeCompare(seedDigest(seed), digest) != 1 {
+		zero.Bytes(secret)
+		return nil, errors.E(op, errors.Invalid, "shares do not recover a valid seed")
+	}
+	if len(seed) < hdkeychain.MinSeedBytes || len(seed) > hdkeychain.MaxSeedBytes {
+		zero.Bytes(secret)
+		return nil, errors.E(op, errors.Invalid, hdkeychain.ErrInvalidSeedLen)
+	}
+	return seed, nil
+}
+
+func (s *SeedShare) encode() []byte {
+	b := make([]byte, 0, shareHeaderLen+len(s.Value)+shareChecksumLen)
+	b = append(b, shareVersion, byte(s.Group>>8), byte(s.Group),
+		s.Threshold, s.Count, s.Index)
+	b = append(b, s.Value...)
+	checksum := shareChecksum(b)
+	return append(b, checksum[:]...)
+}
+
+func shareChecksum(b []byte) [shareChecksumLen]byte {
+	intermediateHash := sha256.Sum256(b)
+	h := sha256.Sum256(intermediateHash[:])
+	var checksum [shareChecksumLen]byte
+	copy(checksum[:], h[:])
+	return checksum
+}
+
+// EncodeMnemonic encodes a seed share as a mnemonic word list separated by
+// spaces.
+func (s *SeedShare) EncodeMnemonic() string {
+	var buf bytes.Buffer
+	for i, b := range s.encode() {
+		if i != 0 {
+			buf.WriteRune(' ')
+		}
+		buf.WriteString(pgpwordlist.ByteToMnemonic(b, i))
+	}
+	return buf.String()
+}
+
+// DecodeSeedShare decodes a seed share from its mnemonic word list encoding.
+func DecodeSeedShare(input string) (*SeedShare, error) {
+	const op errors.Op = "walletseed.DecodeSeedShare"
+	words := strings.Fields(input)
+	decoded, err := pgpwordlist.DecodeMnemonics(words)
+	if err != nil {
+		return nil, errors.E(op, errors.Encoding, err)
+	}
+	valueLen := len(decoded) - shareHeaderLen - shareChecksumLen
+	if valueLen < hdkeychain.MinSeedBytes+shareDigestLen ||
+		valueLen > hdkeychain.MaxSeedBytes+shareDigestLen {
+		return nil, errors.E(op, errors.Encoding, "invalid seed share length")
+	}
+	data := decoded[:len(decoded)-shareChecksumLen]
+	checksum := shareChecksum(data)
+	if !bytes.Equal(checksum[:], decoded[len(data):]) {
+		return nil, errors.E(op, errors.Encoding, "checksum mismatch")
+	}
+	if data[0] != shareVersion {
+		return nil, errors.E(op, errors.Encoding, errors.Errorf("unknown seed share version %d", data[0]))
+	}
+	s := &SeedShare{
+		Group:     binary.BigEndian.Uint16(data[1:3]),
+		Threshold: data[3],
+		Count:     data[4],
+		Index:     data[5],
+		Value:     data[shareHeaderLen:],
+	}
+	if s.Threshold < minShareThreshold || s.Threshold > s.Count ||
+		s.Index == 0 || s.Index > s.Count {
+		return nil, errors.E(op, errors.Encoding, "invalid seed share metadata")
+	}
+	return s, nil
+}
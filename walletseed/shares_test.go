@@ -0,0 +1,94 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package walletseed
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/fonero-project/fnowallet/errors"
+)
+
+func TestSeedShares(t *testing.T) {
+	seed := bytes.Repeat([]byte{0x5a, 0xc3, 0x01}, 11)[:32]
+	shares, err := SplitSeed(seed, 3, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	subsets := [][]int{{0, 1, 2}, {4, 2, 0}, {1, 3, 4, 0}, {0, 1, 2, 3, 4}}
+	for i, subset := range subsets {
+		var decoded []*SeedShare
+		for _, s := range subset {
+			share, err := DecodeSeedShare(shares[s].EncodeMnemonic())
+			if err != nil {
+				t.Fatalf("test %d: decode share %d: %v", i, s, err)
+			}
+			decoded = append(decoded, share)
+		}
+		combined, err := CombineSeedShares(decoded)
+		if err != nil {
+			t.Errorf("test %d: error: %v", i, err)
+			continue
+		}
+		if !bytes.Equal(combined, seed) {
+			t.Errorf("test %d: got %x want %x", i, combined, seed)
+		}
+	}
+
+	_, err = CombineSeedShares(shares[:2])
+	if !errors.Is(errors.Invalid, err) {
+		t.Errorf("combined fewer than threshold shares: %v", err)
+	}
+	_, err = CombineSeedShares([]*SeedShare{shares[0], shares[0], shares[1]})
+	if !errors.Is(errors.Invalid, err) {
+		t.Errorf("combined duplicate shares: %v", err)
+	}
+
+	// Shares of another split of the same seed may not be mixed.
+	other, err := SplitSeed(seed, 3, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other[2].Group = shares[0].Group
+	_, err = CombineSeedShares([]*SeedShare{shares[0], shares[1], other[2]})
+	if !errors.Is(errors.Invalid, err) {
+		t.Errorf("combined shares of different splits: %v", err)
+	}
+}
+
+func TestDecodeSeedShareChecksum(t *testing.T) {
+	shares, err := SplitSeed(bytes.Repeat([]byte{1}, 32), 2, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	words := strings.Fields(shares[0].EncodeMnemonic())
+	// Replace a word with another word valid at the same position.
+	words[10], words[12] = words[12], words[10]
+	if words[10] == words[12] {
+		t.Skip("swapped words are equal")
+	}
+	_, err = DecodeSeedShare(strings.Join(words, " "))
+	if !errors.Is(errors.Encoding, err) {
+		t.Errorf("corrupted share decoded: %v", err)
+	}
+}
+
+func TestSplitSeedThreshold(t *testing.T) {
+	seed := bytes.Repeat([]byte{1}, 32)
+	tests := []struct{ threshold, count int }{
+		{1, 3},
+		{4, 3},
+		{2, 256},
+	}
+	for i, test := range tests {
+		_, err := SplitSeed(seed, test.threshold, test.count)
+		if !errors.Is(errors.Invalid, err) {
+			t.Errorf("test %d: split %d of %d: %v", i, test.threshold,
+				test.count, err)
+		}
+	}
+}
@@ -13,15 +13,19 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/fonero-project/fnod/chaincfg"
 	"github.com/fonero-project/fnod/hdkeychain"
 	"github.com/fonero-project/fnod/wire"
 	"github.com/fonero-project/fnowallet/errors"
 	"github.com/fonero-project/fnowallet/internal/prompt"
+	"github.com/fonero-project/fnowallet/internal/zero"
 	"github.com/fonero-project/fnowallet/loader"
 	"github.com/fonero-project/fnowallet/wallet"
 	_ "github.com/fonero-project/fnowallet/wallet/drivers/bdb"
+	_ "github.com/fonero-project/fnowallet/wallet/drivers/memdb"
+	"github.com/fonero-project/fnowallet/wallet/udb"
 	"github.com/fonero-project/fnowallet/walletseed"
 )
 
@@ -55,14 +59,21 @@ func createWallet(ctx context.Context, cfg *config) error {
 	loader := loader.NewLoader(activeNet.Params, dbDir, stakeOptions,
 		cfg.GapLimit, cfg.AllowHighFees, cfg.RelayFee.ToCoin(), cfg.AccountGapLimit)
 
+	// The current time is recorded as the birthday of wallets created from a
+	// new seed, while the birthday of restored wallets is prompted for, so
+	// blocks preceding it are not searched.
 	var privPass, pubPass, seed []byte
 	var imported bool
+	birthday := &udb.Birthday{Time: time.Now()}
 	var err error
 	c := make(chan struct{}, 1)
 	go func() {
 		reader := bufio.NewReader(os.Stdin)
 		privPass, pubPass, seed, imported, err = prompt.Setup(reader,
 			[]byte(wallet.InsecurePubPassphrase), []byte(cfg.WalletPass))
+		if err == nil && imported {
+			birthday, err = prompt.Birthday(reader)
+		}
 		c <- struct{}{}
 	}()
 	select {
@@ -75,7 +86,7 @@ func createWallet(ctx context.Context, cfg *config) error {
 	}
 
 	fmt.Println("Creating the wallet...")
-	w, err := loader.CreateNewWallet(pubPass, privPass, seed)
+	w, err := loader.CreateNewWallet(pubPass, privPass, seed, birthday)
 	if err != nil {
 		return err
 	}
@@ -117,6 +128,56 @@ func createWallet(ctx context.Context, cfg *config) error {
 	return nil
 }
 
+// verifySeed prompts the user for a seed and reports whether it is the seed of
+// the existing wallet.  The wallet is not unlocked, and nothing derived from
+// the seed is saved.
+func verifySeed(ctx context.Context, cfg *config) error {
+	dbDir := networkDir(cfg.AppDataDir.Value, activeNet.Params)
+	loader := loader.NewLoader(activeNet.Params, dbDir, new(loader.StakeOptions),
+		cfg.GapLimit, cfg.AllowHighFees, cfg.RelayFee.ToCoin(), cfg.AccountGapLimit)
+
+	var pubPass, seed []byte
+	var err error
+	c := make(chan struct{}, 1)
+	go func() {
+		defer func() { c <- struct{}{} }()
+		reader := bufio.NewReader(os.Stdin)
+		pubPass = []byte(cfg.WalletPass)
+		if cfg.PromptPublicPass {
+			pubPass, err = prompt.PassPrompt(reader, "Enter public wallet passphrase", false)
+			if err != nil {
+				return
+			}
+		}
+		seed, err = prompt.ExistingSeed(reader)
+	}()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-c:
+		if err != nil {
+			return err
+		}
+	}
+	defer zero.Bytes(seed)
+
+	w, err := loader.OpenExistingWallet(pubPass)
+	if err != nil {
+		return err
+	}
+	defer loader.UnloadWallet()
+
+	match, err := w.VerifySeed(seed)
+	if err != nil {
+		return err
+	}
+	if !match {
+		return errors.New("the seed does not match the wallet")
+	}
+	fmt.Println("The seed matches the wallet.")
+	return nil
+}
+
 // createSimulationWallet is intended to be called from the rpcclient
 // and used to create a wallet for actors involved in simulations.
 func createSimulationWallet(cfg *config) error {
@@ -146,15 +207,17 @@ func createSimulationWallet(cfg *config) error {
 	dbPath := filepath.Join(netDir, walletDbName)
 	fmt.Println("Creating the wallet...")
 
-	// Create the wallet database backed by bolt db.
-	db, err := wallet.CreateDB("bdb", dbPath)
+	// Create the wallet database in memory.  It is opened again by the
+	// loader using the same driver, and is lost when the process exits.
+	db, err := wallet.CreateDB("memdb", dbPath)
 	if err != nil {
 		return err
 	}
 	defer db.Close()
+	cfg.dbDriver = "memdb"
 
 	// Create the wallet.
-	err = wallet.Create(db, pubPass, privPass, seed, activeNet.Params)
+	err = wallet.Create(db, pubPass, privPass, seed, nil, activeNet.Params)
 	if err != nil {
 		return err
 	}
@@ -195,6 +258,11 @@ func createWatchingOnlyWallet(cfg *config) error {
 		return err
 	}
 
+	birthday, err := prompt.Birthday(reader)
+	if err != nil {
+		return err
+	}
+
 	netDir := networkDir(cfg.AppDataDir.Value, activeNet.Params)
 
 	// Create the wallet.
@@ -208,7 +276,7 @@ func createWatchingOnlyWallet(cfg *config) error {
 	}
 	defer db.Close()
 
-	err = wallet.CreateWatchOnly(db, pubKeyString, pubPass, activeNet.Params)
+	err = wallet.CreateWatchOnly(db, pubKeyString, pubPass, birthday, activeNet.Params)
 	if err != nil {
 		errOS := os.Remove(dbPath)
 		if errOS != nil {